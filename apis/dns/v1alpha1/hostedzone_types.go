@@ -0,0 +1,129 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Tag defines a tag
+type Tag struct {
+	// Key is the name of the tag.
+	Key string `json:"key"`
+
+	// Value is the value of the tag.
+	Value string `json:"value"`
+}
+
+// HostedZoneParameters define the desired state of an AWS Route53 Hosted
+// Zone.
+type HostedZoneParameters struct {
+	// Name is the name of the domain, e.g. example.com.
+	// +immutable
+	Name string `json:"name"`
+
+	// Comment is any comment that you want to include about the hosted zone.
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+
+	// DelegationSetID is the ID of a reusable delegation set whose name
+	// servers are assigned to the hosted zone. It can only be used with
+	// public hosted zones.
+	// +immutable
+	// +optional
+	DelegationSetID *string `json:"delegationSetId,omitempty"`
+
+	// VPCIDs are the IDs of the VPCs to associate with the hosted zone. A
+	// hosted zone that is associated with at least one VPC is a private hosted
+	// zone; one without VPCs is a public hosted zone. The VPCs must be in the
+	// region of the provider.
+	// +optional
+	VPCIDs []string `json:"vpcIds,omitempty"`
+
+	// VPCIDRefs is a set of references that each retrieve the vpcId from the
+	// referenced VPC
+	// +optional
+	VPCIDRefs []runtimev1alpha1.Reference `json:"vpcIdRefs,omitempty"`
+
+	// VPCIDSelector selects a set of references that each retrieve the vpcId
+	// from the referenced VPC
+	// +optional
+	VPCIDSelector *runtimev1alpha1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// Tags represents the tags of the hosted zone.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A HostedZoneSpec defines the desired state of a HostedZone.
+type HostedZoneSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  HostedZoneParameters `json:"forProvider"`
+}
+
+// HostedZoneObservation keeps the state for the external resource
+type HostedZoneObservation struct {
+	// PrivateZone indicates whether the hosted zone is private.
+	PrivateZone bool `json:"privateZone,omitempty"`
+
+	// NameServers are the name servers that Route53 assigned to the hosted
+	// zone. They are only returned for public hosted zones.
+	NameServers []string `json:"nameServers,omitempty"`
+
+	// ResourceRecordSetCount is the number of resource record sets in the
+	// hosted zone.
+	ResourceRecordSetCount int64 `json:"resourceRecordSetCount,omitempty"`
+
+	// CallerReference is the unique string that identified the request that
+	// created the hosted zone.
+	CallerReference string `json:"callerReference,omitempty"`
+}
+
+// A HostedZoneStatus represents the observed state of a HostedZone.
+type HostedZoneStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     HostedZoneObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A HostedZone is a managed resource that represents an AWS Route53 Hosted
+// Zone.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DOMAIN",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type HostedZone struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   HostedZoneSpec   `json:"spec"`
+	Status HostedZoneStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// HostedZoneList contains a list of HostedZones
+type HostedZoneList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HostedZone `json:"items"`
+}
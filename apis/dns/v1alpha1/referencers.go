@@ -0,0 +1,188 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/network/v1alpha3"
+)
+
+// managedGroupSuffix is the suffix of the API groups of the managed resources
+// of this provider. Values can only be selected from these resources.
+const managedGroupSuffix = ".aws.crossplane.io"
+
+// publicConnectionSecretKeys are the keys of a connection secret that may be
+// selected. Other keys, e.g. password, may hold credentials that must never be
+// published in DNS.
+var publicConnectionSecretKeys = map[string]bool{
+	runtimev1alpha1.ResourceCredentialsSecretEndpointKey: true,
+	"address": true,
+	runtimev1alpha1.ResourceCredentialsSecretPortKey: true,
+}
+
+const (
+	errFmtSecretKey        = "connection secret key %q cannot be selected; only endpoint, address and port can"
+	errNoValueSource       = "neither a connection secret key nor a field is selected"
+	errFmtNotManaged       = "%s is not a managed resource of this provider"
+	errNoConnectionSecret  = "selected resource does not write a connection secret"
+	errGetConnectionSecret = "cannot get the connection secret of the selected resource"
+	errGetResource         = "cannot get the selected resource"
+	errGetField            = "cannot get the selected field"
+	errEmptyValue          = "selected value was empty (referenced resource may not yet be ready)"
+	errFmtResolveValue     = "cannot resolve the value of resource record %d"
+)
+
+// ResolveReferences of this HostedZone
+func (mg *HostedZone) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.vpcIds
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.VPCIDs,
+		References:    mg.Spec.ForProvider.VPCIDRefs,
+		Selector:      mg.Spec.ForProvider.VPCIDSelector,
+		To:            reference.To{Managed: &v1alpha3.VPC{}, List: &v1alpha3.VPCList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.VPCIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.VPCIDRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this ResourceRecordSet
+func (mg *ResourceRecordSet) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.hostedZoneId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.HostedZoneID),
+		Reference:    mg.Spec.ForProvider.HostedZoneIDRef,
+		Selector:     mg.Spec.ForProvider.HostedZoneIDSelector,
+		To:           reference.To{Managed: &HostedZone{}, List: &HostedZoneList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.HostedZoneID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.HostedZoneIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.resourceRecords[].value. Unlike references,
+	// values are resolved again on every reconcile so that the record set
+	// follows changes of the selected resource, e.g. a new endpoint.
+	if meta.WasDeleted(mg) {
+		return nil
+	}
+	for i := range mg.Spec.ForProvider.ResourceRecords {
+		rr := &mg.Spec.ForProvider.ResourceRecords[i]
+		if rr.ValueFrom == nil {
+			continue
+		}
+		v, err := resolveValueFrom(ctx, c, *rr.ValueFrom)
+		if err != nil {
+			return errors.Wrapf(err, errFmtResolveValue, i)
+		}
+		rr.Value = reference.ToPtrValue(v)
+	}
+
+	return nil
+}
+
+// getManaged gets the selected managed resource. Only managed resources of
+// this provider can be selected so that the provider's own permissions can't
+// be used to read arbitrary objects.
+func getManaged(ctx context.Context, c client.Reader, sel ManagedResourceSelector) (*unstructured.Unstructured, error) {
+	gv, err := schema.ParseGroupVersion(sel.APIVersion)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetResource)
+	}
+	if !strings.HasSuffix(gv.Group, managedGroupSuffix) {
+		return nil, errors.Errorf(errFmtNotManaged, sel.APIVersion)
+	}
+	u := &unstructured.Unstructured{}
+	u.SetAPIVersion(sel.APIVersion)
+	u.SetKind(sel.Kind)
+	if err := c.Get(ctx, types.NamespacedName{Name: sel.Name}, u); err != nil {
+		return nil, errors.Wrap(err, errGetResource)
+	}
+	return u, nil
+}
+
+// resolveValueFrom returns the value selected by the supplied source.
+func resolveValueFrom(ctx context.Context, c client.Reader, src ResourceRecordValueSource) (string, error) {
+	var v string
+	switch {
+	case src.ConnectionSecretKeyRef != nil:
+		ref := src.ConnectionSecretKeyRef
+		if !publicConnectionSecretKeys[ref.Key] {
+			return "", errors.Errorf(errFmtSecretKey, ref.Key)
+		}
+		u, err := getManaged(ctx, c, ref.ManagedResourceSelector)
+		if err != nil {
+			return "", err
+		}
+		// The secret is read from where the managed resource writes it, never
+		// from a user supplied namespace.
+		p := fieldpath.Pave(u.UnstructuredContent())
+		name, _ := p.GetString("spec.writeConnectionSecretToRef.name")
+		namespace, _ := p.GetString("spec.writeConnectionSecretToRef.namespace")
+		if name == "" || namespace == "" {
+			return "", errors.New(errNoConnectionSecret)
+		}
+		s := &corev1.Secret{}
+		if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, s); err != nil {
+			return "", errors.Wrap(err, errGetConnectionSecret)
+		}
+		v = string(s.Data[ref.Key])
+	case src.FieldRef != nil:
+		ref := src.FieldRef
+		u, err := getManaged(ctx, c, ref.ManagedResourceSelector)
+		if err != nil {
+			return "", err
+		}
+		s, err := fieldpath.Pave(u.UnstructuredContent()).GetString(ref.FieldPath)
+		if resource.Ignore(fieldpath.IsNotFound, err) != nil {
+			return "", errors.Wrap(err, errGetField)
+		}
+		v = s
+	default:
+		return "", errors.New(errNoValueSource)
+	}
+	if v == "" {
+		return "", errors.New(errEmptyValue)
+	}
+	return v, nil
+}
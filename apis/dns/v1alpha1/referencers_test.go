@@ -0,0 +1,130 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+const rdsAPIVersion = "database.aws.crossplane.io/v1beta1"
+
+// getFn serves an RDSInstance that writes its connection secret to
+// crossplane-system/db-conn, and that secret.
+func getFn(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+	switch o := obj.(type) {
+	case *unstructured.Unstructured:
+		o.Object["spec"] = map[string]interface{}{
+			"writeConnectionSecretToRef": map[string]interface{}{"name": "db-conn", "namespace": "crossplane-system"},
+		}
+		o.Object["status"] = map[string]interface{}{
+			"atProvider": map[string]interface{}{"endpoint": map[string]interface{}{"address": "db.aws.com"}},
+		}
+	case *corev1.Secret:
+		if key.Namespace != "crossplane-system" || key.Name != "db-conn" {
+			return errors.New("unexpected secret")
+		}
+		o.Data = map[string][]byte{"endpoint": []byte("db.aws.com"), "password": []byte("secret")}
+	}
+	return nil
+}
+
+func TestResolveValueFrom(t *testing.T) {
+	rds := ManagedResourceSelector{APIVersion: rdsAPIVersion, Kind: "RDSInstance", Name: "db"}
+
+	cases := map[string]struct {
+		src     ResourceRecordValueSource
+		want    string
+		wantErr bool
+	}{
+		"FieldOfManagedResource": {
+			src:  ResourceRecordValueSource{FieldRef: &ResourceFieldSelector{ManagedResourceSelector: rds, FieldPath: "status.atProvider.endpoint.address"}},
+			want: "db.aws.com",
+		},
+		"ConnectionSecretOfManagedResource": {
+			src:  ResourceRecordValueSource{ConnectionSecretKeyRef: &ResourceConnectionSecretKeySelector{ManagedResourceSelector: rds, Key: "endpoint"}},
+			want: "db.aws.com",
+		},
+		"PasswordOfManagedResource": {
+			src:     ResourceRecordValueSource{ConnectionSecretKeyRef: &ResourceConnectionSecretKeySelector{ManagedResourceSelector: rds, Key: "password"}},
+			wantErr: true,
+		},
+		"FieldOfOtherResource": {
+			src: ResourceRecordValueSource{FieldRef: &ResourceFieldSelector{
+				ManagedResourceSelector: ManagedResourceSelector{APIVersion: "v1", Kind: "Node", Name: "node"},
+				FieldPath:               "status.addresses[0].address",
+			}},
+			wantErr: true,
+		},
+		"ConnectionSecretOfOtherResource": {
+			src: ResourceRecordValueSource{ConnectionSecretKeyRef: &ResourceConnectionSecretKeySelector{
+				ManagedResourceSelector: ManagedResourceSelector{APIVersion: "example.org/v1", Kind: "Database", Name: "db"},
+				Key:                     "endpoint",
+			}},
+			wantErr: true,
+		},
+		"EmptyField": {
+			src:     ResourceRecordValueSource{FieldRef: &ResourceFieldSelector{ManagedResourceSelector: rds, FieldPath: "status.atProvider.missing"}},
+			wantErr: true,
+		},
+		"NoSource": {
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := resolveValueFrom(context.Background(), &test.MockClient{MockGet: getFn}, tc.src)
+			if diff := cmp.Diff(tc.wantErr, err != nil); diff != "" {
+				t.Errorf("err: -want, +got:\n%s\n%v", diff, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("value: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResolveReferencesResolvesValuesAgain(t *testing.T) {
+	zone, stale := "zone", "old.aws.com"
+	rrs := &ResourceRecordSet{Spec: ResourceRecordSetSpec{ForProvider: ResourceRecordSetParameters{
+		HostedZoneID: &zone,
+		ResourceRecords: []ResourceRecord{{
+			Value: &stale,
+			ValueFrom: &ResourceRecordValueSource{FieldRef: &ResourceFieldSelector{
+				ManagedResourceSelector: ManagedResourceSelector{APIVersion: rdsAPIVersion, Kind: "RDSInstance", Name: "db"},
+				FieldPath:               "status.atProvider.endpoint.address",
+			}},
+		}},
+	}}}
+
+	if err := rrs.ResolveReferences(context.Background(), &test.MockClient{MockGet: getFn}); err != nil {
+		t.Fatalf("ResolveReferences(...): %v", err)
+	}
+	if diff := cmp.Diff("db.aws.com", *rrs.Spec.ForProvider.ResourceRecords[0].Value); diff != "" {
+		t.Errorf("value: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// A ManagedResourceSelector selects a managed resource of this provider.
+type ManagedResourceSelector struct {
+	// APIVersion of the referenced managed resource, e.g.
+	// database.aws.crossplane.io/v1beta1. It must belong to an
+	// aws.crossplane.io API group.
+	APIVersion string `json:"apiVersion"`
+
+	// Kind of the referenced managed resource, e.g. RDSInstance.
+	Kind string `json:"kind"`
+
+	// Name of the referenced managed resource.
+	Name string `json:"name"`
+}
+
+// A ResourceFieldSelector selects a field of a managed resource.
+type ResourceFieldSelector struct {
+	ManagedResourceSelector `json:",inline"`
+
+	// FieldPath of the field to select, e.g.
+	// status.atProvider.endpoint.address.
+	FieldPath string `json:"fieldPath"`
+}
+
+// A ResourceConnectionSecretKeySelector selects a key of the connection
+// secret of a managed resource.
+type ResourceConnectionSecretKeySelector struct {
+	ManagedResourceSelector `json:",inline"`
+
+	// Key of the connection secret to select. Only keys that never hold
+	// credentials can be selected.
+	// +kubebuilder:validation:Enum=endpoint;address;port
+	Key string `json:"key"`
+}
+
+// A ResourceRecordValueSource selects the value of a resource record from a
+// managed resource. Exactly one of its fields must be set.
+type ResourceRecordValueSource struct {
+	// ConnectionSecretKeyRef selects a key of the connection secret that a
+	// managed resource writes, e.g. the endpoint key of the connection secret
+	// of an RDSInstance.
+	// +optional
+	ConnectionSecretKeyRef *ResourceConnectionSecretKeySelector `json:"connectionSecretKeyRef,omitempty"`
+
+	// FieldRef selects a field of a managed resource.
+	// +optional
+	FieldRef *ResourceFieldSelector `json:"fieldRef,omitempty"`
+}
+
+// ResourceRecord holds the value of a resource record.
+type ResourceRecord struct {
+	// Value of the resource record. It is overwritten with the value selected
+	// by ValueFrom on every reconcile if ValueFrom is set.
+	// +optional
+	Value *string `json:"value,omitempty"`
+
+	// ValueFrom selects the value of the resource record from another
+	// resource.
+	// +optional
+	ValueFrom *ResourceRecordValueSource `json:"valueFrom,omitempty"`
+}
+
+// AliasTarget routes traffic to an AWS resource such as a load balancer
+// instead of to the resource records of the record set.
+type AliasTarget struct {
+	// DNSName is the DNS name of the target resource.
+	DNSName string `json:"dnsName"`
+
+	// HostedZoneID is the ID of the hosted zone of the target resource.
+	HostedZoneID string `json:"hostedZoneId"`
+
+	// EvaluateTargetHealth indicates whether the health of the target
+	// resource is evaluated when Route53 responds to queries.
+	EvaluateTargetHealth bool `json:"evaluateTargetHealth"`
+}
+
+// ResourceRecordSetParameters define the desired state of an AWS Route53
+// Resource Record Set.
+type ResourceRecordSetParameters struct {
+	// HostedZoneID is the ID of the hosted zone that contains the record set.
+	// +immutable
+	// +optional
+	HostedZoneID *string `json:"hostedZoneId,omitempty"`
+
+	// HostedZoneIDRef references a HostedZone to retrieve its ID
+	// +optional
+	HostedZoneIDRef *runtimev1alpha1.Reference `json:"hostedZoneIdRef,omitempty"`
+
+	// HostedZoneIDSelector selects a reference to a HostedZone to retrieve
+	// its ID
+	// +optional
+	HostedZoneIDSelector *runtimev1alpha1.Selector `json:"hostedZoneIdSelector,omitempty"`
+
+	// Name is the fully qualified domain name of the record set, e.g.
+	// db.example.com.
+	// +immutable
+	Name string `json:"name"`
+
+	// Type is the DNS record type.
+	// +immutable
+	// +kubebuilder:validation:Enum=SOA;A;TXT;NS;CNAME;MX;NAPTR;PTR;SRV;SPF;AAAA;CAA
+	Type string `json:"type"`
+
+	// SetIdentifier differentiates among multiple record sets that have the
+	// same name and type. It is required for weighted record sets.
+	// +immutable
+	// +optional
+	SetIdentifier *string `json:"setIdentifier,omitempty"`
+
+	// Weight determines the proportion of DNS queries that Route53 responds
+	// to using the record set.
+	// +optional
+	Weight *int64 `json:"weight,omitempty"`
+
+	// TTL is the resource record cache time to live in seconds. It must not
+	// be set for alias record sets.
+	// +optional
+	TTL *int64 `json:"ttl,omitempty"`
+
+	// ResourceRecords are the values of the record set. They must not be set
+	// for alias record sets.
+	// +optional
+	ResourceRecords []ResourceRecord `json:"resourceRecords,omitempty"`
+
+	// AliasTarget routes traffic to an AWS resource instead of to resource
+	// records.
+	// +optional
+	AliasTarget *AliasTarget `json:"aliasTarget,omitempty"`
+}
+
+// A ResourceRecordSetSpec defines the desired state of a ResourceRecordSet.
+type ResourceRecordSetSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ResourceRecordSetParameters `json:"forProvider"`
+}
+
+// ResourceRecordSetObservation keeps the state for the external resource
+type ResourceRecordSetObservation struct {
+	// ChangeID is the ID of the last change that was submitted for the
+	// record set.
+	ChangeID string `json:"changeId,omitempty"`
+
+	// ChangeStatus is the status of the last change that was submitted for
+	// the record set; PENDING until it has been propagated to all Route53
+	// DNS servers, INSYNC afterwards.
+	ChangeStatus string `json:"changeStatus,omitempty"`
+}
+
+// A ResourceRecordSetStatus represents the observed state of a
+// ResourceRecordSet.
+type ResourceRecordSetStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ResourceRecordSetObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ResourceRecordSet is a managed resource that represents an AWS Route53
+// Resource Record Set. It only manages the record set it created; creating it
+// fails if a record set with the same name, type and set identifier already
+// exists.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.type"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ResourceRecordSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ResourceRecordSetSpec   `json:"spec"`
+	Status ResourceRecordSetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ResourceRecordSetList contains a list of ResourceRecordSets
+type ResourceRecordSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResourceRecordSet `json:"items"`
+}
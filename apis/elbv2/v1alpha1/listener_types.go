@@ -0,0 +1,224 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// RedirectConfig describes a redirect action. Omitted URI components keep
+// their value from the original request.
+type RedirectConfig struct {
+	// Protocol is the protocol of the redirect, i.e. HTTP or HTTPS.
+	// +optional
+	Protocol *string `json:"protocol,omitempty"`
+
+	// Host is the hostname of the redirect.
+	// +optional
+	Host *string `json:"host,omitempty"`
+
+	// Port is the port of the redirect.
+	// +optional
+	Port *string `json:"port,omitempty"`
+
+	// Path is the absolute path of the redirect, starting with a slash.
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// Query is the query parameters of the redirect, without the leading
+	// question mark.
+	// +optional
+	Query *string `json:"query,omitempty"`
+
+	// StatusCode is the HTTP redirect code.
+	// +kubebuilder:validation:Enum=HTTP_301;HTTP_302
+	StatusCode string `json:"statusCode"`
+}
+
+// FixedResponseConfig describes an action that returns a custom HTTP
+// response.
+type FixedResponseConfig struct {
+	// StatusCode is the HTTP response code, e.g. 404.
+	StatusCode string `json:"statusCode"`
+
+	// ContentType is the content type of the response.
+	// +optional
+	ContentType *string `json:"contentType,omitempty"`
+
+	// MessageBody is the body of the response.
+	// +optional
+	MessageBody *string `json:"messageBody,omitempty"`
+}
+
+// Action describes what a listener does with the requests that match one of
+// its rules.
+type Action struct {
+	// Type is the type of the action.
+	// +kubebuilder:validation:Enum=forward;redirect;fixed-response
+	Type string `json:"type"`
+
+	// TargetGroupARN is the ARN of the target group that requests are
+	// forwarded to. It is required when the type is forward.
+	// +optional
+	TargetGroupARN *string `json:"targetGroupArn,omitempty"`
+
+	// TargetGroupARNRef references a TargetGroup to retrieve its ARN
+	// +optional
+	TargetGroupARNRef *runtimev1alpha1.Reference `json:"targetGroupArnRef,omitempty"`
+
+	// TargetGroupARNSelector selects a reference to a TargetGroup to retrieve
+	// its ARN
+	// +optional
+	TargetGroupARNSelector *runtimev1alpha1.Selector `json:"targetGroupArnSelector,omitempty"`
+
+	// RedirectConfig is the redirect to return. It is required when the
+	// type is redirect.
+	// +optional
+	RedirectConfig *RedirectConfig `json:"redirectConfig,omitempty"`
+
+	// FixedResponseConfig is the response to return. It is required when the
+	// type is fixed-response.
+	// +optional
+	FixedResponseConfig *FixedResponseConfig `json:"fixedResponseConfig,omitempty"`
+}
+
+// RuleCondition is a condition that requests must match for a rule to apply.
+type RuleCondition struct {
+	// Field is the field of the request that is matched.
+	// +kubebuilder:validation:Enum=host-header;path-pattern;http-request-method;source-ip
+	Field string `json:"field"`
+
+	// Values are the patterns that the field is matched against; the
+	// condition is met if any of them matches.
+	Values []string `json:"values"`
+}
+
+// Rule routes the requests that match its conditions.
+type Rule struct {
+	// Priority is the priority of the rule and identifies it within the
+	// listener. Rules are evaluated from the lowest priority value to the
+	// highest.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=50000
+	Priority int64 `json:"priority"`
+
+	// Conditions are the conditions that requests must all match.
+	Conditions []RuleCondition `json:"conditions"`
+
+	// Actions are the actions taken for the matching requests.
+	Actions []Action `json:"actions"`
+}
+
+// ListenerParameters define the desired state of an AWS Elastic Load
+// Balancing v2 listener.
+type ListenerParameters struct {
+	// LoadBalancerARN is the ARN of the load balancer of the listener.
+	// +immutable
+	// +optional
+	LoadBalancerARN *string `json:"loadBalancerArn,omitempty"`
+
+	// LoadBalancerARNRef references a LoadBalancer to retrieve its ARN
+	// +immutable
+	// +optional
+	LoadBalancerARNRef *runtimev1alpha1.Reference `json:"loadBalancerArnRef,omitempty"`
+
+	// LoadBalancerARNSelector selects a reference to a LoadBalancer to
+	// retrieve its ARN
+	// +immutable
+	// +optional
+	LoadBalancerARNSelector *runtimev1alpha1.Selector `json:"loadBalancerArnSelector,omitempty"`
+
+	// Port is the port on which the load balancer is listening.
+	Port int64 `json:"port"`
+
+	// Protocol is the protocol of the connections from clients to the load
+	// balancer.
+	// +kubebuilder:validation:Enum=HTTP;HTTPS;TCP;TLS;UDP;TCP_UDP
+	Protocol string `json:"protocol"`
+
+	// SSLPolicy is the security policy that defines the supported protocols
+	// and ciphers of HTTPS and TLS listeners.
+	// +optional
+	SSLPolicy *string `json:"sslPolicy,omitempty"`
+
+	// DefaultCertificateARN is the ARN of the default certificate of an HTTPS
+	// or TLS listener.
+	// +optional
+	DefaultCertificateARN *string `json:"defaultCertificateArn,omitempty"`
+
+	// CertificateARNs are the ARNs of the certificates that are used in
+	// addition to the default certificate.
+	// +optional
+	CertificateARNs []string `json:"certificateArns,omitempty"`
+
+	// DefaultActions are the actions taken for requests that match none of
+	// the rules of the listener.
+	DefaultActions []Action `json:"defaultActions"`
+
+	// Rules are the rules of an HTTP or HTTPS listener.
+	// +optional
+	Rules []Rule `json:"rules,omitempty"`
+}
+
+// A ListenerSpec defines the desired state of a Listener.
+type ListenerSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ListenerParameters `json:"forProvider"`
+}
+
+// ListenerObservation keeps the state for the external resource
+type ListenerObservation struct {
+	// RuleARNs are the ARNs of the rules of the listener, excluding the
+	// default rule.
+	RuleARNs []string `json:"ruleArns,omitempty"`
+}
+
+// A ListenerStatus represents the observed state of a Listener.
+type ListenerStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ListenerObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Listener is a managed resource that represents an AWS Elastic Load
+// Balancing v2 listener along with its rules.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PROTOCOL",type="string",JSONPath=".spec.forProvider.protocol"
+// +kubebuilder:printcolumn:name="PORT",type="integer",JSONPath=".spec.forProvider.port"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Listener struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ListenerSpec   `json:"spec"`
+	Status ListenerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ListenerList contains a list of Listeners
+type ListenerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Listener `json:"items"`
+}
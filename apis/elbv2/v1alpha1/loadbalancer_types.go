@@ -0,0 +1,148 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Tag defines a tag
+type Tag struct {
+	// Key is the name of the tag.
+	Key string `json:"key"`
+
+	// Value is the value of the tag.
+	Value string `json:"value"`
+}
+
+// LoadBalancerParameters define the desired state of an AWS Elastic Load
+// Balancing v2 load balancer. The name of the load balancer is its external
+// name.
+type LoadBalancerParameters struct {
+	// Type is the type of the load balancer.
+	// +kubebuilder:validation:Enum=application;network
+	// +immutable
+	// +optional
+	Type *string `json:"type,omitempty"`
+
+	// Scheme specifies whether the load balancer is reachable from the
+	// internet or only from within its VPC.
+	// +kubebuilder:validation:Enum=internet-facing;internal
+	// +immutable
+	// +optional
+	Scheme *string `json:"scheme,omitempty"`
+
+	// IPAddressType is the type of IP addresses used by the subnets of the
+	// load balancer.
+	// +kubebuilder:validation:Enum=ipv4;dualstack
+	// +optional
+	IPAddressType *string `json:"ipAddressType,omitempty"`
+
+	// SubnetIDs are the IDs of the subnets of the load balancer, at most one
+	// per availability zone.
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SubnetIDRefs is a set of references that each retrieve the subnetId
+	// from the referenced Subnet
+	// +optional
+	SubnetIDRefs []runtimev1alpha1.Reference `json:"subnetIdRefs,omitempty"`
+
+	// SubnetIDSelector selects a set of references that each retrieve the
+	// subnetId from the referenced Subnet
+	// +optional
+	SubnetIDSelector *runtimev1alpha1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// SecurityGroupIDs are the IDs of the security groups of the load
+	// balancer. Only Application Load Balancers have security groups.
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+
+	// SecurityGroupIDRefs is a set of references that each retrieve the
+	// securityGroupId from the referenced SecurityGroup
+	// +optional
+	SecurityGroupIDRefs []runtimev1alpha1.Reference `json:"securityGroupIdRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects a set of references that each retrieve
+	// the securityGroupId from the referenced SecurityGroup
+	// +optional
+	SecurityGroupIDSelector *runtimev1alpha1.Selector `json:"securityGroupIdSelector,omitempty"`
+
+	// Tags represents the tags of the load balancer.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A LoadBalancerSpec defines the desired state of a LoadBalancer.
+type LoadBalancerSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  LoadBalancerParameters `json:"forProvider"`
+}
+
+// LoadBalancerObservation keeps the state for the external resource
+type LoadBalancerObservation struct {
+	// ARN is the Amazon Resource Name of the load balancer.
+	ARN string `json:"arn,omitempty"`
+
+	// DNSName is the public DNS name of the load balancer.
+	DNSName string `json:"dnsName,omitempty"`
+
+	// CanonicalHostedZoneID is the ID of the Route53 hosted zone of the load
+	// balancer, used to create alias records that point to it.
+	CanonicalHostedZoneID string `json:"canonicalHostedZoneId,omitempty"`
+
+	// VPCID is the ID of the VPC of the load balancer.
+	VPCID string `json:"vpcId,omitempty"`
+
+	// State is the state of the load balancer.
+	State string `json:"state,omitempty"`
+}
+
+// A LoadBalancerStatus represents the observed state of a LoadBalancer.
+type LoadBalancerStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     LoadBalancerObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A LoadBalancer is a managed resource that represents an AWS Application or
+// Network Load Balancer.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DNS",type="string",JSONPath=".status.atProvider.dnsName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type LoadBalancer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LoadBalancerSpec   `json:"spec"`
+	Status LoadBalancerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LoadBalancerList contains a list of LoadBalancers
+type LoadBalancerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LoadBalancer `json:"items"`
+}
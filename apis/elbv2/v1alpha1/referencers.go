@@ -0,0 +1,158 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/network/v1alpha3"
+)
+
+// LoadBalancerARN returns the status.atProvider.arn of a LoadBalancer.
+func LoadBalancerARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		lb, ok := mg.(*LoadBalancer)
+		if !ok {
+			return ""
+		}
+		return lb.Status.AtProvider.ARN
+	}
+}
+
+// TargetGroupARN returns the status.atProvider.arn of a TargetGroup.
+func TargetGroupARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		tg, ok := mg.(*TargetGroup)
+		if !ok {
+			return ""
+		}
+		return tg.Status.AtProvider.ARN
+	}
+}
+
+// ResolveReferences of this LoadBalancer
+func (mg *LoadBalancer) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.subnetIds
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.SubnetIDs,
+		References:    mg.Spec.ForProvider.SubnetIDRefs,
+		Selector:      mg.Spec.ForProvider.SubnetIDSelector,
+		To:            reference.To{Managed: &v1alpha3.Subnet{}, List: &v1alpha3.SubnetList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.SubnetIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.SubnetIDRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.securityGroupIds
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.SecurityGroupIDs,
+		References:    mg.Spec.ForProvider.SecurityGroupIDRefs,
+		Selector:      mg.Spec.ForProvider.SecurityGroupIDSelector,
+		To:            reference.To{Managed: &v1alpha3.SecurityGroup{}, List: &v1alpha3.SecurityGroupList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.SecurityGroupIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.SecurityGroupIDRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this TargetGroup
+func (mg *TargetGroup) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.vpcId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.VPCID),
+		Reference:    mg.Spec.ForProvider.VPCIDRef,
+		Selector:     mg.Spec.ForProvider.VPCIDSelector,
+		To:           reference.To{Managed: &v1alpha3.VPC{}, List: &v1alpha3.VPCList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.VPCID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.VPCIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Listener
+func (mg *Listener) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.loadBalancerArn
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.LoadBalancerARN),
+		Reference:    mg.Spec.ForProvider.LoadBalancerARNRef,
+		Selector:     mg.Spec.ForProvider.LoadBalancerARNSelector,
+		To:           reference.To{Managed: &LoadBalancer{}, List: &LoadBalancerList{}},
+		Extract:      LoadBalancerARN(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.LoadBalancerARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.LoadBalancerARNRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.defaultActions[].targetGroupArn
+	if err := resolveActions(ctx, r, mg.Spec.ForProvider.DefaultActions); err != nil {
+		return err
+	}
+
+	// Resolve spec.forProvider.rules[].actions[].targetGroupArn
+	for i := range mg.Spec.ForProvider.Rules {
+		if err := resolveActions(ctx, r, mg.Spec.ForProvider.Rules[i].Actions); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resolveActions resolves the target group ARNs of the supplied actions.
+func resolveActions(ctx context.Context, r *reference.APIResolver, actions []Action) error {
+	for i := range actions {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(actions[i].TargetGroupARN),
+			Reference:    actions[i].TargetGroupARNRef,
+			Selector:     actions[i].TargetGroupARNSelector,
+			To:           reference.To{Managed: &TargetGroup{}, List: &TargetGroupList{}},
+			Extract:      TargetGroupARN(),
+		})
+		if err != nil {
+			return err
+		}
+		actions[i].TargetGroupARN = reference.ToPtrValue(rsp.ResolvedValue)
+		actions[i].TargetGroupARNRef = rsp.ResolvedReference
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// TargetGroupParameters define the desired state of an AWS Elastic Load
+// Balancing v2 target group. The name of the target group is its external
+// name.
+type TargetGroupParameters struct {
+	// Protocol is the protocol used to route traffic to the targets.
+	// +kubebuilder:validation:Enum=HTTP;HTTPS;TCP;TLS;UDP;TCP_UDP
+	// +immutable
+	// +optional
+	Protocol *string `json:"protocol,omitempty"`
+
+	// Port is the port on which the targets receive traffic.
+	// +immutable
+	// +optional
+	Port *int64 `json:"port,omitempty"`
+
+	// TargetType is the type of the targets that are registered with the
+	// target group.
+	// +kubebuilder:validation:Enum=instance;ip;lambda
+	// +immutable
+	// +optional
+	TargetType *string `json:"targetType,omitempty"`
+
+	// VPCID is the ID of the VPC of the targets. It is required unless the
+	// target type is lambda.
+	// +immutable
+	// +optional
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its vpcId
+	// +optional
+	VPCIDRef *runtimev1alpha1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its vpcId
+	// +optional
+	VPCIDSelector *runtimev1alpha1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// HealthCheckEnabled indicates whether health checks are enabled.
+	// +optional
+	HealthCheckEnabled *bool `json:"healthCheckEnabled,omitempty"`
+
+	// HealthCheckProtocol is the protocol used for health checks.
+	// +kubebuilder:validation:Enum=HTTP;HTTPS;TCP;TLS;UDP;TCP_UDP
+	// +optional
+	HealthCheckProtocol *string `json:"healthCheckProtocol,omitempty"`
+
+	// HealthCheckPort is the port used for health checks, or traffic-port to
+	// use the port on which each target receives traffic.
+	// +optional
+	HealthCheckPort *string `json:"healthCheckPort,omitempty"`
+
+	// HealthCheckPath is the destination of HTTP and HTTPS health checks.
+	// +optional
+	HealthCheckPath *string `json:"healthCheckPath,omitempty"`
+
+	// HealthCheckIntervalSeconds is the approximate time between health
+	// checks of a target.
+	// +optional
+	HealthCheckIntervalSeconds *int64 `json:"healthCheckIntervalSeconds,omitempty"`
+
+	// HealthCheckTimeoutSeconds is the time during which no response means
+	// a failed health check.
+	// +optional
+	HealthCheckTimeoutSeconds *int64 `json:"healthCheckTimeoutSeconds,omitempty"`
+
+	// HealthyThresholdCount is the number of consecutive successful health
+	// checks after which an unhealthy target is considered healthy.
+	// +optional
+	HealthyThresholdCount *int64 `json:"healthyThresholdCount,omitempty"`
+
+	// UnhealthyThresholdCount is the number of consecutive failed health
+	// checks after which a target is considered unhealthy.
+	// +optional
+	UnhealthyThresholdCount *int64 `json:"unhealthyThresholdCount,omitempty"`
+
+	// MatcherHTTPCode is the HTTP codes of a successful response from a
+	// target, e.g. 200 or 200-299.
+	// +optional
+	MatcherHTTPCode *string `json:"matcherHttpCode,omitempty"`
+
+	// Tags represents the tags of the target group.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A TargetGroupSpec defines the desired state of a TargetGroup.
+type TargetGroupSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  TargetGroupParameters `json:"forProvider"`
+}
+
+// TargetGroupObservation keeps the state for the external resource
+type TargetGroupObservation struct {
+	// ARN is the Amazon Resource Name of the target group.
+	ARN string `json:"arn,omitempty"`
+
+	// LoadBalancerARNs are the ARNs of the load balancers that route traffic
+	// to the target group.
+	LoadBalancerARNs []string `json:"loadBalancerArns,omitempty"`
+}
+
+// A TargetGroupStatus represents the observed state of a TargetGroup.
+type TargetGroupStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     TargetGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TargetGroup is a managed resource that represents an AWS Elastic Load
+// Balancing v2 target group.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PROTOCOL",type="string",JSONPath=".spec.forProvider.protocol"
+// +kubebuilder:printcolumn:name="PORT",type="integer",JSONPath=".spec.forProvider.port"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type TargetGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TargetGroupSpec   `json:"spec"`
+	Status TargetGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TargetGroupList contains a list of TargetGroups
+type TargetGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TargetGroup `json:"items"`
+}
@@ -51,3 +51,28 @@ func GenerateEC2Tags(tags []Tag) []ec2.Tag {
 	}
 	return res
 }
+
+// A Filter is an EC2 filter that is used to look up an existing resource.
+type Filter struct {
+	// Name of the filter, e.g. cidr-block or tag:Name. See the Describe call
+	// of the resource in the EC2 API reference for the supported names.
+	Name string `json:"name"`
+
+	// Values of the filter. A resource matches the filter if it matches any
+	// of the values.
+	Values []string `json:"values"`
+}
+
+// LookupParameters configure how an existing resource is looked up so that
+// it can be adopted without knowing its ID. A resource is considered a match
+// if it has all of the given tags and matches all of the given filters. At
+// least one tag or filter must be given.
+type LookupParameters struct {
+	// Tags that the existing resource has.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+
+	// Filters that the existing resource matches.
+	// +optional
+	Filters []Filter `json:"filters,omitempty"`
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// ElasticIPParameters define the desired state of an AWS Elastic IP address.
+type ElasticIPParameters struct {
+	// Domain indicates whether the Elastic IP address is for use with
+	// instances in a VPC or with instances in EC2-Classic. Defaults to vpc.
+	// +kubebuilder:validation:Enum=vpc;standard
+	// +optional
+	Domain *string `json:"domain,omitempty"`
+
+	// Address is the Elastic IP address to recover or an IPv4 address from an
+	// address pool that was brought to AWS.
+	// +optional
+	Address *string `json:"address,omitempty"`
+
+	// PublicIPv4Pool is the ID of an address pool that you own. EC2 selects an
+	// address from this pool if no Address is given.
+	// +optional
+	PublicIPv4Pool *string `json:"publicIpv4Pool,omitempty"`
+
+	// NetworkBorderGroup is the location from which AWS advertises the IP
+	// address.
+	// +optional
+	NetworkBorderGroup *string `json:"networkBorderGroup,omitempty"`
+
+	// Tags represents the tags of the Elastic IP address.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// An ElasticIPSpec defines the desired state of an ElasticIP.
+type ElasticIPSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ElasticIPParameters `json:"forProvider,omitempty"`
+}
+
+// ElasticIPObservation keeps the state for the external resource
+type ElasticIPObservation struct {
+	// AllocationID is the ID that AWS assigned to the allocation of the
+	// Elastic IP address.
+	AllocationID string `json:"allocationId,omitempty"`
+
+	// PublicIP is the Elastic IP address.
+	PublicIP string `json:"publicIp,omitempty"`
+
+	// AssociationID is the ID of the association of the address with an
+	// instance or a network interface.
+	AssociationID string `json:"associationId,omitempty"`
+
+	// InstanceID is the ID of the instance the address is associated with.
+	InstanceID string `json:"instanceId,omitempty"`
+
+	// NetworkInterfaceID is the ID of the network interface the address is
+	// associated with.
+	NetworkInterfaceID string `json:"networkInterfaceId,omitempty"`
+
+	// PrivateIPAddress is the private IP address that is associated with the
+	// Elastic IP address.
+	PrivateIPAddress string `json:"privateIpAddress,omitempty"`
+}
+
+// An ElasticIPStatus represents the observed state of an ElasticIP.
+type ElasticIPStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ElasticIPObservation `json:"atProvider,omitempty"`
+
+	// ManagedTagKeys are the keys of the tags that were last set on the Elastic
+	// IP address from the spec. Only these tags are removed when they are
+	// removed from the spec.
+	ManagedTagKeys []string `json:"managedTagKeys,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An ElasticIP is a managed resource that represents an AWS Elastic IP
+// address.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ALLOCATIONID",type="string",JSONPath=".status.atProvider.allocationId"
+// +kubebuilder:printcolumn:name="IP",type="string",JSONPath=".status.atProvider.publicIp"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ElasticIP struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ElasticIPSpec   `json:"spec"`
+	Status ElasticIPStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ElasticIPList contains a list of ElasticIPs
+type ElasticIPList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ElasticIP `json:"items"`
+}
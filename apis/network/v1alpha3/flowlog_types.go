@@ -0,0 +1,171 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Destination types of flow logs.
+const (
+	FlowLogDestinationCloudWatchLogs = "cloud-watch-logs"
+	FlowLogDestinationS3             = "s3"
+)
+
+// FlowLogParameters define the desired state of an AWS VPC Flow Log. Exactly
+// one of VPCID, SubnetID and NetworkInterfaceID must be set. Flow logs cannot
+// be modified, so any change replaces the flow log.
+type FlowLogParameters struct {
+	// VPCID is the ID of the VPC whose traffic is captured.
+	// +optional
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its vpcId
+	// +optional
+	VPCIDRef *runtimev1alpha1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its vpcId
+	// +optional
+	VPCIDSelector *runtimev1alpha1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// SubnetID is the ID of the subnet whose traffic is captured.
+	// +optional
+	SubnetID *string `json:"subnetId,omitempty"`
+
+	// SubnetIDRef references a Subnet to retrieve its subnetId
+	// +optional
+	SubnetIDRef *runtimev1alpha1.Reference `json:"subnetIdRef,omitempty"`
+
+	// SubnetIDSelector selects a reference to a Subnet to retrieve its
+	// subnetId
+	// +optional
+	SubnetIDSelector *runtimev1alpha1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// NetworkInterfaceID is the ID of the network interface whose traffic is
+	// captured.
+	// +optional
+	NetworkInterfaceID *string `json:"networkInterfaceId,omitempty"`
+
+	// TrafficType is the type of traffic that is captured.
+	// +kubebuilder:validation:Enum=ACCEPT;REJECT;ALL
+	TrafficType string `json:"trafficType"`
+
+	// LogDestinationType is where the flow log data is published to. Defaults
+	// to cloud-watch-logs.
+	// +kubebuilder:validation:Enum=cloud-watch-logs;s3
+	// +optional
+	LogDestinationType *string `json:"logDestinationType,omitempty"`
+
+	// LogGroupName is the name of the CloudWatch Logs log group the flow log
+	// data is published to. Required if the destination type is
+	// cloud-watch-logs.
+	// +optional
+	LogGroupName *string `json:"logGroupName,omitempty"`
+
+	// DeliverLogsPermissionARN is the ARN of the IAM role that allows
+	// publishing to the CloudWatch Logs log group. Required if the destination
+	// type is cloud-watch-logs.
+	// +optional
+	DeliverLogsPermissionARN *string `json:"deliverLogsPermissionArn,omitempty"`
+
+	// DeliverLogsPermissionARNRef references an IAMRole to retrieve its ARN
+	// +optional
+	DeliverLogsPermissionARNRef *runtimev1alpha1.Reference `json:"deliverLogsPermissionArnRef,omitempty"`
+
+	// DeliverLogsPermissionARNSelector selects a reference to an IAMRole to
+	// retrieve its ARN
+	// +optional
+	DeliverLogsPermissionARNSelector *runtimev1alpha1.Selector `json:"deliverLogsPermissionArnSelector,omitempty"`
+
+	// S3BucketARN is the ARN of the S3 bucket the flow log data is published
+	// to. Required if the destination type is s3.
+	// +optional
+	S3BucketARN *string `json:"s3BucketArn,omitempty"`
+
+	// S3BucketARNRef references an S3Bucket to retrieve its ARN
+	// +optional
+	S3BucketARNRef *runtimev1alpha1.Reference `json:"s3BucketArnRef,omitempty"`
+
+	// S3BucketARNSelector selects a reference to an S3Bucket to retrieve its
+	// ARN
+	// +optional
+	S3BucketARNSelector *runtimev1alpha1.Selector `json:"s3BucketArnSelector,omitempty"`
+
+	// LogFormat is the fields and their order in the flow log records, e.g.
+	// ${srcaddr} ${dstaddr} ${action}. Defaults to the AWS default format.
+	// +optional
+	LogFormat *string `json:"logFormat,omitempty"`
+}
+
+// A FlowLogSpec defines the desired state of a FlowLog.
+type FlowLogSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  FlowLogParameters `json:"forProvider"`
+}
+
+// FlowLogObservation keeps the state for the external resource
+type FlowLogObservation struct {
+	// FlowLogStatus is the status of the flow log, e.g. ACTIVE.
+	FlowLogStatus string `json:"flowLogStatus,omitempty"`
+
+	// DeliverLogsStatus is the status of the delivery of the flow log data,
+	// i.e. SUCCESS or FAILED.
+	DeliverLogsStatus string `json:"deliverLogsStatus,omitempty"`
+
+	// DeliverLogsErrorMessage is the reason the delivery of the flow log data
+	// fails, if it does.
+	DeliverLogsErrorMessage string `json:"deliverLogsErrorMessage,omitempty"`
+
+	// LogDestination is the ARN of the destination the flow log data is
+	// published to.
+	LogDestination string `json:"logDestination,omitempty"`
+}
+
+// A FlowLogStatus represents the observed state of a FlowLog.
+type FlowLogStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     FlowLogObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A FlowLog is a managed resource that represents an AWS VPC Flow Log.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TRAFFIC",type="string",JSONPath=".spec.forProvider.trafficType"
+// +kubebuilder:printcolumn:name="DELIVERY",type="string",JSONPath=".status.atProvider.deliverLogsStatus"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type FlowLog struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FlowLogSpec   `json:"spec"`
+	Status FlowLogStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FlowLogList contains a list of FlowLogs
+type FlowLogList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FlowLog `json:"items"`
+}
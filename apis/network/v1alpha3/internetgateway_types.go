@@ -35,6 +35,12 @@ type InternetGatewayParameters struct {
 
 	// VPCIDSelector selects a reference to a VPC to and retrieves its vpcId
 	VPCIDSelector *runtimev1alpha1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// Tags are used as identification helpers between AWS resources. Tags
+	// that are removed from this list are removed from the internet gateway,
+	// while tags that were added to it by other systems are kept.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
 }
 
 // An InternetGatewaySpec defines the desired state of an InternetGateway.
@@ -69,6 +75,11 @@ type InternetGatewayExternalStatus struct {
 type InternetGatewayStatus struct {
 	runtimev1alpha1.ResourceStatus `json:",inline"`
 	InternetGatewayExternalStatus  `json:",inline"`
+
+	// ManagedTagKeys are the keys of the tags that were last set on the
+	// internet gateway from the spec. Only these tags are removed when they
+	// are removed from the spec.
+	ManagedTagKeys []string `json:"managedTagKeys,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -111,5 +122,6 @@ func (i *InternetGateway) UpdateExternalStatus(observation ec2.InternetGateway)
 
 	i.Status.InternetGatewayExternalStatus = InternetGatewayExternalStatus{
 		Attachments: attachments,
+		Tags:        BuildFromEC2Tags(observation.Tags),
 	}
 }
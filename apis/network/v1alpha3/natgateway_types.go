@@ -0,0 +1,142 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// NATGatewayParameters define the desired state of an AWS VPC NAT Gateway.
+type NATGatewayParameters struct {
+	// AllocationID is the allocation ID of the Elastic IP address for the NAT
+	// gateway.
+	// +immutable
+	// +optional
+	AllocationID *string `json:"allocationId,omitempty"`
+
+	// AllocationIDRef references an ElasticIP to retrieve its allocationId
+	// +optional
+	AllocationIDRef *runtimev1alpha1.Reference `json:"allocationIdRef,omitempty"`
+
+	// AllocationIDSelector selects a reference to an ElasticIP to retrieve
+	// its allocationId
+	// +optional
+	AllocationIDSelector *runtimev1alpha1.Selector `json:"allocationIdSelector,omitempty"`
+
+	// SubnetID is the ID of the public subnet in which the NAT gateway is
+	// created.
+	// +immutable
+	// +optional
+	SubnetID *string `json:"subnetId,omitempty"`
+
+	// SubnetIDRef references a Subnet to retrieve its subnetId
+	// +optional
+	SubnetIDRef *runtimev1alpha1.Reference `json:"subnetIdRef,omitempty"`
+
+	// SubnetIDSelector selects a reference to a Subnet to retrieve its
+	// subnetId
+	// +optional
+	SubnetIDSelector *runtimev1alpha1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// Tags represents the tags of the NAT gateway.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A NATGatewaySpec defines the desired state of a NATGateway.
+type NATGatewaySpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  NATGatewayParameters `json:"forProvider"`
+}
+
+// NATGatewayAddress describes an IP address of a NAT gateway.
+type NATGatewayAddress struct {
+	// AllocationID is the allocation ID of the Elastic IP address that is
+	// associated with the NAT gateway.
+	AllocationID string `json:"allocationId,omitempty"`
+
+	// NetworkInterfaceID is the ID of the network interface of the NAT
+	// gateway.
+	NetworkInterfaceID string `json:"networkInterfaceId,omitempty"`
+
+	// PrivateIP is the private IP address of the NAT gateway.
+	PrivateIP string `json:"privateIp,omitempty"`
+
+	// PublicIP is the Elastic IP address of the NAT gateway.
+	PublicIP string `json:"publicIp,omitempty"`
+}
+
+// NATGatewayObservation keeps the state for the external resource
+type NATGatewayObservation struct {
+	// State is the current state of the NAT gateway.
+	// +kubebuilder:validation:Enum=pending;failed;available;deleting;deleted
+	State string `json:"state,omitempty"`
+
+	// VPCID is the ID of the VPC in which the NAT gateway is located.
+	VPCID string `json:"vpcId,omitempty"`
+
+	// NATGatewayAddresses are the IP addresses of the NAT gateway.
+	NATGatewayAddresses []NATGatewayAddress `json:"natGatewayAddresses,omitempty"`
+
+	// FailureCode is the error code of the failure if the NAT gateway is in
+	// failed state.
+	FailureCode string `json:"failureCode,omitempty"`
+
+	// FailureMessage is the message of the failure if the NAT gateway is in
+	// failed state.
+	FailureMessage string `json:"failureMessage,omitempty"`
+}
+
+// A NATGatewayStatus represents the observed state of a NATGateway.
+type NATGatewayStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     NATGatewayObservation `json:"atProvider,omitempty"`
+
+	// ManagedTagKeys are the keys of the tags that were last set on the NAT
+	// gateway from the spec. Only these tags are removed when they are removed
+	// from the spec.
+	ManagedTagKeys []string `json:"managedTagKeys,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NATGateway is a managed resource that represents an AWS VPC NAT Gateway.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="SUBNETID",type="string",JSONPath=".spec.forProvider.subnetId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type NATGateway struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NATGatewaySpec   `json:"spec"`
+	Status NATGatewayStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NATGatewayList contains a list of NATGateways
+type NATGatewayList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NATGateway `json:"items"`
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// NetworkACLPortRange describes a range of ports.
+type NetworkACLPortRange struct {
+	// From is the first port in the range.
+	From int64 `json:"from"`
+
+	// To is the last port in the range.
+	To int64 `json:"to"`
+}
+
+// NetworkACLICMPTypeCode describes the ICMP type and code.
+type NetworkACLICMPTypeCode struct {
+	// Type is the ICMP type. A value of -1 means all types.
+	Type int64 `json:"type"`
+
+	// Code is the ICMP code. A value of -1 means all codes for the specified
+	// ICMP type.
+	Code int64 `json:"code"`
+}
+
+// NetworkACLEntry describes an ingress or egress rule of a network ACL.
+// Entries are evaluated in order, starting with the lowest rule number.
+type NetworkACLEntry struct {
+	// RuleNumber is the rule number of the entry. Rule numbers must be unique
+	// per direction.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=32766
+	RuleNumber int64 `json:"ruleNumber"`
+
+	// Egress indicates whether this is an egress rule. Defaults to ingress.
+	// +optional
+	Egress bool `json:"egress,omitempty"`
+
+	// Protocol is the protocol number or name, e.g. 6 or tcp. A value of -1
+	// or all means all protocols.
+	Protocol string `json:"protocol"`
+
+	// RuleAction indicates whether to allow or deny the traffic that matches
+	// the rule.
+	// +kubebuilder:validation:Enum=allow;deny
+	RuleAction string `json:"ruleAction"`
+
+	// CIDRBlock is the IPv4 network range to allow or deny, in CIDR notation.
+	// +optional
+	CIDRBlock *string `json:"cidrBlock,omitempty"`
+
+	// IPv6CIDRBlock is the IPv6 network range to allow or deny, in CIDR
+	// notation.
+	// +optional
+	IPv6CIDRBlock *string `json:"ipv6CidrBlock,omitempty"`
+
+	// PortRange is the range of ports the rule applies to. Required for the
+	// TCP and UDP protocols.
+	// +optional
+	PortRange *NetworkACLPortRange `json:"portRange,omitempty"`
+
+	// ICMPTypeCode is the ICMP type and code the rule applies to. Required
+	// for the ICMP protocols.
+	// +optional
+	ICMPTypeCode *NetworkACLICMPTypeCode `json:"icmpTypeCode,omitempty"`
+}
+
+// NetworkACLParameters define the desired state of an AWS Network ACL.
+type NetworkACLParameters struct {
+	// VPCID is the ID of the VPC the network ACL belongs to.
+	// +immutable
+	// +optional
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its vpcId
+	// +optional
+	VPCIDRef *runtimev1alpha1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its vpcId
+	// +optional
+	VPCIDSelector *runtimev1alpha1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// Entries are the ingress and egress rules of the network ACL.
+	// +optional
+	Entries []NetworkACLEntry `json:"entries,omitempty"`
+
+	// SubnetIDs are the IDs of the subnets that are associated with the
+	// network ACL. Subnets that are removed from this list are associated
+	// with the default network ACL of the VPC again.
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SubnetIDRefs is a set of references that each retrieve the subnetID
+	// from the referenced Subnet
+	// +optional
+	SubnetIDRefs []runtimev1alpha1.Reference `json:"subnetIdRefs,omitempty"`
+
+	// SubnetIDSelector selects a set of references that each retrieve the
+	// subnetID from the referenced Subnet
+	// +optional
+	SubnetIDSelector *runtimev1alpha1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// Tags represents the tags of the network ACL.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A NetworkACLSpec defines the desired state of a NetworkACL.
+type NetworkACLSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  NetworkACLParameters `json:"forProvider"`
+}
+
+// NetworkACLAssociation describes the association between a network ACL and
+// a subnet.
+type NetworkACLAssociation struct {
+	// AssociationID is the ID of the association.
+	AssociationID string `json:"associationId,omitempty"`
+
+	// SubnetID is the ID of the subnet.
+	SubnetID string `json:"subnetId,omitempty"`
+}
+
+// NetworkACLObservation keeps the state for the external resource
+type NetworkACLObservation struct {
+	// IsDefault indicates whether this is the default network ACL of the VPC.
+	IsDefault bool `json:"isDefault,omitempty"`
+
+	// OwnerID is the ID of the AWS account that owns the network ACL.
+	OwnerID string `json:"ownerId,omitempty"`
+
+	// Associations are the subnet associations of the network ACL.
+	Associations []NetworkACLAssociation `json:"associations,omitempty"`
+}
+
+// A NetworkACLStatus represents the observed state of a NetworkACL.
+type NetworkACLStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     NetworkACLObservation `json:"atProvider,omitempty"`
+
+	// ManagedTagKeys are the keys of the tags that were last set on the network
+	// ACL from the spec. Only these tags are removed when they are removed from
+	// the spec.
+	ManagedTagKeys []string `json:"managedTagKeys,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NetworkACL is a managed resource that represents an AWS Network ACL.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="VPCID",type="string",JSONPath=".spec.forProvider.vpcId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type NetworkACL struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NetworkACLSpec   `json:"spec"`
+	Status NetworkACLStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NetworkACLList contains a list of NetworkACLs
+type NetworkACLList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NetworkACL `json:"items"`
+}
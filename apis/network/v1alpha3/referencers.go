@@ -22,6 +22,9 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	identityv1beta1 "github.com/crossplane/provider-aws/apis/identity/v1beta1"
+	storagev1alpha3 "github.com/crossplane/provider-aws/apis/storage/v1alpha3"
 )
 
 // SecurityGroupName returns the spec.groupName of a SecurityGroup.
@@ -90,6 +93,54 @@ func (mg *RouteTable) ResolveReferences(ctx context.Context, c client.Reader) er
 		mg.Spec.Routes[i].GatewayIDRef = rsp.ResolvedReference
 	}
 
+	// Resolve spec.routes[].natGatewayID
+	for i := range mg.Spec.Routes {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: mg.Spec.Routes[i].NATGatewayID,
+			Reference:    mg.Spec.Routes[i].NATGatewayIDRef,
+			Selector:     mg.Spec.Routes[i].NATGatewayIDSelector,
+			To:           reference.To{Managed: &NATGateway{}, List: &NATGatewayList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return err
+		}
+		mg.Spec.Routes[i].NATGatewayID = rsp.ResolvedValue
+		mg.Spec.Routes[i].NATGatewayIDRef = rsp.ResolvedReference
+	}
+
+	// Resolve spec.routes[].vpcPeeringConnectionID
+	for i := range mg.Spec.Routes {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: mg.Spec.Routes[i].VPCPeeringConnectionID,
+			Reference:    mg.Spec.Routes[i].VPCPeeringConnectionIDRef,
+			Selector:     mg.Spec.Routes[i].VPCPeeringConnectionIDSelector,
+			To:           reference.To{Managed: &VPCPeeringConnection{}, List: &VPCPeeringConnectionList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return err
+		}
+		mg.Spec.Routes[i].VPCPeeringConnectionID = rsp.ResolvedValue
+		mg.Spec.Routes[i].VPCPeeringConnectionIDRef = rsp.ResolvedReference
+	}
+
+	// Resolve spec.routes[].transitGatewayID
+	for i := range mg.Spec.Routes {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: mg.Spec.Routes[i].TransitGatewayID,
+			Reference:    mg.Spec.Routes[i].TransitGatewayIDRef,
+			Selector:     mg.Spec.Routes[i].TransitGatewayIDSelector,
+			To:           reference.To{Managed: &TransitGateway{}, List: &TransitGatewayList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return err
+		}
+		mg.Spec.Routes[i].TransitGatewayID = rsp.ResolvedValue
+		mg.Spec.Routes[i].TransitGatewayIDRef = rsp.ResolvedReference
+	}
+
 	// Resolve spec.associations[].subnetID
 	for i := range mg.Spec.Associations {
 		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
@@ -127,6 +178,67 @@ func (mg *SecurityGroup) ResolveReferences(ctx context.Context, c client.Reader)
 	mg.Spec.VPCID = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.VPCIDRef = rsp.ResolvedReference
 
+	// Resolve spec.ingress[].userIdGroupPairs[].groupId
+	for i := range mg.Spec.Ingress {
+		for j := range mg.Spec.Ingress[i].UserIDGroupPairs {
+			if err := resolveUserIDGroupPair(ctx, r, &mg.Spec.Ingress[i].UserIDGroupPairs[j]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Resolve spec.egress[].userIdGroupPairs[].groupId
+	for i := range mg.Spec.Egress {
+		for j := range mg.Spec.Egress[i].UserIDGroupPairs {
+			if err := resolveUserIDGroupPair(ctx, r, &mg.Spec.Egress[i].UserIDGroupPairs[j]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// resolveUserIDGroupPair resolves the group ID of the given security group
+// pair in place.
+func resolveUserIDGroupPair(ctx context.Context, r *reference.APIResolver, pair *UserIDGroupPair) error {
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(pair.GroupID),
+		Reference:    pair.GroupIDRef,
+		Selector:     pair.GroupIDSelector,
+		To:           reference.To{Managed: &SecurityGroup{}, List: &SecurityGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	pair.GroupID = reference.ToPtrValue(rsp.ResolvedValue)
+	pair.GroupIDRef = rsp.ResolvedReference
+	return nil
+}
+
+// ResolveReferences of this SecurityGroupRule
+func (mg *SecurityGroupRule) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.securityGroupId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.SecurityGroupID),
+		Reference:    mg.Spec.ForProvider.SecurityGroupIDRef,
+		Selector:     mg.Spec.ForProvider.SecurityGroupIDSelector,
+		To:           reference.To{Managed: &SecurityGroup{}, List: &SecurityGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.SecurityGroupID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SecurityGroupIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.userIdGroupPair.groupId
+	if mg.Spec.ForProvider.UserIDGroupPair != nil {
+		return resolveUserIDGroupPair(ctx, r, mg.Spec.ForProvider.UserIDGroupPair)
+	}
 	return nil
 }
 
@@ -150,3 +262,332 @@ func (mg *Subnet) ResolveReferences(ctx context.Context, c client.Reader) error
 
 	return nil
 }
+
+// ResolveReferences of this NATGateway
+func (mg *NATGateway) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.allocationId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.AllocationID),
+		Reference:    mg.Spec.ForProvider.AllocationIDRef,
+		Selector:     mg.Spec.ForProvider.AllocationIDSelector,
+		To:           reference.To{Managed: &ElasticIP{}, List: &ElasticIPList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.AllocationID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.AllocationIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.subnetId
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.SubnetID),
+		Reference:    mg.Spec.ForProvider.SubnetIDRef,
+		Selector:     mg.Spec.ForProvider.SubnetIDSelector,
+		To:           reference.To{Managed: &Subnet{}, List: &SubnetList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.SubnetID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SubnetIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this VPCPeeringConnection
+func (mg *VPCPeeringConnection) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.vpcId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.VPCID),
+		Reference:    mg.Spec.ForProvider.VPCIDRef,
+		Selector:     mg.Spec.ForProvider.VPCIDSelector,
+		To:           reference.To{Managed: &VPC{}, List: &VPCList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.VPCID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.VPCIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.peerVpcId
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.PeerVPCID),
+		Reference:    mg.Spec.ForProvider.PeerVPCIDRef,
+		Selector:     mg.Spec.ForProvider.PeerVPCIDSelector,
+		To:           reference.To{Managed: &VPC{}, List: &VPCList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.PeerVPCID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.PeerVPCIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this VPCEndpoint
+func (mg *VPCEndpoint) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.vpcId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.VPCID),
+		Reference:    mg.Spec.ForProvider.VPCIDRef,
+		Selector:     mg.Spec.ForProvider.VPCIDSelector,
+		To:           reference.To{Managed: &VPC{}, List: &VPCList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.VPCID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.VPCIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.routeTableIds
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.RouteTableIDs,
+		References:    mg.Spec.ForProvider.RouteTableIDRefs,
+		Selector:      mg.Spec.ForProvider.RouteTableIDSelector,
+		To:            reference.To{Managed: &RouteTable{}, List: &RouteTableList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.RouteTableIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.RouteTableIDRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.subnetIds
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.SubnetIDs,
+		References:    mg.Spec.ForProvider.SubnetIDRefs,
+		Selector:      mg.Spec.ForProvider.SubnetIDSelector,
+		To:            reference.To{Managed: &Subnet{}, List: &SubnetList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.SubnetIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.SubnetIDRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.securityGroupIds
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.SecurityGroupIDs,
+		References:    mg.Spec.ForProvider.SecurityGroupIDRefs,
+		Selector:      mg.Spec.ForProvider.SecurityGroupIDSelector,
+		To:            reference.To{Managed: &SecurityGroup{}, List: &SecurityGroupList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.SecurityGroupIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.SecurityGroupIDRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this NetworkACL
+func (mg *NetworkACL) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.vpcId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.VPCID),
+		Reference:    mg.Spec.ForProvider.VPCIDRef,
+		Selector:     mg.Spec.ForProvider.VPCIDSelector,
+		To:           reference.To{Managed: &VPC{}, List: &VPCList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.VPCID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.VPCIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.subnetIds
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.SubnetIDs,
+		References:    mg.Spec.ForProvider.SubnetIDRefs,
+		Selector:      mg.Spec.ForProvider.SubnetIDSelector,
+		To:            reference.To{Managed: &Subnet{}, List: &SubnetList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.SubnetIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.SubnetIDRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this TransitGatewayVPCAttachment
+func (mg *TransitGatewayVPCAttachment) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.transitGatewayId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.TransitGatewayID),
+		Reference:    mg.Spec.ForProvider.TransitGatewayIDRef,
+		Selector:     mg.Spec.ForProvider.TransitGatewayIDSelector,
+		To:           reference.To{Managed: &TransitGateway{}, List: &TransitGatewayList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.TransitGatewayID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.TransitGatewayIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.vpcId
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.VPCID),
+		Reference:    mg.Spec.ForProvider.VPCIDRef,
+		Selector:     mg.Spec.ForProvider.VPCIDSelector,
+		To:           reference.To{Managed: &VPC{}, List: &VPCList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.VPCID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.VPCIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.subnetIds
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.SubnetIDs,
+		References:    mg.Spec.ForProvider.SubnetIDRefs,
+		Selector:      mg.Spec.ForProvider.SubnetIDSelector,
+		To:            reference.To{Managed: &Subnet{}, List: &SubnetList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.SubnetIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.SubnetIDRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this TransitGatewayRouteTable
+func (mg *TransitGatewayRouteTable) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.transitGatewayId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.TransitGatewayID),
+		Reference:    mg.Spec.ForProvider.TransitGatewayIDRef,
+		Selector:     mg.Spec.ForProvider.TransitGatewayIDSelector,
+		To:           reference.To{Managed: &TransitGateway{}, List: &TransitGatewayList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.TransitGatewayID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.TransitGatewayIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.associatedAttachmentIds
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.AssociatedAttachmentIDs,
+		References:    mg.Spec.ForProvider.AssociatedAttachmentIDRefs,
+		Selector:      mg.Spec.ForProvider.AssociatedAttachmentIDSelector,
+		To:            reference.To{Managed: &TransitGatewayVPCAttachment{}, List: &TransitGatewayVPCAttachmentList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.AssociatedAttachmentIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.AssociatedAttachmentIDRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.propagatedAttachmentIds
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.PropagatedAttachmentIDs,
+		References:    mg.Spec.ForProvider.PropagatedAttachmentIDRefs,
+		Selector:      mg.Spec.ForProvider.PropagatedAttachmentIDSelector,
+		To:            reference.To{Managed: &TransitGatewayVPCAttachment{}, List: &TransitGatewayVPCAttachmentList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.PropagatedAttachmentIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.PropagatedAttachmentIDRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this FlowLog
+func (mg *FlowLog) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.vpcId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.VPCID),
+		Reference:    mg.Spec.ForProvider.VPCIDRef,
+		Selector:     mg.Spec.ForProvider.VPCIDSelector,
+		To:           reference.To{Managed: &VPC{}, List: &VPCList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.VPCID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.VPCIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.subnetId
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.SubnetID),
+		Reference:    mg.Spec.ForProvider.SubnetIDRef,
+		Selector:     mg.Spec.ForProvider.SubnetIDSelector,
+		To:           reference.To{Managed: &Subnet{}, List: &SubnetList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.SubnetID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SubnetIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.deliverLogsPermissionArn
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DeliverLogsPermissionARN),
+		Reference:    mg.Spec.ForProvider.DeliverLogsPermissionARNRef,
+		Selector:     mg.Spec.ForProvider.DeliverLogsPermissionARNSelector,
+		To:           reference.To{Managed: &identityv1beta1.IAMRole{}, List: &identityv1beta1.IAMRoleList{}},
+		Extract:      identityv1beta1.IAMRoleARN(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.DeliverLogsPermissionARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DeliverLogsPermissionARNRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.s3BucketArn
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.S3BucketARN),
+		Reference:    mg.Spec.ForProvider.S3BucketARNRef,
+		Selector:     mg.Spec.ForProvider.S3BucketARNSelector,
+		To:           reference.To{Managed: &storagev1alpha3.S3Bucket{}, List: &storagev1alpha3.S3BucketList{}},
+		Extract:      storagev1alpha3.S3BucketARN(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.S3BucketARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.S3BucketARNRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -80,10 +80,100 @@ var (
 	RouteTableGroupVersionKind = SchemeGroupVersion.WithKind(RouteTableKind)
 )
 
+// ElasticIP type metadata.
+var (
+	ElasticIPKind             = reflect.TypeOf(ElasticIP{}).Name()
+	ElasticIPGroupKind        = schema.GroupKind{Group: Group, Kind: ElasticIPKind}.String()
+	ElasticIPKindAPIVersion   = ElasticIPKind + "." + SchemeGroupVersion.String()
+	ElasticIPGroupVersionKind = SchemeGroupVersion.WithKind(ElasticIPKind)
+)
+
+// NATGateway type metadata.
+var (
+	NATGatewayKind             = reflect.TypeOf(NATGateway{}).Name()
+	NATGatewayGroupKind        = schema.GroupKind{Group: Group, Kind: NATGatewayKind}.String()
+	NATGatewayKindAPIVersion   = NATGatewayKind + "." + SchemeGroupVersion.String()
+	NATGatewayGroupVersionKind = SchemeGroupVersion.WithKind(NATGatewayKind)
+)
+
+// VPCPeeringConnection type metadata.
+var (
+	VPCPeeringConnectionKind             = reflect.TypeOf(VPCPeeringConnection{}).Name()
+	VPCPeeringConnectionGroupKind        = schema.GroupKind{Group: Group, Kind: VPCPeeringConnectionKind}.String()
+	VPCPeeringConnectionKindAPIVersion   = VPCPeeringConnectionKind + "." + SchemeGroupVersion.String()
+	VPCPeeringConnectionGroupVersionKind = SchemeGroupVersion.WithKind(VPCPeeringConnectionKind)
+)
+
+// VPCEndpoint type metadata.
+var (
+	VPCEndpointKind             = reflect.TypeOf(VPCEndpoint{}).Name()
+	VPCEndpointGroupKind        = schema.GroupKind{Group: Group, Kind: VPCEndpointKind}.String()
+	VPCEndpointKindAPIVersion   = VPCEndpointKind + "." + SchemeGroupVersion.String()
+	VPCEndpointGroupVersionKind = SchemeGroupVersion.WithKind(VPCEndpointKind)
+)
+
+// NetworkACL type metadata.
+var (
+	NetworkACLKind             = reflect.TypeOf(NetworkACL{}).Name()
+	NetworkACLGroupKind        = schema.GroupKind{Group: Group, Kind: NetworkACLKind}.String()
+	NetworkACLKindAPIVersion   = NetworkACLKind + "." + SchemeGroupVersion.String()
+	NetworkACLGroupVersionKind = SchemeGroupVersion.WithKind(NetworkACLKind)
+)
+
+// TransitGateway type metadata.
+var (
+	TransitGatewayKind             = reflect.TypeOf(TransitGateway{}).Name()
+	TransitGatewayGroupKind        = schema.GroupKind{Group: Group, Kind: TransitGatewayKind}.String()
+	TransitGatewayKindAPIVersion   = TransitGatewayKind + "." + SchemeGroupVersion.String()
+	TransitGatewayGroupVersionKind = SchemeGroupVersion.WithKind(TransitGatewayKind)
+)
+
+// TransitGatewayVPCAttachment type metadata.
+var (
+	TransitGatewayVPCAttachmentKind             = reflect.TypeOf(TransitGatewayVPCAttachment{}).Name()
+	TransitGatewayVPCAttachmentGroupKind        = schema.GroupKind{Group: Group, Kind: TransitGatewayVPCAttachmentKind}.String()
+	TransitGatewayVPCAttachmentKindAPIVersion   = TransitGatewayVPCAttachmentKind + "." + SchemeGroupVersion.String()
+	TransitGatewayVPCAttachmentGroupVersionKind = SchemeGroupVersion.WithKind(TransitGatewayVPCAttachmentKind)
+)
+
+// TransitGatewayRouteTable type metadata.
+var (
+	TransitGatewayRouteTableKind             = reflect.TypeOf(TransitGatewayRouteTable{}).Name()
+	TransitGatewayRouteTableGroupKind        = schema.GroupKind{Group: Group, Kind: TransitGatewayRouteTableKind}.String()
+	TransitGatewayRouteTableKindAPIVersion   = TransitGatewayRouteTableKind + "." + SchemeGroupVersion.String()
+	TransitGatewayRouteTableGroupVersionKind = SchemeGroupVersion.WithKind(TransitGatewayRouteTableKind)
+)
+
+// SecurityGroupRule type metadata.
+var (
+	SecurityGroupRuleKind             = reflect.TypeOf(SecurityGroupRule{}).Name()
+	SecurityGroupRuleGroupKind        = schema.GroupKind{Group: Group, Kind: SecurityGroupRuleKind}.String()
+	SecurityGroupRuleKindAPIVersion   = SecurityGroupRuleKind + "." + SchemeGroupVersion.String()
+	SecurityGroupRuleGroupVersionKind = SchemeGroupVersion.WithKind(SecurityGroupRuleKind)
+)
+
+// FlowLog type metadata.
+var (
+	FlowLogKind             = reflect.TypeOf(FlowLog{}).Name()
+	FlowLogGroupKind        = schema.GroupKind{Group: Group, Kind: FlowLogKind}.String()
+	FlowLogKindAPIVersion   = FlowLogKind + "." + SchemeGroupVersion.String()
+	FlowLogGroupVersionKind = SchemeGroupVersion.WithKind(FlowLogKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&VPC{}, &VPCList{})
 	SchemeBuilder.Register(&Subnet{}, &SubnetList{})
 	SchemeBuilder.Register(&SecurityGroup{}, &SecurityGroupList{})
 	SchemeBuilder.Register(&InternetGateway{}, &InternetGatewayList{})
 	SchemeBuilder.Register(&RouteTable{}, &RouteTableList{})
+	SchemeBuilder.Register(&ElasticIP{}, &ElasticIPList{})
+	SchemeBuilder.Register(&NATGateway{}, &NATGatewayList{})
+	SchemeBuilder.Register(&VPCPeeringConnection{}, &VPCPeeringConnectionList{})
+	SchemeBuilder.Register(&VPCEndpoint{}, &VPCEndpointList{})
+	SchemeBuilder.Register(&NetworkACL{}, &NetworkACLList{})
+	SchemeBuilder.Register(&TransitGateway{}, &TransitGatewayList{})
+	SchemeBuilder.Register(&TransitGatewayVPCAttachment{}, &TransitGatewayVPCAttachmentList{})
+	SchemeBuilder.Register(&TransitGatewayRouteTable{}, &TransitGatewayRouteTableList{})
+	SchemeBuilder.Register(&SecurityGroupRule{}, &SecurityGroupRuleList{})
+	SchemeBuilder.Register(&FlowLog{}, &FlowLogList{})
 }
@@ -24,21 +24,82 @@ import (
 	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
 )
 
-// Route describes a route in a route table.
+// Route describes a route in a route table. A route has exactly one
+// destination and exactly one target.
 type Route struct {
 	// The IPv4 CIDR address block used for the destination match. Routing
 	// decisions are based on the most specific match.
-	DestinationCIDRBlock string `json:"destinationCidrBlock"`
+	// +optional
+	DestinationCIDRBlock string `json:"destinationCidrBlock,omitempty"`
+
+	// The IPv6 CIDR block used for the destination match. Routing decisions
+	// are based on the most specific match.
+	// +optional
+	DestinationIPv6CIDRBlock string `json:"destinationIpv6CidrBlock,omitempty"`
 
 	// The ID of an internet gateway or virtual private gateway attached to your
 	// VPC.
+	// +optional
 	GatewayID string `json:"gatewayId,omitempty"`
 
 	// A referencer to retrieve the ID of a gateway
+	// +optional
 	GatewayIDRef *runtimev1alpha1.Reference `json:"gatewayIdRef,omitempty"`
 
 	// A selector to select a referencer to retrieve the ID of a gateway
+	// +optional
 	GatewayIDSelector *runtimev1alpha1.Selector `json:"gatewayIdSelector,omitempty"`
+
+	// The ID of a NAT gateway.
+	// +optional
+	NATGatewayID string `json:"natGatewayId,omitempty"`
+
+	// A referencer to retrieve the ID of a NAT gateway
+	// +optional
+	NATGatewayIDRef *runtimev1alpha1.Reference `json:"natGatewayIdRef,omitempty"`
+
+	// A selector to select a referencer to retrieve the ID of a NAT gateway
+	// +optional
+	NATGatewayIDSelector *runtimev1alpha1.Selector `json:"natGatewayIdSelector,omitempty"`
+
+	// The ID of a VPC peering connection.
+	// +optional
+	VPCPeeringConnectionID string `json:"vpcPeeringConnectionId,omitempty"`
+
+	// A referencer to retrieve the ID of a VPC peering connection
+	// +optional
+	VPCPeeringConnectionIDRef *runtimev1alpha1.Reference `json:"vpcPeeringConnectionIdRef,omitempty"`
+
+	// A selector to select a referencer to retrieve the ID of a VPC peering
+	// connection
+	// +optional
+	VPCPeeringConnectionIDSelector *runtimev1alpha1.Selector `json:"vpcPeeringConnectionIdSelector,omitempty"`
+
+	// The ID of a transit gateway.
+	// +optional
+	TransitGatewayID string `json:"transitGatewayId,omitempty"`
+
+	// A referencer to retrieve the ID of a transit gateway
+	// +optional
+	TransitGatewayIDRef *runtimev1alpha1.Reference `json:"transitGatewayIdRef,omitempty"`
+
+	// A selector to select a referencer to retrieve the ID of a transit
+	// gateway
+	// +optional
+	TransitGatewayIDSelector *runtimev1alpha1.Selector `json:"transitGatewayIdSelector,omitempty"`
+
+	// [IPv6 traffic only] The ID of an egress-only internet gateway.
+	// +optional
+	EgressOnlyInternetGatewayID string `json:"egressOnlyInternetGatewayId,omitempty"`
+
+	// The ID of a NAT instance in your VPC. The instance must have exactly one
+	// network interface attached.
+	// +optional
+	InstanceID string `json:"instanceId,omitempty"`
+
+	// The ID of a network interface.
+	// +optional
+	NetworkInterfaceID string `json:"networkInterfaceId,omitempty"`
 }
 
 // RouteState describes a route state in the route table.
@@ -48,6 +109,10 @@ type RouteState struct {
 	// to the VPC, or the specified NAT instance has been terminated).
 	RouteState string `json:"routeState,omitempty"`
 
+	// The prefix of the AWS service the route points to. Such routes are
+	// added by gateway VPC endpoints that are associated with the route table.
+	DestinationPrefixListID string `json:"destinationPrefixListId,omitempty"`
+
 	Route `json:",inline"`
 }
 
@@ -86,11 +151,21 @@ type RouteTableParameters struct {
 	// VPCIDSelector selects a reference to a VPC to retrieve its vpcId
 	VPCIDSelector *runtimev1alpha1.Selector `json:"vpcIdSelector,omitempty"`
 
-	// the routes in the route table
+	// the routes in the route table. The routes of the route table are only
+	// managed if any are declared here.
 	Routes []Route `json:"routes,omitempty"`
 
 	// The associations between the route table and one or more subnets.
 	Associations []Association `json:"associations,omitempty"`
+
+	// Lookup makes the controller adopt an existing route table that matches the
+	// given tags and filters instead of creating a new one, as long as no
+	// external name is set. Only the route tables of the VPC given by vpcId are
+	// considered. Reconciliation fails unless exactly one route table matches.
+	// The routes of the adopted route table are kept as long as Routes is
+	// empty.
+	// +optional
+	Lookup *LookupParameters `json:"lookup,omitempty"`
 }
 
 // A RouteTableSpec defines the desired state of a RouteTable.
@@ -150,14 +225,21 @@ func (t *RouteTable) UpdateExternalStatus(observation ec2.RouteTable) {
 	st.Routes = make([]RouteState, len(observation.Routes))
 	for i, rt := range observation.Routes {
 		st.Routes[i] = RouteState{
-			RouteState: string(rt.State),
+			RouteState:              string(rt.State),
+			DestinationPrefixListID: aws.StringValue(rt.DestinationPrefixListId),
 			Route: Route{
-				DestinationCIDRBlock: aws.StringValue(rt.DestinationCidrBlock),
-				GatewayID:            aws.StringValue(rt.GatewayId),
+				DestinationCIDRBlock:        aws.StringValue(rt.DestinationCidrBlock),
+				DestinationIPv6CIDRBlock:    aws.StringValue(rt.DestinationIpv6CidrBlock),
+				GatewayID:                   aws.StringValue(rt.GatewayId),
+				NATGatewayID:                aws.StringValue(rt.NatGatewayId),
+				VPCPeeringConnectionID:      aws.StringValue(rt.VpcPeeringConnectionId),
+				TransitGatewayID:            aws.StringValue(rt.TransitGatewayId),
+				EgressOnlyInternetGatewayID: aws.StringValue(rt.EgressOnlyInternetGatewayId),
+				InstanceID:                  aws.StringValue(rt.InstanceId),
+				NetworkInterfaceID:          aws.StringValue(rt.NetworkInterfaceId),
 			},
 		}
 	}
-
 	st.Associations = make([]AssociationState, len(observation.Associations))
 	for i, asc := range observation.Associations {
 		st.Associations[i] = AssociationState{
@@ -45,13 +45,26 @@ type SecurityGroupParameters struct {
 	// The name of the security group.
 	GroupName string `json:"groupName"`
 
-	// One or more inbound rules associated with the security group.
+	// One or more inbound rules associated with the security group. Rules
+	// that are owned by SecurityGroupRules targeting this security group are
+	// left alone. The inbound rules of the security group are only managed if
+	// any are declared here or by SecurityGroupRules.
 	// +optional
 	Ingress []IPPermission `json:"ingress,omitempty"`
 
 	// [EC2-VPC] One or more outbound rules associated with the security group.
 	// +optional
 	Egress []IPPermission `json:"egress,omitempty"`
+
+	// Lookup makes the controller adopt an existing security group that matches the
+	// given tags and filters instead of creating a new one, as long as no
+	// external name is set. Only the security groups of the VPC given by vpcId are
+	// considered. Reconciliation fails unless exactly one security group matches.
+	// The rules of the adopted security group are reconciled against Ingress
+	// and Egress like those of any other security group, so they are kept as
+	// long as neither declares any.
+	// +optional
+	Lookup *LookupParameters `json:"lookup,omitempty"`
 }
 
 // IPRange describes an IPv4 range.
@@ -112,6 +125,15 @@ type UserIDGroupPair struct {
 	// +optional
 	GroupID *string `json:"groupId,omitempty"`
 
+	// GroupIDRef references a SecurityGroup to retrieve its groupId
+	// +optional
+	GroupIDRef *runtimev1alpha1.Reference `json:"groupIdRef,omitempty"`
+
+	// GroupIDSelector selects a reference to a SecurityGroup to retrieve its
+	// groupId
+	// +optional
+	GroupIDSelector *runtimev1alpha1.Selector `json:"groupIdSelector,omitempty"`
+
 	// The name of the security group. In a request, use this parameter for a security
 	// group in EC2-Classic or a default VPC only. For a security group in a nondefault
 	// VPC, use the security group ID.
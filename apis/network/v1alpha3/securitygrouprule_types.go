@@ -0,0 +1,129 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Types of security group rules.
+const (
+	SecurityGroupRuleTypeIngress = "ingress"
+	SecurityGroupRuleTypeEgress  = "egress"
+)
+
+// SecurityGroupRuleParameters define the desired state of a single rule of an
+// AWS VPC Security Group. Exactly one of CIDRIP, CIDRIPv6, PrefixListID and
+// UserIDGroupPair must be set.
+type SecurityGroupRuleParameters struct {
+	// SecurityGroupID is the ID of the security group the rule belongs to.
+	// +optional
+	SecurityGroupID *string `json:"securityGroupId,omitempty"`
+
+	// SecurityGroupIDRef references a SecurityGroup to retrieve its groupId
+	// +optional
+	SecurityGroupIDRef *runtimev1alpha1.Reference `json:"securityGroupIdRef,omitempty"`
+
+	// SecurityGroupIDSelector selects a reference to a SecurityGroup to
+	// retrieve its groupId
+	// +optional
+	SecurityGroupIDSelector *runtimev1alpha1.Selector `json:"securityGroupIdSelector,omitempty"`
+
+	// Type is the direction of the rule.
+	// +kubebuilder:validation:Enum=ingress;egress
+	Type string `json:"type"`
+
+	// The IP protocol name (tcp, udp, icmp, icmpv6) or number. Use -1 to
+	// specify all protocols.
+	IPProtocol string `json:"protocol"`
+
+	// The start of port range for the TCP and UDP protocols, or an ICMP/ICMPv6
+	// type number.
+	// +optional
+	FromPort *int64 `json:"fromPort,omitempty"`
+
+	// The end of port range for the TCP and UDP protocols, or an ICMP/ICMPv6
+	// code.
+	// +optional
+	ToPort *int64 `json:"toPort,omitempty"`
+
+	// CIDRIP is the IPv4 range the rule allows traffic from or to.
+	// +optional
+	CIDRIP *string `json:"cidrIp,omitempty"`
+
+	// CIDRIPv6 is the IPv6 range the rule allows traffic from or to.
+	// +optional
+	CIDRIPv6 *string `json:"cidrIPv6,omitempty"`
+
+	// PrefixListID is the ID of the prefix list the rule allows traffic from
+	// or to.
+	// +optional
+	PrefixListID *string `json:"prefixListId,omitempty"`
+
+	// UserIDGroupPair is the security group the rule allows traffic from or
+	// to.
+	// +optional
+	UserIDGroupPair *UserIDGroupPair `json:"userIdGroupPair,omitempty"`
+
+	// A description of the rule.
+	// +optional
+	Description *string `json:"description,omitempty"`
+}
+
+// A SecurityGroupRuleSpec defines the desired state of a SecurityGroupRule.
+type SecurityGroupRuleSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  SecurityGroupRuleParameters `json:"forProvider"`
+}
+
+// A SecurityGroupRuleStatus represents the observed state of a
+// SecurityGroupRule.
+type SecurityGroupRuleStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SecurityGroupRule is a managed resource that represents a single ingress
+// or egress rule of an AWS VPC Security Group. Its external name consists of
+// the security group ID, the type, the protocol, the port range and the
+// source or destination of the rule, e.g. sg-0123_ingress_tcp_80_80_10.0.0.0/16.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="GROUPID",type="string",JSONPath=".spec.forProvider.securityGroupId"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.type"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type SecurityGroupRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SecurityGroupRuleSpec   `json:"spec"`
+	Status SecurityGroupRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SecurityGroupRuleList contains a list of SecurityGroupRules
+type SecurityGroupRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SecurityGroupRule `json:"items"`
+}
@@ -42,6 +42,36 @@ type SubnetParameters struct {
 
 	// VPCIDSelector selects reference to a VPC to retrieve its vpcId
 	VPCIDSelector *runtimev1alpha1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// MapPublicIPOnLaunch indicates whether network interfaces created in
+	// this subnet receive a public IPv4 address.
+	// +optional
+	MapPublicIPOnLaunch *bool `json:"mapPublicIPOnLaunch,omitempty"`
+
+	// AssignIPv6AddressOnCreation indicates whether a network interface
+	// created in this subnet receives an IPv6 address.
+	// +optional
+	AssignIPv6AddressOnCreation *bool `json:"assignIpv6AddressOnCreation,omitempty"`
+
+	// IPv6CIDRBlock is the IPv6 network range for the subnet, in CIDR
+	// notation. The subnet size must use a /64 prefix length. Removing it
+	// disassociates the block from the subnet, while an IPv6 CIDR block that
+	// was not set from the spec is left alone.
+	// +optional
+	IPv6CIDRBlock *string `json:"ipv6CidrBlock,omitempty"`
+
+	// Tags are used as identification helpers between AWS resources. Tags
+	// that are removed from this list are removed from the subnet, while
+	// tags that were added to the subnet by other systems are kept.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+
+	// Lookup makes the controller adopt an existing subnet that matches the
+	// given tags and filters instead of creating a new one, as long as no
+	// external name is set. Only the subnets of the VPC given by vpcId are
+	// considered. Reconciliation fails unless exactly one subnet matches.
+	// +optional
+	Lookup *LookupParameters `json:"lookup,omitempty"`
 }
 
 // A SubnetSpec defines the desired state of a Subnet.
@@ -64,6 +94,16 @@ type SubnetExternalStatus struct {
 type SubnetStatus struct {
 	runtimev1alpha1.ResourceStatus `json:",inline"`
 	SubnetExternalStatus           `json:",inline"`
+
+	// ManagedTagKeys are the keys of the tags that were last set on the
+	// subnet from the spec. Only these tags are removed when they are
+	// removed from the spec.
+	ManagedTagKeys []string `json:"managedTagKeys,omitempty"`
+
+	// ManagedIPv6CIDRBlock is the IPv6 CIDR block that was last associated
+	// with the subnet from the spec. It is only disassociated when it is
+	// removed from the spec.
+	ManagedIPv6CIDRBlock *string `json:"managedIpv6CidrBlock,omitempty"`
 }
 
 // +kubebuilder:object:root=true
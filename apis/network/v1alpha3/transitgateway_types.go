@@ -0,0 +1,139 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// TransitGatewayParameters define the desired state of an AWS Transit
+// Gateway.
+type TransitGatewayParameters struct {
+	// Description is the description of the transit gateway.
+	// +immutable
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// AmazonSideASN is the private Autonomous System Number for the Amazon
+	// side of a BGP session. Defaults to 64512.
+	// +immutable
+	// +optional
+	AmazonSideASN *int64 `json:"amazonSideAsn,omitempty"`
+
+	// AutoAcceptSharedAttachments enables automatic acceptance of cross-account
+	// attachments. Defaults to disable.
+	// +kubebuilder:validation:Enum=enable;disable
+	// +immutable
+	// +optional
+	AutoAcceptSharedAttachments *string `json:"autoAcceptSharedAttachments,omitempty"`
+
+	// DefaultRouteTableAssociation enables automatic association of
+	// attachments with the default route table. Defaults to enable.
+	// +kubebuilder:validation:Enum=enable;disable
+	// +immutable
+	// +optional
+	DefaultRouteTableAssociation *string `json:"defaultRouteTableAssociation,omitempty"`
+
+	// DefaultRouteTablePropagation enables automatic propagation of the routes
+	// of attachments to the default route table. Defaults to enable.
+	// +kubebuilder:validation:Enum=enable;disable
+	// +immutable
+	// +optional
+	DefaultRouteTablePropagation *string `json:"defaultRouteTablePropagation,omitempty"`
+
+	// DNSSupport enables DNS support. Defaults to enable.
+	// +kubebuilder:validation:Enum=enable;disable
+	// +immutable
+	// +optional
+	DNSSupport *string `json:"dnsSupport,omitempty"`
+
+	// VPNECMPSupport enables Equal Cost Multipath Protocol support for VPN
+	// attachments. Defaults to enable.
+	// +kubebuilder:validation:Enum=enable;disable
+	// +immutable
+	// +optional
+	VPNECMPSupport *string `json:"vpnEcmpSupport,omitempty"`
+
+	// Tags represents the tags of the transit gateway.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A TransitGatewaySpec defines the desired state of a TransitGateway.
+type TransitGatewaySpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  TransitGatewayParameters `json:"forProvider"`
+}
+
+// TransitGatewayObservation keeps the state for the external resource
+type TransitGatewayObservation struct {
+	// State is the state of the transit gateway.
+	State string `json:"state,omitempty"`
+
+	// OwnerID is the ID of the AWS account that owns the transit gateway.
+	OwnerID string `json:"ownerId,omitempty"`
+
+	// TransitGatewayARN is the Amazon Resource Name of the transit gateway.
+	TransitGatewayARN string `json:"transitGatewayArn,omitempty"`
+
+	// AssociationDefaultRouteTableID is the ID of the default association
+	// route table.
+	AssociationDefaultRouteTableID string `json:"associationDefaultRouteTableId,omitempty"`
+
+	// PropagationDefaultRouteTableID is the ID of the default propagation
+	// route table.
+	PropagationDefaultRouteTableID string `json:"propagationDefaultRouteTableId,omitempty"`
+}
+
+// A TransitGatewayStatus represents the observed state of a TransitGateway.
+type TransitGatewayStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     TransitGatewayObservation `json:"atProvider,omitempty"`
+
+	// ManagedTagKeys are the keys of the tags that were last set on the transit
+	// gateway from the spec. Only these tags are removed when they are removed
+	// from the spec.
+	ManagedTagKeys []string `json:"managedTagKeys,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TransitGateway is a managed resource that represents an AWS Transit
+// Gateway.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type TransitGateway struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TransitGatewaySpec   `json:"spec"`
+	Status TransitGatewayStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TransitGatewayList contains a list of TransitGateways
+type TransitGatewayList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TransitGateway `json:"items"`
+}
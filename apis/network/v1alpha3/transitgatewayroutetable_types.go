@@ -0,0 +1,157 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// TransitGatewayRouteTableParameters define the desired state of an AWS
+// Transit Gateway route table.
+type TransitGatewayRouteTableParameters struct {
+	// TransitGatewayID is the ID of the transit gateway.
+	// +immutable
+	// +optional
+	TransitGatewayID *string `json:"transitGatewayId,omitempty"`
+
+	// TransitGatewayIDRef references a TransitGateway to retrieve its ID
+	// +optional
+	TransitGatewayIDRef *runtimev1alpha1.Reference `json:"transitGatewayIdRef,omitempty"`
+
+	// TransitGatewayIDSelector selects a reference to a TransitGateway to
+	// retrieve its ID
+	// +optional
+	TransitGatewayIDSelector *runtimev1alpha1.Selector `json:"transitGatewayIdSelector,omitempty"`
+
+	// AssociatedAttachmentIDs are the IDs of the attachments whose traffic is
+	// routed by this route table. An attachment can be associated with only
+	// one route table.
+	// +optional
+	AssociatedAttachmentIDs []string `json:"associatedAttachmentIds,omitempty"`
+
+	// AssociatedAttachmentIDRefs is a set of references that each retrieve
+	// the ID from the referenced TransitGatewayVPCAttachment
+	// +optional
+	AssociatedAttachmentIDRefs []runtimev1alpha1.Reference `json:"associatedAttachmentIdRefs,omitempty"`
+
+	// AssociatedAttachmentIDSelector selects a set of references that each
+	// retrieve the ID from the referenced TransitGatewayVPCAttachment
+	// +optional
+	AssociatedAttachmentIDSelector *runtimev1alpha1.Selector `json:"associatedAttachmentIdSelector,omitempty"`
+
+	// PropagatedAttachmentIDs are the IDs of the attachments whose routes are
+	// propagated to this route table.
+	// +optional
+	PropagatedAttachmentIDs []string `json:"propagatedAttachmentIds,omitempty"`
+
+	// PropagatedAttachmentIDRefs is a set of references that each retrieve
+	// the ID from the referenced TransitGatewayVPCAttachment
+	// +optional
+	PropagatedAttachmentIDRefs []runtimev1alpha1.Reference `json:"propagatedAttachmentIdRefs,omitempty"`
+
+	// PropagatedAttachmentIDSelector selects a set of references that each
+	// retrieve the ID from the referenced TransitGatewayVPCAttachment
+	// +optional
+	PropagatedAttachmentIDSelector *runtimev1alpha1.Selector `json:"propagatedAttachmentIdSelector,omitempty"`
+
+	// Tags represents the tags of the route table.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A TransitGatewayRouteTableSpec defines the desired state of a
+// TransitGatewayRouteTable.
+type TransitGatewayRouteTableSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  TransitGatewayRouteTableParameters `json:"forProvider"`
+}
+
+// TransitGatewayRouteTableAttachment describes the association or
+// propagation of an attachment.
+type TransitGatewayRouteTableAttachment struct {
+	// AttachmentID is the ID of the attachment.
+	AttachmentID string `json:"attachmentId,omitempty"`
+
+	// ResourceID is the ID of the attached resource, e.g. the VPC ID.
+	ResourceID string `json:"resourceId,omitempty"`
+
+	// State is the state of the association or propagation.
+	State string `json:"state,omitempty"`
+}
+
+// TransitGatewayRouteTableObservation keeps the state for the external
+// resource
+type TransitGatewayRouteTableObservation struct {
+	// State is the state of the route table.
+	State string `json:"state,omitempty"`
+
+	// DefaultAssociationRouteTable indicates whether this is the default
+	// association route table of the transit gateway.
+	DefaultAssociationRouteTable bool `json:"defaultAssociationRouteTable,omitempty"`
+
+	// DefaultPropagationRouteTable indicates whether this is the default
+	// propagation route table of the transit gateway.
+	DefaultPropagationRouteTable bool `json:"defaultPropagationRouteTable,omitempty"`
+
+	// Associations are the attachments associated with the route table.
+	Associations []TransitGatewayRouteTableAttachment `json:"associations,omitempty"`
+
+	// Propagations are the attachments that propagate routes to the route
+	// table.
+	Propagations []TransitGatewayRouteTableAttachment `json:"propagations,omitempty"`
+}
+
+// A TransitGatewayRouteTableStatus represents the observed state of a
+// TransitGatewayRouteTable.
+type TransitGatewayRouteTableStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     TransitGatewayRouteTableObservation `json:"atProvider,omitempty"`
+
+	// ManagedTagKeys are the keys of the tags that were last set on the transit
+	// gateway route table from the spec. Only these tags are removed when they
+	// are removed from the spec.
+	ManagedTagKeys []string `json:"managedTagKeys,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TransitGatewayRouteTable is a managed resource that represents an AWS
+// Transit Gateway route table.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TRANSITGATEWAY",type="string",JSONPath=".spec.forProvider.transitGatewayId"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type TransitGatewayRouteTable struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TransitGatewayRouteTableSpec   `json:"spec"`
+	Status TransitGatewayRouteTableStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TransitGatewayRouteTableList contains a list of TransitGatewayRouteTables
+type TransitGatewayRouteTableList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TransitGatewayRouteTable `json:"items"`
+}
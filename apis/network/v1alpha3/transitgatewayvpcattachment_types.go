@@ -0,0 +1,143 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// TransitGatewayVPCAttachmentParameters define the desired state of an AWS
+// Transit Gateway VPC attachment.
+type TransitGatewayVPCAttachmentParameters struct {
+	// TransitGatewayID is the ID of the transit gateway.
+	// +immutable
+	// +optional
+	TransitGatewayID *string `json:"transitGatewayId,omitempty"`
+
+	// TransitGatewayIDRef references a TransitGateway to retrieve its ID
+	// +optional
+	TransitGatewayIDRef *runtimev1alpha1.Reference `json:"transitGatewayIdRef,omitempty"`
+
+	// TransitGatewayIDSelector selects a reference to a TransitGateway to
+	// retrieve its ID
+	// +optional
+	TransitGatewayIDSelector *runtimev1alpha1.Selector `json:"transitGatewayIdSelector,omitempty"`
+
+	// VPCID is the ID of the VPC to attach.
+	// +immutable
+	// +optional
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its vpcId
+	// +optional
+	VPCIDRef *runtimev1alpha1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its vpcId
+	// +optional
+	VPCIDSelector *runtimev1alpha1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// SubnetIDs are the IDs of the subnets in which the transit gateway
+	// places its network interfaces. Only one subnet per Availability Zone
+	// can be specified.
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SubnetIDRefs is a set of references that each retrieve the subnetID
+	// from the referenced Subnet
+	// +optional
+	SubnetIDRefs []runtimev1alpha1.Reference `json:"subnetIdRefs,omitempty"`
+
+	// SubnetIDSelector selects a set of references that each retrieve the
+	// subnetID from the referenced Subnet
+	// +optional
+	SubnetIDSelector *runtimev1alpha1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// DNSSupport enables DNS support for the attachment. Defaults to enable.
+	// +kubebuilder:validation:Enum=enable;disable
+	// +optional
+	DNSSupport *string `json:"dnsSupport,omitempty"`
+
+	// IPv6Support enables IPv6 support for the attachment. Defaults to
+	// disable.
+	// +kubebuilder:validation:Enum=enable;disable
+	// +optional
+	IPv6Support *string `json:"ipv6Support,omitempty"`
+
+	// Tags represents the tags of the attachment.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A TransitGatewayVPCAttachmentSpec defines the desired state of a
+// TransitGatewayVPCAttachment.
+type TransitGatewayVPCAttachmentSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  TransitGatewayVPCAttachmentParameters `json:"forProvider"`
+}
+
+// TransitGatewayVPCAttachmentObservation keeps the state for the external
+// resource
+type TransitGatewayVPCAttachmentObservation struct {
+	// State is the state of the attachment.
+	State string `json:"state,omitempty"`
+
+	// VPCOwnerID is the ID of the AWS account that owns the VPC.
+	VPCOwnerID string `json:"vpcOwnerId,omitempty"`
+}
+
+// A TransitGatewayVPCAttachmentStatus represents the observed state of a
+// TransitGatewayVPCAttachment.
+type TransitGatewayVPCAttachmentStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     TransitGatewayVPCAttachmentObservation `json:"atProvider,omitempty"`
+
+	// ManagedTagKeys are the keys of the tags that were last set on the transit
+	// gateway VPC attachment from the spec. Only these tags are removed when
+	// they are removed from the spec.
+	ManagedTagKeys []string `json:"managedTagKeys,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TransitGatewayVPCAttachment is a managed resource that represents the
+// attachment of a VPC to an AWS Transit Gateway.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TRANSITGATEWAY",type="string",JSONPath=".spec.forProvider.transitGatewayId"
+// +kubebuilder:printcolumn:name="VPCID",type="string",JSONPath=".spec.forProvider.vpcId"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type TransitGatewayVPCAttachment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TransitGatewayVPCAttachmentSpec   `json:"spec"`
+	Status TransitGatewayVPCAttachmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TransitGatewayVPCAttachmentList contains a list of
+// TransitGatewayVPCAttachments
+type TransitGatewayVPCAttachmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TransitGatewayVPCAttachment `json:"items"`
+}
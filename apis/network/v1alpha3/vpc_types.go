@@ -19,6 +19,7 @@ package v1alpha3
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
 )
@@ -30,14 +31,40 @@ type VPCParameters struct {
 	// +kubebuilder:validation:Required
 	CIDRBlock string `json:"cidrBlock"`
 
+	// SecondaryCIDRBlocks are additional IPv4 network ranges that are
+	// associated with the VPC, in CIDR notation.
+	// +optional
+	SecondaryCIDRBlocks []string `json:"secondaryCidrBlocks,omitempty"`
+
+	// AmazonProvidedIPv6CIDRBlock requests an Amazon-provided IPv6 CIDR block
+	// with a /56 prefix length for the VPC. Setting it to false disassociates
+	// the IPv6 CIDR block.
+	// +optional
+	AmazonProvidedIPv6CIDRBlock *bool `json:"amazonProvidedIpv6CidrBlock,omitempty"`
+
+	// InstanceTenancy is the allowed tenancy of instances launched into the
+	// VPC. A dedicated VPC can only be changed to default after creation.
+	// +kubebuilder:validation:Enum=default;dedicated
+	// +optional
+	InstanceTenancy *string `json:"instanceTenancy,omitempty"`
+
 	// A boolean flag to enable/disable DNS support in the VPC
 	EnableDNSSupport bool `json:"enableDnsSupport,omitempty"`
 
 	// A boolean flag to enable/disable DNS hostnames in the VPC
 	EnableDNSHostNames bool `json:"enableDnsHostNames,omitempty"`
 
-	// Tags are used as identification helpers between AWS resources.
+	// Tags are used as identification helpers between AWS resources. Tags
+	// that are removed from this list are removed from the VPC, while tags
+	// that were added to the VPC by other systems are kept.
 	Tags []Tag `json:"tags,omitempty"`
+
+	// Lookup makes the controller adopt an existing VPC that matches the
+	// given tags and filters instead of creating a new one, as long as no
+	// external name is set. Reconciliation fails unless exactly one
+	// VPC matches.
+	// +optional
+	Lookup *LookupParameters `json:"lookup,omitempty"`
 }
 
 // A VPCSpec defines the desired state of a VPC.
@@ -54,12 +81,36 @@ type VPCExternalStatus struct {
 
 	// Tags represents to current ec2 tags.
 	Tags []Tag `json:"tags,omitempty"`
+
+	// CIDRBlockAssociations are the IPv4 CIDR blocks associated with the VPC.
+	CIDRBlockAssociations []VPCCIDRBlockAssociation `json:"cidrBlockAssociations,omitempty"`
+
+	// IPv6CIDRBlockAssociations are the IPv6 CIDR blocks associated with the
+	// VPC.
+	IPv6CIDRBlockAssociations []VPCCIDRBlockAssociation `json:"ipv6CidrBlockAssociations,omitempty"`
+}
+
+// VPCCIDRBlockAssociation describes a CIDR block associated with a VPC.
+type VPCCIDRBlockAssociation struct {
+	// AssociationID is the ID of the association.
+	AssociationID string `json:"associationId,omitempty"`
+
+	// CIDRBlock is the associated CIDR block.
+	CIDRBlock string `json:"cidrBlock,omitempty"`
+
+	// State is the state of the association.
+	State string `json:"state,omitempty"`
 }
 
 // A VPCStatus represents the observed state of a VPC.
 type VPCStatus struct {
 	runtimev1alpha1.ResourceStatus `json:",inline"`
 	VPCExternalStatus              `json:",inline"`
+
+	// ManagedTagKeys are the keys of the tags that were last set on the VPC
+	// from the spec. Only these tags are removed when they are removed from
+	// the spec.
+	ManagedTagKeys []string `json:"managedTagKeys,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -96,4 +147,25 @@ func (v *VPC) UpdateExternalStatus(observation ec2.Vpc) {
 		Tags:     BuildFromEC2Tags(observation.Tags),
 		VPCState: string(observation.State),
 	}
+	for _, a := range observation.CidrBlockAssociationSet {
+		v.Status.CIDRBlockAssociations = append(v.Status.CIDRBlockAssociations, VPCCIDRBlockAssociation{
+			AssociationID: aws.StringValue(a.AssociationId),
+			CIDRBlock:     aws.StringValue(a.CidrBlock),
+			State:         cidrBlockState(a.CidrBlockState),
+		})
+	}
+	for _, a := range observation.Ipv6CidrBlockAssociationSet {
+		v.Status.IPv6CIDRBlockAssociations = append(v.Status.IPv6CIDRBlockAssociations, VPCCIDRBlockAssociation{
+			AssociationID: aws.StringValue(a.AssociationId),
+			CIDRBlock:     aws.StringValue(a.Ipv6CidrBlock),
+			State:         cidrBlockState(a.Ipv6CidrBlockState),
+		})
+	}
+}
+
+func cidrBlockState(s *ec2.VpcCidrBlockState) string {
+	if s == nil {
+		return ""
+	}
+	return string(s.State)
 }
@@ -0,0 +1,179 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// VPCEndpointParameters define the desired state of an AWS VPC Endpoint.
+type VPCEndpointParameters struct {
+	// VPCID is the ID of the VPC in which the endpoint is created.
+	// +immutable
+	// +optional
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its vpcId
+	// +optional
+	VPCIDRef *runtimev1alpha1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its vpcId
+	// +optional
+	VPCIDSelector *runtimev1alpha1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// ServiceName is the service name, e.g. com.amazonaws.us-east-1.s3.
+	// +immutable
+	ServiceName string `json:"serviceName"`
+
+	// VPCEndpointType is the type of the endpoint. Defaults to Gateway.
+	// +kubebuilder:validation:Enum=Gateway;Interface
+	// +immutable
+	// +optional
+	VPCEndpointType *string `json:"vpcEndpointType,omitempty"`
+
+	// PolicyDocument is a policy in JSON format that controls access to the
+	// service. Defaults to a policy that allows full access.
+	// +optional
+	PolicyDocument *string `json:"policyDocument,omitempty"`
+
+	// RouteTableIDs are the IDs of the route tables that are associated with
+	// a Gateway endpoint.
+	// +optional
+	RouteTableIDs []string `json:"routeTableIds,omitempty"`
+
+	// RouteTableIDRefs is a set of references that each retrieve the ID from
+	// the referenced RouteTable
+	// +optional
+	RouteTableIDRefs []runtimev1alpha1.Reference `json:"routeTableIdRefs,omitempty"`
+
+	// RouteTableIDSelector selects a set of references that each retrieve the
+	// ID from the referenced RouteTable
+	// +optional
+	RouteTableIDSelector *runtimev1alpha1.Selector `json:"routeTableIdSelector,omitempty"`
+
+	// SubnetIDs are the IDs of the subnets in which an endpoint network
+	// interface is created for an Interface endpoint.
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SubnetIDRefs is a set of references that each retrieve the subnetID
+	// from the referenced Subnet
+	// +optional
+	SubnetIDRefs []runtimev1alpha1.Reference `json:"subnetIdRefs,omitempty"`
+
+	// SubnetIDSelector selects a set of references that each retrieve the
+	// subnetID from the referenced Subnet
+	// +optional
+	SubnetIDSelector *runtimev1alpha1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// SecurityGroupIDs are the IDs of the security groups that are associated
+	// with the network interfaces of an Interface endpoint. Defaults to the
+	// default security group of the VPC.
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+
+	// SecurityGroupIDRefs is a set of references that each retrieve the ID
+	// from the referenced SecurityGroup
+	// +optional
+	SecurityGroupIDRefs []runtimev1alpha1.Reference `json:"securityGroupIdRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects a set of references that each retrieve
+	// the ID from the referenced SecurityGroup
+	// +optional
+	SecurityGroupIDSelector *runtimev1alpha1.Selector `json:"securityGroupIdSelector,omitempty"`
+
+	// PrivateDNSEnabled indicates whether to associate a private hosted zone
+	// with the VPC for an Interface endpoint.
+	// +optional
+	PrivateDNSEnabled *bool `json:"privateDnsEnabled,omitempty"`
+
+	// Tags represents the tags of the VPC endpoint.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A VPCEndpointSpec defines the desired state of a VPCEndpoint.
+type VPCEndpointSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  VPCEndpointParameters `json:"forProvider"`
+}
+
+// DNSEntry describes a DNS entry of a VPC endpoint.
+type DNSEntry struct {
+	// DNSName is the DNS name.
+	DNSName string `json:"dnsName,omitempty"`
+
+	// HostedZoneID is the ID of the private hosted zone.
+	HostedZoneID string `json:"hostedZoneId,omitempty"`
+}
+
+// VPCEndpointObservation keeps the state for the external resource
+type VPCEndpointObservation struct {
+	// State is the state of the VPC endpoint.
+	State string `json:"state,omitempty"`
+
+	// OwnerID is the ID of the AWS account that owns the VPC endpoint.
+	OwnerID string `json:"ownerId,omitempty"`
+
+	// DNSEntries are the DNS entries of an Interface endpoint.
+	DNSEntries []DNSEntry `json:"dnsEntries,omitempty"`
+
+	// NetworkInterfaceIDs are the IDs of the network interfaces of an
+	// Interface endpoint.
+	NetworkInterfaceIDs []string `json:"networkInterfaceIds,omitempty"`
+}
+
+// A VPCEndpointStatus represents the observed state of a VPCEndpoint.
+type VPCEndpointStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     VPCEndpointObservation `json:"atProvider,omitempty"`
+
+	// ManagedTagKeys are the keys of the tags that were last set on the VPC
+	// endpoint from the spec. Only these tags are removed when they are removed
+	// from the spec.
+	ManagedTagKeys []string `json:"managedTagKeys,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VPCEndpoint is a managed resource that represents an AWS VPC Endpoint.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="SERVICE",type="string",JSONPath=".spec.forProvider.serviceName"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.vpcEndpointType"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type VPCEndpoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VPCEndpointSpec   `json:"spec"`
+	Status VPCEndpointStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VPCEndpointList contains a list of VPCEndpoints
+type VPCEndpointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VPCEndpoint `json:"items"`
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// VPCPeeringConnectionOptions describes the options of one side of a VPC
+// peering connection.
+type VPCPeeringConnectionOptions struct {
+	// AllowDNSResolutionFromRemoteVPC enables a local VPC to resolve public
+	// DNS hostnames to private IP addresses when queried from instances in
+	// the peer VPC.
+	// +optional
+	AllowDNSResolutionFromRemoteVPC *bool `json:"allowDnsResolutionFromRemoteVpc,omitempty"`
+}
+
+// VPCPeeringConnectionParameters define the desired state of an AWS VPC
+// peering connection.
+type VPCPeeringConnectionParameters struct {
+	// VPCID is the ID of the requester VPC.
+	// +immutable
+	// +optional
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its vpcId
+	// +optional
+	VPCIDRef *runtimev1alpha1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its vpcId
+	// +optional
+	VPCIDSelector *runtimev1alpha1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// PeerVPCID is the ID of the accepter VPC.
+	// +immutable
+	// +optional
+	PeerVPCID *string `json:"peerVpcId,omitempty"`
+
+	// PeerVPCIDRef references a VPC to retrieve its vpcId as the accepter
+	// VPC. The peering connection is accepted automatically when the accepter
+	// VPC is referenced.
+	// +optional
+	PeerVPCIDRef *runtimev1alpha1.Reference `json:"peerVpcIdRef,omitempty"`
+
+	// PeerVPCIDSelector selects a reference to a VPC to retrieve its vpcId as
+	// the accepter VPC.
+	// +optional
+	PeerVPCIDSelector *runtimev1alpha1.Selector `json:"peerVpcIdSelector,omitempty"`
+
+	// PeerOwnerID is the AWS account ID of the owner of the accepter VPC.
+	// Defaults to the account of the requester.
+	// +immutable
+	// +optional
+	PeerOwnerID *string `json:"peerOwnerId,omitempty"`
+
+	// PeerRegion is the region code of the accepter VPC. Defaults to the
+	// region of the requester.
+	// +immutable
+	// +optional
+	PeerRegion *string `json:"peerRegion,omitempty"`
+
+	// AccepterProviderReference references the Provider whose credentials
+	// are used to accept the peering connection and to manage the accepter
+	// options when the accepter VPC belongs to another account. Defaults to
+	// the Provider of this resource.
+	// +optional
+	AccepterProviderReference *corev1.ObjectReference `json:"accepterProviderRef,omitempty"`
+
+	// RequesterPeeringOptions are the peering options of the requester VPC.
+	// +optional
+	RequesterPeeringOptions *VPCPeeringConnectionOptions `json:"requesterPeeringOptions,omitempty"`
+
+	// AccepterPeeringOptions are the peering options of the accepter VPC.
+	// +optional
+	AccepterPeeringOptions *VPCPeeringConnectionOptions `json:"accepterPeeringOptions,omitempty"`
+
+	// Tags represents the tags of the VPC peering connection.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A VPCPeeringConnectionSpec defines the desired state of a
+// VPCPeeringConnection.
+type VPCPeeringConnectionSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  VPCPeeringConnectionParameters `json:"forProvider"`
+}
+
+// VPCPeeringConnectionVPCInfo describes one side of a VPC peering
+// connection.
+type VPCPeeringConnectionVPCInfo struct {
+	// VPCID is the ID of the VPC.
+	VPCID string `json:"vpcId,omitempty"`
+
+	// OwnerID is the AWS account ID of the owner of the VPC.
+	OwnerID string `json:"ownerId,omitempty"`
+
+	// Region is the region in which the VPC is located.
+	Region string `json:"region,omitempty"`
+
+	// CIDRBlock is the IPv4 CIDR block of the VPC. It is only reported once
+	// the peering connection is active.
+	CIDRBlock string `json:"cidrBlock,omitempty"`
+
+	// AllowDNSResolutionFromRemoteVPC indicates whether DNS resolution from
+	// the remote VPC is enabled.
+	AllowDNSResolutionFromRemoteVPC bool `json:"allowDnsResolutionFromRemoteVpc,omitempty"`
+}
+
+// VPCPeeringConnectionObservation keeps the state for the external resource
+type VPCPeeringConnectionObservation struct {
+	// StatusCode is the status of the VPC peering connection.
+	StatusCode string `json:"statusCode,omitempty"`
+
+	// StatusMessage is a message that provides more information about the
+	// status, if applicable.
+	StatusMessage string `json:"statusMessage,omitempty"`
+
+	// RequesterVPCInfo describes the requester VPC.
+	RequesterVPCInfo VPCPeeringConnectionVPCInfo `json:"requesterVpcInfo,omitempty"`
+
+	// AccepterVPCInfo describes the accepter VPC.
+	AccepterVPCInfo VPCPeeringConnectionVPCInfo `json:"accepterVpcInfo,omitempty"`
+}
+
+// A VPCPeeringConnectionStatus represents the observed state of a
+// VPCPeeringConnection.
+type VPCPeeringConnectionStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     VPCPeeringConnectionObservation `json:"atProvider,omitempty"`
+
+	// ManagedTagKeys are the keys of the tags that were last set on the VPC
+	// peering connection from the spec. Only these tags are removed when they
+	// are removed from the spec.
+	ManagedTagKeys []string `json:"managedTagKeys,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VPCPeeringConnection is a managed resource that represents an AWS VPC
+// peering connection.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.statusCode"
+// +kubebuilder:printcolumn:name="VPCID",type="string",JSONPath=".spec.forProvider.vpcId"
+// +kubebuilder:printcolumn:name="PEERVPCID",type="string",JSONPath=".spec.forProvider.peerVpcId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type VPCPeeringConnection struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VPCPeeringConnectionSpec   `json:"spec"`
+	Status VPCPeeringConnectionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VPCPeeringConnectionList contains a list of VPCPeeringConnections
+type VPCPeeringConnectionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VPCPeeringConnection `json:"items"`
+}
@@ -20,6 +20,7 @@ import (
 	"strconv"
 
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	storagev1alpha1 "github.com/crossplane/crossplane/apis/storage/v1alpha1"
@@ -27,6 +28,43 @@ import (
 	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
 )
 
+// Deletion modes of an S3 bucket.
+const (
+	// DeletionModeFailIfNotEmpty deletes the bucket only if it is empty.
+	DeletionModeFailIfNotEmpty = "FailIfNotEmpty"
+
+	// DeletionModeDeleteContents deletes all objects, object versions and
+	// delete markers in the bucket before deleting it.
+	DeletionModeDeleteContents = "DeleteContents"
+)
+
+// Access modes of an S3 bucket.
+const (
+	// AccessModeUser grants access to the bucket by creating an IAM user and
+	// publishing its access key.
+	AccessModeUser = "User"
+
+	// AccessModePolicy grants access to the bucket by creating an IAM policy
+	// and publishing its ARN. The policy may be attached to an IAM role.
+	AccessModePolicy = "Policy"
+)
+
+// ReasonBucketNotEmpty indicates that a bucket cannot be deleted because it
+// still contains objects.
+const ReasonBucketNotEmpty runtimev1alpha1.ConditionReason = "BucketNotEmpty"
+
+// BucketNotEmpty returns a condition that indicates a bucket cannot be deleted
+// because it still contains objects.
+func BucketNotEmpty() runtimev1alpha1.Condition {
+	return runtimev1alpha1.Condition{
+		Type:               runtimev1alpha1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonBucketNotEmpty,
+		Message:            "bucket must be empty before it is deleted unless deletionMode is " + DeletionModeDeleteContents,
+	}
+}
+
 // S3BucketParameters define the desired state of an AWS S3 Bucket.
 type S3BucketParameters struct {
 	// Region of the bucket.
@@ -46,11 +84,115 @@ type S3BucketParameters struct {
 	// granted access to this bucket by Crossplane at bucket creation time.
 	IAMUsername string `json:"iamUsername,omitempty"`
 
+	// AccessMode determines how access to the bucket is granted. User, the
+	// default, creates an IAM user and publishes its access key. Policy
+	// creates an IAM policy and publishes its ARN, allowing access to be
+	// granted without long-lived credentials. It cannot be changed once the
+	// bucket is created.
+	// +kubebuilder:validation:Enum=User;Policy
+	// +optional
+	AccessMode string `json:"accessMode,omitempty"`
+
+	// IAMPolicyName is the name of an IAM policy that is automatically created
+	// and granted access to this bucket by Crossplane at bucket creation time
+	// when the access mode is Policy.
+	// +optional
+	IAMPolicyName string `json:"iamPolicyName,omitempty"`
+
+	// IAMRoleName is the name of an IAM role the IAM policy is attached to
+	// when the access mode is Policy. The policy is detached from any other
+	// role.
+	// +optional
+	IAMRoleName *string `json:"iamRoleName,omitempty"`
+
+	// IAMRoleNameRef references an IAMRole to retrieve its name.
+	// +optional
+	IAMRoleNameRef *runtimev1alpha1.Reference `json:"iamRoleNameRef,omitempty"`
+
+	// IAMRoleNameSelector selects a reference to an IAMRole to retrieve its
+	// name.
+	// +optional
+	IAMRoleNameSelector *runtimev1alpha1.Selector `json:"iamRoleNameSelector,omitempty"`
+
 	// LocalPermission is the permissions granted on the bucket for the provider
 	// specific bucket service account that is available in a secret after
 	// provisioning.
 	// +kubebuilder:validation:Enum=Read;Write;ReadWrite
 	LocalPermission *storagev1alpha1.LocalPermissionType `json:"localPermission"`
+
+	// LifecycleConfiguration specifies the lifecycle rules of the bucket.
+	// Lifecycle rules are not managed by Crossplane when it is omitted.
+	// +optional
+	LifecycleConfiguration *BucketLifecycleConfiguration `json:"lifecycleConfiguration,omitempty"`
+
+	// ServerSideEncryptionConfiguration specifies the default server-side
+	// encryption of the bucket. Default encryption is not managed by
+	// Crossplane when it is omitted.
+	// +optional
+	ServerSideEncryptionConfiguration *ServerSideEncryptionConfiguration `json:"serverSideEncryptionConfiguration,omitempty"`
+
+	// PublicAccessBlockConfiguration specifies the Block Public Access
+	// settings of the bucket. They are not managed by Crossplane when it is
+	// omitted.
+	// +optional
+	PublicAccessBlockConfiguration *PublicAccessBlockConfiguration `json:"publicAccessBlockConfiguration,omitempty"`
+
+	// BucketPolicy is a JSON resource-based policy document attached to the
+	// bucket, e.g. to grant cross-account or CloudFront access. It is compared
+	// semantically with the policy in effect, so formatting differences do
+	// not cause updates. The bucket policy is not managed by Crossplane when
+	// it is omitted.
+	// +optional
+	BucketPolicy *string `json:"bucketPolicy,omitempty"`
+
+	// CORSConfiguration specifies the cross-origin access rules of the
+	// bucket. CORS rules are not managed by Crossplane when it is omitted.
+	// +optional
+	CORSConfiguration *CORSConfiguration `json:"corsConfiguration,omitempty"`
+
+	// WebsiteConfiguration enables static website hosting for the bucket.
+	// The website endpoint is published as a connection detail. Website
+	// hosting is not managed by Crossplane when it is omitted.
+	// +optional
+	WebsiteConfiguration *WebsiteConfiguration `json:"websiteConfiguration,omitempty"`
+
+	// LoggingConfiguration enables server access logging for the bucket.
+	// Logging is not managed by Crossplane when it is omitted.
+	// +optional
+	LoggingConfiguration *LoggingConfiguration `json:"loggingConfiguration,omitempty"`
+
+	// ReplicationConfiguration replicates the objects of the bucket to other
+	// buckets, possibly in other regions. Replication is not managed by
+	// Crossplane when it is omitted.
+	// +optional
+	ReplicationConfiguration *ReplicationConfiguration `json:"replicationConfiguration,omitempty"`
+
+	// Tags of the bucket. Crossplane adds tags that identify the managed
+	// resource. Tags of the bucket that are not listed here are removed.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+
+	// ObjectLockConfiguration enables Object Lock when the bucket is created,
+	// and specifies the default retention of new objects. Versioning must be
+	// enabled for buckets with Object Lock; the bucket is neither created nor
+	// updated otherwise. Object Lock is not managed by Crossplane when it is
+	// omitted.
+	// +optional
+	ObjectLockConfiguration *ObjectLockConfiguration `json:"objectLockConfiguration,omitempty"`
+
+	// RequestPayer specifies who pays for requests to and downloads from the
+	// bucket. Crossplane does not manage who pays when it is omitted.
+	// +kubebuilder:validation:Enum=BucketOwner;Requester
+	// +optional
+	RequestPayer *string `json:"requestPayer,omitempty"`
+
+	// DeletionMode determines how a bucket that still contains objects is
+	// deleted. FailIfNotEmpty, the default, leaves the bucket and its objects
+	// untouched until it is emptied. DeleteContents irreversibly deletes all
+	// objects, object versions and delete markers before deleting the bucket.
+	// +kubebuilder:validation:Enum=FailIfNotEmpty;DeleteContents
+	// +optional
+	DeletionMode string `json:"deletionMode,omitempty"`
 }
 
 // S3BucketSpec defines the desired state of S3Bucket
@@ -67,12 +209,31 @@ type S3BucketStatus struct {
 	ProviderID string `json:"providerID,omitempty"`
 
 	// LastUserPolicyVersion is the most recent version of the policy associated
-	// with this bucket's IAMUser.
+	// with this bucket's IAMUser, or of its IAM policy in policy access mode.
 	LastUserPolicyVersion int `json:"lastUserPolicyVersion,omitempty"`
 
 	// LastLocalPermission is the most recent local permission that was set for
 	// this bucket.
 	LastLocalPermission storagev1alpha1.LocalPermissionType `json:"lastLocalPermission,omitempty"`
+
+	// DeletedObjects is the number of objects, object versions and delete
+	// markers deleted while emptying this bucket for deletion.
+	DeletedObjects int64 `json:"deletedObjects,omitempty"`
+
+	// AtProvider is the observed state of the bucket.
+	AtProvider S3BucketObservation `json:"atProvider,omitempty"`
+}
+
+// S3BucketObservation is the representation of the current state that is
+// observed.
+type S3BucketObservation struct {
+	// ServerSideEncryptionConfiguration is the default server-side encryption
+	// in effect for the bucket.
+	ServerSideEncryptionConfiguration *ServerSideEncryptionConfiguration `json:"serverSideEncryptionConfiguration,omitempty"`
+
+	// PublicAccessBlockConfiguration is the Block Public Access configuration
+	// in effect for the bucket.
+	PublicAccessBlockConfiguration *PublicAccessBlockConfiguration `json:"publicAccessBlockConfiguration,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -83,6 +244,7 @@ type S3BucketStatus struct {
 // +kubebuilder:printcolumn:name="PREDEFINED-ACL",type="string",JSONPath=".spec.cannedACL"
 // +kubebuilder:printcolumn:name="LOCAL-PERMISSION",type="string",JSONPath=".spec.localPermission"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
 type S3Bucket struct {
 	metav1.TypeMeta   `json:",inline"`
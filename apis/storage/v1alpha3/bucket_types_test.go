@@ -0,0 +1,51 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+const s3BucketCRD = "../../../config/crd/storage.aws.crossplane.io_s3buckets.yaml"
+
+// TestS3BucketStatusSubresource makes sure that the status of an S3Bucket is
+// persisted; the managed reconciler only saves it through the status
+// subresource, which would silently drop it if the subresource is missing.
+func TestS3BucketStatusSubresource(t *testing.T) {
+	b, err := ioutil.ReadFile(s3BucketCRD)
+	if err != nil {
+		t.Fatalf("cannot read the S3Bucket CRD: %v", err)
+	}
+
+	crd := struct {
+		Spec struct {
+			Subresources struct {
+				Status *struct{} `json:"status"`
+			} `json:"subresources"`
+		} `json:"spec"`
+	}{}
+	if err := yaml.Unmarshal(b, &crd); err != nil {
+		t.Fatalf("cannot unmarshal the S3Bucket CRD: %v", err)
+	}
+
+	if crd.Spec.Subresources.Status == nil {
+		t.Errorf("the S3Bucket CRD does not enable the status subresource")
+	}
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+// ServerSideEncryptionConfiguration specifies the default server-side
+// encryption applied to new objects in a bucket.
+type ServerSideEncryptionConfiguration struct {
+	// Rules is the list of server-side encryption rules of the bucket.
+	// +kubebuilder:validation:MinItems=1
+	Rules []ServerSideEncryptionRule `json:"rules"`
+}
+
+// A ServerSideEncryptionRule specifies the default server-side encryption
+// configuration to apply.
+type ServerSideEncryptionRule struct {
+	// ApplyServerSideEncryptionByDefault specifies the default server-side
+	// encryption to apply to new objects in the bucket.
+	ApplyServerSideEncryptionByDefault ServerSideEncryptionByDefault `json:"applyServerSideEncryptionByDefault"`
+}
+
+// ServerSideEncryptionByDefault describes the default server-side encryption
+// to apply to new objects in a bucket.
+type ServerSideEncryptionByDefault struct {
+	// SSEAlgorithm is the server-side encryption algorithm to use.
+	// +kubebuilder:validation:Enum=AES256;"aws:kms"
+	SSEAlgorithm string `json:"sseAlgorithm"`
+
+	// KMSMasterKeyID is the AWS KMS master key ID used for SSE-KMS
+	// encryption. It can only be set when SSEAlgorithm is aws:kms. The
+	// default aws/s3 AWS KMS master key is used when it is omitted.
+	// +optional
+	KMSMasterKeyID *string `json:"kmsMasterKeyId,omitempty"`
+}
+
+// PublicAccessBlockConfiguration specifies the Amazon S3 Block Public Access
+// settings of a bucket.
+type PublicAccessBlockConfiguration struct {
+	// BlockPublicAcls specifies whether S3 should block public access control
+	// lists for this bucket and objects in this bucket.
+	// +optional
+	BlockPublicAcls *bool `json:"blockPublicAcls,omitempty"`
+
+	// BlockPublicPolicy specifies whether S3 should block public bucket
+	// policies for this bucket.
+	// +optional
+	BlockPublicPolicy *bool `json:"blockPublicPolicy,omitempty"`
+
+	// IgnorePublicAcls specifies whether S3 should ignore public access
+	// control lists for this bucket and objects in this bucket.
+	// +optional
+	IgnorePublicAcls *bool `json:"ignorePublicAcls,omitempty"`
+
+	// RestrictPublicBuckets specifies whether S3 should restrict public bucket
+	// policies for this bucket.
+	// +optional
+	RestrictPublicBuckets *bool `json:"restrictPublicBuckets,omitempty"`
+}
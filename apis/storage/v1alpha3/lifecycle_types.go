@@ -0,0 +1,174 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Lifecycle rule statuses.
+const (
+	LifecycleRuleStatusEnabled  = "Enabled"
+	LifecycleRuleStatusDisabled = "Disabled"
+)
+
+// Tag is a key value pair used to filter objects in a bucket.
+type Tag struct {
+	// Key is the name of the tag.
+	Key string `json:"key"`
+
+	// Value is the value of the tag.
+	Value string `json:"value"`
+}
+
+// BucketLifecycleConfiguration specifies the lifecycle rules that manage
+// objects stored in an S3 bucket.
+type BucketLifecycleConfiguration struct {
+	// Rules is the list of lifecycle rules of the bucket.
+	// +kubebuilder:validation:MinItems=1
+	Rules []LifecycleRule `json:"rules"`
+}
+
+// A LifecycleRule describes an action taken on objects matched by its filter.
+type LifecycleRule struct {
+	// ID is a unique identifier for the rule. The value cannot be longer than
+	// 255 characters.
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// Status specifies whether the rule is currently being applied.
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	Status string `json:"status"`
+
+	// Filter identifies the objects to which the rule applies. All objects in
+	// the bucket are matched when it is omitted.
+	// +optional
+	Filter *LifecycleRuleFilter `json:"filter,omitempty"`
+
+	// Transitions specify when objects transition to another storage class.
+	// +optional
+	Transitions []Transition `json:"transitions,omitempty"`
+
+	// Expiration specifies when objects expire.
+	// +optional
+	Expiration *LifecycleExpiration `json:"expiration,omitempty"`
+
+	// NoncurrentVersionTransitions specify when noncurrent object versions
+	// transition to another storage class.
+	// +optional
+	NoncurrentVersionTransitions []NoncurrentVersionTransition `json:"noncurrentVersionTransitions,omitempty"`
+
+	// NoncurrentVersionExpiration specifies when noncurrent object versions
+	// expire.
+	// +optional
+	NoncurrentVersionExpiration *NoncurrentVersionExpiration `json:"noncurrentVersionExpiration,omitempty"`
+
+	// AbortIncompleteMultipartUpload specifies the days since the initiation
+	// of an incomplete multipart upload after which it is aborted.
+	// +optional
+	AbortIncompleteMultipartUpload *AbortIncompleteMultipartUpload `json:"abortIncompleteMultipartUpload,omitempty"`
+}
+
+// A LifecycleRuleFilter identifies the objects a lifecycle rule applies to.
+// Exactly one of Prefix, Tag or And should be specified.
+type LifecycleRuleFilter struct {
+	// Prefix identifying one or more objects to which the rule applies.
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+
+	// Tag that objects must have for the rule to apply.
+	// +optional
+	Tag *Tag `json:"tag,omitempty"`
+
+	// And combines a prefix and multiple tags. Objects must match all of
+	// them for the rule to apply.
+	// +optional
+	And *LifecycleRuleAndOperator `json:"and,omitempty"`
+}
+
+// A LifecycleRuleAndOperator combines multiple predicates of a lifecycle rule
+// filter.
+type LifecycleRuleAndOperator struct {
+	// Prefix identifying one or more objects to which the rule applies.
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+
+	// Tags that objects must all have for the rule to apply.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A Transition specifies when an object transitions to a storage class.
+type Transition struct {
+	// Date on which objects transition to the storage class.
+	// +optional
+	Date *metav1.Time `json:"date,omitempty"`
+
+	// Days after creation after which objects transition to the storage
+	// class.
+	// +optional
+	Days *int64 `json:"days,omitempty"`
+
+	// StorageClass to which objects transition.
+	// +kubebuilder:validation:Enum=GLACIER;STANDARD_IA;ONEZONE_IA;INTELLIGENT_TIERING;DEEP_ARCHIVE
+	StorageClass string `json:"storageClass"`
+}
+
+// A LifecycleExpiration specifies when objects expire.
+type LifecycleExpiration struct {
+	// Date on which objects expire.
+	// +optional
+	Date *metav1.Time `json:"date,omitempty"`
+
+	// Days after creation after which objects expire.
+	// +optional
+	Days *int64 `json:"days,omitempty"`
+
+	// ExpiredObjectDeleteMarker specifies whether S3 removes delete markers
+	// that have no noncurrent versions. It cannot be combined with Date or
+	// Days.
+	// +optional
+	ExpiredObjectDeleteMarker *bool `json:"expiredObjectDeleteMarker,omitempty"`
+}
+
+// A NoncurrentVersionTransition specifies when noncurrent object versions
+// transition to a storage class.
+type NoncurrentVersionTransition struct {
+	// NoncurrentDays is the number of days an object is noncurrent before
+	// it transitions.
+	NoncurrentDays int64 `json:"noncurrentDays"`
+
+	// StorageClass to which noncurrent versions transition.
+	// +kubebuilder:validation:Enum=GLACIER;STANDARD_IA;ONEZONE_IA;INTELLIGENT_TIERING;DEEP_ARCHIVE
+	StorageClass string `json:"storageClass"`
+}
+
+// A NoncurrentVersionExpiration specifies when noncurrent object versions
+// expire.
+type NoncurrentVersionExpiration struct {
+	// NoncurrentDays is the number of days an object is noncurrent before
+	// it expires.
+	NoncurrentDays int64 `json:"noncurrentDays"`
+}
+
+// AbortIncompleteMultipartUpload specifies when incomplete multipart uploads
+// are aborted.
+type AbortIncompleteMultipartUpload struct {
+	// DaysAfterInitiation is the number of days after which an incomplete
+	// multipart upload is aborted.
+	DaysAfterInitiation int64 `json:"daysAfterInitiation"`
+}
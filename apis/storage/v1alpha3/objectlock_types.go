@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+// ObjectLockConfiguration enables S3 Object Lock, which prevents objects from
+// being deleted or overwritten. Object Lock can only be enabled when a bucket
+// is created, and requires versioning.
+type ObjectLockConfiguration struct {
+	// DefaultRetention is applied to new objects placed in the bucket. Objects
+	// are only retained when they specify a retention period if it is
+	// omitted.
+	// +optional
+	DefaultRetention *DefaultRetention `json:"defaultRetention,omitempty"`
+}
+
+// DefaultRetention specifies the retention mode and period applied to new
+// objects. Exactly one of days or years must be specified.
+type DefaultRetention struct {
+	// Mode of the retention. Objects retained in GOVERNANCE mode may be
+	// deleted by users with special permissions, while objects retained in
+	// COMPLIANCE mode may not be deleted by any user.
+	// +kubebuilder:validation:Enum=GOVERNANCE;COMPLIANCE
+	Mode string `json:"mode"`
+
+	// Days objects are retained for.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Days *int64 `json:"days,omitempty"`
+
+	// Years objects are retained for.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Years *int64 `json:"years,omitempty"`
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/identity/v1beta1"
+)
+
+// S3BucketARN returns the ARN of an S3Bucket, which is derived from its
+// external name.
+func S3BucketARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		b, ok := mg.(*S3Bucket)
+		if !ok || meta.GetExternalName(b) == "" {
+			return ""
+		}
+		return "arn:aws:s3:::" + meta.GetExternalName(b)
+	}
+}
+
+// ResolveReferences of this S3Bucket
+func (mg *S3Bucket) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.loggingConfiguration.targetBucket
+	if l := mg.Spec.LoggingConfiguration; l != nil {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(l.TargetBucket),
+			Reference:    l.TargetBucketRef,
+			Selector:     l.TargetBucketSelector,
+			To:           reference.To{Managed: &S3Bucket{}, List: &S3BucketList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return err
+		}
+		l.TargetBucket = reference.ToPtrValue(rsp.ResolvedValue)
+		l.TargetBucketRef = rsp.ResolvedReference
+	}
+
+	// Resolve spec.iamRoleName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.IAMRoleName),
+		Reference:    mg.Spec.IAMRoleNameRef,
+		Selector:     mg.Spec.IAMRoleNameSelector,
+		To:           reference.To{Managed: &v1beta1.IAMRole{}, List: &v1beta1.IAMRoleList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.IAMRoleName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.IAMRoleNameRef = rsp.ResolvedReference
+
+	// Resolve spec.replicationConfiguration
+	if rc := mg.Spec.ReplicationConfiguration; rc != nil {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(rc.Role),
+			Reference:    rc.RoleRef,
+			Selector:     rc.RoleSelector,
+			To:           reference.To{Managed: &v1beta1.IAMRole{}, List: &v1beta1.IAMRoleList{}},
+			Extract:      v1beta1.IAMRoleARN(),
+		})
+		if err != nil {
+			return err
+		}
+		rc.Role = reference.ToPtrValue(rsp.ResolvedValue)
+		rc.RoleRef = rsp.ResolvedReference
+
+		for i := range rc.Rules {
+			d := &rc.Rules[i].Destination
+			rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: reference.FromPtrValue(d.Bucket),
+				Reference:    d.BucketRef,
+				Selector:     d.BucketSelector,
+				To:           reference.To{Managed: &S3Bucket{}, List: &S3BucketList{}},
+				Extract:      S3BucketARN(),
+			})
+			if err != nil {
+				return err
+			}
+			d.Bucket = reference.ToPtrValue(rsp.ResolvedValue)
+			d.BucketRef = rsp.ResolvedReference
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Replication rule statuses.
+const (
+	ReplicationRuleStatusEnabled  = "Enabled"
+	ReplicationRuleStatusDisabled = "Disabled"
+)
+
+// ReplicationConfiguration specifies how objects in an S3 bucket are
+// replicated to other buckets. Versioning must be enabled on both the source
+// and the destination buckets.
+type ReplicationConfiguration struct {
+	// Role is the ARN of the IAM role that S3 assumes when replicating
+	// objects.
+	// +optional
+	Role *string `json:"role,omitempty"`
+
+	// RoleRef references an IAMRole to retrieve its ARN.
+	// +optional
+	RoleRef *runtimev1alpha1.Reference `json:"roleRef,omitempty"`
+
+	// RoleSelector selects a reference to an IAMRole to retrieve its ARN.
+	// +optional
+	RoleSelector *runtimev1alpha1.Selector `json:"roleSelector,omitempty"`
+
+	// Rules is the list of replication rules of the bucket.
+	// +kubebuilder:validation:MinItems=1
+	Rules []ReplicationRule `json:"rules"`
+}
+
+// A ReplicationRule describes which objects are replicated and where they are
+// replicated to.
+type ReplicationRule struct {
+	// ID is a unique identifier for the rule. The value cannot be longer than
+	// 255 characters.
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// Priority determines which rule applies when the filters of multiple
+	// rules match an object. Rules with higher values take precedence.
+	// +optional
+	Priority *int64 `json:"priority,omitempty"`
+
+	// Status specifies whether the rule is currently being applied.
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	Status string `json:"status"`
+
+	// Filter identifies the objects to which the rule applies. All objects in
+	// the bucket are matched when it is omitted.
+	// +optional
+	Filter *ReplicationRuleFilter `json:"filter,omitempty"`
+
+	// DeleteMarkerReplication specifies whether delete markers are
+	// replicated. Delete markers are not replicated when it is omitted.
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	// +optional
+	DeleteMarkerReplication *string `json:"deleteMarkerReplication,omitempty"`
+
+	// Destination of the replicated objects.
+	Destination ReplicationDestination `json:"destination"`
+}
+
+// A ReplicationRuleFilter identifies the objects a replication rule applies
+// to. Only one of its fields may be set.
+type ReplicationRuleFilter struct {
+	// Prefix identifies objects whose keys begin with it.
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+
+	// Tag identifies objects that have it.
+	// +optional
+	Tag *Tag `json:"tag,omitempty"`
+
+	// And combines a prefix and multiple tags.
+	// +optional
+	And *ReplicationRuleAndOperator `json:"and,omitempty"`
+}
+
+// A ReplicationRuleAndOperator combines multiple predicates of a replication
+// rule filter. An object must match all of them.
+type ReplicationRuleAndOperator struct {
+	// Prefix identifies objects whose keys begin with it.
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+
+	// Tags identify objects that have all of them.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A ReplicationDestination specifies the bucket objects are replicated to.
+type ReplicationDestination struct {
+	// Bucket is the ARN of the destination bucket. It may be in a different
+	// region than the source bucket.
+	// +optional
+	Bucket *string `json:"bucket,omitempty"`
+
+	// BucketRef references an S3Bucket to retrieve its ARN.
+	// +optional
+	BucketRef *runtimev1alpha1.Reference `json:"bucketRef,omitempty"`
+
+	// BucketSelector selects a reference to an S3Bucket to retrieve its ARN.
+	// +optional
+	BucketSelector *runtimev1alpha1.Selector `json:"bucketSelector,omitempty"`
+
+	// StorageClass of the replicated objects. The storage class of the
+	// source object is used when it is omitted.
+	// +kubebuilder:validation:Enum=STANDARD;REDUCED_REDUNDANCY;STANDARD_IA;ONEZONE_IA;INTELLIGENT_TIERING;GLACIER;DEEP_ARCHIVE
+	// +optional
+	StorageClass *string `json:"storageClass,omitempty"`
+}
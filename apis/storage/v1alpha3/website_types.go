@@ -0,0 +1,175 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// CORSConfiguration describes the cross-origin access configuration for
+// objects in a bucket.
+type CORSConfiguration struct {
+	// CORSRules is the list of CORS rules of the bucket.
+	// +kubebuilder:validation:MinItems=1
+	CORSRules []CORSRule `json:"corsRules"`
+}
+
+// A CORSRule specifies a cross-origin access rule for a bucket.
+type CORSRule struct {
+	// AllowedHeaders specifies which headers are allowed in a preflight
+	// OPTIONS request through the Access-Control-Request-Headers header.
+	// +optional
+	AllowedHeaders []string `json:"allowedHeaders,omitempty"`
+
+	// AllowedMethods is the list of HTTP methods the origin is allowed to
+	// execute.
+	// +kubebuilder:validation:MinItems=1
+	AllowedMethods []string `json:"allowedMethods"`
+
+	// AllowedOrigins is the list of origins allowed to access the bucket.
+	// +kubebuilder:validation:MinItems=1
+	AllowedOrigins []string `json:"allowedOrigins"`
+
+	// ExposeHeaders is the list of headers in the response that customers
+	// are able to access from their applications.
+	// +optional
+	ExposeHeaders []string `json:"exposeHeaders,omitempty"`
+
+	// MaxAgeSeconds is the time in seconds that browsers can cache the
+	// response for a preflight request.
+	// +optional
+	MaxAgeSeconds *int64 `json:"maxAgeSeconds,omitempty"`
+}
+
+// WebsiteConfiguration specifies static website hosting for a bucket.
+type WebsiteConfiguration struct {
+	// ErrorDocument is the object returned when an error occurs.
+	// +optional
+	ErrorDocument *ErrorDocument `json:"errorDocument,omitempty"`
+
+	// IndexDocument is the object returned for requests to a directory.
+	// +optional
+	IndexDocument *IndexDocument `json:"indexDocument,omitempty"`
+
+	// RedirectAllRequestsTo redirects every request to the website endpoint
+	// of the bucket to another host. It cannot be combined with the other
+	// website settings.
+	// +optional
+	RedirectAllRequestsTo *RedirectAllRequestsTo `json:"redirectAllRequestsTo,omitempty"`
+
+	// RoutingRules describe when and how requests are redirected.
+	// +optional
+	RoutingRules []RoutingRule `json:"routingRules,omitempty"`
+}
+
+// An ErrorDocument is the object returned when an error occurs.
+type ErrorDocument struct {
+	// Key is the object key name to use when a 4XX class error occurs.
+	Key string `json:"key"`
+}
+
+// An IndexDocument is the object returned for requests to a directory.
+type IndexDocument struct {
+	// Suffix is appended to requests for a directory on the website
+	// endpoint, e.g. index.html. It must not be empty or include a slash.
+	Suffix string `json:"suffix"`
+}
+
+// RedirectAllRequestsTo specifies the host to which all requests are
+// redirected.
+type RedirectAllRequestsTo struct {
+	// HostName is the name of the host to which requests are redirected.
+	HostName string `json:"hostName"`
+
+	// Protocol to use when redirecting requests. The protocol of the
+	// original request is used when it is omitted.
+	// +kubebuilder:validation:Enum=http;https
+	// +optional
+	Protocol *string `json:"protocol,omitempty"`
+}
+
+// A RoutingRule redirects requests that match its condition.
+type RoutingRule struct {
+	// Condition that must be met for the redirect to apply.
+	// +optional
+	Condition *Condition `json:"condition,omitempty"`
+
+	// Redirect specifies how matching requests are redirected.
+	Redirect Redirect `json:"redirect"`
+}
+
+// A Condition that must be met for a routing rule to apply.
+type Condition struct {
+	// HTTPErrorCodeReturnedEquals is the HTTP error code for which the
+	// redirect is applied.
+	// +optional
+	HTTPErrorCodeReturnedEquals *string `json:"httpErrorCodeReturnedEquals,omitempty"`
+
+	// KeyPrefixEquals is the object key name prefix for which the redirect
+	// is applied.
+	// +optional
+	KeyPrefixEquals *string `json:"keyPrefixEquals,omitempty"`
+}
+
+// A Redirect specifies how requests are redirected.
+type Redirect struct {
+	// HostName to use in the redirect request.
+	// +optional
+	HostName *string `json:"hostName,omitempty"`
+
+	// HTTPRedirectCode is the HTTP redirect code to use on the response.
+	// +optional
+	HTTPRedirectCode *string `json:"httpRedirectCode,omitempty"`
+
+	// Protocol to use when redirecting requests.
+	// +kubebuilder:validation:Enum=http;https
+	// +optional
+	Protocol *string `json:"protocol,omitempty"`
+
+	// ReplaceKeyPrefixWith is the object key prefix to use in the redirect
+	// request. It cannot be combined with ReplaceKeyWith.
+	// +optional
+	ReplaceKeyPrefixWith *string `json:"replaceKeyPrefixWith,omitempty"`
+
+	// ReplaceKeyWith is the specific object key to use in the redirect
+	// request. It cannot be combined with ReplaceKeyPrefixWith.
+	// +optional
+	ReplaceKeyWith *string `json:"replaceKeyWith,omitempty"`
+}
+
+// LoggingConfiguration specifies where server access logs of a bucket are
+// delivered.
+type LoggingConfiguration struct {
+	// TargetBucket is the name of the bucket where access logs are
+	// delivered. The target bucket must grant the S3 log delivery group
+	// write access.
+	// +optional
+	TargetBucket *string `json:"targetBucket,omitempty"`
+
+	// TargetBucketRef references an S3Bucket to retrieve its name.
+	// +optional
+	TargetBucketRef *runtimev1alpha1.Reference `json:"targetBucketRef,omitempty"`
+
+	// TargetBucketSelector selects a reference to an S3Bucket to retrieve
+	// its name.
+	// +optional
+	TargetBucketSelector *runtimev1alpha1.Selector `json:"targetBucketSelector,omitempty"`
+
+	// TargetPrefix is prepended to the keys of all log objects.
+	// +optional
+	TargetPrefix string `json:"targetPrefix,omitempty"`
+}
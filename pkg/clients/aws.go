@@ -23,6 +23,7 @@ import (
 	"io/ioutil"
 	"net/url"
 	"os"
+	"reflect"
 	"strconv"
 	"time"
 
@@ -295,3 +296,16 @@ func CompactAndEscapeJSON(s string) (string, error) {
 	}
 	return url.QueryEscape(buffer.String()), nil
 }
+
+// IsJSONEqual returns true if the supplied JSON documents are semantically
+// equal, i.e. they only differ in formatting or in the order of object keys.
+func IsJSONEqual(a, b string) (bool, error) {
+	var ja, jb interface{}
+	if err := json.Unmarshal([]byte(a), &ja); err != nil {
+		return false, err
+	}
+	if err := json.Unmarshal([]byte(b), &jb); err != nil {
+		return false, err
+	}
+	return reflect.DeepEqual(ja, jb), nil
+}
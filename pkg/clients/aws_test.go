@@ -62,3 +62,18 @@ func TestUseProviderSecret(t *testing.T) {
 	g.Expect(err).NotTo(HaveOccurred())
 	g.Expect(config).NotTo(BeNil())
 }
+
+func TestIsJSONEqual(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	equal, err := IsJSONEqual(`{"Version": "2012-10-17", "Statement": [{"Effect": "Allow"}]}`, `{"Statement":[{"Effect":"Allow"}],"Version":"2012-10-17"}`)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(equal).To(BeTrue())
+
+	equal, err = IsJSONEqual(`{"Statement": [{"Effect": "Allow"}]}`, `{"Statement": [{"Effect": "Deny"}]}`)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(equal).To(BeFalse())
+
+	_, err = IsJSONEqual(`{`, `{}`)
+	g.Expect(err).To(HaveOccurred())
+}
@@ -21,10 +21,13 @@ import (
 	"os"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/google/go-cmp/cmp"
 	"github.com/onsi/gomega"
 
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane/provider-aws/apis/network/v1alpha3"
@@ -286,3 +289,1033 @@ func Test_SecurityGroup_BuildEC2Permissions(t *testing.T) {
 
 	g.Expect(res).ToNot(gomega.BeNil())
 }
+
+func Test_DiffEC2Permissions(t *testing.T) {
+	rule := func(protocol string, from, to int64, cidr string) ec2.IpPermission {
+		return ec2.IpPermission{
+			IpProtocol: aws.String(protocol),
+			FromPort:   aws.Int64(from),
+			ToPort:     aws.Int64(to),
+			IpRanges:   []ec2.IpRange{{CidrIp: aws.String(cidr)}},
+		}
+	}
+	testCases := []struct {
+		name       string
+		desired    []ec2.IpPermission
+		observed   []ec2.IpPermission
+		wantAdd    []ec2.IpPermission
+		wantRemove []ec2.IpPermission
+	}{
+		{
+			"same rules need no change",
+			[]ec2.IpPermission{rule("tcp", 80, 80, "10.0.0.0/8")},
+			[]ec2.IpPermission{rule("tcp", 80, 80, "10.0.0.0/8")},
+			nil,
+			nil,
+		},
+		{
+			"protocol numbers are equal to their names",
+			[]ec2.IpPermission{rule("6", 80, 80, "10.0.0.0/8")},
+			[]ec2.IpPermission{rule("tcp", 80, 80, "10.0.0.0/8")},
+			nil,
+			nil,
+		},
+		{
+			"ports are ignored for all protocols",
+			[]ec2.IpPermission{rule("-1", 0, 65535, "0.0.0.0/0")},
+			[]ec2.IpPermission{{IpProtocol: aws.String("-1"), IpRanges: []ec2.IpRange{{CidrIp: aws.String("0.0.0.0/0")}}}},
+			nil,
+			nil,
+		},
+		{
+			"rules are compared one source at a time",
+			[]ec2.IpPermission{{
+				IpProtocol: aws.String("tcp"),
+				FromPort:   aws.Int64(443),
+				ToPort:     aws.Int64(443),
+				IpRanges:   []ec2.IpRange{{CidrIp: aws.String("10.0.0.0/8")}, {CidrIp: aws.String("172.16.0.0/12")}},
+			}},
+			[]ec2.IpPermission{
+				rule("tcp", 443, 443, "10.0.0.0/8"),
+				rule("tcp", 443, 443, "192.168.0.0/16"),
+			},
+			[]ec2.IpPermission{rule("tcp", 443, 443, "172.16.0.0/12")},
+			[]ec2.IpPermission{rule("tcp", 443, 443, "192.168.0.0/16")},
+		},
+		{
+			"owner of a referenced group is ignored",
+			[]ec2.IpPermission{{
+				IpProtocol:       aws.String("tcp"),
+				FromPort:         aws.Int64(5432),
+				ToPort:           aws.Int64(5432),
+				UserIdGroupPairs: []ec2.UserIdGroupPair{{GroupId: aws.String("sg-1")}},
+			}},
+			[]ec2.IpPermission{{
+				IpProtocol:       aws.String("tcp"),
+				FromPort:         aws.Int64(5432),
+				ToPort:           aws.Int64(5432),
+				UserIdGroupPairs: []ec2.UserIdGroupPair{{GroupId: aws.String("sg-1"), UserId: aws.String("123456789012")}},
+			}},
+			nil,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			add, remove := DiffEC2Permissions(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.wantAdd, add); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantRemove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_IsRtUpToDate(t *testing.T) {
+	route := func(dest, gw string) v1alpha3.Route {
+		return v1alpha3.Route{DestinationCIDRBlock: dest, GatewayID: gw}
+	}
+	testCases := []struct {
+		name string
+		p    v1alpha3.RouteTableParameters
+		o    v1alpha3.RouteTableExternalStatus
+		want bool
+	}{
+		{
+			"local route and main association are ignored",
+			v1alpha3.RouteTableParameters{},
+			v1alpha3.RouteTableExternalStatus{
+				Routes:       []v1alpha3.RouteState{{Route: route("10.0.0.0/16", LocalGatewayID)}},
+				Associations: []v1alpha3.AssociationState{{Main: true}},
+			},
+			true,
+		},
+		{
+			"missing route is not up to date",
+			v1alpha3.RouteTableParameters{Routes: []v1alpha3.Route{route("0.0.0.0/0", "igw-1")}},
+			v1alpha3.RouteTableExternalStatus{},
+			false,
+		},
+		{
+			"route with a different target is not up to date",
+			v1alpha3.RouteTableParameters{Routes: []v1alpha3.Route{route("0.0.0.0/0", "igw-1")}},
+			v1alpha3.RouteTableExternalStatus{Routes: []v1alpha3.RouteState{{Route: route("0.0.0.0/0", "igw-2")}}},
+			false,
+		},
+		{
+			"extra association is not up to date",
+			v1alpha3.RouteTableParameters{},
+			v1alpha3.RouteTableExternalStatus{
+				Associations: []v1alpha3.AssociationState{{Association: v1alpha3.Association{SubnetID: "subnet-1"}}},
+			},
+			false,
+		},
+		{
+			"instance route reporting its network interface is up to date",
+			v1alpha3.RouteTableParameters{Routes: []v1alpha3.Route{{DestinationCIDRBlock: "0.0.0.0/0", InstanceID: "i-1"}}},
+			v1alpha3.RouteTableExternalStatus{Routes: []v1alpha3.RouteState{{
+				Route: v1alpha3.Route{DestinationCIDRBlock: "0.0.0.0/0", InstanceID: "i-1", NetworkInterfaceID: "eni-1"},
+			}}},
+			true,
+		},
+		{
+			"IPv6 route with a different target is not up to date",
+			v1alpha3.RouteTableParameters{Routes: []v1alpha3.Route{{DestinationIPv6CIDRBlock: "::/0", EgressOnlyInternetGatewayID: "eigw-1"}}},
+			v1alpha3.RouteTableExternalStatus{Routes: []v1alpha3.RouteState{{Route: v1alpha3.Route{DestinationIPv6CIDRBlock: "::/0", GatewayID: "igw-1"}}}},
+			false,
+		},
+		{
+			"prefix list routes of VPC endpoints are ignored",
+			v1alpha3.RouteTableParameters{},
+			v1alpha3.RouteTableExternalStatus{Routes: []v1alpha3.RouteState{{
+				DestinationPrefixListID: "pl-1",
+				Route:                   v1alpha3.Route{GatewayID: "vpce-1"},
+			}}},
+			true,
+		},
+		{
+			"matching routes and associations are up to date",
+			v1alpha3.RouteTableParameters{
+				Routes:       []v1alpha3.Route{route("0.0.0.0/0", "igw-1")},
+				Associations: []v1alpha3.Association{{SubnetID: "subnet-1"}},
+			},
+			v1alpha3.RouteTableExternalStatus{
+				Routes:       []v1alpha3.RouteState{{Route: route("0.0.0.0/0", "igw-1")}},
+				Associations: []v1alpha3.AssociationState{{Association: v1alpha3.Association{SubnetID: "subnet-1"}}},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsRtUpToDate(tc.p, tc.o)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_IsVPCPeeringConnectionUpToDate(t *testing.T) {
+	pcx := func(code ec2.VpcPeeringConnectionStateReasonCode, requesterDNS, accepterDNS bool) ec2.VpcPeeringConnection {
+		return ec2.VpcPeeringConnection{
+			Status: &ec2.VpcPeeringConnectionStateReason{Code: code},
+			RequesterVpcInfo: &ec2.VpcPeeringConnectionVpcInfo{
+				PeeringOptions: &ec2.VpcPeeringConnectionOptionsDescription{AllowDnsResolutionFromRemoteVpc: aws.Bool(requesterDNS)},
+			},
+			AccepterVpcInfo: &ec2.VpcPeeringConnectionVpcInfo{
+				PeeringOptions: &ec2.VpcPeeringConnectionOptionsDescription{AllowDnsResolutionFromRemoteVpc: aws.Bool(accepterDNS)},
+			},
+		}
+	}
+	dns := &v1alpha3.VPCPeeringConnectionOptions{AllowDNSResolutionFromRemoteVPC: aws.Bool(true)}
+	foreignTagged := pcx(ec2.VpcPeeringConnectionStateReasonCodeActive, false, false)
+	foreignTagged.Tags = []ec2.Tag{{Key: aws.String("foreign"), Value: aws.String("v")}}
+	testCases := []struct {
+		name string
+		p    v1alpha3.VPCPeeringConnectionParameters
+		o    ec2.VpcPeeringConnection
+		want bool
+	}{
+		{
+			"pending peering connection with a managed accepter is not up to date",
+			v1alpha3.VPCPeeringConnectionParameters{PeerVPCIDRef: &corev1alpha1.Reference{Name: "peer"}},
+			pcx(ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance, false, false),
+			false,
+		},
+		{
+			"pending peering connection with an unmanaged accepter is up to date",
+			v1alpha3.VPCPeeringConnectionParameters{},
+			pcx(ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance, false, false),
+			true,
+		},
+		{
+			"options are not compared before the peering connection is active",
+			v1alpha3.VPCPeeringConnectionParameters{RequesterPeeringOptions: dns},
+			pcx(ec2.VpcPeeringConnectionStateReasonCodeProvisioning, false, false),
+			true,
+		},
+		{
+			"different requester options are not up to date",
+			v1alpha3.VPCPeeringConnectionParameters{RequesterPeeringOptions: dns},
+			pcx(ec2.VpcPeeringConnectionStateReasonCodeActive, false, true),
+			false,
+		},
+		{
+			"different accepter options are not up to date",
+			v1alpha3.VPCPeeringConnectionParameters{AccepterPeeringOptions: dns},
+			pcx(ec2.VpcPeeringConnectionStateReasonCodeActive, true, false),
+			false,
+		},
+		{
+			"different tags are not up to date",
+			v1alpha3.VPCPeeringConnectionParameters{Tags: []v1alpha3.Tag{{Key: "k", Value: "v"}}},
+			pcx(ec2.VpcPeeringConnectionStateReasonCodeActive, false, false),
+			false,
+		},
+		{
+			"tags that were not set from the spec are up to date",
+			v1alpha3.VPCPeeringConnectionParameters{},
+			foreignTagged,
+			true,
+		},
+		{
+			"matching options are up to date",
+			v1alpha3.VPCPeeringConnectionParameters{RequesterPeeringOptions: dns, AccepterPeeringOptions: dns},
+			pcx(ec2.VpcPeeringConnectionStateReasonCodeActive, true, true),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsVPCPeeringConnectionUpToDate(tc.p, tc.o, nil)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_GenerateModifyVPCEndpointInput(t *testing.T) {
+	policy := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"*","Resource":"*"}]}`
+	testCases := []struct {
+		name string
+		p    v1alpha3.VPCEndpointParameters
+		e    ec2.VpcEndpoint
+		want *ec2.ModifyVpcEndpointInput
+	}{
+		{
+			"matching endpoint needs no modification",
+			v1alpha3.VPCEndpointParameters{
+				RouteTableIDs:  []string{"rtb-1"},
+				PolicyDocument: aws.String(policy),
+			},
+			ec2.VpcEndpoint{
+				RouteTableIds:  []string{"rtb-1"},
+				PolicyDocument: aws.String(`{"Statement": [{"Resource": "*", "Action": "*", "Principal": "*", "Effect": "Allow"}], "Version": "2012-10-17"}`),
+			},
+			nil,
+		},
+		{
+			"route tables, subnets and security groups are added and removed",
+			v1alpha3.VPCEndpointParameters{
+				RouteTableIDs:    []string{"rtb-1", "rtb-2"},
+				SubnetIDs:        []string{"subnet-2"},
+				SecurityGroupIDs: []string{"sg-1"},
+			},
+			ec2.VpcEndpoint{
+				RouteTableIds: []string{"rtb-1", "rtb-3"},
+				SubnetIds:     []string{"subnet-1"},
+				Groups:        []ec2.SecurityGroupIdentifier{{GroupId: aws.String("sg-1")}},
+			},
+			&ec2.ModifyVpcEndpointInput{
+				VpcEndpointId:       aws.String("vpce-1"),
+				AddRouteTableIds:    []string{"rtb-2"},
+				RemoveRouteTableIds: []string{"rtb-3"},
+				AddSubnetIds:        []string{"subnet-2"},
+				RemoveSubnetIds:     []string{"subnet-1"},
+			},
+		},
+		{
+			"different policy and private DNS are modified",
+			v1alpha3.VPCEndpointParameters{
+				PolicyDocument:    aws.String(policy),
+				PrivateDNSEnabled: aws.Bool(true),
+			},
+			ec2.VpcEndpoint{
+				PolicyDocument: aws.String(`{"Statement":[]}`),
+			},
+			&ec2.ModifyVpcEndpointInput{
+				VpcEndpointId:     aws.String("vpce-1"),
+				PolicyDocument:    aws.String(policy),
+				PrivateDnsEnabled: aws.Bool(true),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := GenerateModifyVPCEndpointInput("vpce-1", tc.p, tc.e)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_IsVPCUpToDate(t *testing.T) {
+	associated := &ec2.VpcCidrBlockState{State: ec2.VpcCidrBlockStateCodeAssociated}
+	disassociated := &ec2.VpcCidrBlockState{State: ec2.VpcCidrBlockStateCodeDisassociated}
+	vpc := ec2.Vpc{
+		CidrBlock:       aws.String("10.0.0.0/16"),
+		InstanceTenancy: ec2.TenancyDefault,
+		CidrBlockAssociationSet: []ec2.VpcCidrBlockAssociation{
+			{AssociationId: aws.String("a-1"), CidrBlock: aws.String("10.0.0.0/16"), CidrBlockState: associated},
+			{AssociationId: aws.String("a-2"), CidrBlock: aws.String("10.1.0.0/16"), CidrBlockState: associated},
+			{AssociationId: aws.String("a-3"), CidrBlock: aws.String("10.2.0.0/16"), CidrBlockState: disassociated},
+		},
+		Ipv6CidrBlockAssociationSet: []ec2.VpcIpv6CidrBlockAssociation{
+			{AssociationId: aws.String("a-4"), Ipv6CidrBlock: aws.String("2600::/56"), Ipv6CidrBlockState: associated},
+		},
+		Tags: []ec2.Tag{
+			{Key: aws.String("k"), Value: aws.String("v")},
+			{Key: aws.String("aws:reserved"), Value: aws.String("v")},
+			{Key: aws.String("kubernetes.io/cluster/eks"), Value: aws.String("shared")},
+		},
+	}
+	testCases := []struct {
+		name    string
+		spec    v1alpha3.VPCParameters
+		managed []string
+		want    bool
+	}{
+		{
+			"matching VPC is up to date",
+			v1alpha3.VPCParameters{
+				CIDRBlock:                   "10.0.0.0/16",
+				SecondaryCIDRBlocks:         []string{"10.1.0.0/16"},
+				AmazonProvidedIPv6CIDRBlock: aws.Bool(true),
+				InstanceTenancy:             aws.String("default"),
+				Tags:                        []v1alpha3.Tag{{Key: "k", Value: "v"}},
+			},
+			[]string{"k"},
+			true,
+		},
+		{
+			"missing secondary CIDR block is not up to date",
+			v1alpha3.VPCParameters{
+				SecondaryCIDRBlocks: []string{"10.1.0.0/16", "10.2.0.0/16"},
+				Tags:                []v1alpha3.Tag{{Key: "k", Value: "v"}},
+			},
+			[]string{"k"},
+			false,
+		},
+		{
+			"extra secondary CIDR block is not up to date",
+			v1alpha3.VPCParameters{
+				Tags: []v1alpha3.Tag{{Key: "k", Value: "v"}},
+			},
+			[]string{"k"},
+			false,
+		},
+		{
+			"unwanted IPv6 CIDR block is not up to date",
+			v1alpha3.VPCParameters{
+				SecondaryCIDRBlocks:         []string{"10.1.0.0/16"},
+				AmazonProvidedIPv6CIDRBlock: aws.Bool(false),
+				Tags:                        []v1alpha3.Tag{{Key: "k", Value: "v"}},
+			},
+			[]string{"k"},
+			false,
+		},
+		{
+			"different tags are not up to date",
+			v1alpha3.VPCParameters{
+				SecondaryCIDRBlocks: []string{"10.1.0.0/16"},
+				Tags:                []v1alpha3.Tag{{Key: "k", Value: "other"}},
+			},
+			[]string{"k"},
+			false,
+		},
+		{
+			"previously set tag that is no longer desired is not up to date",
+			v1alpha3.VPCParameters{
+				CIDRBlock:                   "10.0.0.0/16",
+				SecondaryCIDRBlocks:         []string{"10.1.0.0/16"},
+				AmazonProvidedIPv6CIDRBlock: aws.Bool(true),
+			},
+			[]string{"k"},
+			false,
+		},
+		{
+			"tag set by another system is kept",
+			v1alpha3.VPCParameters{
+				CIDRBlock:                   "10.0.0.0/16",
+				SecondaryCIDRBlocks:         []string{"10.1.0.0/16"},
+				AmazonProvidedIPv6CIDRBlock: aws.Bool(true),
+			},
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsUpToDate(tc.spec, vpc, tc.managed)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_GenerateLookupFilters(t *testing.T) {
+	testCases := []struct {
+		name    string
+		lookup  v1alpha3.LookupParameters
+		vpcID   string
+		want    []ec2.Filter
+		wantErr bool
+	}{
+		{
+			"tags and filters of a VPC",
+			v1alpha3.LookupParameters{
+				Tags:    []v1alpha3.Tag{{Key: "Name", Value: "private"}},
+				Filters: []v1alpha3.Filter{{Name: "availability-zone", Values: []string{"us-east-1a"}}},
+			},
+			"vpc-1",
+			[]ec2.Filter{
+				{Name: aws.String("tag:Name"), Values: []string{"private"}},
+				{Name: aws.String("availability-zone"), Values: []string{"us-east-1a"}},
+				{Name: aws.String("vpc-id"), Values: []string{"vpc-1"}},
+			},
+			false,
+		},
+		{
+			"empty lookup is rejected",
+			v1alpha3.LookupParameters{},
+			"",
+			nil,
+			true,
+		},
+		{
+			"empty lookup is rejected even within a VPC",
+			v1alpha3.LookupParameters{},
+			"vpc-1",
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := GenerateLookupFilters(tc.lookup, tc.vpcID)
+			if diff := cmp.Diff(tc.wantErr, err != nil); diff != "" {
+				t.Errorf("err: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_DiffEC2Tags(t *testing.T) {
+	add, remove := DiffEC2Tags(
+		[]v1alpha3.Tag{{Key: "same", Value: "v"}, {Key: "changed", Value: "new"}, {Key: "added", Value: "v"}},
+		[]ec2.Tag{
+			{Key: aws.String("same"), Value: aws.String("v")},
+			{Key: aws.String("changed"), Value: aws.String("old")},
+			{Key: aws.String("removed"), Value: aws.String("v")},
+			{Key: aws.String("aws:cloudformation:stack-name"), Value: aws.String("v")},
+		},
+	)
+	wantAdd := []ec2.Tag{{Key: aws.String("changed"), Value: aws.String("new")}, {Key: aws.String("added"), Value: aws.String("v")}}
+	if diff := cmp.Diff(wantAdd, add); diff != "" {
+		t.Errorf("add: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff([]ec2.Tag{{Key: aws.String("removed")}}, remove); diff != "" {
+		t.Errorf("remove: -want, +got:\n%s", diff)
+	}
+}
+
+func Test_DiffManagedEC2Tags(t *testing.T) {
+	add, remove := DiffManagedEC2Tags(
+		[]v1alpha3.Tag{{Key: "same", Value: "v"}, {Key: "added", Value: "v"}},
+		[]ec2.Tag{
+			{Key: aws.String("same"), Value: aws.String("v")},
+			{Key: aws.String("removed"), Value: aws.String("v")},
+			{Key: aws.String("kubernetes.io/cluster/eks"), Value: aws.String("shared")},
+		},
+		[]string{"same", "removed"},
+	)
+	if diff := cmp.Diff([]ec2.Tag{{Key: aws.String("added"), Value: aws.String("v")}}, add); diff != "" {
+		t.Errorf("add: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff([]ec2.Tag{{Key: aws.String("removed")}}, remove); diff != "" {
+		t.Errorf("remove: -want, +got:\n%s", diff)
+	}
+}
+
+func Test_IsSubnetUpToDate(t *testing.T) {
+	subnet := ec2.Subnet{
+		MapPublicIpOnLaunch:         aws.Bool(true),
+		AssignIpv6AddressOnCreation: aws.Bool(false),
+		Ipv6CidrBlockAssociationSet: []ec2.SubnetIpv6CidrBlockAssociation{
+			{
+				AssociationId:      aws.String("a-1"),
+				Ipv6CidrBlock:      aws.String("2600::/64"),
+				Ipv6CidrBlockState: &ec2.SubnetCidrBlockState{State: ec2.SubnetCidrBlockStateCodeAssociated},
+			},
+		},
+		Tags: []ec2.Tag{
+			{Key: aws.String("k"), Value: aws.String("v")},
+			{Key: aws.String("kubernetes.io/role/elb"), Value: aws.String("1")},
+		},
+	}
+	testCases := []struct {
+		name    string
+		spec    v1alpha3.SubnetParameters
+		managed []string
+		want    bool
+	}{
+		{
+			"matching subnet is up to date",
+			v1alpha3.SubnetParameters{
+				MapPublicIPOnLaunch:         aws.Bool(true),
+				AssignIPv6AddressOnCreation: aws.Bool(false),
+				IPv6CIDRBlock:               aws.String("2600::/64"),
+				Tags:                        []v1alpha3.Tag{{Key: "k", Value: "v"}},
+			},
+			[]string{"k"},
+			true,
+		},
+		{
+			"different public IP mapping is not up to date",
+			v1alpha3.SubnetParameters{
+				MapPublicIPOnLaunch: aws.Bool(false),
+				IPv6CIDRBlock:       aws.String("2600::/64"),
+				Tags:                []v1alpha3.Tag{{Key: "k", Value: "v"}},
+			},
+			[]string{"k"},
+			false,
+		},
+		{
+			"different IPv6 CIDR block is not up to date",
+			v1alpha3.SubnetParameters{
+				IPv6CIDRBlock: aws.String("2600:1::/64"),
+				Tags:          []v1alpha3.Tag{{Key: "k", Value: "v"}},
+			},
+			[]string{"k"},
+			false,
+		},
+		{
+			"different tags are not up to date",
+			v1alpha3.SubnetParameters{
+				IPv6CIDRBlock: aws.String("2600::/64"),
+			},
+			[]string{"k"},
+			false,
+		},
+		{
+			"tag set by another system is kept",
+			v1alpha3.SubnetParameters{
+				MapPublicIPOnLaunch: aws.Bool(true),
+				IPv6CIDRBlock:       aws.String("2600::/64"),
+				Tags:                []v1alpha3.Tag{{Key: "k", Value: "v"}},
+			},
+			[]string{"k"},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsSubnetUpToDate(tc.spec, subnet, nil, tc.managed)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_DiffSubnetIPv6CIDRBlock(t *testing.T) {
+	subnet := ec2.Subnet{
+		Ipv6CidrBlockAssociationSet: []ec2.SubnetIpv6CidrBlockAssociation{
+			{
+				AssociationId:      aws.String("a-0"),
+				Ipv6CidrBlock:      aws.String("2600:1f14::/64"),
+				Ipv6CidrBlockState: &ec2.SubnetCidrBlockState{State: ec2.SubnetCidrBlockStateCodeDisassociated},
+			},
+			{
+				AssociationId:      aws.String("a-1"),
+				Ipv6CidrBlock:      aws.String("2600:1f14:0:1::/64"),
+				Ipv6CidrBlockState: &ec2.SubnetCidrBlockState{State: ec2.SubnetCidrBlockStateCodeAssociated},
+			},
+		},
+	}
+	testCases := []struct {
+		name             string
+		spec             *string
+		managed          *string
+		subnet           ec2.Subnet
+		wantAssociate    string
+		wantDisassociate string
+	}{
+		{"same block", aws.String("2600:1f14:0:1::/64"), nil, subnet, "", ""},
+		{"same block in another notation", aws.String("2600:1F14:0000:0001:0:0:0:0/64"), nil, subnet, "", ""},
+		{"different block", aws.String("2600:1f14:0:2::/64"), nil, subnet, "2600:1f14:0:2::/64", "a-1"},
+		{"no block associated", aws.String("2600:1f14:0:2::/64"), nil, ec2.Subnet{}, "2600:1f14:0:2::/64", ""},
+		{"managed block removed from spec", nil, aws.String("2600:1f14:0:1::/64"), subnet, "", "a-1"},
+		{"block not set from spec", nil, nil, subnet, "", ""},
+		{"block changed outside of spec", nil, aws.String("2600:1f14:0:2::/64"), subnet, "", ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			associate, disassociate := DiffSubnetIPv6CIDRBlock(v1alpha3.SubnetParameters{IPv6CIDRBlock: tc.spec}, tc.subnet, tc.managed)
+			if diff := cmp.Diff(tc.wantAssociate, associate); diff != "" {
+				t.Errorf("associate: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantDisassociate, disassociate); diff != "" {
+				t.Errorf("disassociate: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_GenerateNetworkACLEntryInputs(t *testing.T) {
+	observed := []ec2.NetworkAclEntry{
+		{RuleNumber: aws.Int64(100), Egress: aws.Bool(false), Protocol: aws.String("-1"), RuleAction: ec2.RuleActionAllow, CidrBlock: aws.String("0.0.0.0/0")},
+		{RuleNumber: aws.Int64(110), Egress: aws.Bool(false), Protocol: aws.String("1"), RuleAction: ec2.RuleActionAllow, CidrBlock: aws.String("0.0.0.0/0"), IcmpTypeCode: &ec2.IcmpTypeCode{Type: aws.Int64(8), Code: aws.Int64(-1)}},
+		{RuleNumber: aws.Int64(32767), Egress: aws.Bool(false), Protocol: aws.String("-1"), RuleAction: ec2.RuleActionDeny, CidrBlock: aws.String("0.0.0.0/0")},
+	}
+	testCases := []struct {
+		name    string
+		desired []v1alpha3.NetworkACLEntry
+		create  int
+		replace int
+		remove  int
+	}{
+		{
+			"protocol names and ignored port ranges are not a difference",
+			[]v1alpha3.NetworkACLEntry{
+				{RuleNumber: 100, Protocol: "all", RuleAction: "allow", CIDRBlock: aws.String("0.0.0.0/0"), PortRange: &v1alpha3.NetworkACLPortRange{From: 0, To: 65535}},
+				{RuleNumber: 110, Protocol: "icmp", RuleAction: "allow", CIDRBlock: aws.String("0.0.0.0/0"), ICMPTypeCode: &v1alpha3.NetworkACLICMPTypeCode{Type: 8, Code: -1}},
+			},
+			0, 0, 0,
+		},
+		{
+			"same rule number in the other direction is a new entry",
+			[]v1alpha3.NetworkACLEntry{
+				{RuleNumber: 100, Protocol: "-1", RuleAction: "allow", CIDRBlock: aws.String("0.0.0.0/0")},
+				{RuleNumber: 100, Egress: true, Protocol: "-1", RuleAction: "allow", CIDRBlock: aws.String("0.0.0.0/0")},
+			},
+			1, 0, 1,
+		},
+		{
+			"changed action is replaced",
+			[]v1alpha3.NetworkACLEntry{
+				{RuleNumber: 100, Protocol: "-1", RuleAction: "deny", CIDRBlock: aws.String("0.0.0.0/0")},
+				{RuleNumber: 110, Protocol: "1", RuleAction: "allow", CIDRBlock: aws.String("0.0.0.0/0"), ICMPTypeCode: &v1alpha3.NetworkACLICMPTypeCode{Type: 8, Code: -1}},
+			},
+			0, 1, 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			create, replace, remove := GenerateNetworkACLEntryInputs("acl-1", tc.desired, observed)
+			if diff := cmp.Diff([]int{tc.create, tc.replace, tc.remove}, []int{len(create), len(replace), len(remove)}); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_DiffInternetGatewayAttachments(t *testing.T) {
+	testCases := []struct {
+		name        string
+		vpcID       string
+		attachments []v1alpha3.InternetGatewayAttachment
+		attach      bool
+		detach      []string
+	}{
+		{
+			"attached to the desired VPC",
+			"vpc-1",
+			[]v1alpha3.InternetGatewayAttachment{{AttachmentStatus: "available", VPCID: "vpc-1"}},
+			false,
+			nil,
+		},
+		{
+			"attached to another VPC",
+			"vpc-1",
+			[]v1alpha3.InternetGatewayAttachment{{AttachmentStatus: "available", VPCID: "vpc-2"}},
+			true,
+			[]string{"vpc-2"},
+		},
+		{
+			"detaching attachments are ignored",
+			"vpc-1",
+			[]v1alpha3.InternetGatewayAttachment{{AttachmentStatus: "detaching", VPCID: "vpc-2"}},
+			true,
+			nil,
+		},
+		{
+			"no desired VPC detaches all",
+			"",
+			[]v1alpha3.InternetGatewayAttachment{{AttachmentStatus: "attaching", VPCID: "vpc-2"}},
+			false,
+			[]string{"vpc-2"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			attach, detach := DiffInternetGatewayAttachments(tc.vpcID, tc.attachments)
+			if diff := cmp.Diff(tc.attach, attach); diff != "" {
+				t.Errorf("attach: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.detach, detach); diff != "" {
+				t.Errorf("detach: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_DiffTransitGatewayRouteTableAttachments(t *testing.T) {
+	observation := v1alpha3.TransitGatewayRouteTableObservation{
+		Associations: []v1alpha3.TransitGatewayRouteTableAttachment{
+			{AttachmentID: "tgw-attach-1", State: "associated"},
+			{AttachmentID: "tgw-attach-2", State: "disassociating"},
+		},
+		Propagations: []v1alpha3.TransitGatewayRouteTableAttachment{
+			{AttachmentID: "tgw-attach-1", State: "enabled"},
+		},
+	}
+	testCases := []struct {
+		name         string
+		params       v1alpha3.TransitGatewayRouteTableParameters
+		associate    []string
+		disassociate []string
+		enable       []string
+		disable      []string
+	}{
+		{
+			"up to date",
+			v1alpha3.TransitGatewayRouteTableParameters{
+				AssociatedAttachmentIDs: []string{"tgw-attach-1"},
+				PropagatedAttachmentIDs: []string{"tgw-attach-1"},
+			},
+			nil, nil, nil, nil,
+		},
+		{
+			"disassociating attachments are associated again",
+			v1alpha3.TransitGatewayRouteTableParameters{
+				AssociatedAttachmentIDs: []string{"tgw-attach-1", "tgw-attach-2"},
+				PropagatedAttachmentIDs: []string{"tgw-attach-1"},
+			},
+			[]string{"tgw-attach-2"}, nil, nil, nil,
+		},
+		{
+			"nothing desired removes everything",
+			v1alpha3.TransitGatewayRouteTableParameters{},
+			nil, []string{"tgw-attach-1"}, nil, []string{"tgw-attach-1"},
+		},
+		{
+			"new propagation is enabled",
+			v1alpha3.TransitGatewayRouteTableParameters{
+				AssociatedAttachmentIDs: []string{"tgw-attach-1"},
+				PropagatedAttachmentIDs: []string{"tgw-attach-1", "tgw-attach-3"},
+			},
+			nil, nil, []string{"tgw-attach-3"}, nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			associate, disassociate, enable, disable := DiffTransitGatewayRouteTableAttachments(observation, tc.params)
+			if diff := cmp.Diff([][]string{tc.associate, tc.disassociate, tc.enable, tc.disable}, [][]string{associate, disassociate, enable, disable}); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_SecurityGroupRuleID(t *testing.T) {
+	testCases := []struct {
+		name     string
+		params   v1alpha3.SecurityGroupRuleParameters
+		wantID   string
+		wantRule ec2.IpPermission
+		wantErr  bool
+	}{
+		{
+			name: "IPv4 range",
+			params: v1alpha3.SecurityGroupRuleParameters{
+				SecurityGroupID: aws.String("sg-1"),
+				Type:            v1alpha3.SecurityGroupRuleTypeIngress,
+				IPProtocol:      "6",
+				FromPort:        aws.Int64(80),
+				ToPort:          aws.Int64(80),
+				CIDRIP:          aws.String("10.0.0.0/16"),
+				Description:     aws.String("http"),
+			},
+			wantID: "sg-1_ingress_tcp_80_80_10.0.0.0/16",
+			wantRule: ec2.IpPermission{
+				IpProtocol: aws.String("tcp"),
+				FromPort:   aws.Int64(80),
+				ToPort:     aws.Int64(80),
+				IpRanges:   []ec2.IpRange{{CidrIp: aws.String("10.0.0.0/16")}},
+			},
+		},
+		{
+			name: "IPv6 range for all protocols",
+			params: v1alpha3.SecurityGroupRuleParameters{
+				SecurityGroupID: aws.String("sg-1"),
+				Type:            v1alpha3.SecurityGroupRuleTypeEgress,
+				IPProtocol:      "-1",
+				CIDRIPv6:        aws.String("::/0"),
+			},
+			wantID: "sg-1_egress_-1___::/0",
+			wantRule: ec2.IpPermission{
+				IpProtocol: aws.String("-1"),
+				Ipv6Ranges: []ec2.Ipv6Range{{CidrIpv6: aws.String("::/0")}},
+			},
+		},
+		{
+			name: "prefix list",
+			params: v1alpha3.SecurityGroupRuleParameters{
+				SecurityGroupID: aws.String("sg-1"),
+				Type:            v1alpha3.SecurityGroupRuleTypeEgress,
+				IPProtocol:      "tcp",
+				FromPort:        aws.Int64(443),
+				ToPort:          aws.Int64(443),
+				PrefixListID:    aws.String("pl-1"),
+			},
+			wantID: "sg-1_egress_tcp_443_443_pl-1",
+			wantRule: ec2.IpPermission{
+				IpProtocol:    aws.String("tcp"),
+				FromPort:      aws.Int64(443),
+				ToPort:        aws.Int64(443),
+				PrefixListIds: []ec2.PrefixListId{{PrefixListId: aws.String("pl-1")}},
+			},
+		},
+		{
+			name: "security group of another account",
+			params: v1alpha3.SecurityGroupRuleParameters{
+				SecurityGroupID: aws.String("sg-1"),
+				Type:            v1alpha3.SecurityGroupRuleTypeIngress,
+				IPProtocol:      "tcp",
+				FromPort:        aws.Int64(5432),
+				ToPort:          aws.Int64(5432),
+				UserIDGroupPair: &v1alpha3.UserIDGroupPair{GroupID: aws.String("sg-2"), UserID: aws.String("123456789012")},
+			},
+			wantID: "sg-1_ingress_tcp_5432_5432_123456789012/sg-2",
+			wantRule: ec2.IpPermission{
+				IpProtocol:       aws.String("tcp"),
+				FromPort:         aws.Int64(5432),
+				ToPort:           aws.Int64(5432),
+				UserIdGroupPairs: []ec2.UserIdGroupPair{{GroupId: aws.String("sg-2"), UserId: aws.String("123456789012")}},
+			},
+		},
+		{
+			name: "more than one source is not allowed",
+			params: v1alpha3.SecurityGroupRuleParameters{
+				SecurityGroupID: aws.String("sg-1"),
+				Type:            v1alpha3.SecurityGroupRuleTypeIngress,
+				IPProtocol:      "tcp",
+				CIDRIP:          aws.String("10.0.0.0/16"),
+				PrefixListID:    aws.String("pl-1"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			id, err := GenerateSecurityGroupRuleID(tc.params)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("GenerateSecurityGroupRuleID(...): unexpected error %v", err)
+			}
+			if tc.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tc.wantID, id); diff != "" {
+				t.Errorf("GenerateSecurityGroupRuleID(...): -want, +got:\n%s", diff)
+			}
+			groupID, ruleType, rule, err := ParseSecurityGroupRuleID(id)
+			if err != nil {
+				t.Fatalf("ParseSecurityGroupRuleID(...): unexpected error %v", err)
+			}
+			if groupID != aws.StringValue(tc.params.SecurityGroupID) || ruleType != tc.params.Type {
+				t.Errorf("ParseSecurityGroupRuleID(...): got group %q and type %q", groupID, ruleType)
+			}
+			if diff := cmp.Diff(tc.wantRule, rule); diff != "" {
+				t.Errorf("ParseSecurityGroupRuleID(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_IsSecurityGroupRuleUpToDate(t *testing.T) {
+	params := v1alpha3.SecurityGroupRuleParameters{
+		SecurityGroupID: aws.String("sg-1"),
+		Type:            v1alpha3.SecurityGroupRuleTypeIngress,
+		IPProtocol:      "tcp",
+		FromPort:        aws.Int64(80),
+		ToPort:          aws.Int64(80),
+		CIDRIP:          aws.String("10.0.0.0/16"),
+		Description:     aws.String("http"),
+	}
+	observed := []ec2.IpPermission{{
+		IpProtocol: aws.String("tcp"),
+		FromPort:   aws.Int64(80),
+		ToPort:     aws.Int64(80),
+		IpRanges: []ec2.IpRange{
+			{CidrIp: aws.String("10.1.0.0/16")},
+			{CidrIp: aws.String("10.0.0.0/16"), Description: aws.String("old")},
+		},
+	}}
+	_, _, rule, _ := ParseSecurityGroupRuleID("sg-1_ingress_tcp_80_80_10.0.0.0/16")
+	found := FindSecurityGroupRule(rule, observed)
+	if found == nil {
+		t.Fatal("FindSecurityGroupRule(...): rule with a different description should be found")
+	}
+	if IsSecurityGroupRuleUpToDate(params, "sg-1_ingress_tcp_80_80_10.0.0.0/16", *found) {
+		t.Error("IsSecurityGroupRuleUpToDate(...): rule with a different description should not be up to date")
+	}
+	found.IpRanges[0].Description = aws.String("http")
+	if !IsSecurityGroupRuleUpToDate(params, "sg-1_ingress_tcp_80_80_10.0.0.0/16", *found) {
+		t.Error("IsSecurityGroupRuleUpToDate(...): same rule should be up to date")
+	}
+	if IsSecurityGroupRuleUpToDate(params, "sg-1_ingress_tcp_8080_8080_10.0.0.0/16", *found) {
+		t.Error("IsSecurityGroupRuleUpToDate(...): rule with a different ID should not be up to date")
+	}
+}
+
+func Test_IsFlowLogUpToDate(t *testing.T) {
+	params := v1alpha3.FlowLogParameters{
+		VPCID:                    aws.String("vpc-1"),
+		TrafficType:              "ALL",
+		LogGroupName:             aws.String("flow-logs"),
+		DeliverLogsPermissionARN: aws.String("arn:aws:iam::123456789012:role/flow-logs"),
+	}
+	observed := ec2.FlowLog{
+		ResourceId:               aws.String("vpc-1"),
+		TrafficType:              ec2.TrafficTypeAll,
+		LogDestinationType:       ec2.LogDestinationTypeCloudWatchLogs,
+		LogGroupName:             aws.String("flow-logs"),
+		DeliverLogsPermissionArn: aws.String("arn:aws:iam::123456789012:role/flow-logs"),
+		LogFormat:                aws.String("${version} ${account-id}"),
+	}
+	s3 := v1alpha3.FlowLogParameters{
+		SubnetID:           aws.String("subnet-1"),
+		TrafficType:        "REJECT",
+		LogDestinationType: aws.String(v1alpha3.FlowLogDestinationS3),
+		S3BucketARN:        aws.String("arn:aws:s3:::flow-logs"),
+	}
+	observedS3 := ec2.FlowLog{
+		ResourceId:         aws.String("subnet-1"),
+		TrafficType:        ec2.TrafficTypeReject,
+		LogDestinationType: ec2.LogDestinationTypeS3,
+		LogDestination:     aws.String("arn:aws:s3:::flow-logs/"),
+	}
+	testCases := []struct {
+		name     string
+		params   func(p *v1alpha3.FlowLogParameters)
+		base     v1alpha3.FlowLogParameters
+		observed ec2.FlowLog
+		want     bool
+	}{
+		{name: "same CloudWatch Logs flow log is up to date", base: params, observed: observed, want: true},
+		{name: "same S3 flow log is up to date", base: s3, observed: observedS3, want: true},
+		{
+			name:     "different log group is not up to date",
+			base:     params,
+			params:   func(p *v1alpha3.FlowLogParameters) { p.LogGroupName = aws.String("other") },
+			observed: observed,
+		},
+		{
+			name:     "different traffic type is not up to date",
+			base:     params,
+			params:   func(p *v1alpha3.FlowLogParameters) { p.TrafficType = "ACCEPT" },
+			observed: observed,
+		},
+		{
+			name:     "different log format is not up to date",
+			base:     params,
+			params:   func(p *v1alpha3.FlowLogParameters) { p.LogFormat = aws.String("${srcaddr}") },
+			observed: observed,
+		},
+		{
+			name:     "different bucket is not up to date",
+			base:     s3,
+			params:   func(p *v1alpha3.FlowLogParameters) { p.S3BucketARN = aws.String("arn:aws:s3:::other") },
+			observed: observedS3,
+		},
+		{
+			name:     "more than one resource is not up to date",
+			base:     params,
+			params:   func(p *v1alpha3.FlowLogParameters) { p.SubnetID = aws.String("subnet-1") },
+			observed: observed,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := *tc.base.DeepCopy()
+			if tc.params != nil {
+				tc.params(&p)
+			}
+			if got := IsFlowLogUpToDate(p, tc.observed); got != tc.want {
+				t.Errorf("IsFlowLogUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
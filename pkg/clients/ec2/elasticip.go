@@ -0,0 +1,90 @@
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/provider-aws/apis/network/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// AllocationIDNotFound is the code that is returned by ec2 when the given AllocationID is not valid
+	AllocationIDNotFound = "InvalidAllocationID.NotFound"
+)
+
+// ElasticIPClient is the external client used for ElasticIP Custom Resource
+type ElasticIPClient interface {
+	AllocateAddressRequest(input *ec2.AllocateAddressInput) ec2.AllocateAddressRequest
+	ReleaseAddressRequest(input *ec2.ReleaseAddressInput) ec2.ReleaseAddressRequest
+	DescribeAddressesRequest(input *ec2.DescribeAddressesInput) ec2.DescribeAddressesRequest
+	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewElasticIPClient returns a new client using AWS credentials as JSON encoded data.
+func NewElasticIPClient(cfg *aws.Config) (ElasticIPClient, error) {
+	return ec2.New(*cfg), nil
+}
+
+// IsAddressNotFoundErr returns true if the error is because the address doesn't exist
+func IsAddressNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == AllocationIDNotFound {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateAllocateAddressInput returns the input to allocate an Elastic IP
+// address with the given parameters.
+func GenerateAllocateAddressInput(p v1alpha3.ElasticIPParameters) *ec2.AllocateAddressInput {
+	domain := ec2.DomainTypeVpc
+	if p.Domain != nil {
+		domain = ec2.DomainType(*p.Domain)
+	}
+	return &ec2.AllocateAddressInput{
+		Address:            p.Address,
+		Domain:             domain,
+		NetworkBorderGroup: p.NetworkBorderGroup,
+		PublicIpv4Pool:     p.PublicIPv4Pool,
+	}
+}
+
+// GenerateAddressObservation is used to produce v1alpha3.ElasticIPObservation
+// from ec2.Address.
+func GenerateAddressObservation(a ec2.Address) v1alpha3.ElasticIPObservation {
+	return v1alpha3.ElasticIPObservation{
+		AllocationID:       aws.StringValue(a.AllocationId),
+		PublicIP:           aws.StringValue(a.PublicIp),
+		AssociationID:      aws.StringValue(a.AssociationId),
+		InstanceID:         aws.StringValue(a.InstanceId),
+		NetworkInterfaceID: aws.StringValue(a.NetworkInterfaceId),
+		PrivateIPAddress:   aws.StringValue(a.PrivateIpAddress),
+	}
+}
+
+// LateInitializeAddress fills the empty fields in *v1alpha3.ElasticIPParameters
+// with the values seen in ec2.Address.
+func LateInitializeAddress(in *v1alpha3.ElasticIPParameters, a *ec2.Address) {
+	if a == nil {
+		return
+	}
+	if in.Domain == nil && a.Domain != "" {
+		in.Domain = aws.String(string(a.Domain))
+	}
+	in.PublicIPv4Pool = awsclients.LateInitializeStringPtr(in.PublicIPv4Pool, a.PublicIpv4Pool)
+	in.NetworkBorderGroup = awsclients.LateInitializeStringPtr(in.NetworkBorderGroup, a.NetworkBorderGroup)
+}
+
+// IsAddressUpToDate returns true if the tags of the observed Elastic IP
+// address match the desired ones. The other parameters cannot be changed once
+// the address is allocated.
+// managedTagKeys are the keys of the tags that were previously set from the
+// spec.
+func IsAddressUpToDate(p v1alpha3.ElasticIPParameters, a ec2.Address, managedTagKeys []string) bool {
+	add, remove := DiffManagedEC2Tags(p.Tags, a.Tags, managedTagKeys)
+	return len(add) == 0 && len(remove) == 0
+}
@@ -32,6 +32,8 @@ type MockInternetGatewayClient struct {
 	MockDescribeInternetGatewaysRequest func(*ec2.DescribeInternetGatewaysInput) ec2.DescribeInternetGatewaysRequest
 	MockAttachInternetGatewayRequest    func(*ec2.AttachInternetGatewayInput) ec2.AttachInternetGatewayRequest
 	MockDetachInternetGatewayRequest    func(*ec2.DetachInternetGatewayInput) ec2.DetachInternetGatewayRequest
+	MockCreateTagsRequest               func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	MockDeleteTagsRequest               func(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
 }
 
 // CreateInternetGatewayRequest mocks CreateInternetGatewayRequest method
@@ -54,7 +56,17 @@ func (m *MockInternetGatewayClient) AttachInternetGatewayRequest(input *ec2.Atta
 	return m.MockAttachInternetGatewayRequest(input)
 }
 
-// DetachInternetGatewayRequest mocks DetachInternetGatewayRequest
+// DetachInternetGatewayRequest mocks DetachInternetGatewayRequest method
 func (m *MockInternetGatewayClient) DetachInternetGatewayRequest(input *ec2.DetachInternetGatewayInput) ec2.DetachInternetGatewayRequest {
 	return m.MockDetachInternetGatewayRequest(input)
 }
+
+// CreateTagsRequest mocks CreateTagsRequest method
+func (m *MockInternetGatewayClient) CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest {
+	return m.MockCreateTagsRequest(input)
+}
+
+// DeleteTagsRequest mocks DeleteTagsRequest method
+func (m *MockInternetGatewayClient) DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest {
+	return m.MockDeleteTagsRequest(input)
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.NATGatewayClient = (*MockNATGatewayClient)(nil)
+
+// MockNATGatewayClient is a type that implements all the methods for NATGatewayClient interface
+type MockNATGatewayClient struct {
+	MockCreateNatGatewayRequest    func(*ec2.CreateNatGatewayInput) ec2.CreateNatGatewayRequest
+	MockDeleteNatGatewayRequest    func(*ec2.DeleteNatGatewayInput) ec2.DeleteNatGatewayRequest
+	MockDescribeNatGatewaysRequest func(*ec2.DescribeNatGatewaysInput) ec2.DescribeNatGatewaysRequest
+	MockCreateTagsRequest          func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	MockDeleteTagsRequest          func(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// CreateNatGatewayRequest mocks CreateNatGatewayRequest method
+func (m *MockNATGatewayClient) CreateNatGatewayRequest(input *ec2.CreateNatGatewayInput) ec2.CreateNatGatewayRequest {
+	return m.MockCreateNatGatewayRequest(input)
+}
+
+// DeleteNatGatewayRequest mocks DeleteNatGatewayRequest method
+func (m *MockNATGatewayClient) DeleteNatGatewayRequest(input *ec2.DeleteNatGatewayInput) ec2.DeleteNatGatewayRequest {
+	return m.MockDeleteNatGatewayRequest(input)
+}
+
+// DescribeNatGatewaysRequest mocks DescribeNatGatewaysRequest method
+func (m *MockNATGatewayClient) DescribeNatGatewaysRequest(input *ec2.DescribeNatGatewaysInput) ec2.DescribeNatGatewaysRequest {
+	return m.MockDescribeNatGatewaysRequest(input)
+}
+
+// CreateTagsRequest mocks CreateTagsRequest method
+func (m *MockNATGatewayClient) CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest {
+	return m.MockCreateTagsRequest(input)
+}
+
+// DeleteTagsRequest mocks DeleteTagsRequest method
+func (m *MockNATGatewayClient) DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest {
+	return m.MockDeleteTagsRequest(input)
+}
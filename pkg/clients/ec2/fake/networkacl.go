@@ -0,0 +1,84 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.NetworkACLClient = (*MockNetworkACLClient)(nil)
+
+// MockNetworkACLClient is a type that implements all the methods for NetworkACLClient interface
+type MockNetworkACLClient struct {
+	MockCreateNetworkAclRequest             func(*ec2.CreateNetworkAclInput) ec2.CreateNetworkAclRequest
+	MockDeleteNetworkAclRequest             func(*ec2.DeleteNetworkAclInput) ec2.DeleteNetworkAclRequest
+	MockDescribeNetworkAclsRequest          func(*ec2.DescribeNetworkAclsInput) ec2.DescribeNetworkAclsRequest
+	MockCreateNetworkAclEntryRequest        func(*ec2.CreateNetworkAclEntryInput) ec2.CreateNetworkAclEntryRequest
+	MockReplaceNetworkAclEntryRequest       func(*ec2.ReplaceNetworkAclEntryInput) ec2.ReplaceNetworkAclEntryRequest
+	MockDeleteNetworkAclEntryRequest        func(*ec2.DeleteNetworkAclEntryInput) ec2.DeleteNetworkAclEntryRequest
+	MockReplaceNetworkAclAssociationRequest func(*ec2.ReplaceNetworkAclAssociationInput) ec2.ReplaceNetworkAclAssociationRequest
+	MockCreateTagsRequest                   func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	MockDeleteTagsRequest                   func(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// CreateNetworkAclRequest mocks CreateNetworkAclRequest method
+func (m *MockNetworkACLClient) CreateNetworkAclRequest(input *ec2.CreateNetworkAclInput) ec2.CreateNetworkAclRequest {
+	return m.MockCreateNetworkAclRequest(input)
+}
+
+// DeleteNetworkAclRequest mocks DeleteNetworkAclRequest method
+func (m *MockNetworkACLClient) DeleteNetworkAclRequest(input *ec2.DeleteNetworkAclInput) ec2.DeleteNetworkAclRequest {
+	return m.MockDeleteNetworkAclRequest(input)
+}
+
+// DescribeNetworkAclsRequest mocks DescribeNetworkAclsRequest method
+func (m *MockNetworkACLClient) DescribeNetworkAclsRequest(input *ec2.DescribeNetworkAclsInput) ec2.DescribeNetworkAclsRequest {
+	return m.MockDescribeNetworkAclsRequest(input)
+}
+
+// CreateNetworkAclEntryRequest mocks CreateNetworkAclEntryRequest method
+func (m *MockNetworkACLClient) CreateNetworkAclEntryRequest(input *ec2.CreateNetworkAclEntryInput) ec2.CreateNetworkAclEntryRequest {
+	return m.MockCreateNetworkAclEntryRequest(input)
+}
+
+// ReplaceNetworkAclEntryRequest mocks ReplaceNetworkAclEntryRequest method
+func (m *MockNetworkACLClient) ReplaceNetworkAclEntryRequest(input *ec2.ReplaceNetworkAclEntryInput) ec2.ReplaceNetworkAclEntryRequest {
+	return m.MockReplaceNetworkAclEntryRequest(input)
+}
+
+// DeleteNetworkAclEntryRequest mocks DeleteNetworkAclEntryRequest method
+func (m *MockNetworkACLClient) DeleteNetworkAclEntryRequest(input *ec2.DeleteNetworkAclEntryInput) ec2.DeleteNetworkAclEntryRequest {
+	return m.MockDeleteNetworkAclEntryRequest(input)
+}
+
+// ReplaceNetworkAclAssociationRequest mocks ReplaceNetworkAclAssociationRequest method
+func (m *MockNetworkACLClient) ReplaceNetworkAclAssociationRequest(input *ec2.ReplaceNetworkAclAssociationInput) ec2.ReplaceNetworkAclAssociationRequest {
+	return m.MockReplaceNetworkAclAssociationRequest(input)
+}
+
+// CreateTagsRequest mocks CreateTagsRequest method
+func (m *MockNetworkACLClient) CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest {
+	return m.MockCreateTagsRequest(input)
+}
+
+// DeleteTagsRequest mocks DeleteTagsRequest method
+func (m *MockNetworkACLClient) DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest {
+	return m.MockDeleteTagsRequest(input)
+}
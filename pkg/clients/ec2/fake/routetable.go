@@ -31,6 +31,7 @@ type MockRouteTableClient struct {
 	MockDeleteRouteTableRequest       func(*ec2.DeleteRouteTableInput) ec2.DeleteRouteTableRequest
 	MockDescribeRouteTablesRequest    func(*ec2.DescribeRouteTablesInput) ec2.DescribeRouteTablesRequest
 	MockCreateRouteRequest            func(*ec2.CreateRouteInput) ec2.CreateRouteRequest
+	MockReplaceRouteRequest           func(*ec2.ReplaceRouteInput) ec2.ReplaceRouteRequest
 	MockDeleteRouteRequest            func(*ec2.DeleteRouteInput) ec2.DeleteRouteRequest
 	MockAssociateRouteTableRequest    func(*ec2.AssociateRouteTableInput) ec2.AssociateRouteTableRequest
 	MockDisassociateRouteTableRequest func(*ec2.DisassociateRouteTableInput) ec2.DisassociateRouteTableRequest
@@ -66,6 +67,11 @@ func (m *MockRouteTableClient) CreateRouteRequest(input *ec2.CreateRouteInput) e
 	return m.MockCreateRouteRequest(input)
 }
 
+// ReplaceRouteRequest mocks ReplaceRouteRequest method
+func (m *MockRouteTableClient) ReplaceRouteRequest(input *ec2.ReplaceRouteInput) ec2.ReplaceRouteRequest {
+	return m.MockReplaceRouteRequest(input)
+}
+
 // DeleteRouteRequest mocks DeleteRouteRequest method
 func (m *MockRouteTableClient) DeleteRouteRequest(input *ec2.DeleteRouteInput) ec2.DeleteRouteRequest {
 	return m.MockDeleteRouteRequest(input)
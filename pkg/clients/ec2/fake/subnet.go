@@ -27,9 +27,14 @@ var _ clientset.SubnetClient = (*MockSubnetClient)(nil)
 
 // MockSubnetClient is a type that implements all the methods for SubnetClient interface
 type MockSubnetClient struct {
-	MockCreateSubnetRequest    func(*ec2.CreateSubnetInput) ec2.CreateSubnetRequest
-	MockDeleteSubnetRequest    func(*ec2.DeleteSubnetInput) ec2.DeleteSubnetRequest
-	MockDescribeSubnetsRequest func(*ec2.DescribeSubnetsInput) ec2.DescribeSubnetsRequest
+	MockCreateSubnetRequest                func(*ec2.CreateSubnetInput) ec2.CreateSubnetRequest
+	MockDeleteSubnetRequest                func(*ec2.DeleteSubnetInput) ec2.DeleteSubnetRequest
+	MockDescribeSubnetsRequest             func(*ec2.DescribeSubnetsInput) ec2.DescribeSubnetsRequest
+	MockModifySubnetAttributeRequest       func(*ec2.ModifySubnetAttributeInput) ec2.ModifySubnetAttributeRequest
+	MockAssociateSubnetCidrBlockRequest    func(*ec2.AssociateSubnetCidrBlockInput) ec2.AssociateSubnetCidrBlockRequest
+	MockDisassociateSubnetCidrBlockRequest func(*ec2.DisassociateSubnetCidrBlockInput) ec2.DisassociateSubnetCidrBlockRequest
+	MockCreateTagsRequest                  func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	MockDeleteTagsRequest                  func(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
 }
 
 // CreateSubnetRequest mocks CreateSubnetRequest method
@@ -46,3 +51,28 @@ func (m *MockSubnetClient) DeleteSubnetRequest(input *ec2.DeleteSubnetInput) ec2
 func (m *MockSubnetClient) DescribeSubnetsRequest(input *ec2.DescribeSubnetsInput) ec2.DescribeSubnetsRequest {
 	return m.MockDescribeSubnetsRequest(input)
 }
+
+// ModifySubnetAttributeRequest mocks ModifySubnetAttributeRequest method
+func (m *MockSubnetClient) ModifySubnetAttributeRequest(input *ec2.ModifySubnetAttributeInput) ec2.ModifySubnetAttributeRequest {
+	return m.MockModifySubnetAttributeRequest(input)
+}
+
+// AssociateSubnetCidrBlockRequest mocks AssociateSubnetCidrBlockRequest method
+func (m *MockSubnetClient) AssociateSubnetCidrBlockRequest(input *ec2.AssociateSubnetCidrBlockInput) ec2.AssociateSubnetCidrBlockRequest {
+	return m.MockAssociateSubnetCidrBlockRequest(input)
+}
+
+// DisassociateSubnetCidrBlockRequest mocks DisassociateSubnetCidrBlockRequest method
+func (m *MockSubnetClient) DisassociateSubnetCidrBlockRequest(input *ec2.DisassociateSubnetCidrBlockInput) ec2.DisassociateSubnetCidrBlockRequest {
+	return m.MockDisassociateSubnetCidrBlockRequest(input)
+}
+
+// CreateTagsRequest mocks CreateTagsRequest method
+func (m *MockSubnetClient) CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest {
+	return m.MockCreateTagsRequest(input)
+}
+
+// DeleteTagsRequest mocks DeleteTagsRequest method
+func (m *MockSubnetClient) DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest {
+	return m.MockDeleteTagsRequest(input)
+}
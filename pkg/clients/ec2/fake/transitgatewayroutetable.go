@@ -0,0 +1,96 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.TransitGatewayRouteTableClient = (*MockTransitGatewayRouteTableClient)(nil)
+
+// MockTransitGatewayRouteTableClient is a type that implements all the methods for TransitGatewayRouteTableClient interface
+type MockTransitGatewayRouteTableClient struct {
+	MockCreateTransitGatewayRouteTableRequest             func(*ec2.CreateTransitGatewayRouteTableInput) ec2.CreateTransitGatewayRouteTableRequest
+	MockDeleteTransitGatewayRouteTableRequest             func(*ec2.DeleteTransitGatewayRouteTableInput) ec2.DeleteTransitGatewayRouteTableRequest
+	MockDescribeTransitGatewayRouteTablesRequest          func(*ec2.DescribeTransitGatewayRouteTablesInput) ec2.DescribeTransitGatewayRouteTablesRequest
+	MockGetTransitGatewayRouteTableAssociationsRequest    func(*ec2.GetTransitGatewayRouteTableAssociationsInput) ec2.GetTransitGatewayRouteTableAssociationsRequest
+	MockGetTransitGatewayRouteTablePropagationsRequest    func(*ec2.GetTransitGatewayRouteTablePropagationsInput) ec2.GetTransitGatewayRouteTablePropagationsRequest
+	MockAssociateTransitGatewayRouteTableRequest          func(*ec2.AssociateTransitGatewayRouteTableInput) ec2.AssociateTransitGatewayRouteTableRequest
+	MockDisassociateTransitGatewayRouteTableRequest       func(*ec2.DisassociateTransitGatewayRouteTableInput) ec2.DisassociateTransitGatewayRouteTableRequest
+	MockEnableTransitGatewayRouteTablePropagationRequest  func(*ec2.EnableTransitGatewayRouteTablePropagationInput) ec2.EnableTransitGatewayRouteTablePropagationRequest
+	MockDisableTransitGatewayRouteTablePropagationRequest func(*ec2.DisableTransitGatewayRouteTablePropagationInput) ec2.DisableTransitGatewayRouteTablePropagationRequest
+	MockCreateTagsRequest                                 func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	MockDeleteTagsRequest                                 func(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// CreateTransitGatewayRouteTableRequest mocks CreateTransitGatewayRouteTableRequest method
+func (m *MockTransitGatewayRouteTableClient) CreateTransitGatewayRouteTableRequest(input *ec2.CreateTransitGatewayRouteTableInput) ec2.CreateTransitGatewayRouteTableRequest {
+	return m.MockCreateTransitGatewayRouteTableRequest(input)
+}
+
+// DeleteTransitGatewayRouteTableRequest mocks DeleteTransitGatewayRouteTableRequest method
+func (m *MockTransitGatewayRouteTableClient) DeleteTransitGatewayRouteTableRequest(input *ec2.DeleteTransitGatewayRouteTableInput) ec2.DeleteTransitGatewayRouteTableRequest {
+	return m.MockDeleteTransitGatewayRouteTableRequest(input)
+}
+
+// DescribeTransitGatewayRouteTablesRequest mocks DescribeTransitGatewayRouteTablesRequest method
+func (m *MockTransitGatewayRouteTableClient) DescribeTransitGatewayRouteTablesRequest(input *ec2.DescribeTransitGatewayRouteTablesInput) ec2.DescribeTransitGatewayRouteTablesRequest {
+	return m.MockDescribeTransitGatewayRouteTablesRequest(input)
+}
+
+// GetTransitGatewayRouteTableAssociationsRequest mocks GetTransitGatewayRouteTableAssociationsRequest method
+func (m *MockTransitGatewayRouteTableClient) GetTransitGatewayRouteTableAssociationsRequest(input *ec2.GetTransitGatewayRouteTableAssociationsInput) ec2.GetTransitGatewayRouteTableAssociationsRequest {
+	return m.MockGetTransitGatewayRouteTableAssociationsRequest(input)
+}
+
+// GetTransitGatewayRouteTablePropagationsRequest mocks GetTransitGatewayRouteTablePropagationsRequest method
+func (m *MockTransitGatewayRouteTableClient) GetTransitGatewayRouteTablePropagationsRequest(input *ec2.GetTransitGatewayRouteTablePropagationsInput) ec2.GetTransitGatewayRouteTablePropagationsRequest {
+	return m.MockGetTransitGatewayRouteTablePropagationsRequest(input)
+}
+
+// AssociateTransitGatewayRouteTableRequest mocks AssociateTransitGatewayRouteTableRequest method
+func (m *MockTransitGatewayRouteTableClient) AssociateTransitGatewayRouteTableRequest(input *ec2.AssociateTransitGatewayRouteTableInput) ec2.AssociateTransitGatewayRouteTableRequest {
+	return m.MockAssociateTransitGatewayRouteTableRequest(input)
+}
+
+// DisassociateTransitGatewayRouteTableRequest mocks DisassociateTransitGatewayRouteTableRequest method
+func (m *MockTransitGatewayRouteTableClient) DisassociateTransitGatewayRouteTableRequest(input *ec2.DisassociateTransitGatewayRouteTableInput) ec2.DisassociateTransitGatewayRouteTableRequest {
+	return m.MockDisassociateTransitGatewayRouteTableRequest(input)
+}
+
+// EnableTransitGatewayRouteTablePropagationRequest mocks EnableTransitGatewayRouteTablePropagationRequest method
+func (m *MockTransitGatewayRouteTableClient) EnableTransitGatewayRouteTablePropagationRequest(input *ec2.EnableTransitGatewayRouteTablePropagationInput) ec2.EnableTransitGatewayRouteTablePropagationRequest {
+	return m.MockEnableTransitGatewayRouteTablePropagationRequest(input)
+}
+
+// DisableTransitGatewayRouteTablePropagationRequest mocks DisableTransitGatewayRouteTablePropagationRequest method
+func (m *MockTransitGatewayRouteTableClient) DisableTransitGatewayRouteTablePropagationRequest(input *ec2.DisableTransitGatewayRouteTablePropagationInput) ec2.DisableTransitGatewayRouteTablePropagationRequest {
+	return m.MockDisableTransitGatewayRouteTablePropagationRequest(input)
+}
+
+// CreateTagsRequest mocks CreateTagsRequest method
+func (m *MockTransitGatewayRouteTableClient) CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest {
+	return m.MockCreateTagsRequest(input)
+}
+
+// DeleteTagsRequest mocks DeleteTagsRequest method
+func (m *MockTransitGatewayRouteTableClient) DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest {
+	return m.MockDeleteTagsRequest(input)
+}
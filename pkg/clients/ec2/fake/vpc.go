@@ -27,11 +27,15 @@ var _ clientset.VPCClient = (*MockVPCClient)(nil)
 
 // MockVPCClient is a type that implements all the methods for VPCClient interface
 type MockVPCClient struct {
-	MockCreateVpcRequest          func(*ec2.CreateVpcInput) ec2.CreateVpcRequest
-	MockDeleteVpcRequest          func(*ec2.DeleteVpcInput) ec2.DeleteVpcRequest
-	MockDescribeVpcsRequest       func(*ec2.DescribeVpcsInput) ec2.DescribeVpcsRequest
-	MockModifyVpcAttributeRequest func(*ec2.ModifyVpcAttributeInput) ec2.ModifyVpcAttributeRequest
-	MockCreateTagsRequest         func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	MockCreateVpcRequest                func(*ec2.CreateVpcInput) ec2.CreateVpcRequest
+	MockDeleteVpcRequest                func(*ec2.DeleteVpcInput) ec2.DeleteVpcRequest
+	MockDescribeVpcsRequest             func(*ec2.DescribeVpcsInput) ec2.DescribeVpcsRequest
+	MockModifyVpcAttributeRequest       func(*ec2.ModifyVpcAttributeInput) ec2.ModifyVpcAttributeRequest
+	MockCreateTagsRequest               func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	MockDeleteTagsRequest               func(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+	MockAssociateVpcCidrBlockRequest    func(*ec2.AssociateVpcCidrBlockInput) ec2.AssociateVpcCidrBlockRequest
+	MockDisassociateVpcCidrBlockRequest func(*ec2.DisassociateVpcCidrBlockInput) ec2.DisassociateVpcCidrBlockRequest
+	MockModifyVpcTenancyRequest         func(*ec2.ModifyVpcTenancyInput) ec2.ModifyVpcTenancyRequest
 }
 
 // CreateVpcRequest mocks CreateVpcRequest method
@@ -58,3 +62,23 @@ func (m *MockVPCClient) ModifyVpcAttributeRequest(input *ec2.ModifyVpcAttributeI
 func (m *MockVPCClient) CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest {
 	return m.MockCreateTagsRequest(input)
 }
+
+// DeleteTagsRequest mocks DeleteTagsRequest method
+func (m *MockVPCClient) DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest {
+	return m.MockDeleteTagsRequest(input)
+}
+
+// AssociateVpcCidrBlockRequest mocks AssociateVpcCidrBlockRequest method
+func (m *MockVPCClient) AssociateVpcCidrBlockRequest(input *ec2.AssociateVpcCidrBlockInput) ec2.AssociateVpcCidrBlockRequest {
+	return m.MockAssociateVpcCidrBlockRequest(input)
+}
+
+// DisassociateVpcCidrBlockRequest mocks DisassociateVpcCidrBlockRequest method
+func (m *MockVPCClient) DisassociateVpcCidrBlockRequest(input *ec2.DisassociateVpcCidrBlockInput) ec2.DisassociateVpcCidrBlockRequest {
+	return m.MockDisassociateVpcCidrBlockRequest(input)
+}
+
+// ModifyVpcTenancyRequest mocks ModifyVpcTenancyRequest method
+func (m *MockVPCClient) ModifyVpcTenancyRequest(input *ec2.ModifyVpcTenancyInput) ec2.ModifyVpcTenancyRequest {
+	return m.MockModifyVpcTenancyRequest(input)
+}
@@ -0,0 +1,150 @@
+package ec2
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-aws/apis/network/v1alpha3"
+)
+
+const (
+	// FlowLogIDNotFound is the code that is returned by ec2 when the given
+	// flow log ID is not valid
+	FlowLogIDNotFound = "InvalidFlowLogId.NotFound"
+
+	errFlowLogResource = "exactly one of vpcId, subnetId and networkInterfaceId must be set"
+)
+
+// FlowLogClient is the external client used for FlowLog Custom Resource
+type FlowLogClient interface {
+	CreateFlowLogsRequest(input *ec2.CreateFlowLogsInput) ec2.CreateFlowLogsRequest
+	DescribeFlowLogsRequest(input *ec2.DescribeFlowLogsInput) ec2.DescribeFlowLogsRequest
+	DeleteFlowLogsRequest(input *ec2.DeleteFlowLogsInput) ec2.DeleteFlowLogsRequest
+}
+
+// NewFlowLogClient returns a new client using AWS credentials as JSON encoded data.
+func NewFlowLogClient(cfg *aws.Config) (FlowLogClient, error) {
+	return ec2.New(*cfg), nil
+}
+
+// IsFlowLogNotFoundErr returns true if the error is because the item doesn't exist
+func IsFlowLogNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == FlowLogIDNotFound {
+			return true
+		}
+	}
+	return false
+}
+
+// UnsuccessfulItemsErr returns the error of the first of the given items,
+// which CreateFlowLogs and DeleteFlowLogs report instead of failing, or nil
+// if there are none.
+func UnsuccessfulItemsErr(items []ec2.UnsuccessfulItem) error {
+	for _, i := range items {
+		if i.Error == nil {
+			continue
+		}
+		return awserr.New(aws.StringValue(i.Error.Code), aws.StringValue(i.Error.Message), nil)
+	}
+	return nil
+}
+
+// flowLogResource returns the type and ID of the resource whose traffic the
+// flow log captures.
+func flowLogResource(p v1alpha3.FlowLogParameters) (ec2.FlowLogsResourceType, string, error) {
+	var resourceType ec2.FlowLogsResourceType
+	var id string
+	n := 0
+	if p.VPCID != nil {
+		resourceType, id = ec2.FlowLogsResourceTypeVpc, *p.VPCID
+		n++
+	}
+	if p.SubnetID != nil {
+		resourceType, id = ec2.FlowLogsResourceTypeSubnet, *p.SubnetID
+		n++
+	}
+	if p.NetworkInterfaceID != nil {
+		resourceType, id = ec2.FlowLogsResourceTypeNetworkInterface, *p.NetworkInterfaceID
+		n++
+	}
+	if n != 1 {
+		return "", "", errors.New(errFlowLogResource)
+	}
+	return resourceType, id, nil
+}
+
+// GenerateCreateFlowLogsInput returns the input of the CreateFlowLogs call
+// that creates the given flow log.
+func GenerateCreateFlowLogsInput(p v1alpha3.FlowLogParameters) (*ec2.CreateFlowLogsInput, error) {
+	resourceType, id, err := flowLogResource(p)
+	if err != nil {
+		return nil, err
+	}
+	input := &ec2.CreateFlowLogsInput{
+		ResourceIds:  []string{id},
+		ResourceType: resourceType,
+		TrafficType:  ec2.TrafficType(p.TrafficType),
+		LogFormat:    p.LogFormat,
+	}
+	if aws.StringValue(p.LogDestinationType) == v1alpha3.FlowLogDestinationS3 {
+		input.LogDestinationType = ec2.LogDestinationTypeS3
+		input.LogDestination = p.S3BucketARN
+		return input, nil
+	}
+	input.LogDestinationType = ec2.LogDestinationTypeCloudWatchLogs
+	input.LogGroupName = p.LogGroupName
+	input.DeliverLogsPermissionArn = p.DeliverLogsPermissionARN
+	return input, nil
+}
+
+// GenerateFlowLogObservation is used to produce v1alpha3.FlowLogObservation
+// from ec2.FlowLog.
+func GenerateFlowLogObservation(fl ec2.FlowLog) v1alpha3.FlowLogObservation {
+	return v1alpha3.FlowLogObservation{
+		FlowLogStatus:           aws.StringValue(fl.FlowLogStatus),
+		DeliverLogsStatus:       aws.StringValue(fl.DeliverLogsStatus),
+		DeliverLogsErrorMessage: aws.StringValue(fl.DeliverLogsErrorMessage),
+		LogDestination:          aws.StringValue(fl.LogDestination),
+	}
+}
+
+// LateInitializeFlowLog fills the empty fields in *v1alpha3.FlowLogParameters
+// with the values seen in ec2.FlowLog.
+func LateInitializeFlowLog(in *v1alpha3.FlowLogParameters, fl *ec2.FlowLog) {
+	if fl == nil {
+		return
+	}
+	if in.LogDestinationType == nil && fl.LogDestinationType != "" {
+		in.LogDestinationType = aws.String(string(fl.LogDestinationType))
+	}
+	if in.LogFormat == nil {
+		in.LogFormat = fl.LogFormat
+	}
+}
+
+// IsFlowLogUpToDate returns true if the observed flow log matches the desired
+// one.
+func IsFlowLogUpToDate(p v1alpha3.FlowLogParameters, fl ec2.FlowLog) bool {
+	input, err := GenerateCreateFlowLogsInput(p)
+	if err != nil {
+		return false
+	}
+	if input.ResourceIds[0] != aws.StringValue(fl.ResourceId) ||
+		input.TrafficType != fl.TrafficType ||
+		input.LogDestinationType != fl.LogDestinationType {
+		return false
+	}
+	if p.LogFormat != nil && aws.StringValue(p.LogFormat) != aws.StringValue(fl.LogFormat) {
+		return false
+	}
+	if input.LogDestinationType == ec2.LogDestinationTypeS3 {
+		return strings.TrimSuffix(aws.StringValue(input.LogDestination), "/") == strings.TrimSuffix(aws.StringValue(fl.LogDestination), "/")
+	}
+	return aws.StringValue(input.LogGroupName) == aws.StringValue(fl.LogGroupName) &&
+		aws.StringValue(input.DeliverLogsPermissionArn) == aws.StringValue(fl.DeliverLogsPermissionArn)
+}
@@ -4,6 +4,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/awserr"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/provider-aws/apis/network/v1alpha3"
 )
 
 const (
@@ -18,6 +20,8 @@ type InternetGatewayClient interface {
 	DescribeInternetGatewaysRequest(input *ec2.DescribeInternetGatewaysInput) ec2.DescribeInternetGatewaysRequest
 	AttachInternetGatewayRequest(input *ec2.AttachInternetGatewayInput) ec2.AttachInternetGatewayRequest
 	DetachInternetGatewayRequest(input *ec2.DetachInternetGatewayInput) ec2.DetachInternetGatewayRequest
+	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest
 }
 
 // NewInternetGatewayClient returns a new client using AWS credentials as JSON encoded data.
@@ -35,3 +39,34 @@ func IsInternetGatewayNotFoundErr(err error) bool {
 
 	return false
 }
+
+// DiffInternetGatewayAttachments returns whether the internet gateway needs
+// to be attached to the given VPC and the IDs of the VPCs it needs to be
+// detached from. Attachments that are being detached or are already detached
+// are ignored.
+func DiffInternetGatewayAttachments(vpcID string, attachments []v1alpha3.InternetGatewayAttachment) (attach bool, detach []string) {
+	attach = vpcID != ""
+	for _, a := range attachments {
+		if a.AttachmentStatus == string(ec2.AttachmentStatusDetaching) || a.AttachmentStatus == string(ec2.AttachmentStatusDetached) {
+			continue
+		}
+		if a.VPCID == vpcID {
+			attach = false
+			continue
+		}
+		detach = append(detach, a.VPCID)
+	}
+	return attach, detach
+}
+
+// IsInternetGatewayUpToDate returns true if the observed attachments and tags
+// of the internet gateway match the desired ones. managedTagKeys are the keys
+// of the tags that were previously set from the spec.
+func IsInternetGatewayUpToDate(spec v1alpha3.InternetGatewayParameters, status v1alpha3.InternetGatewayExternalStatus, managedTagKeys []string) bool {
+	attach, detach := DiffInternetGatewayAttachments(spec.VPCID, status.Attachments)
+	if attach || len(detach) > 0 {
+		return false
+	}
+	add, remove := DiffManagedEC2Tags(spec.Tags, v1alpha3.GenerateEC2Tags(status.Tags), managedTagKeys)
+	return len(add) == 0 && len(remove) == 0
+}
@@ -0,0 +1,32 @@
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-aws/apis/network/v1alpha3"
+)
+
+const errEmptyLookup = "lookup parameters must contain at least one tag or filter"
+
+// GenerateLookupFilters returns the EC2 filters that select the resources
+// matching the given lookup parameters. If vpcID is not empty, only the
+// resources of that VPC are selected. Lookup parameters without tags and
+// filters are rejected, since they would match e.g. the default VPC.
+func GenerateLookupFilters(l v1alpha3.LookupParameters, vpcID string) ([]ec2.Filter, error) {
+	if len(l.Tags) == 0 && len(l.Filters) == 0 {
+		return nil, errors.New(errEmptyLookup)
+	}
+	filters := make([]ec2.Filter, 0, len(l.Tags)+len(l.Filters)+1)
+	for _, t := range l.Tags {
+		filters = append(filters, ec2.Filter{Name: aws.String("tag:" + t.Key), Values: []string{t.Value}})
+	}
+	for _, f := range l.Filters {
+		filters = append(filters, ec2.Filter{Name: aws.String(f.Name), Values: f.Values})
+	}
+	if vpcID != "" {
+		filters = append(filters, ec2.Filter{Name: aws.String("vpc-id"), Values: []string{vpcID}})
+	}
+	return filters, nil
+}
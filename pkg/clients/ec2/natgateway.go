@@ -0,0 +1,74 @@
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/provider-aws/apis/network/v1alpha3"
+)
+
+const (
+	// NATGatewayNotFound is the code that is returned by ec2 when the given NATGatewayID is not found
+	NATGatewayNotFound = "NatGatewayNotFound"
+
+	// NATGatewayIDMalformed is the code that is returned by ec2 when the given NATGatewayID is not valid
+	NATGatewayIDMalformed = "NatGatewayMalformed"
+)
+
+// NATGatewayClient is the external client used for NATGateway Custom Resource
+type NATGatewayClient interface {
+	CreateNatGatewayRequest(input *ec2.CreateNatGatewayInput) ec2.CreateNatGatewayRequest
+	DeleteNatGatewayRequest(input *ec2.DeleteNatGatewayInput) ec2.DeleteNatGatewayRequest
+	DescribeNatGatewaysRequest(input *ec2.DescribeNatGatewaysInput) ec2.DescribeNatGatewaysRequest
+	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewNATGatewayClient returns a new client using AWS credentials as JSON encoded data.
+func NewNATGatewayClient(cfg *aws.Config) (NATGatewayClient, error) {
+	return ec2.New(*cfg), nil
+}
+
+// IsNATGatewayNotFoundErr returns true if the error is because the item doesn't exist
+func IsNATGatewayNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == NATGatewayNotFound || awsErr.Code() == NATGatewayIDMalformed {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateNATGatewayObservation is used to produce v1alpha3.NATGatewayObservation
+// from ec2.NatGateway.
+func GenerateNATGatewayObservation(nat ec2.NatGateway) v1alpha3.NATGatewayObservation {
+	o := v1alpha3.NATGatewayObservation{
+		State:          string(nat.State),
+		VPCID:          aws.StringValue(nat.VpcId),
+		FailureCode:    aws.StringValue(nat.FailureCode),
+		FailureMessage: aws.StringValue(nat.FailureMessage),
+	}
+	if len(nat.NatGatewayAddresses) > 0 {
+		o.NATGatewayAddresses = make([]v1alpha3.NATGatewayAddress, len(nat.NatGatewayAddresses))
+		for i, a := range nat.NatGatewayAddresses {
+			o.NATGatewayAddresses[i] = v1alpha3.NATGatewayAddress{
+				AllocationID:       aws.StringValue(a.AllocationId),
+				NetworkInterfaceID: aws.StringValue(a.NetworkInterfaceId),
+				PrivateIP:          aws.StringValue(a.PrivateIp),
+				PublicIP:           aws.StringValue(a.PublicIp),
+			}
+		}
+	}
+	return o
+}
+
+// IsNATGatewayUpToDate returns true if the tags of the observed NAT gateway
+// match the desired ones. The other parameters cannot be changed once the NAT
+// gateway is created.
+// managedTagKeys are the keys of the tags that were previously set from the
+// spec.
+func IsNATGatewayUpToDate(p v1alpha3.NATGatewayParameters, nat ec2.NatGateway, managedTagKeys []string) bool {
+	add, remove := DiffManagedEC2Tags(p.Tags, nat.Tags, managedTagKeys)
+	return len(add) == 0 && len(remove) == 0
+}
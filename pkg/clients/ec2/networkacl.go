@@ -0,0 +1,242 @@
+package ec2
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/provider-aws/apis/network/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// NetworkACLIDNotFound is the code that is returned by ec2 when the given
+	// network ACL ID is not valid
+	NetworkACLIDNotFound = "InvalidNetworkAclID.NotFound"
+
+	// DefaultNetworkACLRuleNumber is the rule number of the catch-all deny
+	// entries AWS adds to every network ACL. They cannot be modified.
+	DefaultNetworkACLRuleNumber = 32767
+)
+
+// protocolNumbers maps the protocol names accepted by AWS to the protocol
+// numbers it returns.
+var protocolNumbers = map[string]string{
+	"all":    "-1",
+	"icmp":   "1",
+	"tcp":    "6",
+	"udp":    "17",
+	"icmpv6": "58",
+}
+
+// NetworkACLClient is the external client used for NetworkACL Custom Resource
+type NetworkACLClient interface {
+	CreateNetworkAclRequest(*ec2.CreateNetworkAclInput) ec2.CreateNetworkAclRequest
+	DeleteNetworkAclRequest(*ec2.DeleteNetworkAclInput) ec2.DeleteNetworkAclRequest
+	DescribeNetworkAclsRequest(*ec2.DescribeNetworkAclsInput) ec2.DescribeNetworkAclsRequest
+	CreateNetworkAclEntryRequest(*ec2.CreateNetworkAclEntryInput) ec2.CreateNetworkAclEntryRequest
+	ReplaceNetworkAclEntryRequest(*ec2.ReplaceNetworkAclEntryInput) ec2.ReplaceNetworkAclEntryRequest
+	DeleteNetworkAclEntryRequest(*ec2.DeleteNetworkAclEntryInput) ec2.DeleteNetworkAclEntryRequest
+	ReplaceNetworkAclAssociationRequest(*ec2.ReplaceNetworkAclAssociationInput) ec2.ReplaceNetworkAclAssociationRequest
+	CreateTagsRequest(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewNetworkACLClient returns a new client using AWS credentials as JSON encoded data.
+func NewNetworkACLClient(cfg *aws.Config) (NetworkACLClient, error) {
+	return ec2.New(*cfg), nil
+}
+
+// IsNetworkACLNotFoundErr returns true if the error is because the item doesn't exist
+func IsNetworkACLNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == NetworkACLIDNotFound {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateNetworkACLObservation is used to produce v1alpha3.NetworkACLObservation
+// from ec2.NetworkAcl.
+func GenerateNetworkACLObservation(acl ec2.NetworkAcl) v1alpha3.NetworkACLObservation {
+	o := v1alpha3.NetworkACLObservation{
+		IsDefault: aws.BoolValue(acl.IsDefault),
+		OwnerID:   aws.StringValue(acl.OwnerId),
+	}
+	if len(acl.Associations) > 0 {
+		o.Associations = make([]v1alpha3.NetworkACLAssociation, len(acl.Associations))
+		for i, a := range acl.Associations {
+			o.Associations[i] = v1alpha3.NetworkACLAssociation{
+				AssociationID: aws.StringValue(a.NetworkAclAssociationId),
+				SubnetID:      aws.StringValue(a.SubnetId),
+			}
+		}
+	}
+	return o
+}
+
+// LateInitializeNetworkACL fills the empty fields in
+// *v1alpha3.NetworkACLParameters with the values seen in ec2.NetworkAcl.
+func LateInitializeNetworkACL(in *v1alpha3.NetworkACLParameters, acl *ec2.NetworkAcl) {
+	if acl == nil {
+		return
+	}
+	in.VPCID = awsclients.LateInitializeStringPtr(in.VPCID, acl.VpcId)
+}
+
+func normalizeProtocol(p string) string {
+	if n, ok := protocolNumbers[strings.ToLower(p)]; ok {
+		return n
+	}
+	return p
+}
+
+// generateNetworkACLEntry converts the given entry into its AWS
+// representation. The port range and the ICMP type and code are only kept for
+// the protocols AWS stores them for.
+func generateNetworkACLEntry(e v1alpha3.NetworkACLEntry) ec2.NetworkAclEntry {
+	out := ec2.NetworkAclEntry{
+		RuleNumber:    aws.Int64(e.RuleNumber),
+		Egress:        aws.Bool(e.Egress),
+		Protocol:      aws.String(normalizeProtocol(e.Protocol)),
+		RuleAction:    ec2.RuleAction(e.RuleAction),
+		CidrBlock:     e.CIDRBlock,
+		Ipv6CidrBlock: e.IPv6CIDRBlock,
+	}
+	switch aws.StringValue(out.Protocol) {
+	case "6", "17":
+		if e.PortRange != nil {
+			out.PortRange = &ec2.PortRange{From: aws.Int64(e.PortRange.From), To: aws.Int64(e.PortRange.To)}
+		}
+	case "1", "58":
+		if e.ICMPTypeCode != nil {
+			out.IcmpTypeCode = &ec2.IcmpTypeCode{Type: aws.Int64(e.ICMPTypeCode.Type), Code: aws.Int64(e.ICMPTypeCode.Code)}
+		}
+	}
+	return out
+}
+
+func isNetworkACLEntryEqual(desired, observed ec2.NetworkAclEntry) bool {
+	return aws.StringValue(desired.Protocol) == aws.StringValue(observed.Protocol) &&
+		desired.RuleAction == observed.RuleAction &&
+		aws.StringValue(desired.CidrBlock) == aws.StringValue(observed.CidrBlock) &&
+		aws.StringValue(desired.Ipv6CidrBlock) == aws.StringValue(observed.Ipv6CidrBlock) &&
+		isPortRangeEqual(desired.PortRange, observed.PortRange) &&
+		isICMPTypeCodeEqual(desired.IcmpTypeCode, observed.IcmpTypeCode)
+}
+
+func isPortRangeEqual(a, b *ec2.PortRange) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return aws.Int64Value(a.From) == aws.Int64Value(b.From) && aws.Int64Value(a.To) == aws.Int64Value(b.To)
+}
+
+func isICMPTypeCodeEqual(a, b *ec2.IcmpTypeCode) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return aws.Int64Value(a.Type) == aws.Int64Value(b.Type) && aws.Int64Value(a.Code) == aws.Int64Value(b.Code)
+}
+
+type networkACLEntryKey struct {
+	egress     bool
+	ruleNumber int64
+}
+
+// GenerateNetworkACLEntryInputs returns the inputs needed to bring the
+// entries of the network ACL with the given ID to the desired state. Entries
+// are identified by their direction and rule number.
+func GenerateNetworkACLEntryInputs(id string, desired []v1alpha3.NetworkACLEntry, observed []ec2.NetworkAclEntry) (create []*ec2.CreateNetworkAclEntryInput, replace []*ec2.ReplaceNetworkAclEntryInput, remove []*ec2.DeleteNetworkAclEntryInput) { // nolint:gocyclo
+	o := make(map[networkACLEntryKey]ec2.NetworkAclEntry, len(observed))
+	for _, e := range observed {
+		if aws.Int64Value(e.RuleNumber) == DefaultNetworkACLRuleNumber {
+			continue
+		}
+		o[networkACLEntryKey{egress: aws.BoolValue(e.Egress), ruleNumber: aws.Int64Value(e.RuleNumber)}] = e
+	}
+	d := make(map[networkACLEntryKey]struct{}, len(desired))
+	for _, de := range desired {
+		e := generateNetworkACLEntry(de)
+		k := networkACLEntryKey{egress: de.Egress, ruleNumber: de.RuleNumber}
+		d[k] = struct{}{}
+		oe, ok := o[k]
+		switch {
+		case !ok:
+			create = append(create, &ec2.CreateNetworkAclEntryInput{
+				NetworkAclId:  aws.String(id),
+				RuleNumber:    e.RuleNumber,
+				Egress:        e.Egress,
+				Protocol:      e.Protocol,
+				RuleAction:    e.RuleAction,
+				CidrBlock:     e.CidrBlock,
+				Ipv6CidrBlock: e.Ipv6CidrBlock,
+				PortRange:     e.PortRange,
+				IcmpTypeCode:  e.IcmpTypeCode,
+			})
+		case !isNetworkACLEntryEqual(e, oe):
+			replace = append(replace, &ec2.ReplaceNetworkAclEntryInput{
+				NetworkAclId:  aws.String(id),
+				RuleNumber:    e.RuleNumber,
+				Egress:        e.Egress,
+				Protocol:      e.Protocol,
+				RuleAction:    e.RuleAction,
+				CidrBlock:     e.CidrBlock,
+				Ipv6CidrBlock: e.Ipv6CidrBlock,
+				PortRange:     e.PortRange,
+				IcmpTypeCode:  e.IcmpTypeCode,
+			})
+		}
+	}
+	for _, e := range observed {
+		k := networkACLEntryKey{egress: aws.BoolValue(e.Egress), ruleNumber: aws.Int64Value(e.RuleNumber)}
+		if _, ok := o[k]; !ok {
+			continue
+		}
+		if _, ok := d[k]; !ok {
+			remove = append(remove, &ec2.DeleteNetworkAclEntryInput{
+				NetworkAclId: aws.String(id),
+				RuleNumber:   e.RuleNumber,
+				Egress:       e.Egress,
+			})
+		}
+	}
+	return create, replace, remove
+}
+
+// DiffNetworkACLSubnets returns the IDs of the subnets that need to be
+// associated with the network ACL and the IDs of the associations that need
+// to be moved back to the default network ACL of the VPC.
+func DiffNetworkACLSubnets(spec v1alpha3.NetworkACLParameters, acl ec2.NetworkAcl) (associate, disassociate []string) {
+	observed := make([]string, len(acl.Associations))
+	associations := make(map[string]string, len(acl.Associations))
+	for i, a := range acl.Associations {
+		observed[i] = aws.StringValue(a.SubnetId)
+		associations[aws.StringValue(a.SubnetId)] = aws.StringValue(a.NetworkAclAssociationId)
+	}
+	associate, remove := diffStrings(spec.SubnetIDs, observed)
+	for _, s := range remove {
+		disassociate = append(disassociate, associations[s])
+	}
+	return associate, disassociate
+}
+
+// IsNetworkACLUpToDate returns true if there is no update-able difference
+// between desired and observed state of the resource.
+// managedTagKeys are the keys of the tags that were previously set from the
+// spec.
+func IsNetworkACLUpToDate(spec v1alpha3.NetworkACLParameters, acl ec2.NetworkAcl, managedTagKeys []string) bool {
+	create, replace, remove := GenerateNetworkACLEntryInputs(aws.StringValue(acl.NetworkAclId), spec.Entries, acl.Entries)
+	if len(create) > 0 || len(replace) > 0 || len(remove) > 0 {
+		return false
+	}
+	associate, disassociate := DiffNetworkACLSubnets(spec, acl)
+	if len(associate) > 0 || len(disassociate) > 0 {
+		return false
+	}
+	add, del := DiffManagedEC2Tags(spec.Tags, acl.Tags, managedTagKeys)
+	return len(add) == 0 && len(del) == 0
+}
@@ -4,6 +4,9 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/awserr"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/provider-aws/apis/network/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
 )
 
 const (
@@ -27,6 +30,7 @@ type RouteTableClient interface {
 	DescribeRouteTablesRequest(*ec2.DescribeRouteTablesInput) ec2.DescribeRouteTablesRequest
 
 	CreateRouteRequest(*ec2.CreateRouteInput) ec2.CreateRouteRequest
+	ReplaceRouteRequest(*ec2.ReplaceRouteInput) ec2.ReplaceRouteRequest
 	DeleteRouteRequest(*ec2.DeleteRouteInput) ec2.DeleteRouteRequest
 
 	AssociateRouteTableRequest(*ec2.AssociateRouteTableInput) ec2.AssociateRouteTableRequest
@@ -67,3 +71,157 @@ func IsAssociationIDNotFoundErr(err error) bool {
 	}
 	return false
 }
+
+// RouteDestination returns the IPv4 or IPv6 CIDR block that the given route
+// matches.
+func RouteDestination(r v1alpha3.Route) string {
+	if r.DestinationCIDRBlock != "" {
+		return r.DestinationCIDRBlock
+	}
+	return r.DestinationIPv6CIDRBlock
+}
+
+// routeTargets returns the IDs of the targets of the given route keyed by the
+// type of the target.
+func routeTargets(r v1alpha3.Route) map[string]string {
+	return map[string]string{
+		"gateway":                   r.GatewayID,
+		"natGateway":                r.NATGatewayID,
+		"vpcPeeringConnection":      r.VPCPeeringConnectionID,
+		"transitGateway":            r.TransitGatewayID,
+		"egressOnlyInternetGateway": r.EgressOnlyInternetGatewayID,
+		"instance":                  r.InstanceID,
+		"networkInterface":          r.NetworkInterfaceID,
+	}
+}
+
+// isSameTarget returns true if the observed route points to the target of the
+// desired route. EC2 reports both the instance and its network interface for
+// a route that targets an instance, so only the target types that are set in
+// the desired route are compared.
+func isSameTarget(desired, observed v1alpha3.Route) bool {
+	ob := routeTargets(observed)
+	for k, id := range routeTargets(desired) {
+		if id != "" && id != ob[k] {
+			return false
+		}
+	}
+	return true
+}
+
+// DiffRoutes compares the desired routes with the observed ones by their
+// destination. It returns the routes that do not exist yet, the routes whose
+// destination exists with a different target and the observed routes that are
+// not desired anymore. The local routes of the VPC and the prefix list routes
+// of gateway VPC endpoints are never returned for removal since they are not
+// managed through the route table.
+func DiffRoutes(desired []v1alpha3.Route, observed []v1alpha3.RouteState) (create, replace []v1alpha3.Route, remove []v1alpha3.RouteState) {
+	observedByDest := make(map[string]v1alpha3.Route, len(observed))
+	for _, ob := range observed {
+		observedByDest[RouteDestination(ob.Route)] = ob.Route
+	}
+	desiredDest := make(map[string]struct{}, len(desired))
+	for _, rt := range desired {
+		desiredDest[RouteDestination(rt)] = struct{}{}
+		ob, ok := observedByDest[RouteDestination(rt)]
+		switch {
+		case !ok:
+			create = append(create, rt)
+		case !isSameTarget(rt, ob):
+			replace = append(replace, rt)
+		}
+	}
+	for _, ob := range observed {
+		if ob.GatewayID == LocalGatewayID || ob.DestinationPrefixListID != "" {
+			continue
+		}
+		if _, ok := desiredDest[RouteDestination(ob.Route)]; !ok {
+			remove = append(remove, ob)
+		}
+	}
+	return create, replace, remove
+}
+
+// GenerateCreateRouteInput returns the input to create the given route in the
+// given route table.
+func GenerateCreateRouteInput(tableID string, r v1alpha3.Route) *ec2.CreateRouteInput {
+	return &ec2.CreateRouteInput{
+		RouteTableId:                aws.String(tableID),
+		DestinationCidrBlock:        awsclients.String(r.DestinationCIDRBlock),
+		DestinationIpv6CidrBlock:    awsclients.String(r.DestinationIPv6CIDRBlock),
+		GatewayId:                   awsclients.String(r.GatewayID),
+		NatGatewayId:                awsclients.String(r.NATGatewayID),
+		VpcPeeringConnectionId:      awsclients.String(r.VPCPeeringConnectionID),
+		TransitGatewayId:            awsclients.String(r.TransitGatewayID),
+		EgressOnlyInternetGatewayId: awsclients.String(r.EgressOnlyInternetGatewayID),
+		InstanceId:                  awsclients.String(r.InstanceID),
+		NetworkInterfaceId:          awsclients.String(r.NetworkInterfaceID),
+	}
+}
+
+// GenerateReplaceRouteInput returns the input to point the route with the
+// destination of the given route to the target of the given route.
+func GenerateReplaceRouteInput(tableID string, r v1alpha3.Route) *ec2.ReplaceRouteInput {
+	return &ec2.ReplaceRouteInput{
+		RouteTableId:                aws.String(tableID),
+		DestinationCidrBlock:        awsclients.String(r.DestinationCIDRBlock),
+		DestinationIpv6CidrBlock:    awsclients.String(r.DestinationIPv6CIDRBlock),
+		GatewayId:                   awsclients.String(r.GatewayID),
+		NatGatewayId:                awsclients.String(r.NATGatewayID),
+		VpcPeeringConnectionId:      awsclients.String(r.VPCPeeringConnectionID),
+		TransitGatewayId:            awsclients.String(r.TransitGatewayID),
+		EgressOnlyInternetGatewayId: awsclients.String(r.EgressOnlyInternetGatewayID),
+		InstanceId:                  awsclients.String(r.InstanceID),
+		NetworkInterfaceId:          awsclients.String(r.NetworkInterfaceID),
+	}
+}
+
+// GenerateDeleteRouteInput returns the input to delete the route with the
+// destination of the given route.
+func GenerateDeleteRouteInput(tableID string, r v1alpha3.Route) *ec2.DeleteRouteInput {
+	return &ec2.DeleteRouteInput{
+		RouteTableId:             aws.String(tableID),
+		DestinationCidrBlock:     awsclients.String(r.DestinationCIDRBlock),
+		DestinationIpv6CidrBlock: awsclients.String(r.DestinationIPv6CIDRBlock),
+	}
+}
+
+// DiffAssociations compares the desired subnet associations with the observed
+// ones and returns the associations that need to be created and the ones that
+// need to be removed. The main route table association is never removed.
+func DiffAssociations(desired []v1alpha3.Association, observed []v1alpha3.AssociationState) (create []v1alpha3.Association, remove []v1alpha3.AssociationState) {
+	observedSubnets := make(map[string]struct{}, len(observed))
+	for _, ob := range observed {
+		observedSubnets[ob.SubnetID] = struct{}{}
+	}
+	desiredSubnets := make(map[string]struct{}, len(desired))
+	for _, asc := range desired {
+		desiredSubnets[asc.SubnetID] = struct{}{}
+		if _, ok := observedSubnets[asc.SubnetID]; !ok {
+			create = append(create, asc)
+		}
+	}
+	for _, ob := range observed {
+		if ob.Main {
+			continue
+		}
+		if _, ok := desiredSubnets[ob.SubnetID]; !ok {
+			remove = append(remove, ob)
+		}
+	}
+	return create, remove
+}
+
+// IsRtUpToDate returns true if the observed routes and subnet associations of
+// the route table match the desired ones. Routes are only compared if the
+// spec declares any.
+func IsRtUpToDate(p v1alpha3.RouteTableParameters, o v1alpha3.RouteTableExternalStatus) bool {
+	if len(p.Routes) != 0 {
+		create, replace, remove := DiffRoutes(p.Routes, o.Routes)
+		if len(create) != 0 || len(replace) != 0 || len(remove) != 0 {
+			return false
+		}
+	}
+	associate, disassociate := DiffAssociations(p.Associations, o.Associations)
+	return len(associate) == 0 && len(disassociate) == 0
+}
@@ -1,6 +1,9 @@
 package ec2
 
 import (
+	"strconv"
+	"strings"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/awserr"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
@@ -14,6 +17,8 @@ const (
 
 	// InvalidPermissionDuplicate is returned when you try to Authorize for a rule that already exists.
 	InvalidPermissionDuplicate = "InvalidPermission.Duplicate"
+	// InvalidPermissionNotFound is returned when you try to Revoke a rule that doesn't exist.
+	InvalidPermissionNotFound = "InvalidPermission.NotFound"
 )
 
 // SecurityGroupClient is the external client used for SecurityGroup Custom Resource
@@ -23,6 +28,8 @@ type SecurityGroupClient interface {
 	DescribeSecurityGroupsRequest(input *ec2.DescribeSecurityGroupsInput) ec2.DescribeSecurityGroupsRequest
 	AuthorizeSecurityGroupIngressRequest(input *ec2.AuthorizeSecurityGroupIngressInput) ec2.AuthorizeSecurityGroupIngressRequest
 	AuthorizeSecurityGroupEgressRequest(input *ec2.AuthorizeSecurityGroupEgressInput) ec2.AuthorizeSecurityGroupEgressRequest
+	RevokeSecurityGroupIngressRequest(input *ec2.RevokeSecurityGroupIngressInput) ec2.RevokeSecurityGroupIngressRequest
+	RevokeSecurityGroupEgressRequest(input *ec2.RevokeSecurityGroupEgressInput) ec2.RevokeSecurityGroupEgressRequest
 }
 
 // NewSecurityGroupClient returns a new client using AWS credentials as JSON encoded data.
@@ -50,6 +57,16 @@ func IsRuleAlreadyExistsErr(err error) bool {
 	return false
 }
 
+// IsRuleNotFoundErr returns true if the error is because the rule doesn't exist.
+func IsRuleNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == InvalidPermissionNotFound {
+			return true
+		}
+	}
+	return false
+}
+
 // GenerateEC2Permissions converts object Permissions to ec2 format
 func GenerateEC2Permissions(objectPerms []v1alpha3.IPPermission) []ec2.IpPermission {
 	if len(objectPerms) == 0 {
@@ -94,3 +111,152 @@ func GenerateEC2Permissions(objectPerms []v1alpha3.IPPermission) []ec2.IpPermiss
 	}
 	return permissions
 }
+
+// protocolNames maps the protocol numbers that EC2 reports by name to those
+// names so that both notations are considered equal.
+var protocolNames = map[string]string{
+	"1":   "icmp",
+	"6":   "tcp",
+	"17":  "udp",
+	"58":  "icmpv6",
+	"all": "-1",
+}
+
+// SplitEC2Permissions splits the given permissions into rules that have
+// exactly one IPv4 range, IPv6 range, prefix list or security group pair each,
+// which is the granularity EC2 uses to identify a security group rule.
+func SplitEC2Permissions(perms []ec2.IpPermission) []ec2.IpPermission {
+	var rules []ec2.IpPermission
+	for _, p := range perms {
+		base := ec2.IpPermission{
+			FromPort:   p.FromPort,
+			IpProtocol: p.IpProtocol,
+			ToPort:     p.ToPort,
+		}
+		for _, r := range p.IpRanges {
+			rule := base
+			rule.IpRanges = []ec2.IpRange{r}
+			rules = append(rules, rule)
+		}
+		for _, r := range p.Ipv6Ranges {
+			rule := base
+			rule.Ipv6Ranges = []ec2.Ipv6Range{r}
+			rules = append(rules, rule)
+		}
+		for _, r := range p.PrefixListIds {
+			rule := base
+			rule.PrefixListIds = []ec2.PrefixListId{r}
+			rules = append(rules, rule)
+		}
+		for _, r := range p.UserIdGroupPairs {
+			rule := base
+			rule.UserIdGroupPairs = []ec2.UserIdGroupPair{r}
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+// ruleKey returns a string that uniquely identifies a rule produced by
+// SplitEC2Permissions along with its description.
+func ruleKey(r ec2.IpPermission) string {
+	_, description := ruleSource(r)
+	return ruleIdentity(r) + "|" + description
+}
+
+// ruleIdentity returns a string that identifies a rule produced by
+// SplitEC2Permissions the way EC2 does, i.e. regardless of its description.
+// Fields that EC2 fills in on its own, like the owner of a referenced
+// security group, are not part of the identity.
+func ruleIdentity(r ec2.IpPermission) string {
+	protocol, from, to := rulePorts(r)
+	source, _ := ruleSource(r)
+	return strings.Join([]string{protocol, from, to, source}, "|")
+}
+
+// rulePorts returns the normalized protocol and port range of a rule.
+func rulePorts(r ec2.IpPermission) (string, string, string) {
+	protocol := strings.ToLower(aws.StringValue(r.IpProtocol))
+	if name, ok := protocolNames[protocol]; ok {
+		protocol = name
+	}
+	// EC2 ignores the port range when all protocols are allowed.
+	var from, to string
+	if protocol != "-1" && r.FromPort != nil {
+		from = strconv.FormatInt(*r.FromPort, 10)
+	}
+	if protocol != "-1" && r.ToPort != nil {
+		to = strconv.FormatInt(*r.ToPort, 10)
+	}
+	return protocol, from, to
+}
+
+// ruleSource returns the source or destination of a rule produced by
+// SplitEC2Permissions along with its description.
+func ruleSource(r ec2.IpPermission) (string, string) {
+	switch {
+	case len(r.IpRanges) == 1:
+		return "cidr/" + aws.StringValue(r.IpRanges[0].CidrIp), aws.StringValue(r.IpRanges[0].Description)
+	case len(r.Ipv6Ranges) == 1:
+		return "cidr6/" + strings.ToLower(aws.StringValue(r.Ipv6Ranges[0].CidrIpv6)), aws.StringValue(r.Ipv6Ranges[0].Description)
+	case len(r.PrefixListIds) == 1:
+		return "pl/" + aws.StringValue(r.PrefixListIds[0].PrefixListId), aws.StringValue(r.PrefixListIds[0].Description)
+	case len(r.UserIdGroupPairs) == 1:
+		pair := r.UserIdGroupPairs[0]
+		group := aws.StringValue(pair.GroupId)
+		if group == "" {
+			group = aws.StringValue(pair.GroupName)
+		}
+		return "sg/" + group + "/" + aws.StringValue(pair.VpcPeeringConnectionId), aws.StringValue(pair.Description)
+	}
+	return "", ""
+}
+
+// DiffEC2Permissions compares the desired permissions with the observed ones
+// rule by rule and returns the rules that need to be authorized and the rules
+// that need to be revoked for the security group to match the desired state.
+func DiffEC2Permissions(desired, observed []ec2.IpPermission) (add, remove []ec2.IpPermission) {
+	desiredRules := SplitEC2Permissions(desired)
+	observedRules := SplitEC2Permissions(observed)
+
+	observedKeys := make(map[string]struct{}, len(observedRules))
+	for _, r := range observedRules {
+		observedKeys[ruleKey(r)] = struct{}{}
+	}
+	desiredKeys := make(map[string]struct{}, len(desiredRules))
+	for _, r := range desiredRules {
+		k := ruleKey(r)
+		if _, ok := desiredKeys[k]; ok {
+			continue
+		}
+		desiredKeys[k] = struct{}{}
+		if _, ok := observedKeys[k]; !ok {
+			add = append(add, r)
+		}
+	}
+	for _, r := range observedRules {
+		if _, ok := desiredKeys[ruleKey(r)]; !ok {
+			remove = append(remove, r)
+		}
+	}
+	return add, remove
+}
+
+// IsSGUpToDate returns true if the ingress and egress rules of the observed
+// security group match the desired ones. Ingress and egress rules are each
+// only compared if the spec declares any, so that the default allow-all egress
+// rule EC2 adds to every new security group, and the rules of an adopted
+// security group, are left alone otherwise.
+func IsSGUpToDate(p v1alpha3.SecurityGroupParameters, sg ec2.SecurityGroup) bool {
+	if len(p.Ingress) != 0 {
+		add, remove := DiffEC2Permissions(GenerateEC2Permissions(p.Ingress), sg.IpPermissions)
+		if len(add) != 0 || len(remove) != 0 {
+			return false
+		}
+	}
+	if len(p.Egress) == 0 {
+		return true
+	}
+	add, remove := DiffEC2Permissions(GenerateEC2Permissions(p.Egress), sg.IpPermissionsEgress)
+	return len(add) == 0 && len(remove) == 0
+}
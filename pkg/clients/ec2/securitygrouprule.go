@@ -0,0 +1,168 @@
+package ec2
+
+import (
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-aws/apis/network/v1alpha3"
+)
+
+const (
+	errRuleSource = "exactly one of cidrIp, cidrIPv6, prefixListId and userIdGroupPair must be set"
+	errRuleID     = "invalid security group rule ID"
+)
+
+// SecurityGroupRuleClient is the external client used for SecurityGroupRule
+// Custom Resource
+type SecurityGroupRuleClient interface {
+	DescribeSecurityGroupsRequest(input *ec2.DescribeSecurityGroupsInput) ec2.DescribeSecurityGroupsRequest
+	AuthorizeSecurityGroupIngressRequest(input *ec2.AuthorizeSecurityGroupIngressInput) ec2.AuthorizeSecurityGroupIngressRequest
+	AuthorizeSecurityGroupEgressRequest(input *ec2.AuthorizeSecurityGroupEgressInput) ec2.AuthorizeSecurityGroupEgressRequest
+	RevokeSecurityGroupIngressRequest(input *ec2.RevokeSecurityGroupIngressInput) ec2.RevokeSecurityGroupIngressRequest
+	RevokeSecurityGroupEgressRequest(input *ec2.RevokeSecurityGroupEgressInput) ec2.RevokeSecurityGroupEgressRequest
+	UpdateSecurityGroupRuleDescriptionsIngressRequest(input *ec2.UpdateSecurityGroupRuleDescriptionsIngressInput) ec2.UpdateSecurityGroupRuleDescriptionsIngressRequest
+	UpdateSecurityGroupRuleDescriptionsEgressRequest(input *ec2.UpdateSecurityGroupRuleDescriptionsEgressInput) ec2.UpdateSecurityGroupRuleDescriptionsEgressRequest
+}
+
+// NewSecurityGroupRuleClient returns a new client using AWS credentials as JSON encoded data.
+func NewSecurityGroupRuleClient(cfg *aws.Config) (SecurityGroupRuleClient, error) {
+	return ec2.New(*cfg), nil
+}
+
+// GenerateIPPermission returns the permission that consists of the single
+// rule described by the given parameters.
+func GenerateIPPermission(p v1alpha3.SecurityGroupRuleParameters) v1alpha3.IPPermission {
+	perm := v1alpha3.IPPermission{
+		FromPort:   p.FromPort,
+		IPProtocol: p.IPProtocol,
+		ToPort:     p.ToPort,
+	}
+	if p.CIDRIP != nil {
+		perm.IPRanges = []v1alpha3.IPRange{{CIDRIP: *p.CIDRIP, Description: p.Description}}
+	}
+	if p.CIDRIPv6 != nil {
+		perm.IPv6Ranges = []v1alpha3.IPv6Range{{CIDRIPv6: *p.CIDRIPv6, Description: p.Description}}
+	}
+	if p.PrefixListID != nil {
+		perm.PrefixListIDs = []v1alpha3.PrefixListID{{PrefixListID: *p.PrefixListID, Description: p.Description}}
+	}
+	if p.UserIDGroupPair != nil {
+		pair := *p.UserIDGroupPair
+		pair.Description = p.Description
+		perm.UserIDGroupPairs = []v1alpha3.UserIDGroupPair{pair}
+	}
+	return perm
+}
+
+// GenerateSecurityGroupRuleID returns the ID of the rule described by the
+// given parameters. It consists of the security group ID, the type, the
+// protocol, the port range and the source or destination of the rule, e.g.
+// sg-0123_ingress_tcp_80_80_10.0.0.0/16. The description is not part of the
+// ID since it can be changed in place.
+func GenerateSecurityGroupRuleID(p v1alpha3.SecurityGroupRuleParameters) (string, error) {
+	rules := SplitEC2Permissions(GenerateEC2Permissions([]v1alpha3.IPPermission{GenerateIPPermission(p)}))
+	if len(rules) != 1 {
+		return "", errors.New(errRuleSource)
+	}
+	r := rules[0]
+	protocol, from, to := rulePorts(r)
+
+	var source string
+	switch {
+	case len(r.IpRanges) == 1:
+		source = aws.StringValue(r.IpRanges[0].CidrIp)
+	case len(r.Ipv6Ranges) == 1:
+		source = strings.ToLower(aws.StringValue(r.Ipv6Ranges[0].CidrIpv6))
+	case len(r.PrefixListIds) == 1:
+		source = aws.StringValue(r.PrefixListIds[0].PrefixListId)
+	default:
+		pair := r.UserIdGroupPairs[0]
+		source = aws.StringValue(pair.GroupId)
+		if source == "" {
+			source = aws.StringValue(pair.GroupName)
+		}
+		if pair.UserId != nil {
+			source = aws.StringValue(pair.UserId) + "/" + source
+		}
+	}
+	return strings.Join([]string{aws.StringValue(p.SecurityGroupID), p.Type, protocol, from, to, source}, "_"), nil
+}
+
+// ParseSecurityGroupRuleID returns the security group ID, the type and the
+// rule that the given ID generated by GenerateSecurityGroupRuleID refers to.
+func ParseSecurityGroupRuleID(id string) (string, string, ec2.IpPermission, error) {
+	parts := strings.SplitN(id, "_", 6)
+	if len(parts) != 6 {
+		return "", "", ec2.IpPermission{}, errors.New(errRuleID)
+	}
+	groupID, ruleType, source := parts[0], parts[1], parts[5]
+	if ruleType != v1alpha3.SecurityGroupRuleTypeIngress && ruleType != v1alpha3.SecurityGroupRuleTypeEgress {
+		return "", "", ec2.IpPermission{}, errors.New(errRuleID)
+	}
+
+	rule := ec2.IpPermission{IpProtocol: aws.String(parts[2])}
+	for i, port := range []**int64{&rule.FromPort, &rule.ToPort} {
+		if parts[3+i] == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(parts[3+i], 10, 64)
+		if err != nil {
+			return "", "", ec2.IpPermission{}, errors.Wrap(err, errRuleID)
+		}
+		*port = aws.Int64(n)
+	}
+
+	if ip, _, err := net.ParseCIDR(source); err == nil {
+		if ip.To4() != nil {
+			rule.IpRanges = []ec2.IpRange{{CidrIp: aws.String(source)}}
+		} else {
+			rule.Ipv6Ranges = []ec2.Ipv6Range{{CidrIpv6: aws.String(source)}}
+		}
+		return groupID, ruleType, rule, nil
+	}
+	if strings.HasPrefix(source, "pl-") {
+		rule.PrefixListIds = []ec2.PrefixListId{{PrefixListId: aws.String(source)}}
+		return groupID, ruleType, rule, nil
+	}
+	pair := ec2.UserIdGroupPair{}
+	if i := strings.LastIndex(source, "/"); i >= 0 {
+		pair.UserId = aws.String(source[:i])
+		source = source[i+1:]
+	}
+	if strings.HasPrefix(source, "sg-") {
+		pair.GroupId = aws.String(source)
+	} else {
+		pair.GroupName = aws.String(source)
+	}
+	rule.UserIdGroupPairs = []ec2.UserIdGroupPair{pair}
+	return groupID, ruleType, rule, nil
+}
+
+// FindSecurityGroupRule returns the rule among the observed permissions that
+// matches the given one regardless of its description, or nil if there is no
+// such rule.
+func FindSecurityGroupRule(rule ec2.IpPermission, observed []ec2.IpPermission) *ec2.IpPermission {
+	id := ruleIdentity(rule)
+	for _, r := range SplitEC2Permissions(observed) {
+		if ruleIdentity(r) == id {
+			return &r
+		}
+	}
+	return nil
+}
+
+// IsSecurityGroupRuleUpToDate returns true if the observed rule, which is
+// identified by the given ID, matches the desired one.
+func IsSecurityGroupRuleUpToDate(p v1alpha3.SecurityGroupRuleParameters, id string, observed ec2.IpPermission) bool {
+	desired, err := GenerateSecurityGroupRuleID(p)
+	if err != nil || desired != id {
+		return false
+	}
+	_, description := ruleSource(observed)
+	return description == aws.StringValue(p.Description)
+}
@@ -1,9 +1,13 @@
 package ec2
 
 import (
+	"net"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/awserr"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/provider-aws/apis/network/v1alpha3"
 )
 
 const (
@@ -16,6 +20,11 @@ type SubnetClient interface {
 	CreateSubnetRequest(input *ec2.CreateSubnetInput) ec2.CreateSubnetRequest
 	DescribeSubnetsRequest(input *ec2.DescribeSubnetsInput) ec2.DescribeSubnetsRequest
 	DeleteSubnetRequest(input *ec2.DeleteSubnetInput) ec2.DeleteSubnetRequest
+	ModifySubnetAttributeRequest(input *ec2.ModifySubnetAttributeInput) ec2.ModifySubnetAttributeRequest
+	AssociateSubnetCidrBlockRequest(input *ec2.AssociateSubnetCidrBlockInput) ec2.AssociateSubnetCidrBlockRequest
+	DisassociateSubnetCidrBlockRequest(input *ec2.DisassociateSubnetCidrBlockInput) ec2.DisassociateSubnetCidrBlockRequest
+	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest
 }
 
 // NewSubnetClient returns a new client using AWS credentials as JSON encoded data.
@@ -33,3 +42,116 @@ func IsSubnetNotFoundErr(err error) bool {
 
 	return false
 }
+
+// GenerateCreateSubnetInput returns the input to create a Subnet with the
+// given parameters.
+func GenerateCreateSubnetInput(spec v1alpha3.SubnetParameters) *ec2.CreateSubnetInput {
+	return &ec2.CreateSubnetInput{
+		VpcId:            aws.String(spec.VPCID),
+		AvailabilityZone: aws.String(spec.AvailabilityZone),
+		CidrBlock:        aws.String(spec.CIDRBlock),
+		Ipv6CidrBlock:    spec.IPv6CIDRBlock,
+	}
+}
+
+// LateInitializeSubnet fills the empty fields in *v1alpha3.SubnetParameters
+// with the values seen in ec2.Subnet.
+func LateInitializeSubnet(in *v1alpha3.SubnetParameters, s *ec2.Subnet) {
+	if s == nil {
+		return
+	}
+	if in.AvailabilityZone == "" {
+		in.AvailabilityZone = aws.StringValue(s.AvailabilityZone)
+	}
+	if in.MapPublicIPOnLaunch == nil {
+		in.MapPublicIPOnLaunch = s.MapPublicIpOnLaunch
+	}
+	if in.AssignIPv6AddressOnCreation == nil {
+		in.AssignIPv6AddressOnCreation = s.AssignIpv6AddressOnCreation
+	}
+}
+
+// activeSubnetIPv6CIDRBlock returns the IPv6 CIDR block association that is
+// associated or being associated with the subnet, if any. A subnet can have
+// at most one IPv6 CIDR block.
+func activeSubnetIPv6CIDRBlock(s ec2.Subnet) *ec2.SubnetIpv6CidrBlockAssociation {
+	for i, a := range s.Ipv6CidrBlockAssociationSet {
+		if a.Ipv6CidrBlockState == nil {
+			continue
+		}
+		if a.Ipv6CidrBlockState.State == ec2.SubnetCidrBlockStateCodeAssociating || a.Ipv6CidrBlockState.State == ec2.SubnetCidrBlockStateCodeAssociated {
+			return &s.Ipv6CidrBlockAssociationSet[i]
+		}
+	}
+	return nil
+}
+
+// GenerateModifySubnetAttributeInputs returns the inputs needed to bring the
+// attributes of the subnet to the desired state. AWS allows only one
+// attribute to be modified per call.
+func GenerateModifySubnetAttributeInputs(spec v1alpha3.SubnetParameters, s ec2.Subnet) []*ec2.ModifySubnetAttributeInput {
+	var inputs []*ec2.ModifySubnetAttributeInput
+	if spec.MapPublicIPOnLaunch != nil && aws.BoolValue(spec.MapPublicIPOnLaunch) != aws.BoolValue(s.MapPublicIpOnLaunch) {
+		inputs = append(inputs, &ec2.ModifySubnetAttributeInput{
+			SubnetId:            s.SubnetId,
+			MapPublicIpOnLaunch: &ec2.AttributeBooleanValue{Value: spec.MapPublicIPOnLaunch},
+		})
+	}
+	if spec.AssignIPv6AddressOnCreation != nil && aws.BoolValue(spec.AssignIPv6AddressOnCreation) != aws.BoolValue(s.AssignIpv6AddressOnCreation) {
+		inputs = append(inputs, &ec2.ModifySubnetAttributeInput{
+			SubnetId:                    s.SubnetId,
+			AssignIpv6AddressOnCreation: &ec2.AttributeBooleanValue{Value: spec.AssignIPv6AddressOnCreation},
+		})
+	}
+	return inputs
+}
+
+// DiffSubnetIPv6CIDRBlock returns the IPv6 CIDR block that needs to be
+// associated with the subnet and the association ID of the IPv6 CIDR block
+// that needs to be disassociated from it. Empty strings mean no action.
+// managed is the IPv6 CIDR block that was last associated from the spec; the
+// observed block is only disassociated without a replacement if it is the
+// managed one.
+func DiffSubnetIPv6CIDRBlock(spec v1alpha3.SubnetParameters, s ec2.Subnet, managed *string) (associate, disassociate string) {
+	observed := activeSubnetIPv6CIDRBlock(s)
+	switch {
+	case spec.IPv6CIDRBlock == nil:
+		if observed == nil || managed == nil || !isSameCIDR(aws.StringValue(observed.Ipv6CidrBlock), *managed) {
+			return "", ""
+		}
+		return "", aws.StringValue(observed.AssociationId)
+	case observed == nil:
+		return *spec.IPv6CIDRBlock, ""
+	case isSameCIDR(aws.StringValue(observed.Ipv6CidrBlock), *spec.IPv6CIDRBlock):
+		return "", ""
+	default:
+		return *spec.IPv6CIDRBlock, aws.StringValue(observed.AssociationId)
+	}
+}
+
+// isSameCIDR returns true if both CIDR blocks denote the same network, e.g.
+// 2600:1f14::/64 and 2600:1F14:0::/64. Blocks that can't be parsed are
+// compared as they are.
+func isSameCIDR(a, b string) bool {
+	_, an, aerr := net.ParseCIDR(a)
+	_, bn, berr := net.ParseCIDR(b)
+	if aerr != nil || berr != nil {
+		return a == b
+	}
+	return an.String() == bn.String()
+}
+
+// IsSubnetUpToDate returns true if there is no update-able difference between
+// desired and observed state of the resource. managedIPv6CIDRBlock and
+// managedTagKeys are the IPv6 CIDR block and the keys of the tags that were
+// previously set from the spec.
+func IsSubnetUpToDate(spec v1alpha3.SubnetParameters, s ec2.Subnet, managedIPv6CIDRBlock *string, managedTagKeys []string) bool {
+	if len(GenerateModifySubnetAttributeInputs(spec, s)) > 0 {
+		return false
+	}
+	if associate, disassociate := DiffSubnetIPv6CIDRBlock(spec, s, managedIPv6CIDRBlock); associate != "" || disassociate != "" {
+		return false
+	}
+	add, remove := DiffManagedEC2Tags(spec.Tags, s.Tags, managedTagKeys)
+	return len(add) == 0 && len(remove) == 0
+}
@@ -0,0 +1,66 @@
+package ec2
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/provider-aws/apis/network/v1alpha3"
+)
+
+// awsTagPrefix is the prefix of the tags that are reserved for AWS use.
+const awsTagPrefix = "aws:"
+
+// DiffEC2Tags returns the tags that need to be created or overwritten and the
+// tags that need to be deleted so that the observed tags of an EC2 resource
+// match the desired ones. Tags reserved for AWS use are never deleted.
+func DiffEC2Tags(desired []v1alpha3.Tag, observed []ec2.Tag) (add, remove []ec2.Tag) {
+	observedTags := make(map[string]string, len(observed))
+	for _, t := range observed {
+		observedTags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	desiredTags := make(map[string]struct{}, len(desired))
+	for _, t := range desired {
+		desiredTags[t.Key] = struct{}{}
+		if v, ok := observedTags[t.Key]; !ok || v != t.Value {
+			add = append(add, ec2.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)})
+		}
+	}
+	for _, t := range observed {
+		if _, ok := desiredTags[aws.StringValue(t.Key)]; ok || strings.HasPrefix(aws.StringValue(t.Key), awsTagPrefix) {
+			continue
+		}
+		remove = append(remove, ec2.Tag{Key: t.Key})
+	}
+	return add, remove
+}
+
+// DiffManagedEC2Tags is like DiffEC2Tags but only deletes the observed tags
+// whose keys are in managed, i.e. the tags that were previously set from the
+// spec. Tags that were added to the resource by other systems are kept.
+func DiffManagedEC2Tags(desired []v1alpha3.Tag, observed []ec2.Tag, managed []string) (add, remove []ec2.Tag) {
+	add, all := DiffEC2Tags(desired, observed)
+	keys := make(map[string]struct{}, len(managed))
+	for _, k := range managed {
+		keys[k] = struct{}{}
+	}
+	for _, t := range all {
+		if _, ok := keys[aws.StringValue(t.Key)]; ok {
+			remove = append(remove, t)
+		}
+	}
+	return add, remove
+}
+
+// TagKeys returns the keys of the given tags.
+func TagKeys(tags []v1alpha3.Tag) []string {
+	if len(tags) == 0 {
+		return nil
+	}
+	keys := make([]string, len(tags))
+	for i, t := range tags {
+		keys[i] = t.Key
+	}
+	return keys
+}
@@ -0,0 +1,116 @@
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/provider-aws/apis/network/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// TransitGatewayIDNotFound is the code that is returned by ec2 when the
+	// given TransitGatewayID is not found
+	TransitGatewayIDNotFound = "InvalidTransitGatewayID.NotFound"
+)
+
+// TransitGatewayClient is the external client used for TransitGateway Custom Resource
+type TransitGatewayClient interface {
+	CreateTransitGatewayRequest(*ec2.CreateTransitGatewayInput) ec2.CreateTransitGatewayRequest
+	DeleteTransitGatewayRequest(*ec2.DeleteTransitGatewayInput) ec2.DeleteTransitGatewayRequest
+	DescribeTransitGatewaysRequest(*ec2.DescribeTransitGatewaysInput) ec2.DescribeTransitGatewaysRequest
+	CreateTagsRequest(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewTransitGatewayClient returns a new client using AWS credentials as JSON encoded data.
+func NewTransitGatewayClient(cfg *aws.Config) (TransitGatewayClient, error) {
+	return ec2.New(*cfg), nil
+}
+
+// IsTransitGatewayNotFoundErr returns true if the error is because the item doesn't exist
+func IsTransitGatewayNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == TransitGatewayIDNotFound {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateCreateTransitGatewayInput returns the input to create a transit
+// gateway with the given parameters.
+func GenerateCreateTransitGatewayInput(p v1alpha3.TransitGatewayParameters) *ec2.CreateTransitGatewayInput {
+	in := &ec2.CreateTransitGatewayInput{
+		Description: p.Description,
+		Options: &ec2.TransitGatewayRequestOptions{
+			AmazonSideAsn:                p.AmazonSideASN,
+			AutoAcceptSharedAttachments:  ec2.AutoAcceptSharedAttachmentsValue(aws.StringValue(p.AutoAcceptSharedAttachments)),
+			DefaultRouteTableAssociation: ec2.DefaultRouteTableAssociationValue(aws.StringValue(p.DefaultRouteTableAssociation)),
+			DefaultRouteTablePropagation: ec2.DefaultRouteTablePropagationValue(aws.StringValue(p.DefaultRouteTablePropagation)),
+			DnsSupport:                   ec2.DnsSupportValue(aws.StringValue(p.DNSSupport)),
+			VpnEcmpSupport:               ec2.VpnEcmpSupportValue(aws.StringValue(p.VPNECMPSupport)),
+		},
+	}
+	if len(p.Tags) > 0 {
+		in.TagSpecifications = []ec2.TagSpecification{{
+			ResourceType: ec2.ResourceTypeTransitGateway,
+			Tags:         v1alpha3.GenerateEC2Tags(p.Tags),
+		}}
+	}
+	return in
+}
+
+// GenerateTransitGatewayObservation is used to produce
+// v1alpha3.TransitGatewayObservation from ec2.TransitGateway.
+func GenerateTransitGatewayObservation(tgw ec2.TransitGateway) v1alpha3.TransitGatewayObservation {
+	o := v1alpha3.TransitGatewayObservation{
+		State:             string(tgw.State),
+		OwnerID:           aws.StringValue(tgw.OwnerId),
+		TransitGatewayARN: aws.StringValue(tgw.TransitGatewayArn),
+	}
+	if tgw.Options != nil {
+		o.AssociationDefaultRouteTableID = aws.StringValue(tgw.Options.AssociationDefaultRouteTableId)
+		o.PropagationDefaultRouteTableID = aws.StringValue(tgw.Options.PropagationDefaultRouteTableId)
+	}
+	return o
+}
+
+func lateInitializeEnum(in *string, from string) *string {
+	if in != nil || from == "" {
+		return in
+	}
+	return aws.String(from)
+}
+
+// LateInitializeTransitGateway fills the empty fields in
+// *v1alpha3.TransitGatewayParameters with the values seen in
+// ec2.TransitGateway.
+func LateInitializeTransitGateway(in *v1alpha3.TransitGatewayParameters, tgw *ec2.TransitGateway) {
+	if tgw == nil {
+		return
+	}
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, tgw.Description)
+	if tgw.Options == nil {
+		return
+	}
+	if in.AmazonSideASN == nil {
+		in.AmazonSideASN = tgw.Options.AmazonSideAsn
+	}
+	in.AutoAcceptSharedAttachments = lateInitializeEnum(in.AutoAcceptSharedAttachments, string(tgw.Options.AutoAcceptSharedAttachments))
+	in.DefaultRouteTableAssociation = lateInitializeEnum(in.DefaultRouteTableAssociation, string(tgw.Options.DefaultRouteTableAssociation))
+	in.DefaultRouteTablePropagation = lateInitializeEnum(in.DefaultRouteTablePropagation, string(tgw.Options.DefaultRouteTablePropagation))
+	in.DNSSupport = lateInitializeEnum(in.DNSSupport, string(tgw.Options.DnsSupport))
+	in.VPNECMPSupport = lateInitializeEnum(in.VPNECMPSupport, string(tgw.Options.VpnEcmpSupport))
+}
+
+// IsTransitGatewayUpToDate returns true if the tags of the observed transit
+// gateway match the desired ones. The other parameters cannot be changed once
+// the transit gateway is created.
+// managedTagKeys are the keys of the tags that were previously set from the
+// spec.
+func IsTransitGatewayUpToDate(p v1alpha3.TransitGatewayParameters, tgw ec2.TransitGateway, managedTagKeys []string) bool {
+	add, remove := DiffManagedEC2Tags(p.Tags, tgw.Tags, managedTagKeys)
+	return len(add) == 0 && len(remove) == 0
+}
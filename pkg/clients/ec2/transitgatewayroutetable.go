@@ -0,0 +1,128 @@
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/provider-aws/apis/network/v1alpha3"
+)
+
+const (
+	// TransitGatewayRouteTableIDNotFound is the code that is returned by ec2
+	// when the given transit gateway route table ID is not found
+	TransitGatewayRouteTableIDNotFound = "InvalidRouteTableID.NotFound"
+)
+
+// TransitGatewayRouteTableClient is the external client used for
+// TransitGatewayRouteTable Custom Resource
+type TransitGatewayRouteTableClient interface {
+	CreateTransitGatewayRouteTableRequest(*ec2.CreateTransitGatewayRouteTableInput) ec2.CreateTransitGatewayRouteTableRequest
+	DeleteTransitGatewayRouteTableRequest(*ec2.DeleteTransitGatewayRouteTableInput) ec2.DeleteTransitGatewayRouteTableRequest
+	DescribeTransitGatewayRouteTablesRequest(*ec2.DescribeTransitGatewayRouteTablesInput) ec2.DescribeTransitGatewayRouteTablesRequest
+	GetTransitGatewayRouteTableAssociationsRequest(*ec2.GetTransitGatewayRouteTableAssociationsInput) ec2.GetTransitGatewayRouteTableAssociationsRequest
+	GetTransitGatewayRouteTablePropagationsRequest(*ec2.GetTransitGatewayRouteTablePropagationsInput) ec2.GetTransitGatewayRouteTablePropagationsRequest
+	AssociateTransitGatewayRouteTableRequest(*ec2.AssociateTransitGatewayRouteTableInput) ec2.AssociateTransitGatewayRouteTableRequest
+	DisassociateTransitGatewayRouteTableRequest(*ec2.DisassociateTransitGatewayRouteTableInput) ec2.DisassociateTransitGatewayRouteTableRequest
+	EnableTransitGatewayRouteTablePropagationRequest(*ec2.EnableTransitGatewayRouteTablePropagationInput) ec2.EnableTransitGatewayRouteTablePropagationRequest
+	DisableTransitGatewayRouteTablePropagationRequest(*ec2.DisableTransitGatewayRouteTablePropagationInput) ec2.DisableTransitGatewayRouteTablePropagationRequest
+	CreateTagsRequest(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewTransitGatewayRouteTableClient returns a new client using AWS credentials as JSON encoded data.
+func NewTransitGatewayRouteTableClient(cfg *aws.Config) (TransitGatewayRouteTableClient, error) {
+	return ec2.New(*cfg), nil
+}
+
+// IsTransitGatewayRouteTableNotFoundErr returns true if the error is because
+// the item doesn't exist
+func IsTransitGatewayRouteTableNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == TransitGatewayRouteTableIDNotFound {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateCreateTransitGatewayRouteTableInput returns the input to create a
+// transit gateway route table with the given parameters.
+func GenerateCreateTransitGatewayRouteTableInput(p v1alpha3.TransitGatewayRouteTableParameters) *ec2.CreateTransitGatewayRouteTableInput {
+	in := &ec2.CreateTransitGatewayRouteTableInput{
+		TransitGatewayId: p.TransitGatewayID,
+	}
+	if len(p.Tags) > 0 {
+		in.TagSpecifications = []ec2.TagSpecification{{
+			ResourceType: ec2.ResourceTypeTransitGatewayRouteTable,
+			Tags:         v1alpha3.GenerateEC2Tags(p.Tags),
+		}}
+	}
+	return in
+}
+
+// GenerateTransitGatewayRouteTableObservation is used to produce
+// v1alpha3.TransitGatewayRouteTableObservation from the route table and its
+// associations and propagations.
+func GenerateTransitGatewayRouteTableObservation(rt ec2.TransitGatewayRouteTable, associations []ec2.TransitGatewayRouteTableAssociation, propagations []ec2.TransitGatewayRouteTablePropagation) v1alpha3.TransitGatewayRouteTableObservation {
+	o := v1alpha3.TransitGatewayRouteTableObservation{
+		State:                        string(rt.State),
+		DefaultAssociationRouteTable: aws.BoolValue(rt.DefaultAssociationRouteTable),
+		DefaultPropagationRouteTable: aws.BoolValue(rt.DefaultPropagationRouteTable),
+	}
+	if len(associations) > 0 {
+		o.Associations = make([]v1alpha3.TransitGatewayRouteTableAttachment, len(associations))
+		for i, a := range associations {
+			o.Associations[i] = v1alpha3.TransitGatewayRouteTableAttachment{
+				AttachmentID: aws.StringValue(a.TransitGatewayAttachmentId),
+				ResourceID:   aws.StringValue(a.ResourceId),
+				State:        string(a.State),
+			}
+		}
+	}
+	if len(propagations) > 0 {
+		o.Propagations = make([]v1alpha3.TransitGatewayRouteTableAttachment, len(propagations))
+		for i, p := range propagations {
+			o.Propagations[i] = v1alpha3.TransitGatewayRouteTableAttachment{
+				AttachmentID: aws.StringValue(p.TransitGatewayAttachmentId),
+				ResourceID:   aws.StringValue(p.ResourceId),
+				State:        string(p.State),
+			}
+		}
+	}
+	return o
+}
+
+// DiffTransitGatewayRouteTableAttachments returns the IDs of the attachments
+// that need to be associated with or propagated to the route table and the
+// IDs of the ones that need to be removed. Associations and propagations that
+// are being removed are ignored.
+func DiffTransitGatewayRouteTableAttachments(o v1alpha3.TransitGatewayRouteTableObservation, p v1alpha3.TransitGatewayRouteTableParameters) (associate, disassociate, enable, disable []string) {
+	var associated, propagated []string
+	for _, a := range o.Associations {
+		if a.State != string(ec2.TransitGatewayAssociationStateDisassociating) && a.State != string(ec2.TransitGatewayAssociationStateDisassociated) {
+			associated = append(associated, a.AttachmentID)
+		}
+	}
+	for _, a := range o.Propagations {
+		if a.State != string(ec2.TransitGatewayPropagationStateDisabling) && a.State != string(ec2.TransitGatewayPropagationStateDisabled) {
+			propagated = append(propagated, a.AttachmentID)
+		}
+	}
+	associate, disassociate = diffStrings(p.AssociatedAttachmentIDs, associated)
+	enable, disable = diffStrings(p.PropagatedAttachmentIDs, propagated)
+	return associate, disassociate, enable, disable
+}
+
+// IsTransitGatewayRouteTableUpToDate returns true if there is no update-able
+// difference between desired and observed state of the resource.
+// managedTagKeys are the keys of the tags that were previously set from the
+// spec.
+func IsTransitGatewayRouteTableUpToDate(p v1alpha3.TransitGatewayRouteTableParameters, rt ec2.TransitGatewayRouteTable, o v1alpha3.TransitGatewayRouteTableObservation, managedTagKeys []string) bool {
+	associate, disassociate, enable, disable := DiffTransitGatewayRouteTableAttachments(o, p)
+	if len(associate) > 0 || len(disassociate) > 0 || len(enable) > 0 || len(disable) > 0 {
+		return false
+	}
+	add, remove := DiffManagedEC2Tags(p.Tags, rt.Tags, managedTagKeys)
+	return len(add) == 0 && len(remove) == 0
+}
@@ -0,0 +1,125 @@
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/provider-aws/apis/network/v1alpha3"
+)
+
+const (
+	// TransitGatewayAttachmentIDNotFound is the code that is returned by ec2
+	// when the given transit gateway attachment ID is not found
+	TransitGatewayAttachmentIDNotFound = "InvalidTransitGatewayAttachmentID.NotFound"
+)
+
+// TransitGatewayVPCAttachmentClient is the external client used for
+// TransitGatewayVPCAttachment Custom Resource
+type TransitGatewayVPCAttachmentClient interface {
+	CreateTransitGatewayVpcAttachmentRequest(*ec2.CreateTransitGatewayVpcAttachmentInput) ec2.CreateTransitGatewayVpcAttachmentRequest
+	DeleteTransitGatewayVpcAttachmentRequest(*ec2.DeleteTransitGatewayVpcAttachmentInput) ec2.DeleteTransitGatewayVpcAttachmentRequest
+	DescribeTransitGatewayVpcAttachmentsRequest(*ec2.DescribeTransitGatewayVpcAttachmentsInput) ec2.DescribeTransitGatewayVpcAttachmentsRequest
+	ModifyTransitGatewayVpcAttachmentRequest(*ec2.ModifyTransitGatewayVpcAttachmentInput) ec2.ModifyTransitGatewayVpcAttachmentRequest
+	CreateTagsRequest(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewTransitGatewayVPCAttachmentClient returns a new client using AWS credentials as JSON encoded data.
+func NewTransitGatewayVPCAttachmentClient(cfg *aws.Config) (TransitGatewayVPCAttachmentClient, error) {
+	return ec2.New(*cfg), nil
+}
+
+// IsTransitGatewayAttachmentNotFoundErr returns true if the error is because
+// the item doesn't exist
+func IsTransitGatewayAttachmentNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == TransitGatewayAttachmentIDNotFound {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateCreateTransitGatewayVPCAttachmentInput returns the input to create
+// a transit gateway VPC attachment with the given parameters.
+func GenerateCreateTransitGatewayVPCAttachmentInput(p v1alpha3.TransitGatewayVPCAttachmentParameters) *ec2.CreateTransitGatewayVpcAttachmentInput {
+	in := &ec2.CreateTransitGatewayVpcAttachmentInput{
+		TransitGatewayId: p.TransitGatewayID,
+		VpcId:            p.VPCID,
+		SubnetIds:        p.SubnetIDs,
+		Options: &ec2.CreateTransitGatewayVpcAttachmentRequestOptions{
+			DnsSupport:  ec2.DnsSupportValue(aws.StringValue(p.DNSSupport)),
+			Ipv6Support: ec2.Ipv6SupportValue(aws.StringValue(p.IPv6Support)),
+		},
+	}
+	if len(p.Tags) > 0 {
+		in.TagSpecifications = []ec2.TagSpecification{{
+			ResourceType: ec2.ResourceTypeTransitGatewayAttachment,
+			Tags:         v1alpha3.GenerateEC2Tags(p.Tags),
+		}}
+	}
+	return in
+}
+
+// GenerateTransitGatewayVPCAttachmentObservation is used to produce
+// v1alpha3.TransitGatewayVPCAttachmentObservation from
+// ec2.TransitGatewayVpcAttachment.
+func GenerateTransitGatewayVPCAttachmentObservation(a ec2.TransitGatewayVpcAttachment) v1alpha3.TransitGatewayVPCAttachmentObservation {
+	return v1alpha3.TransitGatewayVPCAttachmentObservation{
+		State:      string(a.State),
+		VPCOwnerID: aws.StringValue(a.VpcOwnerId),
+	}
+}
+
+// LateInitializeTransitGatewayVPCAttachment fills the empty fields in
+// *v1alpha3.TransitGatewayVPCAttachmentParameters with the values seen in
+// ec2.TransitGatewayVpcAttachment.
+func LateInitializeTransitGatewayVPCAttachment(in *v1alpha3.TransitGatewayVPCAttachmentParameters, a *ec2.TransitGatewayVpcAttachment) {
+	if a == nil || a.Options == nil {
+		return
+	}
+	in.DNSSupport = lateInitializeEnum(in.DNSSupport, string(a.Options.DnsSupport))
+	in.IPv6Support = lateInitializeEnum(in.IPv6Support, string(a.Options.Ipv6Support))
+}
+
+// GenerateModifyTransitGatewayVPCAttachmentInput returns the input to bring
+// the subnets and options of the attachment to the desired state, or nil if
+// they are up to date.
+func GenerateModifyTransitGatewayVPCAttachmentInput(id string, p v1alpha3.TransitGatewayVPCAttachmentParameters, a ec2.TransitGatewayVpcAttachment) *ec2.ModifyTransitGatewayVpcAttachmentInput {
+	in := &ec2.ModifyTransitGatewayVpcAttachmentInput{
+		TransitGatewayAttachmentId: aws.String(id),
+	}
+	in.AddSubnetIds, in.RemoveSubnetIds = diffStrings(p.SubnetIDs, a.SubnetIds)
+
+	opts := &ec2.ModifyTransitGatewayVpcAttachmentRequestOptions{}
+	observed := &ec2.TransitGatewayVpcAttachmentOptions{}
+	if a.Options != nil {
+		observed = a.Options
+	}
+	if p.DNSSupport != nil && aws.StringValue(p.DNSSupport) != string(observed.DnsSupport) {
+		opts.DnsSupport = ec2.DnsSupportValue(aws.StringValue(p.DNSSupport))
+		in.Options = opts
+	}
+	if p.IPv6Support != nil && aws.StringValue(p.IPv6Support) != string(observed.Ipv6Support) {
+		opts.Ipv6Support = ec2.Ipv6SupportValue(aws.StringValue(p.IPv6Support))
+		in.Options = opts
+	}
+
+	if len(in.AddSubnetIds) == 0 && len(in.RemoveSubnetIds) == 0 && in.Options == nil {
+		return nil
+	}
+	return in
+}
+
+// IsTransitGatewayVPCAttachmentUpToDate returns true if there is no
+// update-able difference between desired and observed state of the resource.
+// managedTagKeys are the keys of the tags that were previously set from the
+// spec.
+func IsTransitGatewayVPCAttachmentUpToDate(p v1alpha3.TransitGatewayVPCAttachmentParameters, a ec2.TransitGatewayVpcAttachment, managedTagKeys []string) bool {
+	if GenerateModifyTransitGatewayVPCAttachmentInput(aws.StringValue(a.TransitGatewayAttachmentId), p, a) != nil {
+		return false
+	}
+	add, remove := DiffManagedEC2Tags(p.Tags, a.Tags, managedTagKeys)
+	return len(add) == 0 && len(remove) == 0
+}
@@ -4,7 +4,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/awserr"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
-	"github.com/google/go-cmp/cmp"
 
 	"github.com/crossplane/provider-aws/apis/network/v1alpha3"
 )
@@ -21,6 +20,10 @@ type VPCClient interface {
 	DescribeVpcsRequest(*ec2.DescribeVpcsInput) ec2.DescribeVpcsRequest
 	ModifyVpcAttributeRequest(*ec2.ModifyVpcAttributeInput) ec2.ModifyVpcAttributeRequest
 	CreateTagsRequest(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+	AssociateVpcCidrBlockRequest(*ec2.AssociateVpcCidrBlockInput) ec2.AssociateVpcCidrBlockRequest
+	DisassociateVpcCidrBlockRequest(*ec2.DisassociateVpcCidrBlockInput) ec2.DisassociateVpcCidrBlockRequest
+	ModifyVpcTenancyRequest(*ec2.ModifyVpcTenancyInput) ec2.ModifyVpcTenancyRequest
 }
 
 // NewVPCClient returns a new client using AWS credentials as JSON encoded data.
@@ -39,9 +42,115 @@ func IsVPCNotFoundErr(err error) bool {
 	return false
 }
 
+// GenerateCreateVpcInput returns the input to create a VPC with the given
+// parameters.
+func GenerateCreateVpcInput(spec v1alpha3.VPCParameters) *ec2.CreateVpcInput {
+	in := &ec2.CreateVpcInput{
+		CidrBlock:                   aws.String(spec.CIDRBlock),
+		AmazonProvidedIpv6CidrBlock: spec.AmazonProvidedIPv6CIDRBlock,
+	}
+	if spec.InstanceTenancy != nil {
+		in.InstanceTenancy = ec2.Tenancy(*spec.InstanceTenancy)
+	}
+	return in
+}
+
+// LateInitializeVPC fills the empty fields in *v1alpha3.VPCParameters with
+// the values seen in ec2.Vpc.
+func LateInitializeVPC(in *v1alpha3.VPCParameters, v *ec2.Vpc) {
+	if v == nil {
+		return
+	}
+	if in.InstanceTenancy == nil && v.InstanceTenancy != "" {
+		in.InstanceTenancy = aws.String(string(v.InstanceTenancy))
+	}
+	if in.AmazonProvidedIPv6CIDRBlock == nil && len(activeIPv6CIDRBlocks(*v)) > 0 {
+		in.AmazonProvidedIPv6CIDRBlock = aws.Bool(true)
+	}
+}
+
+func isActiveCIDRBlockState(s *ec2.VpcCidrBlockState) bool {
+	return s != nil && (s.State == ec2.VpcCidrBlockStateCodeAssociating || s.State == ec2.VpcCidrBlockStateCodeAssociated)
+}
+
+// activeIPv6CIDRBlocks returns the association IDs of the IPv6 CIDR blocks
+// that are associated or being associated with the VPC.
+func activeIPv6CIDRBlocks(v ec2.Vpc) []string {
+	var ids []string
+	for _, a := range v.Ipv6CidrBlockAssociationSet {
+		if isActiveCIDRBlockState(a.Ipv6CidrBlockState) {
+			ids = append(ids, aws.StringValue(a.AssociationId))
+		}
+	}
+	return ids
+}
+
+// DiffVPCCIDRBlocks returns the secondary IPv4 CIDR blocks that need to be
+// associated with the VPC and the association IDs of the ones that need to be
+// disassociated from it. The primary CIDR block is never disassociated.
+func DiffVPCCIDRBlocks(spec v1alpha3.VPCParameters, v ec2.Vpc) (associate, disassociate []string) {
+	observed := map[string]string{}
+	for _, a := range v.CidrBlockAssociationSet {
+		if !isActiveCIDRBlockState(a.CidrBlockState) || aws.StringValue(a.CidrBlock) == aws.StringValue(v.CidrBlock) {
+			continue
+		}
+		observed[aws.StringValue(a.CidrBlock)] = aws.StringValue(a.AssociationId)
+	}
+	desired := map[string]struct{}{}
+	for _, c := range spec.SecondaryCIDRBlocks {
+		desired[c] = struct{}{}
+		if _, ok := observed[c]; !ok {
+			associate = append(associate, c)
+		}
+	}
+	for _, a := range v.CidrBlockAssociationSet {
+		id, ok := observed[aws.StringValue(a.CidrBlock)]
+		if !ok {
+			continue
+		}
+		if _, ok := desired[aws.StringValue(a.CidrBlock)]; !ok {
+			disassociate = append(disassociate, id)
+		}
+	}
+	return associate, disassociate
+}
+
+// DiffVPCIPv6CIDRBlock returns whether an Amazon-provided IPv6 CIDR block
+// needs to be associated with the VPC and the association IDs of the IPv6
+// CIDR blocks that need to be disassociated from it.
+func DiffVPCIPv6CIDRBlock(spec v1alpha3.VPCParameters, v ec2.Vpc) (associate bool, disassociate []string) {
+	if spec.AmazonProvidedIPv6CIDRBlock == nil {
+		return false, nil
+	}
+	active := activeIPv6CIDRBlocks(v)
+	if aws.BoolValue(spec.AmazonProvidedIPv6CIDRBlock) {
+		return len(active) == 0, nil
+	}
+	return false, active
+}
+
+// NeedsTenancyUpdate returns true if the tenancy of the VPC needs to be
+// changed. Only a change from dedicated to default tenancy is supported by
+// AWS.
+func NeedsTenancyUpdate(spec v1alpha3.VPCParameters, v ec2.Vpc) bool {
+	return aws.StringValue(spec.InstanceTenancy) == string(ec2.TenancyDefault) && v.InstanceTenancy == ec2.TenancyDedicated
+}
+
 // IsUpToDate returns true if there is no update-able difference between desired
-// and observed state of the resource.
-func IsUpToDate(spec v1alpha3.VPCParameters, o ec2.Vpc) bool {
-	actual := v1alpha3.BuildFromEC2Tags(o.Tags)
-	return cmp.Equal(spec.Tags, actual)
+// and observed state of the resource. managedTagKeys are the keys of the tags
+// that were previously set from the spec.
+func IsUpToDate(spec v1alpha3.VPCParameters, o ec2.Vpc, managedTagKeys []string) bool {
+	if NeedsTenancyUpdate(spec, o) {
+		return false
+	}
+	associate, disassociate := DiffVPCCIDRBlocks(spec, o)
+	if len(associate) > 0 || len(disassociate) > 0 {
+		return false
+	}
+	associateIPv6, disassociate := DiffVPCIPv6CIDRBlock(spec, o)
+	if associateIPv6 || len(disassociate) > 0 {
+		return false
+	}
+	add, remove := DiffManagedEC2Tags(spec.Tags, o.Tags, managedTagKeys)
+	return len(add) == 0 && len(remove) == 0
 }
@@ -0,0 +1,186 @@
+package ec2
+
+import (
+	"net/url"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/provider-aws/apis/network/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// VPCEndpointIDNotFound is the code that is returned by ec2 when the given VPCEndpointID is not valid
+	VPCEndpointIDNotFound = "InvalidVpcEndpointId.NotFound"
+)
+
+// VPCEndpointClient is the external client used for VPCEndpoint Custom Resource
+type VPCEndpointClient interface {
+	CreateVpcEndpointRequest(input *ec2.CreateVpcEndpointInput) ec2.CreateVpcEndpointRequest
+	DescribeVpcEndpointsRequest(input *ec2.DescribeVpcEndpointsInput) ec2.DescribeVpcEndpointsRequest
+	ModifyVpcEndpointRequest(input *ec2.ModifyVpcEndpointInput) ec2.ModifyVpcEndpointRequest
+	DeleteVpcEndpointsRequest(input *ec2.DeleteVpcEndpointsInput) ec2.DeleteVpcEndpointsRequest
+	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewVPCEndpointClient returns a new client using AWS credentials as JSON encoded data.
+func NewVPCEndpointClient(cfg *aws.Config) (VPCEndpointClient, error) {
+	return ec2.New(*cfg), nil
+}
+
+// IsVPCEndpointNotFoundErr returns true if the error is because the item doesn't exist
+func IsVPCEndpointNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == VPCEndpointIDNotFound {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateCreateVPCEndpointInput returns the input to create a VPC endpoint
+// with the given parameters.
+func GenerateCreateVPCEndpointInput(p v1alpha3.VPCEndpointParameters) *ec2.CreateVpcEndpointInput {
+	endpointType := ec2.VpcEndpointTypeGateway
+	if p.VPCEndpointType != nil {
+		endpointType = ec2.VpcEndpointType(*p.VPCEndpointType)
+	}
+	return &ec2.CreateVpcEndpointInput{
+		VpcId:             p.VPCID,
+		ServiceName:       aws.String(p.ServiceName),
+		VpcEndpointType:   endpointType,
+		PolicyDocument:    p.PolicyDocument,
+		RouteTableIds:     p.RouteTableIDs,
+		SubnetIds:         p.SubnetIDs,
+		SecurityGroupIds:  p.SecurityGroupIDs,
+		PrivateDnsEnabled: p.PrivateDNSEnabled,
+	}
+}
+
+// GenerateVPCEndpointObservation is used to produce
+// v1alpha3.VPCEndpointObservation from ec2.VpcEndpoint.
+func GenerateVPCEndpointObservation(e ec2.VpcEndpoint) v1alpha3.VPCEndpointObservation {
+	o := v1alpha3.VPCEndpointObservation{
+		State:               string(e.State),
+		OwnerID:             aws.StringValue(e.OwnerId),
+		NetworkInterfaceIDs: e.NetworkInterfaceIds,
+	}
+	if len(e.DnsEntries) > 0 {
+		o.DNSEntries = make([]v1alpha3.DNSEntry, len(e.DnsEntries))
+		for i, d := range e.DnsEntries {
+			o.DNSEntries[i] = v1alpha3.DNSEntry{
+				DNSName:      aws.StringValue(d.DnsName),
+				HostedZoneID: aws.StringValue(d.HostedZoneId),
+			}
+		}
+	}
+	return o
+}
+
+// LateInitializeVPCEndpoint fills the empty fields in
+// *v1alpha3.VPCEndpointParameters with the values seen in ec2.VpcEndpoint.
+func LateInitializeVPCEndpoint(in *v1alpha3.VPCEndpointParameters, e *ec2.VpcEndpoint) {
+	if e == nil {
+		return
+	}
+	if in.VPCEndpointType == nil && e.VpcEndpointType != "" {
+		in.VPCEndpointType = aws.String(string(e.VpcEndpointType))
+	}
+	in.PolicyDocument = awsclients.LateInitializeStringPtr(in.PolicyDocument, e.PolicyDocument)
+	in.PrivateDNSEnabled = awsclients.LateInitializeBoolPtr(in.PrivateDNSEnabled, e.PrivateDnsEnabled)
+	if len(in.SecurityGroupIDs) == 0 && len(e.Groups) > 0 {
+		in.SecurityGroupIDs = make([]string, len(e.Groups))
+		for i, g := range e.Groups {
+			in.SecurityGroupIDs[i] = aws.StringValue(g.GroupId)
+		}
+	}
+}
+
+// diffStrings returns the elements that are in desired but not in observed
+// and the elements that are in observed but not in desired.
+func diffStrings(desired, observed []string) (add, remove []string) {
+	o := make(map[string]struct{}, len(observed))
+	for _, s := range observed {
+		o[s] = struct{}{}
+	}
+	d := make(map[string]struct{}, len(desired))
+	for _, s := range desired {
+		d[s] = struct{}{}
+		if _, ok := o[s]; !ok {
+			add = append(add, s)
+		}
+	}
+	for _, s := range observed {
+		if _, ok := d[s]; !ok {
+			remove = append(remove, s)
+		}
+	}
+	return add, remove
+}
+
+func isPolicyDocumentUpToDate(desired, observed *string) (bool, error) {
+	if desired == nil {
+		return true, nil
+	}
+	// the policy document may be returned URL-encoded.
+	o, err := url.QueryUnescape(aws.StringValue(observed))
+	if err != nil {
+		return false, err
+	}
+	return awsclients.IsJSONEqual(*desired, o)
+}
+
+// GenerateModifyVPCEndpointInput returns the input to modify the observed
+// VPC endpoint to match the desired parameters, or nil if there is nothing to
+// modify.
+func GenerateModifyVPCEndpointInput(id string, p v1alpha3.VPCEndpointParameters, e ec2.VpcEndpoint) (*ec2.ModifyVpcEndpointInput, error) {
+	in := &ec2.ModifyVpcEndpointInput{VpcEndpointId: aws.String(id)}
+	changed := false
+
+	in.AddRouteTableIds, in.RemoveRouteTableIds = diffStrings(p.RouteTableIDs, e.RouteTableIds)
+	in.AddSubnetIds, in.RemoveSubnetIds = diffStrings(p.SubnetIDs, e.SubnetIds)
+	observedGroups := make([]string, len(e.Groups))
+	for i, g := range e.Groups {
+		observedGroups[i] = aws.StringValue(g.GroupId)
+	}
+	in.AddSecurityGroupIds, in.RemoveSecurityGroupIds = diffStrings(p.SecurityGroupIDs, observedGroups)
+	if len(in.AddRouteTableIds)+len(in.RemoveRouteTableIds)+len(in.AddSubnetIds)+len(in.RemoveSubnetIds)+
+		len(in.AddSecurityGroupIds)+len(in.RemoveSecurityGroupIds) > 0 {
+		changed = true
+	}
+
+	upToDate, err := isPolicyDocumentUpToDate(p.PolicyDocument, e.PolicyDocument)
+	if err != nil {
+		return nil, err
+	}
+	if !upToDate {
+		in.PolicyDocument = p.PolicyDocument
+		changed = true
+	}
+
+	if p.PrivateDNSEnabled != nil && aws.BoolValue(p.PrivateDNSEnabled) != aws.BoolValue(e.PrivateDnsEnabled) {
+		in.PrivateDnsEnabled = p.PrivateDNSEnabled
+		changed = true
+	}
+
+	if !changed {
+		return nil, nil
+	}
+	return in, nil
+}
+
+// IsVPCEndpointUpToDate returns true if the observed VPC endpoint matches the
+// desired parameters.
+// managedTagKeys are the keys of the tags that were previously set from the
+// spec.
+func IsVPCEndpointUpToDate(p v1alpha3.VPCEndpointParameters, e ec2.VpcEndpoint, managedTagKeys []string) (bool, error) {
+	in, err := GenerateModifyVPCEndpointInput(aws.StringValue(e.VpcEndpointId), p, e)
+	if err != nil || in != nil {
+		return false, err
+	}
+	add, remove := DiffManagedEC2Tags(p.Tags, e.Tags, managedTagKeys)
+	return len(add) == 0 && len(remove) == 0, nil
+}
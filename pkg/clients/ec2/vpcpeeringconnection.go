@@ -0,0 +1,128 @@
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/provider-aws/apis/network/v1alpha3"
+)
+
+const (
+	// VPCPeeringConnectionIDNotFound is the code that is returned by ec2 when the given VPCPeeringConnectionID is not valid
+	VPCPeeringConnectionIDNotFound = "InvalidVpcPeeringConnectionID.NotFound"
+)
+
+// VPCPeeringConnectionClient is the external client used for VPCPeeringConnection Custom Resource
+type VPCPeeringConnectionClient interface {
+	CreateVpcPeeringConnectionRequest(input *ec2.CreateVpcPeeringConnectionInput) ec2.CreateVpcPeeringConnectionRequest
+	AcceptVpcPeeringConnectionRequest(input *ec2.AcceptVpcPeeringConnectionInput) ec2.AcceptVpcPeeringConnectionRequest
+	DescribeVpcPeeringConnectionsRequest(input *ec2.DescribeVpcPeeringConnectionsInput) ec2.DescribeVpcPeeringConnectionsRequest
+	ModifyVpcPeeringConnectionOptionsRequest(input *ec2.ModifyVpcPeeringConnectionOptionsInput) ec2.ModifyVpcPeeringConnectionOptionsRequest
+	DeleteVpcPeeringConnectionRequest(input *ec2.DeleteVpcPeeringConnectionInput) ec2.DeleteVpcPeeringConnectionRequest
+	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewVPCPeeringConnectionClient returns a new client using AWS credentials as JSON encoded data.
+func NewVPCPeeringConnectionClient(cfg *aws.Config) (VPCPeeringConnectionClient, error) {
+	return ec2.New(*cfg), nil
+}
+
+// IsVPCPeeringConnectionNotFoundErr returns true if the error is because the item doesn't exist
+func IsVPCPeeringConnectionNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == VPCPeeringConnectionIDNotFound {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateCreateVPCPeeringConnectionInput returns the input to request a VPC
+// peering connection with the given parameters.
+func GenerateCreateVPCPeeringConnectionInput(p v1alpha3.VPCPeeringConnectionParameters) *ec2.CreateVpcPeeringConnectionInput {
+	return &ec2.CreateVpcPeeringConnectionInput{
+		VpcId:       p.VPCID,
+		PeerVpcId:   p.PeerVPCID,
+		PeerOwnerId: p.PeerOwnerID,
+		PeerRegion:  p.PeerRegion,
+	}
+}
+
+func generateVPCInfo(in *ec2.VpcPeeringConnectionVpcInfo) v1alpha3.VPCPeeringConnectionVPCInfo {
+	if in == nil {
+		return v1alpha3.VPCPeeringConnectionVPCInfo{}
+	}
+	o := v1alpha3.VPCPeeringConnectionVPCInfo{
+		VPCID:     aws.StringValue(in.VpcId),
+		OwnerID:   aws.StringValue(in.OwnerId),
+		Region:    aws.StringValue(in.Region),
+		CIDRBlock: aws.StringValue(in.CidrBlock),
+	}
+	if in.PeeringOptions != nil {
+		o.AllowDNSResolutionFromRemoteVPC = aws.BoolValue(in.PeeringOptions.AllowDnsResolutionFromRemoteVpc)
+	}
+	return o
+}
+
+// GenerateVPCPeeringConnectionObservation is used to produce
+// v1alpha3.VPCPeeringConnectionObservation from ec2.VpcPeeringConnection.
+func GenerateVPCPeeringConnectionObservation(pcx ec2.VpcPeeringConnection) v1alpha3.VPCPeeringConnectionObservation {
+	o := v1alpha3.VPCPeeringConnectionObservation{
+		RequesterVPCInfo: generateVPCInfo(pcx.RequesterVpcInfo),
+		AccepterVPCInfo:  generateVPCInfo(pcx.AccepterVpcInfo),
+	}
+	if pcx.Status != nil {
+		o.StatusCode = string(pcx.Status.Code)
+		o.StatusMessage = aws.StringValue(pcx.Status.Message)
+	}
+	return o
+}
+
+// GeneratePeeringOptionsRequest returns the options request that changes the
+// observed peering options of one side of a VPC peering connection to the
+// desired ones, or nil if they are already in the desired state.
+func GeneratePeeringOptionsRequest(desired *v1alpha3.VPCPeeringConnectionOptions, observed *ec2.VpcPeeringConnectionVpcInfo) *ec2.PeeringConnectionOptionsRequest {
+	if desired == nil || desired.AllowDNSResolutionFromRemoteVPC == nil {
+		return nil
+	}
+	current := false
+	if observed != nil && observed.PeeringOptions != nil {
+		current = aws.BoolValue(observed.PeeringOptions.AllowDnsResolutionFromRemoteVpc)
+	}
+	if current == aws.BoolValue(desired.AllowDNSResolutionFromRemoteVPC) {
+		return nil
+	}
+	return &ec2.PeeringConnectionOptionsRequest{
+		AllowDnsResolutionFromRemoteVpc: desired.AllowDNSResolutionFromRemoteVPC,
+	}
+}
+
+// IsVPCPeeringConnectionAcceptable returns true if the VPC peering connection
+// is waiting for acceptance and the accepter VPC is managed, in which case it
+// is accepted automatically.
+func IsVPCPeeringConnectionAcceptable(p v1alpha3.VPCPeeringConnectionParameters, pcx ec2.VpcPeeringConnection) bool {
+	return p.PeerVPCIDRef != nil && pcx.Status != nil &&
+		pcx.Status.Code == ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance
+}
+
+// IsVPCPeeringConnectionUpToDate returns true if the observed VPC peering
+// connection does not need to be accepted and its peering options and tags
+// match the desired ones. Peering options can only be compared once the
+// peering connection is active.
+// managedTagKeys are the keys of the tags that were previously set from the
+// spec.
+func IsVPCPeeringConnectionUpToDate(p v1alpha3.VPCPeeringConnectionParameters, pcx ec2.VpcPeeringConnection, managedTagKeys []string) bool {
+	if IsVPCPeeringConnectionAcceptable(p, pcx) {
+		return false
+	}
+	if pcx.Status != nil && pcx.Status.Code == ec2.VpcPeeringConnectionStateReasonCodeActive {
+		if GeneratePeeringOptionsRequest(p.RequesterPeeringOptions, pcx.RequesterVpcInfo) != nil ||
+			GeneratePeeringOptionsRequest(p.AccepterPeeringOptions, pcx.AccepterVpcInfo) != nil {
+			return false
+		}
+	}
+	add, remove := DiffManagedEC2Tags(p.Tags, pcx.Tags, managedTagKeys)
+	return len(add) == 0 && len(remove) == 0
+}
@@ -0,0 +1,29 @@
+package elbv2
+
+// lateInitializeEnum returns in if it's set, otherwise the observed value of
+// an enum field unless it's empty.
+func lateInitializeEnum(in *string, from string) *string {
+	if in != nil || from == "" {
+		return in
+	}
+	return &from
+}
+
+// isStringSetEqual returns true if the supplied slices contain the same
+// strings regardless of their order.
+func isStringSetEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	s := make(map[string]int, len(a))
+	for _, v := range a {
+		s[v]++
+	}
+	for _, v := range b {
+		if s[v] == 0 {
+			return false
+		}
+		s[v]--
+	}
+	return true
+}
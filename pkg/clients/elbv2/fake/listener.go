@@ -0,0 +1,96 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/elbv2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ListenerClient = (*MockListenerClient)(nil)
+
+// MockListenerClient is a type that implements all the methods for ListenerClient interface
+type MockListenerClient struct {
+	MockCreateListenerRequest               func(*elbv2.CreateListenerInput) elbv2.CreateListenerRequest
+	MockDescribeListenersRequest            func(*elbv2.DescribeListenersInput) elbv2.DescribeListenersRequest
+	MockModifyListenerRequest               func(*elbv2.ModifyListenerInput) elbv2.ModifyListenerRequest
+	MockDeleteListenerRequest               func(*elbv2.DeleteListenerInput) elbv2.DeleteListenerRequest
+	MockDescribeListenerCertificatesRequest func(*elbv2.DescribeListenerCertificatesInput) elbv2.DescribeListenerCertificatesRequest
+	MockAddListenerCertificatesRequest      func(*elbv2.AddListenerCertificatesInput) elbv2.AddListenerCertificatesRequest
+	MockRemoveListenerCertificatesRequest   func(*elbv2.RemoveListenerCertificatesInput) elbv2.RemoveListenerCertificatesRequest
+	MockDescribeRulesRequest                func(*elbv2.DescribeRulesInput) elbv2.DescribeRulesRequest
+	MockCreateRuleRequest                   func(*elbv2.CreateRuleInput) elbv2.CreateRuleRequest
+	MockModifyRuleRequest                   func(*elbv2.ModifyRuleInput) elbv2.ModifyRuleRequest
+	MockDeleteRuleRequest                   func(*elbv2.DeleteRuleInput) elbv2.DeleteRuleRequest
+}
+
+// CreateListenerRequest mocks CreateListenerRequest method
+func (m *MockListenerClient) CreateListenerRequest(input *elbv2.CreateListenerInput) elbv2.CreateListenerRequest {
+	return m.MockCreateListenerRequest(input)
+}
+
+// DescribeListenersRequest mocks DescribeListenersRequest method
+func (m *MockListenerClient) DescribeListenersRequest(input *elbv2.DescribeListenersInput) elbv2.DescribeListenersRequest {
+	return m.MockDescribeListenersRequest(input)
+}
+
+// ModifyListenerRequest mocks ModifyListenerRequest method
+func (m *MockListenerClient) ModifyListenerRequest(input *elbv2.ModifyListenerInput) elbv2.ModifyListenerRequest {
+	return m.MockModifyListenerRequest(input)
+}
+
+// DeleteListenerRequest mocks DeleteListenerRequest method
+func (m *MockListenerClient) DeleteListenerRequest(input *elbv2.DeleteListenerInput) elbv2.DeleteListenerRequest {
+	return m.MockDeleteListenerRequest(input)
+}
+
+// DescribeListenerCertificatesRequest mocks DescribeListenerCertificatesRequest method
+func (m *MockListenerClient) DescribeListenerCertificatesRequest(input *elbv2.DescribeListenerCertificatesInput) elbv2.DescribeListenerCertificatesRequest {
+	return m.MockDescribeListenerCertificatesRequest(input)
+}
+
+// AddListenerCertificatesRequest mocks AddListenerCertificatesRequest method
+func (m *MockListenerClient) AddListenerCertificatesRequest(input *elbv2.AddListenerCertificatesInput) elbv2.AddListenerCertificatesRequest {
+	return m.MockAddListenerCertificatesRequest(input)
+}
+
+// RemoveListenerCertificatesRequest mocks RemoveListenerCertificatesRequest method
+func (m *MockListenerClient) RemoveListenerCertificatesRequest(input *elbv2.RemoveListenerCertificatesInput) elbv2.RemoveListenerCertificatesRequest {
+	return m.MockRemoveListenerCertificatesRequest(input)
+}
+
+// DescribeRulesRequest mocks DescribeRulesRequest method
+func (m *MockListenerClient) DescribeRulesRequest(input *elbv2.DescribeRulesInput) elbv2.DescribeRulesRequest {
+	return m.MockDescribeRulesRequest(input)
+}
+
+// CreateRuleRequest mocks CreateRuleRequest method
+func (m *MockListenerClient) CreateRuleRequest(input *elbv2.CreateRuleInput) elbv2.CreateRuleRequest {
+	return m.MockCreateRuleRequest(input)
+}
+
+// ModifyRuleRequest mocks ModifyRuleRequest method
+func (m *MockListenerClient) ModifyRuleRequest(input *elbv2.ModifyRuleInput) elbv2.ModifyRuleRequest {
+	return m.MockModifyRuleRequest(input)
+}
+
+// DeleteRuleRequest mocks DeleteRuleRequest method
+func (m *MockListenerClient) DeleteRuleRequest(input *elbv2.DeleteRuleInput) elbv2.DeleteRuleRequest {
+	return m.MockDeleteRuleRequest(input)
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/elbv2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.TargetGroupClient = (*MockTargetGroupClient)(nil)
+
+// MockTargetGroupClient is a type that implements all the methods for TargetGroupClient interface
+type MockTargetGroupClient struct {
+	MockCreateTargetGroupRequest    func(*elbv2.CreateTargetGroupInput) elbv2.CreateTargetGroupRequest
+	MockDescribeTargetGroupsRequest func(*elbv2.DescribeTargetGroupsInput) elbv2.DescribeTargetGroupsRequest
+	MockModifyTargetGroupRequest    func(*elbv2.ModifyTargetGroupInput) elbv2.ModifyTargetGroupRequest
+	MockDeleteTargetGroupRequest    func(*elbv2.DeleteTargetGroupInput) elbv2.DeleteTargetGroupRequest
+	MockDescribeTagsRequest         func(*elbv2.DescribeTagsInput) elbv2.DescribeTagsRequest
+	MockAddTagsRequest              func(*elbv2.AddTagsInput) elbv2.AddTagsRequest
+	MockRemoveTagsRequest           func(*elbv2.RemoveTagsInput) elbv2.RemoveTagsRequest
+}
+
+// CreateTargetGroupRequest mocks CreateTargetGroupRequest method
+func (m *MockTargetGroupClient) CreateTargetGroupRequest(input *elbv2.CreateTargetGroupInput) elbv2.CreateTargetGroupRequest {
+	return m.MockCreateTargetGroupRequest(input)
+}
+
+// DescribeTargetGroupsRequest mocks DescribeTargetGroupsRequest method
+func (m *MockTargetGroupClient) DescribeTargetGroupsRequest(input *elbv2.DescribeTargetGroupsInput) elbv2.DescribeTargetGroupsRequest {
+	return m.MockDescribeTargetGroupsRequest(input)
+}
+
+// ModifyTargetGroupRequest mocks ModifyTargetGroupRequest method
+func (m *MockTargetGroupClient) ModifyTargetGroupRequest(input *elbv2.ModifyTargetGroupInput) elbv2.ModifyTargetGroupRequest {
+	return m.MockModifyTargetGroupRequest(input)
+}
+
+// DeleteTargetGroupRequest mocks DeleteTargetGroupRequest method
+func (m *MockTargetGroupClient) DeleteTargetGroupRequest(input *elbv2.DeleteTargetGroupInput) elbv2.DeleteTargetGroupRequest {
+	return m.MockDeleteTargetGroupRequest(input)
+}
+
+// DescribeTagsRequest mocks DescribeTagsRequest method
+func (m *MockTargetGroupClient) DescribeTagsRequest(input *elbv2.DescribeTagsInput) elbv2.DescribeTagsRequest {
+	return m.MockDescribeTagsRequest(input)
+}
+
+// AddTagsRequest mocks AddTagsRequest method
+func (m *MockTargetGroupClient) AddTagsRequest(input *elbv2.AddTagsInput) elbv2.AddTagsRequest {
+	return m.MockAddTagsRequest(input)
+}
+
+// RemoveTagsRequest mocks RemoveTagsRequest method
+func (m *MockTargetGroupClient) RemoveTagsRequest(input *elbv2.RemoveTagsInput) elbv2.RemoveTagsRequest {
+	return m.MockRemoveTagsRequest(input)
+}
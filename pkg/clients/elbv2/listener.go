@@ -0,0 +1,369 @@
+package elbv2
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// The fields of rule conditions.
+const (
+	conditionFieldHostHeader        = "host-header"
+	conditionFieldPathPattern       = "path-pattern"
+	conditionFieldHTTPRequestMethod = "http-request-method"
+	conditionFieldSourceIP          = "source-ip"
+)
+
+// ListenerClient is the external client used for Listener Custom Resource
+type ListenerClient interface {
+	CreateListenerRequest(*elbv2.CreateListenerInput) elbv2.CreateListenerRequest
+	DescribeListenersRequest(*elbv2.DescribeListenersInput) elbv2.DescribeListenersRequest
+	ModifyListenerRequest(*elbv2.ModifyListenerInput) elbv2.ModifyListenerRequest
+	DeleteListenerRequest(*elbv2.DeleteListenerInput) elbv2.DeleteListenerRequest
+	DescribeListenerCertificatesRequest(*elbv2.DescribeListenerCertificatesInput) elbv2.DescribeListenerCertificatesRequest
+	AddListenerCertificatesRequest(*elbv2.AddListenerCertificatesInput) elbv2.AddListenerCertificatesRequest
+	RemoveListenerCertificatesRequest(*elbv2.RemoveListenerCertificatesInput) elbv2.RemoveListenerCertificatesRequest
+	DescribeRulesRequest(*elbv2.DescribeRulesInput) elbv2.DescribeRulesRequest
+	CreateRuleRequest(*elbv2.CreateRuleInput) elbv2.CreateRuleRequest
+	ModifyRuleRequest(*elbv2.ModifyRuleInput) elbv2.ModifyRuleRequest
+	DeleteRuleRequest(*elbv2.DeleteRuleInput) elbv2.DeleteRuleRequest
+}
+
+// NewListenerClient returns a new client using AWS credentials as JSON encoded data.
+func NewListenerClient(conf *aws.Config) (ListenerClient, error) {
+	return elbv2.New(*conf), nil
+}
+
+// IsListenerNotFoundErr returns true if the error is because the item doesn't exist
+func IsListenerNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == elbv2.ErrCodeListenerNotFoundException {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GenerateActions converts the supplied actions to the ELBv2 actions. Actions
+// are ordered as they are listed.
+func GenerateActions(actions []v1alpha1.Action) []elbv2.Action {
+	if len(actions) == 0 {
+		return nil
+	}
+	res := make([]elbv2.Action, len(actions))
+	for i, a := range actions {
+		res[i] = elbv2.Action{
+			Type:           elbv2.ActionTypeEnum(a.Type),
+			TargetGroupArn: a.TargetGroupARN,
+		}
+		if len(actions) > 1 {
+			res[i].Order = aws.Int64(int64(i + 1))
+		}
+		if a.RedirectConfig != nil {
+			res[i].RedirectConfig = &elbv2.RedirectActionConfig{
+				Protocol:   a.RedirectConfig.Protocol,
+				Host:       a.RedirectConfig.Host,
+				Port:       a.RedirectConfig.Port,
+				Path:       a.RedirectConfig.Path,
+				Query:      a.RedirectConfig.Query,
+				StatusCode: elbv2.RedirectActionStatusCodeEnum(a.RedirectConfig.StatusCode),
+			}
+		}
+		if a.FixedResponseConfig != nil {
+			res[i].FixedResponseConfig = &elbv2.FixedResponseActionConfig{
+				StatusCode:  aws.String(a.FixedResponseConfig.StatusCode),
+				ContentType: a.FixedResponseConfig.ContentType,
+				MessageBody: a.FixedResponseConfig.MessageBody,
+			}
+		}
+	}
+	return res
+}
+
+// GenerateRuleConditions converts the supplied conditions to the ELBv2 rule
+// conditions.
+func GenerateRuleConditions(conditions []v1alpha1.RuleCondition) []elbv2.RuleCondition {
+	res := make([]elbv2.RuleCondition, len(conditions))
+	for i, c := range conditions {
+		res[i] = elbv2.RuleCondition{Field: aws.String(c.Field)}
+		switch c.Field {
+		case conditionFieldHostHeader:
+			res[i].HostHeaderConfig = &elbv2.HostHeaderConditionConfig{Values: c.Values}
+		case conditionFieldPathPattern:
+			res[i].PathPatternConfig = &elbv2.PathPatternConditionConfig{Values: c.Values}
+		case conditionFieldHTTPRequestMethod:
+			res[i].HttpRequestMethodConfig = &elbv2.HttpRequestMethodConditionConfig{Values: c.Values}
+		case conditionFieldSourceIP:
+			res[i].SourceIpConfig = &elbv2.SourceIpConditionConfig{Values: c.Values}
+		default:
+			res[i].Values = c.Values
+		}
+	}
+	return res
+}
+
+// generateDefaultCertificates returns the default certificate of the
+// listener, if any.
+func generateDefaultCertificates(p v1alpha1.ListenerParameters) []elbv2.Certificate {
+	if p.DefaultCertificateARN == nil {
+		return nil
+	}
+	return []elbv2.Certificate{{CertificateArn: p.DefaultCertificateARN}}
+}
+
+// GenerateCreateListenerInput returns the input to create a listener. The
+// additional certificates and the rules of the listener are added once it
+// exists.
+func GenerateCreateListenerInput(p v1alpha1.ListenerParameters) *elbv2.CreateListenerInput {
+	return &elbv2.CreateListenerInput{
+		LoadBalancerArn: p.LoadBalancerARN,
+		Port:            aws.Int64(p.Port),
+		Protocol:        elbv2.ProtocolEnum(p.Protocol),
+		SslPolicy:       p.SSLPolicy,
+		Certificates:    generateDefaultCertificates(p),
+		DefaultActions:  GenerateActions(p.DefaultActions),
+	}
+}
+
+// GenerateModifyListenerInput returns the input to bring the listener to the
+// desired state.
+func GenerateModifyListenerInput(arn string, p v1alpha1.ListenerParameters) *elbv2.ModifyListenerInput {
+	return &elbv2.ModifyListenerInput{
+		ListenerArn:    aws.String(arn),
+		Port:           aws.Int64(p.Port),
+		Protocol:       elbv2.ProtocolEnum(p.Protocol),
+		SslPolicy:      p.SSLPolicy,
+		Certificates:   generateDefaultCertificates(p),
+		DefaultActions: GenerateActions(p.DefaultActions),
+	}
+}
+
+// GenerateListenerObservation is used to produce v1alpha1.ListenerObservation
+// from the rules of the listener.
+func GenerateListenerObservation(rules []elbv2.Rule) v1alpha1.ListenerObservation {
+	o := v1alpha1.ListenerObservation{}
+	for _, r := range rules {
+		if aws.BoolValue(r.IsDefault) {
+			continue
+		}
+		o.RuleARNs = append(o.RuleARNs, aws.StringValue(r.RuleArn))
+	}
+	return o
+}
+
+// LateInitializeListener fills the empty fields in
+// *v1alpha1.ListenerParameters with the values seen in elbv2.Listener.
+func LateInitializeListener(in *v1alpha1.ListenerParameters, l *elbv2.Listener) {
+	if l == nil {
+		return
+	}
+	in.SSLPolicy = awsclients.LateInitializeStringPtr(in.SSLPolicy, l.SslPolicy)
+	if len(l.Certificates) > 0 {
+		in.DefaultCertificateARN = awsclients.LateInitializeStringPtr(in.DefaultCertificateARN, l.Certificates[0].CertificateArn)
+	}
+}
+
+// IsListenerUpToDate returns true if the settings of the listener that are
+// changed by ModifyListener are in the desired state.
+func IsListenerUpToDate(p v1alpha1.ListenerParameters, l elbv2.Listener) bool {
+	var cert *string
+	if len(l.Certificates) > 0 {
+		cert = l.Certificates[0].CertificateArn
+	}
+	switch {
+	case p.Port != aws.Int64Value(l.Port),
+		p.Protocol != string(l.Protocol),
+		aws.StringValue(p.SSLPolicy) != aws.StringValue(l.SslPolicy),
+		aws.StringValue(p.DefaultCertificateARN) != aws.StringValue(cert):
+		return false
+	}
+	return isActionsUpToDate(p.DefaultActions, l.DefaultActions)
+}
+
+// isActionsUpToDate returns true if the observed actions match the desired
+// ones. The components of a redirect that are not specified are filled in by
+// AWS and are not compared.
+func isActionsUpToDate(desired []v1alpha1.Action, observed []elbv2.Action) bool {
+	if len(desired) != len(observed) {
+		return false
+	}
+	o := make([]elbv2.Action, len(observed))
+	copy(o, observed)
+	sort.SliceStable(o, func(i, j int) bool { return aws.Int64Value(o[i].Order) < aws.Int64Value(o[j].Order) })
+	for i, d := range desired {
+		if d.Type != string(o[i].Type) {
+			return false
+		}
+		if aws.StringValue(d.TargetGroupARN) != aws.StringValue(o[i].TargetGroupArn) {
+			return false
+		}
+		if !isRedirectConfigUpToDate(d.RedirectConfig, o[i].RedirectConfig) {
+			return false
+		}
+		if !isFixedResponseConfigUpToDate(d.FixedResponseConfig, o[i].FixedResponseConfig) {
+			return false
+		}
+	}
+	return true
+}
+
+func isRedirectConfigUpToDate(d *v1alpha1.RedirectConfig, o *elbv2.RedirectActionConfig) bool {
+	if d == nil || o == nil {
+		return d == nil && o == nil
+	}
+	switch {
+	case d.StatusCode != string(o.StatusCode),
+		d.Protocol != nil && aws.StringValue(d.Protocol) != aws.StringValue(o.Protocol),
+		d.Host != nil && aws.StringValue(d.Host) != aws.StringValue(o.Host),
+		d.Port != nil && aws.StringValue(d.Port) != aws.StringValue(o.Port),
+		d.Path != nil && aws.StringValue(d.Path) != aws.StringValue(o.Path),
+		d.Query != nil && aws.StringValue(d.Query) != aws.StringValue(o.Query):
+		return false
+	}
+	return true
+}
+
+func isFixedResponseConfigUpToDate(d *v1alpha1.FixedResponseConfig, o *elbv2.FixedResponseActionConfig) bool {
+	if d == nil || o == nil {
+		return d == nil && o == nil
+	}
+	return d.StatusCode == aws.StringValue(o.StatusCode) &&
+		aws.StringValue(d.ContentType) == aws.StringValue(o.ContentType) &&
+		aws.StringValue(d.MessageBody) == aws.StringValue(o.MessageBody)
+}
+
+// ruleConditionValues returns the values of the observed rule condition,
+// which are returned either in the condition itself or in its field specific
+// configuration.
+func ruleConditionValues(c elbv2.RuleCondition) []string {
+	switch {
+	case c.HostHeaderConfig != nil:
+		return c.HostHeaderConfig.Values
+	case c.PathPatternConfig != nil:
+		return c.PathPatternConfig.Values
+	case c.HttpRequestMethodConfig != nil:
+		return c.HttpRequestMethodConfig.Values
+	case c.SourceIpConfig != nil:
+		return c.SourceIpConfig.Values
+	}
+	return c.Values
+}
+
+// isRuleConditionsUpToDate returns true if the observed rule conditions match
+// the desired ones regardless of their order.
+func isRuleConditionsUpToDate(desired []v1alpha1.RuleCondition, observed []elbv2.RuleCondition) bool {
+	if len(desired) != len(observed) {
+		return false
+	}
+	matched := make([]bool, len(observed))
+	for _, d := range desired {
+		found := false
+		for i, o := range observed {
+			if matched[i] || d.Field != aws.StringValue(o.Field) || !isStringSetEqual(d.Values, ruleConditionValues(o)) {
+				continue
+			}
+			matched[i] = true
+			found = true
+			break
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// DiffListenerRules returns the inputs to create and modify the rules of the
+// listener, and the ARNs of the rules to delete, so that the observed rules
+// match the desired ones. Rules are identified by their priority and the
+// default rule of the listener is left alone.
+func DiffListenerRules(listenerARN string, desired []v1alpha1.Rule, observed []elbv2.Rule) (create []elbv2.CreateRuleInput, modify []elbv2.ModifyRuleInput, remove []string) {
+	o := make(map[int64]elbv2.Rule, len(observed))
+	for _, r := range observed {
+		if aws.BoolValue(r.IsDefault) {
+			continue
+		}
+		p, err := strconv.ParseInt(aws.StringValue(r.Priority), 10, 64)
+		if err != nil {
+			continue
+		}
+		o[p] = r
+	}
+	d := make(map[int64]struct{}, len(desired))
+	for _, r := range desired {
+		d[r.Priority] = struct{}{}
+		or, ok := o[r.Priority]
+		switch {
+		case !ok:
+			create = append(create, elbv2.CreateRuleInput{
+				ListenerArn: aws.String(listenerARN),
+				Priority:    aws.Int64(r.Priority),
+				Conditions:  GenerateRuleConditions(r.Conditions),
+				Actions:     GenerateActions(r.Actions),
+			})
+		case !isRuleConditionsUpToDate(r.Conditions, or.Conditions) || !isActionsUpToDate(r.Actions, or.Actions):
+			modify = append(modify, elbv2.ModifyRuleInput{
+				RuleArn:    or.RuleArn,
+				Conditions: GenerateRuleConditions(r.Conditions),
+				Actions:    GenerateActions(r.Actions),
+			})
+		}
+	}
+	for _, r := range observed {
+		if aws.BoolValue(r.IsDefault) {
+			continue
+		}
+		p, err := strconv.ParseInt(aws.StringValue(r.Priority), 10, 64)
+		if _, ok := d[p]; ok && err == nil {
+			continue
+		}
+		remove = append(remove, aws.StringValue(r.RuleArn))
+	}
+	return create, modify, remove
+}
+
+// DiffListenerCertificates returns the certificates that need to be added to
+// and removed from the listener, apart from its default certificate.
+func DiffListenerCertificates(p v1alpha1.ListenerParameters, observed []elbv2.Certificate) (add, remove []elbv2.Certificate) {
+	o := make(map[string]struct{}, len(observed))
+	for _, c := range observed {
+		if aws.BoolValue(c.IsDefault) {
+			continue
+		}
+		o[aws.StringValue(c.CertificateArn)] = struct{}{}
+	}
+	d := make(map[string]struct{}, len(p.CertificateARNs))
+	for _, arn := range p.CertificateARNs {
+		d[arn] = struct{}{}
+		if _, ok := o[arn]; !ok {
+			add = append(add, elbv2.Certificate{CertificateArn: aws.String(arn)})
+		}
+	}
+	for _, c := range observed {
+		if aws.BoolValue(c.IsDefault) {
+			continue
+		}
+		if _, ok := d[aws.StringValue(c.CertificateArn)]; !ok {
+			remove = append(remove, elbv2.Certificate{CertificateArn: c.CertificateArn})
+		}
+	}
+	return add, remove
+}
+
+// IsListenerRulesAndCertificatesUpToDate returns true if the rules and the
+// additional certificates of the listener are in the desired state.
+func IsListenerRulesAndCertificatesUpToDate(p v1alpha1.ListenerParameters, rules []elbv2.Rule, certs []elbv2.Certificate) bool {
+	create, modify, remove := DiffListenerRules("", p.Rules, rules)
+	if len(create) > 0 || len(modify) > 0 || len(remove) > 0 {
+		return false
+	}
+	add, removeCerts := DiffListenerCertificates(p, certs)
+	return len(add) == 0 && len(removeCerts) == 0
+}
@@ -0,0 +1,173 @@
+package elbv2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+)
+
+func TestIsListenerUpToDate(t *testing.T) {
+	l := elbv2.Listener{
+		Port:         aws.Int64(80),
+		Protocol:     elbv2.ProtocolEnumHttp,
+		Certificates: nil,
+		DefaultActions: []elbv2.Action{{
+			Type: elbv2.ActionTypeEnumRedirect,
+			RedirectConfig: &elbv2.RedirectActionConfig{
+				Protocol:   aws.String("HTTPS"),
+				Host:       aws.String("#{host}"),
+				Port:       aws.String("443"),
+				Path:       aws.String("/#{path}"),
+				Query:      aws.String("#{query}"),
+				StatusCode: elbv2.RedirectActionStatusCodeEnumHttp301,
+			},
+		}},
+	}
+	redirect := func(protocol string) []v1alpha1.Action {
+		return []v1alpha1.Action{{
+			Type: "redirect",
+			RedirectConfig: &v1alpha1.RedirectConfig{
+				Protocol:   aws.String(protocol),
+				Port:       aws.String("443"),
+				StatusCode: "HTTP_301",
+			},
+		}}
+	}
+	cases := map[string]struct {
+		in   v1alpha1.ListenerParameters
+		want bool
+	}{
+		"UpToDate": {
+			in:   v1alpha1.ListenerParameters{Port: 80, Protocol: "HTTP", DefaultActions: redirect("HTTPS")},
+			want: true,
+		},
+		"PortChanged": {
+			in:   v1alpha1.ListenerParameters{Port: 8080, Protocol: "HTTP", DefaultActions: redirect("HTTPS")},
+			want: false,
+		},
+		"RedirectChanged": {
+			in:   v1alpha1.ListenerParameters{Port: 80, Protocol: "HTTP", DefaultActions: redirect("HTTP")},
+			want: false,
+		},
+		"ActionTypeChanged": {
+			in: v1alpha1.ListenerParameters{Port: 80, Protocol: "HTTP", DefaultActions: []v1alpha1.Action{{
+				Type:           "forward",
+				TargetGroupARN: aws.String("tg"),
+			}}},
+			want: false,
+		},
+		"CertificateAdded": {
+			in:   v1alpha1.ListenerParameters{Port: 80, Protocol: "HTTP", DefaultActions: redirect("HTTPS"), DefaultCertificateARN: aws.String("cert")},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsListenerUpToDate(tc.in, l)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffListenerRules(t *testing.T) {
+	forward := func(tg string) []v1alpha1.Action {
+		return []v1alpha1.Action{{Type: "forward", TargetGroupARN: aws.String(tg)}}
+	}
+	observedRule := func(arn, priority, path, tg string) elbv2.Rule {
+		return elbv2.Rule{
+			RuleArn:  aws.String(arn),
+			Priority: aws.String(priority),
+			Conditions: []elbv2.RuleCondition{{
+				Field:             aws.String("path-pattern"),
+				Values:            []string{path},
+				PathPatternConfig: &elbv2.PathPatternConditionConfig{Values: []string{path}},
+			}},
+			Actions: []elbv2.Action{{
+				Type:           elbv2.ActionTypeEnumForward,
+				TargetGroupArn: aws.String(tg),
+				ForwardConfig:  &elbv2.ForwardActionConfig{TargetGroups: []elbv2.TargetGroupTuple{{TargetGroupArn: aws.String(tg)}}},
+			}},
+		}
+	}
+	observed := []elbv2.Rule{
+		{RuleArn: aws.String("default"), Priority: aws.String("default"), IsDefault: aws.Bool(true)},
+		observedRule("rule-1", "1", "/api/*", "tg-1"),
+		observedRule("rule-2", "2", "/web/*", "tg-2"),
+		observedRule("rule-3", "3", "/old/*", "tg-3"),
+	}
+	desired := []v1alpha1.Rule{
+		{Priority: 1, Conditions: []v1alpha1.RuleCondition{{Field: "path-pattern", Values: []string{"/api/*"}}}, Actions: forward("tg-1")},
+		{Priority: 2, Conditions: []v1alpha1.RuleCondition{{Field: "path-pattern", Values: []string{"/web/*"}}}, Actions: forward("tg-4")},
+		{Priority: 4, Conditions: []v1alpha1.RuleCondition{{Field: "host-header", Values: []string{"example.com"}}}, Actions: forward("tg-5")},
+	}
+
+	create, modify, remove := DiffListenerRules("listener", desired, observed)
+
+	wantCreate := []elbv2.CreateRuleInput{{
+		ListenerArn: aws.String("listener"),
+		Priority:    aws.Int64(4),
+		Conditions: []elbv2.RuleCondition{{
+			Field:            aws.String("host-header"),
+			HostHeaderConfig: &elbv2.HostHeaderConditionConfig{Values: []string{"example.com"}},
+		}},
+		Actions: []elbv2.Action{{Type: elbv2.ActionTypeEnumForward, TargetGroupArn: aws.String("tg-5")}},
+	}}
+	wantModify := []elbv2.ModifyRuleInput{{
+		RuleArn: aws.String("rule-2"),
+		Conditions: []elbv2.RuleCondition{{
+			Field:             aws.String("path-pattern"),
+			PathPatternConfig: &elbv2.PathPatternConditionConfig{Values: []string{"/web/*"}},
+		}},
+		Actions: []elbv2.Action{{Type: elbv2.ActionTypeEnumForward, TargetGroupArn: aws.String("tg-4")}},
+	}}
+	if diff := cmp.Diff(wantCreate, create); diff != "" {
+		t.Errorf("create: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(wantModify, modify); diff != "" {
+		t.Errorf("modify: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"rule-3"}, remove); diff != "" {
+		t.Errorf("remove: -want, +got:\n%s", diff)
+	}
+}
+
+func TestDiffListenerCertificates(t *testing.T) {
+	observed := []elbv2.Certificate{
+		{CertificateArn: aws.String("default"), IsDefault: aws.Bool(true)},
+		{CertificateArn: aws.String("cert-1"), IsDefault: aws.Bool(false)},
+		{CertificateArn: aws.String("cert-2"), IsDefault: aws.Bool(false)},
+	}
+	cases := map[string]struct {
+		in     []string
+		add    []elbv2.Certificate
+		remove []elbv2.Certificate
+	}{
+		"UpToDate": {
+			in: []string{"cert-2", "cert-1"},
+		},
+		"Replaced": {
+			in:     []string{"cert-1", "cert-3"},
+			add:    []elbv2.Certificate{{CertificateArn: aws.String("cert-3")}},
+			remove: []elbv2.Certificate{{CertificateArn: aws.String("cert-2")}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffListenerCertificates(v1alpha1.ListenerParameters{CertificateARNs: tc.in}, observed)
+			if diff := cmp.Diff(tc.add, add); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package elbv2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+)
+
+// LoadBalancerClient is the external client used for LoadBalancer Custom Resource
+type LoadBalancerClient interface {
+	CreateLoadBalancerRequest(*elbv2.CreateLoadBalancerInput) elbv2.CreateLoadBalancerRequest
+	DescribeLoadBalancersRequest(*elbv2.DescribeLoadBalancersInput) elbv2.DescribeLoadBalancersRequest
+	DeleteLoadBalancerRequest(*elbv2.DeleteLoadBalancerInput) elbv2.DeleteLoadBalancerRequest
+	SetSubnetsRequest(*elbv2.SetSubnetsInput) elbv2.SetSubnetsRequest
+	SetSecurityGroupsRequest(*elbv2.SetSecurityGroupsInput) elbv2.SetSecurityGroupsRequest
+	SetIpAddressTypeRequest(*elbv2.SetIpAddressTypeInput) elbv2.SetIpAddressTypeRequest
+	DescribeTagsRequest(*elbv2.DescribeTagsInput) elbv2.DescribeTagsRequest
+	AddTagsRequest(*elbv2.AddTagsInput) elbv2.AddTagsRequest
+	RemoveTagsRequest(*elbv2.RemoveTagsInput) elbv2.RemoveTagsRequest
+}
+
+// NewLoadBalancerClient returns a new client using AWS credentials as JSON encoded data.
+func NewLoadBalancerClient(conf *aws.Config) (LoadBalancerClient, error) {
+	return elbv2.New(*conf), nil
+}
+
+// IsLoadBalancerNotFoundErr returns true if the error is because the item doesn't exist
+func IsLoadBalancerNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == elbv2.ErrCodeLoadBalancerNotFoundException {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GenerateCreateLoadBalancerInput returns the input to create a load balancer
+// with the supplied name.
+func GenerateCreateLoadBalancerInput(name string, p v1alpha1.LoadBalancerParameters) *elbv2.CreateLoadBalancerInput {
+	return &elbv2.CreateLoadBalancerInput{
+		Name:           aws.String(name),
+		Type:           elbv2.LoadBalancerTypeEnum(aws.StringValue(p.Type)),
+		Scheme:         elbv2.LoadBalancerSchemeEnum(aws.StringValue(p.Scheme)),
+		IpAddressType:  elbv2.IpAddressType(aws.StringValue(p.IPAddressType)),
+		Subnets:        p.SubnetIDs,
+		SecurityGroups: p.SecurityGroupIDs,
+		Tags:           GenerateTags(p.Tags),
+	}
+}
+
+// GenerateLoadBalancerObservation is used to produce
+// v1alpha1.LoadBalancerObservation from elbv2.LoadBalancer.
+func GenerateLoadBalancerObservation(lb elbv2.LoadBalancer) v1alpha1.LoadBalancerObservation {
+	o := v1alpha1.LoadBalancerObservation{
+		ARN:                   aws.StringValue(lb.LoadBalancerArn),
+		DNSName:               aws.StringValue(lb.DNSName),
+		CanonicalHostedZoneID: aws.StringValue(lb.CanonicalHostedZoneId),
+		VPCID:                 aws.StringValue(lb.VpcId),
+	}
+	if lb.State != nil {
+		o.State = string(lb.State.Code)
+	}
+	return o
+}
+
+// LateInitializeLoadBalancer fills the empty fields in
+// *v1alpha1.LoadBalancerParameters with the values seen in
+// elbv2.LoadBalancer.
+func LateInitializeLoadBalancer(in *v1alpha1.LoadBalancerParameters, lb *elbv2.LoadBalancer) {
+	if lb == nil {
+		return
+	}
+	in.Type = lateInitializeEnum(in.Type, string(lb.Type))
+	in.Scheme = lateInitializeEnum(in.Scheme, string(lb.Scheme))
+	in.IPAddressType = lateInitializeEnum(in.IPAddressType, string(lb.IpAddressType))
+	if len(in.SubnetIDs) == 0 {
+		in.SubnetIDs = loadBalancerSubnetIDs(lb)
+	}
+	if len(in.SecurityGroupIDs) == 0 {
+		in.SecurityGroupIDs = lb.SecurityGroups
+	}
+}
+
+// loadBalancerSubnetIDs returns the IDs of the subnets of the supplied load
+// balancer.
+func loadBalancerSubnetIDs(lb *elbv2.LoadBalancer) []string {
+	var ids []string
+	for _, az := range lb.AvailabilityZones {
+		if az.SubnetId != nil {
+			ids = append(ids, aws.StringValue(az.SubnetId))
+		}
+	}
+	return ids
+}
+
+// IsLoadBalancerSubnetsUpToDate returns true if the load balancer is in the
+// desired subnets.
+func IsLoadBalancerSubnetsUpToDate(p v1alpha1.LoadBalancerParameters, lb elbv2.LoadBalancer) bool {
+	return isStringSetEqual(p.SubnetIDs, loadBalancerSubnetIDs(&lb))
+}
+
+// IsLoadBalancerSecurityGroupsUpToDate returns true if the load balancer has
+// the desired security groups.
+func IsLoadBalancerSecurityGroupsUpToDate(p v1alpha1.LoadBalancerParameters, lb elbv2.LoadBalancer) bool {
+	return isStringSetEqual(p.SecurityGroupIDs, lb.SecurityGroups)
+}
+
+// IsLoadBalancerUpToDate returns true if there is no update-able difference
+// between desired and observed state of the resource.
+func IsLoadBalancerUpToDate(p v1alpha1.LoadBalancerParameters, lb elbv2.LoadBalancer, tags []elbv2.Tag) bool {
+	if aws.StringValue(p.IPAddressType) != string(lb.IpAddressType) {
+		return false
+	}
+	if !IsLoadBalancerSubnetsUpToDate(p, lb) || !IsLoadBalancerSecurityGroupsUpToDate(p, lb) {
+		return false
+	}
+	add, remove := DiffTags(p.Tags, tags)
+	return len(add) == 0 && len(remove) == 0
+}
+
+// GetLoadBalancerConnectionDetails returns the connection details of the
+// supplied load balancer, i.e. its DNS name.
+func GetLoadBalancerConnectionDetails(in v1alpha1.LoadBalancer) managed.ConnectionDetails {
+	if in.Status.AtProvider.DNSName == "" {
+		return nil
+	}
+	return managed.ConnectionDetails{
+		runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(in.Status.AtProvider.DNSName),
+	}
+}
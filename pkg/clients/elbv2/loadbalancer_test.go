@@ -0,0 +1,112 @@
+package elbv2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+)
+
+func loadBalancer() elbv2.LoadBalancer {
+	return elbv2.LoadBalancer{
+		Type:          elbv2.LoadBalancerTypeEnumApplication,
+		Scheme:        elbv2.LoadBalancerSchemeEnumInternal,
+		IpAddressType: elbv2.IpAddressTypeIpv4,
+		AvailabilityZones: []elbv2.AvailabilityZone{
+			{SubnetId: aws.String("subnet-1")},
+			{SubnetId: aws.String("subnet-2")},
+		},
+		SecurityGroups: []string{"sg-1"},
+	}
+}
+
+func TestLateInitializeLoadBalancer(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.LoadBalancerParameters
+		want v1alpha1.LoadBalancerParameters
+	}{
+		"Empty": {
+			in: v1alpha1.LoadBalancerParameters{},
+			want: v1alpha1.LoadBalancerParameters{
+				Type:             aws.String("application"),
+				Scheme:           aws.String("internal"),
+				IPAddressType:    aws.String("ipv4"),
+				SubnetIDs:        []string{"subnet-1", "subnet-2"},
+				SecurityGroupIDs: []string{"sg-1"},
+			},
+		},
+		"Set": {
+			in: v1alpha1.LoadBalancerParameters{
+				Type:             aws.String("application"),
+				Scheme:           aws.String("internal"),
+				IPAddressType:    aws.String("dualstack"),
+				SubnetIDs:        []string{"subnet-3"},
+				SecurityGroupIDs: []string{"sg-2"},
+			},
+			want: v1alpha1.LoadBalancerParameters{
+				Type:             aws.String("application"),
+				Scheme:           aws.String("internal"),
+				IPAddressType:    aws.String("dualstack"),
+				SubnetIDs:        []string{"subnet-3"},
+				SecurityGroupIDs: []string{"sg-2"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			lb := loadBalancer()
+			LateInitializeLoadBalancer(&tc.in, &lb)
+			if diff := cmp.Diff(tc.want, tc.in); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsLoadBalancerUpToDate(t *testing.T) {
+	upToDate := v1alpha1.LoadBalancerParameters{
+		IPAddressType:    aws.String("ipv4"),
+		SubnetIDs:        []string{"subnet-2", "subnet-1"},
+		SecurityGroupIDs: []string{"sg-1"},
+	}
+	cases := map[string]struct {
+		in   func(*v1alpha1.LoadBalancerParameters)
+		want bool
+	}{
+		"UpToDate": {
+			in:   func(*v1alpha1.LoadBalancerParameters) {},
+			want: true,
+		},
+		"IPAddressTypeChanged": {
+			in:   func(p *v1alpha1.LoadBalancerParameters) { p.IPAddressType = aws.String("dualstack") },
+			want: false,
+		},
+		"SubnetRemoved": {
+			in:   func(p *v1alpha1.LoadBalancerParameters) { p.SubnetIDs = []string{"subnet-1"} },
+			want: false,
+		},
+		"SecurityGroupReplaced": {
+			in:   func(p *v1alpha1.LoadBalancerParameters) { p.SecurityGroupIDs = []string{"sg-2"} },
+			want: false,
+		},
+		"TagAdded": {
+			in:   func(p *v1alpha1.LoadBalancerParameters) { p.Tags = []v1alpha1.Tag{{Key: "k", Value: "v"}} },
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := *upToDate.DeepCopy()
+			tc.in(&p)
+			got := IsLoadBalancerUpToDate(p, loadBalancer(), nil)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package elbv2
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+)
+
+// awsTagPrefix is the prefix of the tags that are reserved for AWS use.
+const awsTagPrefix = "aws:"
+
+// GenerateTags converts the supplied tags to the ELBv2 tags.
+func GenerateTags(tags []v1alpha1.Tag) []elbv2.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]elbv2.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = elbv2.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)}
+	}
+	return res
+}
+
+// DiffTags returns the tags that need to be created or overwritten and the
+// keys of the tags that need to be removed so that the observed tags of an
+// ELBv2 resource match the desired ones. Tags reserved for AWS use are never
+// removed.
+func DiffTags(desired []v1alpha1.Tag, observed []elbv2.Tag) (add []elbv2.Tag, remove []string) {
+	observedTags := make(map[string]string, len(observed))
+	for _, t := range observed {
+		observedTags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	desiredTags := make(map[string]struct{}, len(desired))
+	for _, t := range desired {
+		desiredTags[t.Key] = struct{}{}
+		if v, ok := observedTags[t.Key]; !ok || v != t.Value {
+			add = append(add, elbv2.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)})
+		}
+	}
+	for _, t := range observed {
+		if _, ok := desiredTags[aws.StringValue(t.Key)]; ok || strings.HasPrefix(aws.StringValue(t.Key), awsTagPrefix) {
+			continue
+		}
+		remove = append(remove, aws.StringValue(t.Key))
+	}
+	sort.Strings(remove)
+	return add, remove
+}
@@ -0,0 +1,49 @@
+package elbv2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+)
+
+func TestDiffTags(t *testing.T) {
+	cases := map[string]struct {
+		desired  []v1alpha1.Tag
+		observed []elbv2.Tag
+		add      []elbv2.Tag
+		remove   []string
+	}{
+		"UpToDate": {
+			desired:  []v1alpha1.Tag{{Key: "k", Value: "v"}},
+			observed: []elbv2.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+		},
+		"Changed": {
+			desired: []v1alpha1.Tag{{Key: "k", Value: "new"}},
+			observed: []elbv2.Tag{
+				{Key: aws.String("k"), Value: aws.String("v")},
+				{Key: aws.String("old"), Value: aws.String("v")},
+			},
+			add:    []elbv2.Tag{{Key: aws.String("k"), Value: aws.String("new")}},
+			remove: []string{"old"},
+		},
+		"AWSTagsAreKept": {
+			observed: []elbv2.Tag{{Key: aws.String("aws:cloudformation:stack-name"), Value: aws.String("v")}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffTags(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.add, add); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
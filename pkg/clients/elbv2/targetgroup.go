@@ -0,0 +1,146 @@
+package elbv2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// TargetGroupClient is the external client used for TargetGroup Custom Resource
+type TargetGroupClient interface {
+	CreateTargetGroupRequest(*elbv2.CreateTargetGroupInput) elbv2.CreateTargetGroupRequest
+	DescribeTargetGroupsRequest(*elbv2.DescribeTargetGroupsInput) elbv2.DescribeTargetGroupsRequest
+	ModifyTargetGroupRequest(*elbv2.ModifyTargetGroupInput) elbv2.ModifyTargetGroupRequest
+	DeleteTargetGroupRequest(*elbv2.DeleteTargetGroupInput) elbv2.DeleteTargetGroupRequest
+	DescribeTagsRequest(*elbv2.DescribeTagsInput) elbv2.DescribeTagsRequest
+	AddTagsRequest(*elbv2.AddTagsInput) elbv2.AddTagsRequest
+	RemoveTagsRequest(*elbv2.RemoveTagsInput) elbv2.RemoveTagsRequest
+}
+
+// NewTargetGroupClient returns a new client using AWS credentials as JSON encoded data.
+func NewTargetGroupClient(conf *aws.Config) (TargetGroupClient, error) {
+	return elbv2.New(*conf), nil
+}
+
+// IsTargetGroupNotFoundErr returns true if the error is because the item doesn't exist
+func IsTargetGroupNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == elbv2.ErrCodeTargetGroupNotFoundException {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GenerateCreateTargetGroupInput returns the input to create a target group
+// with the supplied name.
+func GenerateCreateTargetGroupInput(name string, p v1alpha1.TargetGroupParameters) *elbv2.CreateTargetGroupInput {
+	in := &elbv2.CreateTargetGroupInput{
+		Name:                       aws.String(name),
+		Protocol:                   elbv2.ProtocolEnum(aws.StringValue(p.Protocol)),
+		Port:                       p.Port,
+		TargetType:                 elbv2.TargetTypeEnum(aws.StringValue(p.TargetType)),
+		VpcId:                      p.VPCID,
+		HealthCheckEnabled:         p.HealthCheckEnabled,
+		HealthCheckProtocol:        elbv2.ProtocolEnum(aws.StringValue(p.HealthCheckProtocol)),
+		HealthCheckPort:            p.HealthCheckPort,
+		HealthCheckPath:            p.HealthCheckPath,
+		HealthCheckIntervalSeconds: p.HealthCheckIntervalSeconds,
+		HealthCheckTimeoutSeconds:  p.HealthCheckTimeoutSeconds,
+		HealthyThresholdCount:      p.HealthyThresholdCount,
+		UnhealthyThresholdCount:    p.UnhealthyThresholdCount,
+	}
+	if p.MatcherHTTPCode != nil {
+		in.Matcher = &elbv2.Matcher{HttpCode: p.MatcherHTTPCode}
+	}
+	return in
+}
+
+// GenerateModifyTargetGroupInput returns the input to bring the health check
+// settings of the target group to the desired state.
+func GenerateModifyTargetGroupInput(arn string, p v1alpha1.TargetGroupParameters) *elbv2.ModifyTargetGroupInput {
+	in := &elbv2.ModifyTargetGroupInput{
+		TargetGroupArn:             aws.String(arn),
+		HealthCheckEnabled:         p.HealthCheckEnabled,
+		HealthCheckProtocol:        elbv2.ProtocolEnum(aws.StringValue(p.HealthCheckProtocol)),
+		HealthCheckPort:            p.HealthCheckPort,
+		HealthCheckPath:            p.HealthCheckPath,
+		HealthCheckIntervalSeconds: p.HealthCheckIntervalSeconds,
+		HealthCheckTimeoutSeconds:  p.HealthCheckTimeoutSeconds,
+		HealthyThresholdCount:      p.HealthyThresholdCount,
+		UnhealthyThresholdCount:    p.UnhealthyThresholdCount,
+	}
+	if p.MatcherHTTPCode != nil {
+		in.Matcher = &elbv2.Matcher{HttpCode: p.MatcherHTTPCode}
+	}
+	return in
+}
+
+// GenerateTargetGroupObservation is used to produce
+// v1alpha1.TargetGroupObservation from elbv2.TargetGroup.
+func GenerateTargetGroupObservation(tg elbv2.TargetGroup) v1alpha1.TargetGroupObservation {
+	return v1alpha1.TargetGroupObservation{
+		ARN:              aws.StringValue(tg.TargetGroupArn),
+		LoadBalancerARNs: tg.LoadBalancerArns,
+	}
+}
+
+// LateInitializeTargetGroup fills the empty fields in
+// *v1alpha1.TargetGroupParameters with the values seen in elbv2.TargetGroup.
+func LateInitializeTargetGroup(in *v1alpha1.TargetGroupParameters, tg *elbv2.TargetGroup) {
+	if tg == nil {
+		return
+	}
+	in.Protocol = lateInitializeEnum(in.Protocol, string(tg.Protocol))
+	in.Port = awsclients.LateInitializeInt64Ptr(in.Port, tg.Port)
+	in.TargetType = lateInitializeEnum(in.TargetType, string(tg.TargetType))
+	in.VPCID = awsclients.LateInitializeStringPtr(in.VPCID, tg.VpcId)
+	in.HealthCheckEnabled = awsclients.LateInitializeBoolPtr(in.HealthCheckEnabled, tg.HealthCheckEnabled)
+	in.HealthCheckProtocol = lateInitializeEnum(in.HealthCheckProtocol, string(tg.HealthCheckProtocol))
+	in.HealthCheckPort = awsclients.LateInitializeStringPtr(in.HealthCheckPort, tg.HealthCheckPort)
+	in.HealthCheckPath = awsclients.LateInitializeStringPtr(in.HealthCheckPath, tg.HealthCheckPath)
+	in.HealthCheckIntervalSeconds = awsclients.LateInitializeInt64Ptr(in.HealthCheckIntervalSeconds, tg.HealthCheckIntervalSeconds)
+	in.HealthCheckTimeoutSeconds = awsclients.LateInitializeInt64Ptr(in.HealthCheckTimeoutSeconds, tg.HealthCheckTimeoutSeconds)
+	in.HealthyThresholdCount = awsclients.LateInitializeInt64Ptr(in.HealthyThresholdCount, tg.HealthyThresholdCount)
+	in.UnhealthyThresholdCount = awsclients.LateInitializeInt64Ptr(in.UnhealthyThresholdCount, tg.UnhealthyThresholdCount)
+	if tg.Matcher != nil {
+		in.MatcherHTTPCode = awsclients.LateInitializeStringPtr(in.MatcherHTTPCode, tg.Matcher.HttpCode)
+	}
+}
+
+// IsTargetGroupHealthCheckUpToDate returns true if the health check settings
+// of the target group are in the desired state. Settings that are not
+// specified are not compared.
+func IsTargetGroupHealthCheckUpToDate(p v1alpha1.TargetGroupParameters, tg elbv2.TargetGroup) bool {
+	var matcher *string
+	if tg.Matcher != nil {
+		matcher = tg.Matcher.HttpCode
+	}
+	switch {
+	case p.HealthCheckEnabled != nil && aws.BoolValue(p.HealthCheckEnabled) != aws.BoolValue(tg.HealthCheckEnabled),
+		p.HealthCheckProtocol != nil && aws.StringValue(p.HealthCheckProtocol) != string(tg.HealthCheckProtocol),
+		p.HealthCheckPort != nil && aws.StringValue(p.HealthCheckPort) != aws.StringValue(tg.HealthCheckPort),
+		p.HealthCheckPath != nil && aws.StringValue(p.HealthCheckPath) != aws.StringValue(tg.HealthCheckPath),
+		p.HealthCheckIntervalSeconds != nil && aws.Int64Value(p.HealthCheckIntervalSeconds) != aws.Int64Value(tg.HealthCheckIntervalSeconds),
+		p.HealthCheckTimeoutSeconds != nil && aws.Int64Value(p.HealthCheckTimeoutSeconds) != aws.Int64Value(tg.HealthCheckTimeoutSeconds),
+		p.HealthyThresholdCount != nil && aws.Int64Value(p.HealthyThresholdCount) != aws.Int64Value(tg.HealthyThresholdCount),
+		p.UnhealthyThresholdCount != nil && aws.Int64Value(p.UnhealthyThresholdCount) != aws.Int64Value(tg.UnhealthyThresholdCount),
+		p.MatcherHTTPCode != nil && aws.StringValue(p.MatcherHTTPCode) != aws.StringValue(matcher):
+		return false
+	}
+	return true
+}
+
+// IsTargetGroupUpToDate returns true if there is no update-able difference
+// between desired and observed state of the resource.
+func IsTargetGroupUpToDate(p v1alpha1.TargetGroupParameters, tg elbv2.TargetGroup, tags []elbv2.Tag) bool {
+	if !IsTargetGroupHealthCheckUpToDate(p, tg) {
+		return false
+	}
+	add, remove := DiffTags(p.Tags, tags)
+	return len(add) == 0 && len(remove) == 0
+}
@@ -0,0 +1,60 @@
+package elbv2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+)
+
+func TestIsTargetGroupHealthCheckUpToDate(t *testing.T) {
+	tg := elbv2.TargetGroup{
+		HealthCheckEnabled:         aws.Bool(true),
+		HealthCheckProtocol:        elbv2.ProtocolEnumHttp,
+		HealthCheckPort:            aws.String("traffic-port"),
+		HealthCheckPath:            aws.String("/"),
+		HealthCheckIntervalSeconds: aws.Int64(30),
+		Matcher:                    &elbv2.Matcher{HttpCode: aws.String("200")},
+	}
+	cases := map[string]struct {
+		in   v1alpha1.TargetGroupParameters
+		want bool
+	}{
+		"Unspecified": {
+			in:   v1alpha1.TargetGroupParameters{},
+			want: true,
+		},
+		"UpToDate": {
+			in: v1alpha1.TargetGroupParameters{
+				HealthCheckProtocol: aws.String("HTTP"),
+				HealthCheckPath:     aws.String("/"),
+				MatcherHTTPCode:     aws.String("200"),
+			},
+			want: true,
+		},
+		"PathChanged": {
+			in:   v1alpha1.TargetGroupParameters{HealthCheckPath: aws.String("/healthz")},
+			want: false,
+		},
+		"MatcherChanged": {
+			in:   v1alpha1.TargetGroupParameters{MatcherHTTPCode: aws.String("200-299")},
+			want: false,
+		},
+		"IntervalChanged": {
+			in:   v1alpha1.TargetGroupParameters{HealthCheckIntervalSeconds: aws.Int64(10)},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsTargetGroupHealthCheckUpToDate(tc.in, tg)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
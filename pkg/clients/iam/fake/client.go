@@ -3,6 +3,8 @@
 package fake
 
 import (
+	context "context"
+
 	serviceiam "github.com/aws/aws-sdk-go-v2/service/iam"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,20 +14,34 @@ type Client struct {
 	mock.Mock
 }
 
-// CreatePolicyAndAttach provides a mock function with given fields: username, policyName, policyDocument
-func (_m *Client) CreatePolicyAndAttach(username string, policyName string, policyDocument string) (string, error) {
-	ret := _m.Called(username, policyName, policyDocument)
+// AttachPolicyToRole provides a mock function with given fields: ctx, policyName, roleName
+func (_m *Client) AttachPolicyToRole(ctx context.Context, policyName string, roleName string) error {
+	ret := _m.Called(ctx, policyName, roleName)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, policyName, roleName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreatePolicy provides a mock function with given fields: ctx, policyName, policyDocument
+func (_m *Client) CreatePolicy(ctx context.Context, policyName string, policyDocument string) (string, error) {
+	ret := _m.Called(ctx, policyName, policyDocument)
 
 	var r0 string
-	if rf, ok := ret.Get(0).(func(string, string, string) string); ok {
-		r0 = rf(username, policyName, policyDocument)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, policyName, policyDocument)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
-		r1 = rf(username, policyName, policyDocument)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, policyName, policyDocument)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -33,13 +49,34 @@ func (_m *Client) CreatePolicyAndAttach(username string, policyName string, poli
 	return r0, r1
 }
 
-// CreateUser provides a mock function with given fields: username
-func (_m *Client) CreateUser(username string) (*serviceiam.AccessKey, error) {
-	ret := _m.Called(username)
+// CreatePolicyAndAttach provides a mock function with given fields: ctx, username, policyName, policyDocument
+func (_m *Client) CreatePolicyAndAttach(ctx context.Context, username string, policyName string, policyDocument string) (string, error) {
+	ret := _m.Called(ctx, username, policyName, policyDocument)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) string); ok {
+		r0 = rf(ctx, username, policyName, policyDocument)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, username, policyName, policyDocument)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateUser provides a mock function with given fields: ctx, username
+func (_m *Client) CreateUser(ctx context.Context, username string) (*serviceiam.AccessKey, error) {
+	ret := _m.Called(ctx, username)
 
 	var r0 *serviceiam.AccessKey
-	if rf, ok := ret.Get(0).(func(string) *serviceiam.AccessKey); ok {
-		r0 = rf(username)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *serviceiam.AccessKey); ok {
+		r0 = rf(ctx, username)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*serviceiam.AccessKey)
@@ -47,8 +84,8 @@ func (_m *Client) CreateUser(username string) (*serviceiam.AccessKey, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(username)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, username)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -56,13 +93,41 @@ func (_m *Client) CreateUser(username string) (*serviceiam.AccessKey, error) {
 	return r0, r1
 }
 
-// DeletePolicyAndDetach provides a mock function with given fields: username, policyName
-func (_m *Client) DeletePolicyAndDetach(username string, policyName string) error {
-	ret := _m.Called(username, policyName)
+// DeletePolicy provides a mock function with given fields: ctx, policyName
+func (_m *Client) DeletePolicy(ctx context.Context, policyName string) error {
+	ret := _m.Called(ctx, policyName)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, policyName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeletePolicyAndDetach provides a mock function with given fields: ctx, username, policyName
+func (_m *Client) DeletePolicyAndDetach(ctx context.Context, username string, policyName string) error {
+	ret := _m.Called(ctx, username, policyName)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, username, policyName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteUser provides a mock function with given fields: ctx, username
+func (_m *Client) DeleteUser(ctx context.Context, username string) error {
+	ret := _m.Called(ctx, username)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string) error); ok {
-		r0 = rf(username, policyName)
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, username)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -70,13 +135,13 @@ func (_m *Client) DeletePolicyAndDetach(username string, policyName string) erro
 	return r0
 }
 
-// DeleteUser provides a mock function with given fields: username
-func (_m *Client) DeleteUser(username string) error {
-	ret := _m.Called(username)
+// DetachPolicyFromRole provides a mock function with given fields: ctx, policyName, roleName
+func (_m *Client) DetachPolicyFromRole(ctx context.Context, policyName string, roleName string) error {
+	ret := _m.Called(ctx, policyName, roleName)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string) error); ok {
-		r0 = rf(username)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, policyName, roleName)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -84,20 +149,64 @@ func (_m *Client) DeleteUser(username string) error {
 	return r0
 }
 
-// GetPolicyVersion provides a mock function with given fields: policyName
-func (_m *Client) GetPolicyVersion(policyName string) (string, error) {
-	ret := _m.Called(policyName)
+// GetPolicyARN provides a mock function with given fields: ctx, policyName
+func (_m *Client) GetPolicyARN(ctx context.Context, policyName string) (string, error) {
+	ret := _m.Called(ctx, policyName)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, policyName)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, policyName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPolicyRoles provides a mock function with given fields: ctx, policyName
+func (_m *Client) GetPolicyRoles(ctx context.Context, policyName string) ([]string, error) {
+	ret := _m.Called(ctx, policyName)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = rf(ctx, policyName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, policyName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPolicyVersion provides a mock function with given fields: ctx, policyName
+func (_m *Client) GetPolicyVersion(ctx context.Context, policyName string) (string, error) {
+	ret := _m.Called(ctx, policyName)
 
 	var r0 string
-	if rf, ok := ret.Get(0).(func(string) string); ok {
-		r0 = rf(policyName)
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, policyName)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(policyName)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, policyName)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -105,20 +214,20 @@ func (_m *Client) GetPolicyVersion(policyName string) (string, error) {
 	return r0, r1
 }
 
-// UpdatePolicy provides a mock function with given fields: policyName, policyDocument
-func (_m *Client) UpdatePolicy(policyName string, policyDocument string) (string, error) {
-	ret := _m.Called(policyName, policyDocument)
+// UpdatePolicy provides a mock function with given fields: ctx, policyName, policyDocument
+func (_m *Client) UpdatePolicy(ctx context.Context, policyName string, policyDocument string) (string, error) {
+	ret := _m.Called(ctx, policyName, policyDocument)
 
 	var r0 string
-	if rf, ok := ret.Get(0).(func(string, string) string); ok {
-		r0 = rf(policyName, policyDocument)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, policyName, policyDocument)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = rf(policyName, policyDocument)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, policyName, policyDocument)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -22,12 +22,18 @@ const (
 // Client defines IAM Client operations
 // mockery -case snake -name Client -output fake -outpkg fake
 type Client interface {
-	CreateUser(username string) (*iam.AccessKey, error)
-	DeleteUser(username string) error
-	CreatePolicyAndAttach(username string, policyName string, policyDocument string) (string, error)
-	GetPolicyVersion(policyName string) (string, error)
-	UpdatePolicy(policyName string, policyDocument string) (string, error)
-	DeletePolicyAndDetach(username string, policyName string) error
+	CreateUser(ctx context.Context, username string) (*iam.AccessKey, error)
+	DeleteUser(ctx context.Context, username string) error
+	CreatePolicyAndAttach(ctx context.Context, username string, policyName string, policyDocument string) (string, error)
+	GetPolicyVersion(ctx context.Context, policyName string) (string, error)
+	UpdatePolicy(ctx context.Context, policyName string, policyDocument string) (string, error)
+	DeletePolicyAndDetach(ctx context.Context, username string, policyName string) error
+	CreatePolicy(ctx context.Context, policyName string, policyDocument string) (string, error)
+	GetPolicyARN(ctx context.Context, policyName string) (string, error)
+	GetPolicyRoles(ctx context.Context, policyName string) ([]string, error)
+	AttachPolicyToRole(ctx context.Context, policyName string, roleName string) error
+	DetachPolicyFromRole(ctx context.Context, policyName string, roleName string) error
+	DeletePolicy(ctx context.Context, policyName string) error
 }
 
 type iamClient struct {
@@ -40,14 +46,20 @@ func NewClient(config *aws.Config) Client {
 	return &iamClient{iam: iam.New(*config)}
 }
 
-// CreateUser - Creates an IAM User, a policy, binds user to policy and returns an access key and policy version for the user.
-func (c *iamClient) CreateUser(username string) (*iam.AccessKey, error) {
-	err := c.createUser(username)
+// CreateUser - Creates an IAM User, or reuses it if it already exists, and returns a new access key for it.
+// The access keys of an existing user are deleted first; they were never handed out, because creating the
+// user did not complete, and would otherwise count against the limit of access keys per user.
+func (c *iamClient) CreateUser(ctx context.Context, username string) (*iam.AccessKey, error) {
+	err := c.createUser(ctx, username)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create user, %s", err)
 	}
 
-	key, err := c.createAccessKey(username)
+	if err := c.deleteAccessKeys(ctx, username); err != nil {
+		return nil, fmt.Errorf("failed to delete access keys, %s", err)
+	}
+
+	key, err := c.createAccessKey(ctx, username)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create access key, %s", err)
 	}
@@ -56,13 +68,13 @@ func (c *iamClient) CreateUser(username string) (*iam.AccessKey, error) {
 }
 
 // CreatePolicyAndAttach - Creates the IAM policy and attaches it to the username
-func (c *iamClient) CreatePolicyAndAttach(username string, policyName string, policyDocument string) (string, error) {
-	currentVersion, err := c.createPolicy(username, policyDocument)
+func (c *iamClient) CreatePolicyAndAttach(ctx context.Context, username string, policyName string, policyDocument string) (string, error) {
+	currentVersion, err := c.createPolicy(ctx, username, policyDocument)
 	if err != nil {
 		return "", fmt.Errorf("failed to create policy, %s", err)
 	}
 
-	err = c.attachPolicyToUser(username, username)
+	err = c.attachPolicyToUser(ctx, username, username)
 	if err != nil {
 		return "", fmt.Errorf("failed to attach policy, %s", err)
 	}
@@ -71,15 +83,15 @@ func (c *iamClient) CreatePolicyAndAttach(username string, policyName string, po
 }
 
 // GetPolicyVersion get the policy document for the IAM user
-func (c *iamClient) GetPolicyVersion(username string) (string, error) {
-	policyARN, err := c.getPolicyARN(username)
+func (c *iamClient) GetPolicyVersion(ctx context.Context, username string) (string, error) {
+	policyARN, err := c.GetPolicyARN(ctx, username)
 	if err != nil {
 		return "", err
 	}
 
 	policyResponse, err := c.iam.GetPolicyRequest(&iam.GetPolicyInput{
 		PolicyArn: aws.String(policyARN),
-	}).Send(context.TODO())
+	}).Send(ctx)
 
 	if err != nil {
 		return "", err
@@ -89,27 +101,27 @@ func (c *iamClient) GetPolicyVersion(username string) (string, error) {
 }
 
 // UpdatePolicy - updates the policy document for the IAM user and return current policy version
-func (c *iamClient) UpdatePolicy(policyName string, policyDocument string) (string, error) {
-	policyARN, err := c.getPolicyARN(policyName)
+func (c *iamClient) UpdatePolicy(ctx context.Context, policyName string, policyDocument string) (string, error) {
+	policyARN, err := c.GetPolicyARN(ctx, policyName)
 	if err != nil {
 		return "", err
 	}
 	// Create a new policy version
-	policyVersionResponse, err := c.iam.CreatePolicyVersionRequest(&iam.CreatePolicyVersionInput{PolicyArn: aws.String(policyARN), PolicyDocument: aws.String(policyDocument), SetAsDefault: aws.Bool(true)}).Send(context.TODO())
+	policyVersionResponse, err := c.iam.CreatePolicyVersionRequest(&iam.CreatePolicyVersionInput{PolicyArn: aws.String(policyARN), PolicyDocument: aws.String(policyDocument), SetAsDefault: aws.Bool(true)}).Send(ctx)
 	if err != nil {
 		return "", err
 	}
 
 	currentPolicyVersion := policyVersionResponse.PolicyVersion.VersionId
 	// Delete old versions of policy - Max 5 allowed
-	policyVersions, err := c.iam.ListPolicyVersionsRequest(&iam.ListPolicyVersionsInput{PolicyArn: aws.String(policyARN)}).Send(context.TODO())
+	policyVersions, err := c.iam.ListPolicyVersionsRequest(&iam.ListPolicyVersionsInput{PolicyArn: aws.String(policyARN)}).Send(ctx)
 	if err != nil {
 		return "", err
 	}
 
 	for _, policy := range policyVersions.Versions {
 		if aws.StringValue(policy.VersionId) != aws.StringValue(currentPolicyVersion) {
-			_, err := c.iam.DeletePolicyVersionRequest(&iam.DeletePolicyVersionInput{PolicyArn: aws.String(policyARN), VersionId: policy.VersionId}).Send(context.TODO())
+			_, err := c.iam.DeletePolicyVersionRequest(&iam.DeletePolicyVersionInput{PolicyArn: aws.String(policyARN), VersionId: policy.VersionId}).Send(ctx)
 			if err != nil {
 				return "", err
 			}
@@ -120,39 +132,103 @@ func (c *iamClient) UpdatePolicy(policyName string, policyDocument string) (stri
 }
 
 // DeletePolicyAndDetach delete the policy of PolicyName and detach it from the username provided
-func (c *iamClient) DeletePolicyAndDetach(username string, policyName string) error {
-	policyARN, err := c.getPolicyARN(username)
+func (c *iamClient) DeletePolicyAndDetach(ctx context.Context, username string, policyName string) error {
+	policyARN, err := c.GetPolicyARN(ctx, username)
 	if err != nil {
 		return err
 	}
 
-	_, err = c.iam.DetachUserPolicyRequest(&iam.DetachUserPolicyInput{PolicyArn: aws.String(policyARN), UserName: aws.String(username)}).Send(context.TODO())
+	_, err = c.iam.DetachUserPolicyRequest(&iam.DetachUserPolicyInput{PolicyArn: aws.String(policyARN), UserName: aws.String(username)}).Send(ctx)
 	if resource.Ignore(IsErrorNotFound, err) != nil {
 		return err
 	}
 
-	_, err = c.iam.DeletePolicyRequest(&iam.DeletePolicyInput{PolicyArn: aws.String(policyARN)}).Send(context.TODO())
+	_, err = c.iam.DeletePolicyRequest(&iam.DeletePolicyInput{PolicyArn: aws.String(policyARN)}).Send(ctx)
 	if resource.Ignore(IsErrorNotFound, err) != nil {
 		return err
 	}
 	return nil
 }
 
-// DeleteUser Policy and IAM User
-func (c *iamClient) DeleteUser(username string) error {
-	keys, err := c.iam.ListAccessKeysRequest(&iam.ListAccessKeysInput{UserName: aws.String(username)}).Send(context.TODO())
+// CreatePolicy - Creates the IAM policy, or updates it if it already exists, and
+// returns its current version.
+func (c *iamClient) CreatePolicy(ctx context.Context, policyName string, policyDocument string) (string, error) {
+	currentVersion, err := c.createPolicy(ctx, policyName, policyDocument)
 	if err != nil {
-		return err
+		return "", fmt.Errorf("failed to create policy, %s", err)
 	}
+	return currentVersion, nil
+}
 
-	for _, key := range keys.AccessKeyMetadata {
-		_, err = c.iam.DeleteAccessKeyRequest(&iam.DeleteAccessKeyInput{AccessKeyId: key.AccessKeyId, UserName: aws.String(username)}).Send(context.TODO())
+// GetPolicyRoles returns the names of the roles the IAM policy is attached to.
+func (c *iamClient) GetPolicyRoles(ctx context.Context, policyName string) ([]string, error) {
+	policyARN, err := c.GetPolicyARN(ctx, policyName)
+	if err != nil {
+		return nil, err
+	}
+	var roles []string
+	input := &iam.ListEntitiesForPolicyInput{PolicyArn: aws.String(policyARN), EntityFilter: iam.EntityTypeRole}
+	for {
+		rsp, err := c.iam.ListEntitiesForPolicyRequest(input).Send(ctx)
 		if err != nil {
+			return nil, err
+		}
+		for _, r := range rsp.PolicyRoles {
+			roles = append(roles, aws.StringValue(r.RoleName))
+		}
+		if !aws.BoolValue(rsp.IsTruncated) {
+			return roles, nil
+		}
+		input.Marker = rsp.Marker
+	}
+}
+
+// AttachPolicyToRole attaches the IAM policy to the role.
+func (c *iamClient) AttachPolicyToRole(ctx context.Context, policyName string, roleName string) error {
+	policyARN, err := c.GetPolicyARN(ctx, policyName)
+	if err != nil {
+		return err
+	}
+	_, err = c.iam.AttachRolePolicyRequest(&iam.AttachRolePolicyInput{PolicyArn: aws.String(policyARN), RoleName: aws.String(roleName)}).Send(ctx)
+	return err
+}
+
+// DetachPolicyFromRole detaches the IAM policy from the role.
+func (c *iamClient) DetachPolicyFromRole(ctx context.Context, policyName string, roleName string) error {
+	policyARN, err := c.GetPolicyARN(ctx, policyName)
+	if err != nil {
+		return err
+	}
+	_, err = c.iam.DetachRolePolicyRequest(&iam.DetachRolePolicyInput{PolicyArn: aws.String(policyARN), RoleName: aws.String(roleName)}).Send(ctx)
+	return resource.Ignore(IsErrorNotFound, err)
+}
+
+// DeletePolicy detaches the IAM policy from all roles and deletes it.
+func (c *iamClient) DeletePolicy(ctx context.Context, policyName string) error {
+	roles, err := c.GetPolicyRoles(ctx, policyName)
+	if err != nil {
+		return resource.Ignore(IsErrorNotFound, err)
+	}
+	for _, r := range roles {
+		if err := c.DetachPolicyFromRole(ctx, policyName, r); err != nil {
 			return err
 		}
 	}
+	policyARN, err := c.GetPolicyARN(ctx, policyName)
+	if err != nil {
+		return err
+	}
+	_, err = c.iam.DeletePolicyRequest(&iam.DeletePolicyInput{PolicyArn: aws.String(policyARN)}).Send(ctx)
+	return resource.Ignore(IsErrorNotFound, err)
+}
+
+// DeleteUser Policy and IAM User
+func (c *iamClient) DeleteUser(ctx context.Context, username string) error {
+	if err := c.deleteAccessKeys(ctx, username); err != nil {
+		return err
+	}
 
-	_, err = c.iam.DeleteUserRequest(&iam.DeleteUserInput{UserName: aws.String(username)}).Send(context.TODO())
+	_, err := c.iam.DeleteUserRequest(&iam.DeleteUserInput{UserName: aws.String(username)}).Send(ctx)
 	if resource.Ignore(IsErrorNotFound, err) != nil {
 		return err
 	}
@@ -160,9 +236,9 @@ func (c *iamClient) DeleteUser(username string) error {
 }
 
 // getAccountID - Gets the accountID of the authenticated session.
-func (c *iamClient) getAccountID() (string, error) {
+func (c *iamClient) getAccountID(ctx context.Context) (string, error) {
 	if c.accountID == nil {
-		user, err := c.iam.GetUserRequest(&iam.GetUserInput{}).Send(context.TODO())
+		user, err := c.iam.GetUserRequest(&iam.GetUserInput{}).Send(ctx)
 		if err != nil {
 			return "", err
 		}
@@ -177,8 +253,10 @@ func (c *iamClient) getAccountID() (string, error) {
 	return aws.StringValue(c.accountID), nil
 }
 
-func (c *iamClient) getPolicyARN(policyName string) (string, error) {
-	accountID, err := c.getAccountID()
+// GetPolicyARN returns the ARN of the IAM policy with the supplied name in the
+// account of the authenticated session.
+func (c *iamClient) GetPolicyARN(ctx context.Context, policyName string) (string, error) {
+	accountID, err := c.getAccountID(ctx)
 	if err != nil {
 		return "", err
 	}
@@ -186,16 +264,31 @@ func (c *iamClient) getPolicyARN(policyName string) (string, error) {
 	return policyARN, nil
 }
 
-func (c *iamClient) createUser(username string) error {
-	_, err := c.iam.CreateUserRequest(&iam.CreateUserInput{UserName: aws.String(username)}).Send(context.TODO())
+func (c *iamClient) createUser(ctx context.Context, username string) error {
+	_, err := c.iam.CreateUserRequest(&iam.CreateUserInput{UserName: aws.String(username)}).Send(ctx)
 	if err != nil && isErrorAlreadyExists(err) {
 		return nil
 	}
 	return err
 }
 
-func (c *iamClient) createAccessKey(username string) (*iam.AccessKey, error) {
-	keysResponse, err := c.iam.CreateAccessKeyRequest(&iam.CreateAccessKeyInput{UserName: aws.String(username)}).Send(context.TODO())
+func (c *iamClient) deleteAccessKeys(ctx context.Context, username string) error {
+	keys, err := c.iam.ListAccessKeysRequest(&iam.ListAccessKeysInput{UserName: aws.String(username)}).Send(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys.AccessKeyMetadata {
+		_, err = c.iam.DeleteAccessKeyRequest(&iam.DeleteAccessKeyInput{AccessKeyId: key.AccessKeyId, UserName: aws.String(username)}).Send(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *iamClient) createAccessKey(ctx context.Context, username string) (*iam.AccessKey, error) {
+	keysResponse, err := c.iam.CreateAccessKeyRequest(&iam.CreateAccessKeyInput{UserName: aws.String(username)}).Send(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -203,23 +296,23 @@ func (c *iamClient) createAccessKey(username string) (*iam.AccessKey, error) {
 	return keysResponse.AccessKey, nil
 }
 
-func (c *iamClient) createPolicy(policyName string, policyDocument string) (string, error) {
-	response, err := c.iam.CreatePolicyRequest(&iam.CreatePolicyInput{PolicyName: aws.String(policyName), PolicyDocument: aws.String(policyDocument)}).Send(context.TODO())
+func (c *iamClient) createPolicy(ctx context.Context, policyName string, policyDocument string) (string, error) {
+	response, err := c.iam.CreatePolicyRequest(&iam.CreatePolicyInput{PolicyName: aws.String(policyName), PolicyDocument: aws.String(policyDocument)}).Send(ctx)
 	if err != nil {
 		if isErrorAlreadyExists(err) {
-			return c.UpdatePolicy(policyName, policyDocument)
+			return c.UpdatePolicy(ctx, policyName, policyDocument)
 		}
 		return "", err
 	}
 	return aws.StringValue(response.Policy.DefaultVersionId), nil
 }
 
-func (c *iamClient) attachPolicyToUser(policyName string, username string) error {
-	policyArn, err := c.getPolicyARN(policyName)
+func (c *iamClient) attachPolicyToUser(ctx context.Context, policyName string, username string) error {
+	policyArn, err := c.GetPolicyARN(ctx, policyName)
 	if err != nil {
 		return err
 	}
-	_, err = c.iam.AttachUserPolicyRequest(&iam.AttachUserPolicyInput{PolicyArn: aws.String(policyArn), UserName: aws.String(username)}).Send(context.TODO())
+	_, err = c.iam.AttachUserPolicyRequest(&iam.AttachUserPolicyInput{PolicyArn: aws.String(policyArn), UserName: aws.String(username)}).Send(ctx)
 	return err
 }
 
@@ -0,0 +1,78 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/route53"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.HostedZoneClient = (*MockHostedZoneClient)(nil)
+
+// MockHostedZoneClient is a type that implements all the methods for HostedZoneClient interface
+type MockHostedZoneClient struct {
+	MockCreateHostedZoneRequest              func(*route53.CreateHostedZoneInput) route53.CreateHostedZoneRequest
+	MockGetHostedZoneRequest                 func(*route53.GetHostedZoneInput) route53.GetHostedZoneRequest
+	MockUpdateHostedZoneCommentRequest       func(*route53.UpdateHostedZoneCommentInput) route53.UpdateHostedZoneCommentRequest
+	MockDeleteHostedZoneRequest              func(*route53.DeleteHostedZoneInput) route53.DeleteHostedZoneRequest
+	MockAssociateVPCWithHostedZoneRequest    func(*route53.AssociateVPCWithHostedZoneInput) route53.AssociateVPCWithHostedZoneRequest
+	MockDisassociateVPCFromHostedZoneRequest func(*route53.DisassociateVPCFromHostedZoneInput) route53.DisassociateVPCFromHostedZoneRequest
+	MockListTagsForResourceRequest           func(*route53.ListTagsForResourceInput) route53.ListTagsForResourceRequest
+	MockChangeTagsForResourceRequest         func(*route53.ChangeTagsForResourceInput) route53.ChangeTagsForResourceRequest
+}
+
+// CreateHostedZoneRequest mocks CreateHostedZoneRequest method
+func (m *MockHostedZoneClient) CreateHostedZoneRequest(input *route53.CreateHostedZoneInput) route53.CreateHostedZoneRequest {
+	return m.MockCreateHostedZoneRequest(input)
+}
+
+// GetHostedZoneRequest mocks GetHostedZoneRequest method
+func (m *MockHostedZoneClient) GetHostedZoneRequest(input *route53.GetHostedZoneInput) route53.GetHostedZoneRequest {
+	return m.MockGetHostedZoneRequest(input)
+}
+
+// UpdateHostedZoneCommentRequest mocks UpdateHostedZoneCommentRequest method
+func (m *MockHostedZoneClient) UpdateHostedZoneCommentRequest(input *route53.UpdateHostedZoneCommentInput) route53.UpdateHostedZoneCommentRequest {
+	return m.MockUpdateHostedZoneCommentRequest(input)
+}
+
+// DeleteHostedZoneRequest mocks DeleteHostedZoneRequest method
+func (m *MockHostedZoneClient) DeleteHostedZoneRequest(input *route53.DeleteHostedZoneInput) route53.DeleteHostedZoneRequest {
+	return m.MockDeleteHostedZoneRequest(input)
+}
+
+// AssociateVPCWithHostedZoneRequest mocks AssociateVPCWithHostedZoneRequest method
+func (m *MockHostedZoneClient) AssociateVPCWithHostedZoneRequest(input *route53.AssociateVPCWithHostedZoneInput) route53.AssociateVPCWithHostedZoneRequest {
+	return m.MockAssociateVPCWithHostedZoneRequest(input)
+}
+
+// DisassociateVPCFromHostedZoneRequest mocks DisassociateVPCFromHostedZoneRequest method
+func (m *MockHostedZoneClient) DisassociateVPCFromHostedZoneRequest(input *route53.DisassociateVPCFromHostedZoneInput) route53.DisassociateVPCFromHostedZoneRequest {
+	return m.MockDisassociateVPCFromHostedZoneRequest(input)
+}
+
+// ListTagsForResourceRequest mocks ListTagsForResourceRequest method
+func (m *MockHostedZoneClient) ListTagsForResourceRequest(input *route53.ListTagsForResourceInput) route53.ListTagsForResourceRequest {
+	return m.MockListTagsForResourceRequest(input)
+}
+
+// ChangeTagsForResourceRequest mocks ChangeTagsForResourceRequest method
+func (m *MockHostedZoneClient) ChangeTagsForResourceRequest(input *route53.ChangeTagsForResourceInput) route53.ChangeTagsForResourceRequest {
+	return m.MockChangeTagsForResourceRequest(input)
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/route53"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ResourceRecordSetClient = (*MockResourceRecordSetClient)(nil)
+
+// MockResourceRecordSetClient is a type that implements all the methods for ResourceRecordSetClient interface
+type MockResourceRecordSetClient struct {
+	MockListResourceRecordSetsRequest   func(*route53.ListResourceRecordSetsInput) route53.ListResourceRecordSetsRequest
+	MockChangeResourceRecordSetsRequest func(*route53.ChangeResourceRecordSetsInput) route53.ChangeResourceRecordSetsRequest
+	MockGetChangeRequest                func(*route53.GetChangeInput) route53.GetChangeRequest
+}
+
+// ListResourceRecordSetsRequest mocks ListResourceRecordSetsRequest method
+func (m *MockResourceRecordSetClient) ListResourceRecordSetsRequest(input *route53.ListResourceRecordSetsInput) route53.ListResourceRecordSetsRequest {
+	return m.MockListResourceRecordSetsRequest(input)
+}
+
+// ChangeResourceRecordSetsRequest mocks ChangeResourceRecordSetsRequest method
+func (m *MockResourceRecordSetClient) ChangeResourceRecordSetsRequest(input *route53.ChangeResourceRecordSetsInput) route53.ChangeResourceRecordSetsRequest {
+	return m.MockChangeResourceRecordSetsRequest(input)
+}
+
+// GetChangeRequest mocks GetChangeRequest method
+func (m *MockResourceRecordSetClient) GetChangeRequest(input *route53.GetChangeInput) route53.GetChangeRequest {
+	return m.MockGetChangeRequest(input)
+}
@@ -0,0 +1,163 @@
+package route53
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+
+	"github.com/crossplane/provider-aws/apis/dns/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// hostedZoneIDPrefix is the prefix of the hosted zone IDs returned by
+	// Route53.
+	hostedZoneIDPrefix = "/hostedzone/"
+)
+
+// HostedZoneClient is the external client used for HostedZone Custom Resource
+type HostedZoneClient interface {
+	CreateHostedZoneRequest(*route53.CreateHostedZoneInput) route53.CreateHostedZoneRequest
+	GetHostedZoneRequest(*route53.GetHostedZoneInput) route53.GetHostedZoneRequest
+	UpdateHostedZoneCommentRequest(*route53.UpdateHostedZoneCommentInput) route53.UpdateHostedZoneCommentRequest
+	DeleteHostedZoneRequest(*route53.DeleteHostedZoneInput) route53.DeleteHostedZoneRequest
+	AssociateVPCWithHostedZoneRequest(*route53.AssociateVPCWithHostedZoneInput) route53.AssociateVPCWithHostedZoneRequest
+	DisassociateVPCFromHostedZoneRequest(*route53.DisassociateVPCFromHostedZoneInput) route53.DisassociateVPCFromHostedZoneRequest
+	ListTagsForResourceRequest(*route53.ListTagsForResourceInput) route53.ListTagsForResourceRequest
+	ChangeTagsForResourceRequest(*route53.ChangeTagsForResourceInput) route53.ChangeTagsForResourceRequest
+}
+
+// NewHostedZoneClient returns a new client using AWS credentials as JSON encoded data.
+func NewHostedZoneClient(conf *aws.Config) (HostedZoneClient, error) {
+	return route53.New(*conf), nil
+}
+
+// IsHostedZoneNotFoundErr returns true if the error is because the item doesn't exist
+func IsHostedZoneNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == route53.ErrCodeNoSuchHostedZone {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TrimHostedZoneID returns the ID of a hosted zone without the /hostedzone/
+// prefix Route53 adds to it.
+func TrimHostedZoneID(id string) string {
+	return strings.TrimPrefix(id, hostedZoneIDPrefix)
+}
+
+// GenerateCreateHostedZoneInput returns the input to create a HostedZone.
+// Private hosted zones are created with their first VPC, the rest are
+// associated afterwards.
+func GenerateCreateHostedZoneInput(p v1alpha1.HostedZoneParameters, callerReference, region string) *route53.CreateHostedZoneInput {
+	in := &route53.CreateHostedZoneInput{
+		Name:            aws.String(p.Name),
+		CallerReference: aws.String(callerReference),
+		DelegationSetId: p.DelegationSetID,
+		HostedZoneConfig: &route53.HostedZoneConfig{
+			Comment: p.Comment,
+		},
+	}
+	if len(p.VPCIDs) > 0 {
+		in.HostedZoneConfig.PrivateZone = aws.Bool(true)
+		in.VPC = &route53.VPC{
+			VPCId:     aws.String(p.VPCIDs[0]),
+			VPCRegion: route53.VPCRegion(region),
+		}
+	}
+	return in
+}
+
+// GenerateHostedZoneObservation is used to produce
+// v1alpha1.HostedZoneObservation from route53.GetHostedZoneOutput.
+func GenerateHostedZoneObservation(o route53.GetHostedZoneOutput) v1alpha1.HostedZoneObservation {
+	obs := v1alpha1.HostedZoneObservation{}
+	if o.HostedZone != nil {
+		obs.CallerReference = aws.StringValue(o.HostedZone.CallerReference)
+		obs.ResourceRecordSetCount = aws.Int64Value(o.HostedZone.ResourceRecordSetCount)
+		if o.HostedZone.Config != nil {
+			obs.PrivateZone = aws.BoolValue(o.HostedZone.Config.PrivateZone)
+		}
+	}
+	if o.DelegationSet != nil && len(o.DelegationSet.NameServers) > 0 {
+		obs.NameServers = make([]string, len(o.DelegationSet.NameServers))
+		copy(obs.NameServers, o.DelegationSet.NameServers)
+	}
+	return obs
+}
+
+// LateInitializeHostedZone fills the empty fields in
+// *v1alpha1.HostedZoneParameters with the values seen in route53.HostedZone.
+func LateInitializeHostedZone(in *v1alpha1.HostedZoneParameters, hz *route53.HostedZone) {
+	if hz == nil || hz.Config == nil {
+		return
+	}
+	in.Comment = awsclients.LateInitializeStringPtr(in.Comment, hz.Config.Comment)
+}
+
+// DiffHostedZoneVPCs returns the VPCs that need to be associated with and
+// disassociated from the hosted zone. New associations are made in the
+// supplied region.
+func DiffHostedZoneVPCs(p v1alpha1.HostedZoneParameters, observed []route53.VPC, region string) (associate, disassociate []route53.VPC) {
+	o := make(map[string]struct{}, len(observed))
+	for _, v := range observed {
+		o[aws.StringValue(v.VPCId)] = struct{}{}
+	}
+	d := make(map[string]struct{}, len(p.VPCIDs))
+	for _, id := range p.VPCIDs {
+		d[id] = struct{}{}
+		if _, ok := o[id]; !ok {
+			associate = append(associate, route53.VPC{VPCId: aws.String(id), VPCRegion: route53.VPCRegion(region)})
+		}
+	}
+	for _, v := range observed {
+		if _, ok := d[aws.StringValue(v.VPCId)]; !ok {
+			disassociate = append(disassociate, v)
+		}
+	}
+	return associate, disassociate
+}
+
+// DiffHostedZoneTags returns the tags that need to be added to the hosted
+// zone and the keys of the ones that need to be removed.
+func DiffHostedZoneTags(desired []v1alpha1.Tag, observed []route53.Tag) (add []route53.Tag, remove []string) {
+	o := make(map[string]string, len(observed))
+	for _, t := range observed {
+		o[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	d := make(map[string]struct{}, len(desired))
+	for _, t := range desired {
+		d[t.Key] = struct{}{}
+		if v, ok := o[t.Key]; !ok || v != t.Value {
+			add = append(add, route53.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)})
+		}
+	}
+	for _, t := range observed {
+		if _, ok := d[aws.StringValue(t.Key)]; !ok {
+			remove = append(remove, aws.StringValue(t.Key))
+		}
+	}
+	sort.Strings(remove)
+	return add, remove
+}
+
+// IsHostedZoneUpToDate returns true if there is no update-able difference
+// between desired and observed state of the resource.
+func IsHostedZoneUpToDate(p v1alpha1.HostedZoneParameters, o route53.GetHostedZoneOutput, tags []route53.Tag) bool {
+	if o.HostedZone != nil && o.HostedZone.Config != nil &&
+		aws.StringValue(p.Comment) != aws.StringValue(o.HostedZone.Config.Comment) {
+		return false
+	}
+	associate, disassociate := DiffHostedZoneVPCs(p, o.VPCs, "")
+	if len(associate) > 0 || len(disassociate) > 0 {
+		return false
+	}
+	add, remove := DiffHostedZoneTags(p.Tags, tags)
+	return len(add) == 0 && len(remove) == 0
+}
@@ -0,0 +1,149 @@
+package route53
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/dns/v1alpha1"
+)
+
+func TestGenerateCreateHostedZoneInput(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.HostedZoneParameters
+		want *route53.CreateHostedZoneInput
+	}{
+		"Public": {
+			in: v1alpha1.HostedZoneParameters{Name: "example.com", Comment: aws.String("c")},
+			want: &route53.CreateHostedZoneInput{
+				Name:             aws.String("example.com"),
+				CallerReference:  aws.String("uid"),
+				HostedZoneConfig: &route53.HostedZoneConfig{Comment: aws.String("c")},
+			},
+		},
+		"Private": {
+			in: v1alpha1.HostedZoneParameters{Name: "example.internal", VPCIDs: []string{"vpc-1", "vpc-2"}},
+			want: &route53.CreateHostedZoneInput{
+				Name:             aws.String("example.internal"),
+				CallerReference:  aws.String("uid"),
+				HostedZoneConfig: &route53.HostedZoneConfig{PrivateZone: aws.Bool(true)},
+				VPC:              &route53.VPC{VPCId: aws.String("vpc-1"), VPCRegion: route53.VPCRegionUsEast1},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCreateHostedZoneInput(tc.in, "uid", "us-east-1")
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffHostedZoneVPCs(t *testing.T) {
+	observed := []route53.VPC{
+		{VPCId: aws.String("vpc-1"), VPCRegion: route53.VPCRegionUsEast1},
+		{VPCId: aws.String("vpc-2"), VPCRegion: route53.VPCRegionEuWest1},
+	}
+	cases := map[string]struct {
+		vpcs         []string
+		associate    []route53.VPC
+		disassociate []route53.VPC
+	}{
+		"UpToDate": {
+			vpcs: []string{"vpc-1", "vpc-2"},
+		},
+		"Replace": {
+			vpcs:         []string{"vpc-1", "vpc-3"},
+			associate:    []route53.VPC{{VPCId: aws.String("vpc-3"), VPCRegion: route53.VPCRegionUsEast1}},
+			disassociate: []route53.VPC{{VPCId: aws.String("vpc-2"), VPCRegion: route53.VPCRegionEuWest1}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			associate, disassociate := DiffHostedZoneVPCs(v1alpha1.HostedZoneParameters{VPCIDs: tc.vpcs}, observed, "us-east-1")
+			if diff := cmp.Diff(tc.associate, associate); diff != "" {
+				t.Errorf("associate: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.disassociate, disassociate); diff != "" {
+				t.Errorf("disassociate: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffHostedZoneTags(t *testing.T) {
+	cases := map[string]struct {
+		desired  []v1alpha1.Tag
+		observed []route53.Tag
+		add      []route53.Tag
+		remove   []string
+	}{
+		"UpToDate": {
+			desired:  []v1alpha1.Tag{{Key: "k", Value: "v"}},
+			observed: []route53.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+		},
+		"Changed": {
+			desired:  []v1alpha1.Tag{{Key: "k", Value: "new"}},
+			observed: []route53.Tag{{Key: aws.String("k"), Value: aws.String("v")}, {Key: aws.String("old"), Value: aws.String("v")}},
+			add:      []route53.Tag{{Key: aws.String("k"), Value: aws.String("new")}},
+			remove:   []string{"old"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffHostedZoneTags(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.add, add); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsHostedZoneUpToDate(t *testing.T) {
+	observed := route53.GetHostedZoneOutput{
+		HostedZone: &route53.HostedZone{
+			Config: &route53.HostedZoneConfig{Comment: aws.String("c"), PrivateZone: aws.Bool(true)},
+		},
+		VPCs: []route53.VPC{{VPCId: aws.String("vpc-1")}},
+	}
+	cases := map[string]struct {
+		in   v1alpha1.HostedZoneParameters
+		want bool
+	}{
+		"UpToDate": {
+			in:   v1alpha1.HostedZoneParameters{Comment: aws.String("c"), VPCIDs: []string{"vpc-1"}},
+			want: true,
+		},
+		"CommentChanged": {
+			in:   v1alpha1.HostedZoneParameters{Comment: aws.String("other"), VPCIDs: []string{"vpc-1"}},
+			want: false,
+		},
+		"VPCAdded": {
+			in:   v1alpha1.HostedZoneParameters{Comment: aws.String("c"), VPCIDs: []string{"vpc-1", "vpc-2"}},
+			want: false,
+		},
+		"TagAdded": {
+			in:   v1alpha1.HostedZoneParameters{Comment: aws.String("c"), VPCIDs: []string{"vpc-1"}, Tags: []v1alpha1.Tag{{Key: "k", Value: "v"}}},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsHostedZoneUpToDate(tc.in, observed, nil)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
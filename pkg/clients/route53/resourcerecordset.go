@@ -0,0 +1,204 @@
+package route53
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+
+	"github.com/crossplane/provider-aws/apis/dns/v1alpha1"
+)
+
+// ResourceRecordSetClient is the external client used for ResourceRecordSet
+// Custom Resource
+type ResourceRecordSetClient interface {
+	ListResourceRecordSetsRequest(*route53.ListResourceRecordSetsInput) route53.ListResourceRecordSetsRequest
+	ChangeResourceRecordSetsRequest(*route53.ChangeResourceRecordSetsInput) route53.ChangeResourceRecordSetsRequest
+	GetChangeRequest(*route53.GetChangeInput) route53.GetChangeRequest
+}
+
+// NewResourceRecordSetClient returns a new client using AWS credentials as JSON encoded data.
+func NewResourceRecordSetClient(conf *aws.Config) (ResourceRecordSetClient, error) {
+	return route53.New(*conf), nil
+}
+
+// IsResourceRecordSetAlreadyExistsErr returns true if the error is because a
+// record set could not be created since it already exists.
+func IsResourceRecordSetAlreadyExistsErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == route53.ErrCodeInvalidChangeBatch && strings.Contains(awsErr.Message(), "already exists")
+	}
+	return false
+}
+
+// GenerateExternalName returns the external name of the record set described
+// by the supplied parameters. Record sets have no ID, so the external name is
+// made of the name, type and, if any, set identifier of the record set,
+// separated by slashes. Slashes in the name are escaped the way Route53
+// escapes them.
+func GenerateExternalName(p v1alpha1.ResourceRecordSetParameters) string {
+	n := strings.ReplaceAll(p.Name, "/", `\057`) + "/" + p.Type
+	if p.SetIdentifier != nil {
+		n += "/" + aws.StringValue(p.SetIdentifier)
+	}
+	return n
+}
+
+// ParseExternalName returns the supplied parameters with the name, type and
+// set identifier of the record set with the supplied external name.
+func ParseExternalName(p v1alpha1.ResourceRecordSetParameters, externalName string) v1alpha1.ResourceRecordSetParameters {
+	parts := strings.SplitN(externalName, "/", 3)
+	p.Name = parts[0]
+	if len(parts) > 1 {
+		p.Type = parts[1]
+		p.SetIdentifier = nil
+	}
+	if len(parts) > 2 {
+		p.SetIdentifier = aws.String(parts[2])
+	}
+	return p
+}
+
+// IsSameResourceRecordSet returns true if both parameters describe the record
+// set with the same name, type and set identifier.
+func IsSameResourceRecordSet(a, b v1alpha1.ResourceRecordSetParameters) bool {
+	return normalizeName(a.Name) == normalizeName(b.Name) &&
+		a.Type == b.Type &&
+		aws.StringValue(a.SetIdentifier) == aws.StringValue(b.SetIdentifier)
+}
+
+// GenerateListResourceRecordSetsInput returns the input to list the record
+// sets of the hosted zone starting with the one described by the supplied
+// parameters.
+func GenerateListResourceRecordSetsInput(p v1alpha1.ResourceRecordSetParameters) *route53.ListResourceRecordSetsInput {
+	return &route53.ListResourceRecordSetsInput{
+		HostedZoneId:          p.HostedZoneID,
+		StartRecordName:       aws.String(p.Name),
+		StartRecordType:       route53.RRType(p.Type),
+		StartRecordIdentifier: p.SetIdentifier,
+		MaxItems:              aws.String("1"),
+	}
+}
+
+// FindResourceRecordSet returns the record set described by the supplied
+// parameters among the observed ones, or nil if there is none.
+func FindResourceRecordSet(p v1alpha1.ResourceRecordSetParameters, observed []route53.ResourceRecordSet) *route53.ResourceRecordSet {
+	for i := range observed {
+		rrs := &observed[i]
+		if normalizeName(aws.StringValue(rrs.Name)) == normalizeName(p.Name) &&
+			string(rrs.Type) == p.Type &&
+			aws.StringValue(rrs.SetIdentifier) == aws.StringValue(p.SetIdentifier) {
+			return rrs
+		}
+	}
+	return nil
+}
+
+// GenerateResourceRecordSet returns the route53.ResourceRecordSet described
+// by the supplied parameters.
+func GenerateResourceRecordSet(p v1alpha1.ResourceRecordSetParameters) *route53.ResourceRecordSet {
+	rrs := &route53.ResourceRecordSet{
+		Name:          aws.String(p.Name),
+		Type:          route53.RRType(p.Type),
+		SetIdentifier: p.SetIdentifier,
+		Weight:        p.Weight,
+		TTL:           p.TTL,
+	}
+	if len(p.ResourceRecords) > 0 {
+		rrs.ResourceRecords = make([]route53.ResourceRecord, len(p.ResourceRecords))
+		for i, r := range p.ResourceRecords {
+			rrs.ResourceRecords[i] = route53.ResourceRecord{Value: r.Value}
+		}
+	}
+	if p.AliasTarget != nil {
+		rrs.AliasTarget = &route53.AliasTarget{
+			DNSName:              aws.String(p.AliasTarget.DNSName),
+			HostedZoneId:         aws.String(p.AliasTarget.HostedZoneID),
+			EvaluateTargetHealth: aws.Bool(p.AliasTarget.EvaluateTargetHealth),
+		}
+	}
+	return rrs
+}
+
+// GenerateChangeResourceRecordSetsInput returns the input to apply the
+// supplied action to the record set in the hosted zone.
+func GenerateChangeResourceRecordSetsInput(hostedZoneID string, action route53.ChangeAction, rrs *route53.ResourceRecordSet) *route53.ChangeResourceRecordSetsInput {
+	return &route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: aws.String(hostedZoneID),
+		ChangeBatch: &route53.ChangeBatch{
+			Changes: []route53.Change{{
+				Action:            action,
+				ResourceRecordSet: rrs,
+			}},
+		},
+	}
+}
+
+// GenerateResourceRecordSetObservation is used to produce
+// v1alpha1.ResourceRecordSetObservation from route53.ChangeInfo.
+func GenerateResourceRecordSetObservation(ci *route53.ChangeInfo) v1alpha1.ResourceRecordSetObservation {
+	if ci == nil {
+		return v1alpha1.ResourceRecordSetObservation{}
+	}
+	return v1alpha1.ResourceRecordSetObservation{
+		ChangeID:     aws.StringValue(ci.Id),
+		ChangeStatus: string(ci.Status),
+	}
+}
+
+// IsResourceRecordSetUpToDate returns true if there is no update-able
+// difference between desired and observed state of the resource.
+func IsResourceRecordSetUpToDate(p v1alpha1.ResourceRecordSetParameters, rrs route53.ResourceRecordSet) bool {
+	if aws.Int64Value(p.TTL) != aws.Int64Value(rrs.TTL) || aws.Int64Value(p.Weight) != aws.Int64Value(rrs.Weight) {
+		return false
+	}
+
+	desired := make([]string, len(p.ResourceRecords))
+	for i, r := range p.ResourceRecords {
+		desired[i] = aws.StringValue(r.Value)
+	}
+	observed := make([]string, len(rrs.ResourceRecords))
+	for i, r := range rrs.ResourceRecords {
+		observed[i] = aws.StringValue(r.Value)
+	}
+	sort.Strings(desired)
+	sort.Strings(observed)
+	if strings.Join(desired, "\n") != strings.Join(observed, "\n") {
+		return false
+	}
+
+	switch {
+	case p.AliasTarget == nil && rrs.AliasTarget == nil:
+		return true
+	case p.AliasTarget == nil || rrs.AliasTarget == nil:
+		return false
+	}
+	return normalizeName(p.AliasTarget.DNSName) == normalizeName(aws.StringValue(rrs.AliasTarget.DNSName)) &&
+		p.AliasTarget.HostedZoneID == aws.StringValue(rrs.AliasTarget.HostedZoneId) &&
+		p.AliasTarget.EvaluateTargetHealth == aws.BoolValue(rrs.AliasTarget.EvaluateTargetHealth)
+}
+
+// normalizeName returns the supplied domain name the way Route53 returns it;
+// lower case, fully qualified and with the octal escape codes Route53 uses
+// for characters such as * decoded.
+func normalizeName(name string) string {
+	var b strings.Builder
+	for i := 0; i < len(name); i++ {
+		if name[i] == '\\' && i+4 <= len(name) {
+			if c, err := strconv.ParseUint(name[i+1:i+4], 8, 8); err == nil {
+				b.WriteByte(byte(c))
+				i += 3
+				continue
+			}
+		}
+		b.WriteByte(name[i])
+	}
+	n := strings.ToLower(b.String())
+	if !strings.HasSuffix(n, ".") {
+		n += "."
+	}
+	return n
+}
@@ -0,0 +1,177 @@
+package route53
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/dns/v1alpha1"
+)
+
+func rrsParams(m ...func(*v1alpha1.ResourceRecordSetParameters)) v1alpha1.ResourceRecordSetParameters {
+	p := v1alpha1.ResourceRecordSetParameters{
+		HostedZoneID: aws.String("Z1"),
+		Name:         "db.example.com",
+		Type:         "CNAME",
+		TTL:          aws.Int64(300),
+		ResourceRecords: []v1alpha1.ResourceRecord{
+			{Value: aws.String("a.example.com")},
+			{Value: aws.String("b.example.com")},
+		},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func rrs(m ...func(*route53.ResourceRecordSet)) route53.ResourceRecordSet {
+	o := route53.ResourceRecordSet{
+		Name: aws.String("db.example.com."),
+		Type: route53.RRTypeCname,
+		TTL:  aws.Int64(300),
+		ResourceRecords: []route53.ResourceRecord{
+			{Value: aws.String("b.example.com")},
+			{Value: aws.String("a.example.com")},
+		},
+	}
+	for _, f := range m {
+		f(&o)
+	}
+	return o
+}
+
+func TestFindResourceRecordSet(t *testing.T) {
+	cases := map[string]struct {
+		p        v1alpha1.ResourceRecordSetParameters
+		observed []route53.ResourceRecordSet
+		found    bool
+	}{
+		"Found": {
+			p:        rrsParams(),
+			observed: []route53.ResourceRecordSet{rrs()},
+			found:    true,
+		},
+		"EscapedWildcard": {
+			p: rrsParams(func(p *v1alpha1.ResourceRecordSetParameters) { p.Name = "*.Example.com" }),
+			observed: []route53.ResourceRecordSet{rrs(func(r *route53.ResourceRecordSet) {
+				r.Name = aws.String(`\052.example.com.`)
+			})},
+			found: true,
+		},
+		"NextRecordSet": {
+			p: rrsParams(),
+			observed: []route53.ResourceRecordSet{rrs(func(r *route53.ResourceRecordSet) {
+				r.Name = aws.String("dc.example.com.")
+			})},
+			found: false,
+		},
+		"OtherSetIdentifier": {
+			p: rrsParams(func(p *v1alpha1.ResourceRecordSetParameters) { p.SetIdentifier = aws.String("blue") }),
+			observed: []route53.ResourceRecordSet{rrs(func(r *route53.ResourceRecordSet) {
+				r.SetIdentifier = aws.String("green")
+			})},
+			found: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := FindResourceRecordSet(tc.p, tc.observed)
+			if diff := cmp.Diff(tc.found, got != nil); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsResourceRecordSetUpToDate(t *testing.T) {
+	alias := func(p *v1alpha1.ResourceRecordSetParameters) {
+		p.TTL = nil
+		p.ResourceRecords = nil
+		p.AliasTarget = &v1alpha1.AliasTarget{DNSName: "lb.amazonaws.com", HostedZoneID: "Z2"}
+	}
+	cases := map[string]struct {
+		p    v1alpha1.ResourceRecordSetParameters
+		rrs  route53.ResourceRecordSet
+		want bool
+	}{
+		"UpToDate": {
+			p:    rrsParams(),
+			rrs:  rrs(),
+			want: true,
+		},
+		"TTLChanged": {
+			p:    rrsParams(func(p *v1alpha1.ResourceRecordSetParameters) { p.TTL = aws.Int64(60) }),
+			rrs:  rrs(),
+			want: false,
+		},
+		"ValueChanged": {
+			p: rrsParams(func(p *v1alpha1.ResourceRecordSetParameters) {
+				p.ResourceRecords = []v1alpha1.ResourceRecord{{Value: aws.String("a.example.com")}}
+			}),
+			rrs:  rrs(),
+			want: false,
+		},
+		"AliasUpToDate": {
+			p: rrsParams(alias),
+			rrs: route53.ResourceRecordSet{
+				AliasTarget: &route53.AliasTarget{DNSName: aws.String("lb.amazonaws.com."), HostedZoneId: aws.String("Z2"), EvaluateTargetHealth: aws.Bool(false)},
+			},
+			want: true,
+		},
+		"AliasAdded": {
+			p:    rrsParams(alias),
+			rrs:  rrs(),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsResourceRecordSetUpToDate(tc.p, tc.rrs)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestExternalName(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.ResourceRecordSetParameters
+		want string
+	}{
+		"NameAndType": {
+			p:    rrsParams(),
+			want: "db.example.com/CNAME",
+		},
+		"SetIdentifier": {
+			p:    rrsParams(func(p *v1alpha1.ResourceRecordSetParameters) { p.SetIdentifier = aws.String("blue/green") }),
+			want: "db.example.com/CNAME/blue/green",
+		},
+		"SlashInName": {
+			p:    rrsParams(func(p *v1alpha1.ResourceRecordSetParameters) { p.Name = "a/b.example.com" }),
+			want: `a\057b.example.com/CNAME`,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateExternalName(tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateExternalName: -want, +got:\n%s", diff)
+			}
+			parsed := ParseExternalName(rrsParams(func(p *v1alpha1.ResourceRecordSetParameters) {
+				p.Name = "other.example.com"
+				p.Type = "A"
+				p.SetIdentifier = aws.String("other")
+			}), got)
+			if !IsSameResourceRecordSet(tc.p, parsed) {
+				t.Errorf("ParseExternalName(%q): got %s/%s/%s", got, parsed.Name, parsed.Type, aws.StringValue(parsed.SetIdentifier))
+			}
+		})
+	}
+}
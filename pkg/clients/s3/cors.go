@@ -0,0 +1,83 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/storage/v1alpha3"
+)
+
+// CORSNotFoundErrCode is the error code returned by S3 when a bucket has no
+// CORS configuration.
+const CORSNotFoundErrCode = "NoSuchCORSConfiguration"
+
+// IsCORSNotFound returns true if the error indicates that a bucket has no CORS
+// configuration.
+func IsCORSNotFound(err error) bool {
+	return isErrorCode(err, CORSNotFoundErrCode)
+}
+
+// GenerateCORSRules returns the S3 CORS rules described by the supplied
+// configuration.
+func GenerateCORSRules(in *v1alpha3.CORSConfiguration) []s3.CORSRule {
+	if in == nil {
+		return nil
+	}
+	rules := make([]s3.CORSRule, len(in.CORSRules))
+	for i, r := range in.CORSRules {
+		rules[i] = s3.CORSRule{
+			AllowedHeaders: r.AllowedHeaders,
+			AllowedMethods: r.AllowedMethods,
+			AllowedOrigins: r.AllowedOrigins,
+			ExposeHeaders:  r.ExposeHeaders,
+			MaxAgeSeconds:  r.MaxAgeSeconds,
+		}
+	}
+	return rules
+}
+
+// GenerateCORSConfiguration returns the CORS configuration described by the
+// supplied S3 CORS rules.
+func GenerateCORSConfiguration(in []s3.CORSRule) *v1alpha3.CORSConfiguration {
+	if len(in) == 0 {
+		return nil
+	}
+	cfg := &v1alpha3.CORSConfiguration{CORSRules: make([]v1alpha3.CORSRule, len(in))}
+	for i, r := range in {
+		cfg.CORSRules[i] = v1alpha3.CORSRule{
+			AllowedHeaders: r.AllowedHeaders,
+			AllowedMethods: r.AllowedMethods,
+			AllowedOrigins: r.AllowedOrigins,
+			ExposeHeaders:  r.ExposeHeaders,
+			MaxAgeSeconds:  r.MaxAgeSeconds,
+		}
+	}
+	return cfg
+}
+
+// IsCORSConfigurationUpToDate returns true if the observed CORS rules match the
+// desired configuration. CORS rules are considered up to date when no
+// configuration is desired.
+func IsCORSConfigurationUpToDate(desired *v1alpha3.CORSConfiguration, observed []s3.CORSRule) bool {
+	if desired == nil {
+		return true
+	}
+	return cmp.Equal(desired, GenerateCORSConfiguration(observed), cmpopts.EquateEmpty())
+}
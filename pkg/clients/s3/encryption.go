@@ -0,0 +1,84 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/storage/v1alpha3"
+)
+
+// SSENotFoundErrCode is the error code returned by S3 when a bucket has no
+// default encryption configuration.
+const SSENotFoundErrCode = "ServerSideEncryptionConfigurationNotFoundError"
+
+// IsSSENotFound returns true if the error indicates that a bucket has no
+// default encryption configuration.
+func IsSSENotFound(err error) bool {
+	return isErrorCode(err, SSENotFoundErrCode)
+}
+
+// GenerateServerSideEncryption returns the S3 default encryption configuration
+// described by the supplied configuration.
+func GenerateServerSideEncryption(in *v1alpha3.ServerSideEncryptionConfiguration) *s3.ServerSideEncryptionConfiguration {
+	if in == nil {
+		return nil
+	}
+	cfg := &s3.ServerSideEncryptionConfiguration{Rules: make([]s3.ServerSideEncryptionRule, len(in.Rules))}
+	for i, r := range in.Rules {
+		cfg.Rules[i] = s3.ServerSideEncryptionRule{
+			ApplyServerSideEncryptionByDefault: &s3.ServerSideEncryptionByDefault{
+				SSEAlgorithm:   s3.ServerSideEncryption(r.ApplyServerSideEncryptionByDefault.SSEAlgorithm),
+				KMSMasterKeyID: r.ApplyServerSideEncryptionByDefault.KMSMasterKeyID,
+			},
+		}
+	}
+	return cfg
+}
+
+// GenerateServerSideEncryptionConfiguration returns the default encryption
+// configuration described by the supplied S3 configuration.
+func GenerateServerSideEncryptionConfiguration(in *s3.ServerSideEncryptionConfiguration) *v1alpha3.ServerSideEncryptionConfiguration {
+	if in == nil || len(in.Rules) == 0 {
+		return nil
+	}
+	cfg := &v1alpha3.ServerSideEncryptionConfiguration{}
+	for _, r := range in.Rules {
+		if r.ApplyServerSideEncryptionByDefault == nil {
+			continue
+		}
+		cfg.Rules = append(cfg.Rules, v1alpha3.ServerSideEncryptionRule{
+			ApplyServerSideEncryptionByDefault: v1alpha3.ServerSideEncryptionByDefault{
+				SSEAlgorithm:   string(r.ApplyServerSideEncryptionByDefault.SSEAlgorithm),
+				KMSMasterKeyID: r.ApplyServerSideEncryptionByDefault.KMSMasterKeyID,
+			},
+		})
+	}
+	return cfg
+}
+
+// IsServerSideEncryptionUpToDate returns true if the observed default
+// encryption matches the desired configuration. Default encryption is
+// considered up to date when no configuration is desired.
+func IsServerSideEncryptionUpToDate(desired *v1alpha3.ServerSideEncryptionConfiguration, observed *s3.ServerSideEncryptionConfiguration) bool {
+	if desired == nil {
+		return true
+	}
+	return cmp.Equal(desired, GenerateServerSideEncryptionConfiguration(observed), cmpopts.EquateEmpty())
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/storage/v1alpha3"
+)
+
+func sseConfiguration(algorithm string, key *string) *v1alpha3.ServerSideEncryptionConfiguration {
+	return &v1alpha3.ServerSideEncryptionConfiguration{
+		Rules: []v1alpha3.ServerSideEncryptionRule{{
+			ApplyServerSideEncryptionByDefault: v1alpha3.ServerSideEncryptionByDefault{SSEAlgorithm: algorithm, KMSMasterKeyID: key},
+		}},
+	}
+}
+
+func sseRules(algorithm s3.ServerSideEncryption, key *string) *s3.ServerSideEncryptionConfiguration {
+	return &s3.ServerSideEncryptionConfiguration{
+		Rules: []s3.ServerSideEncryptionRule{{
+			ApplyServerSideEncryptionByDefault: &s3.ServerSideEncryptionByDefault{SSEAlgorithm: algorithm, KMSMasterKeyID: key},
+		}},
+	}
+}
+
+func TestGenerateServerSideEncryption(t *testing.T) {
+	cases := map[string]struct {
+		in   *v1alpha3.ServerSideEncryptionConfiguration
+		want *s3.ServerSideEncryptionConfiguration
+	}{
+		"Nil": {},
+		"KMS": {
+			in:   sseConfiguration(string(s3.ServerSideEncryptionAwsKms), aws.String("key")),
+			want: sseRules(s3.ServerSideEncryptionAwsKms, aws.String("key")),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateServerSideEncryption(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateServerSideEncryption(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsServerSideEncryptionUpToDate(t *testing.T) {
+	type args struct {
+		desired  *v1alpha3.ServerSideEncryptionConfiguration
+		observed *s3.ServerSideEncryptionConfiguration
+	}
+
+	cases := map[string]struct {
+		args
+		want bool
+	}{
+		"NotManaged": {
+			args: args{
+				observed: sseRules(s3.ServerSideEncryptionAes256, nil),
+			},
+			want: true,
+		},
+		"UpToDate": {
+			args: args{
+				desired:  sseConfiguration(string(s3.ServerSideEncryptionAwsKms), aws.String("key")),
+				observed: sseRules(s3.ServerSideEncryptionAwsKms, aws.String("key")),
+			},
+			want: true,
+		},
+		"Missing": {
+			args: args{
+				desired: sseConfiguration(string(s3.ServerSideEncryptionAes256), nil),
+			},
+			want: false,
+		},
+		"KeyChanged": {
+			args: args{
+				desired:  sseConfiguration(string(s3.ServerSideEncryptionAwsKms), aws.String("key")),
+				observed: sseRules(s3.ServerSideEncryptionAwsKms, aws.String("other")),
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsServerSideEncryptionUpToDate(tc.args.desired, tc.args.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsServerSideEncryptionUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package fake
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 
 	"github.com/crossplane/provider-aws/apis/storage/v1alpha3"
@@ -25,46 +27,140 @@ import (
 
 // MockS3Client for testing.
 type MockS3Client struct {
-	MockCreateOrUpdateBucket func(bucket *v1alpha3.S3Bucket) error
-	MockGetBucketInfo        func(username string, bucket *v1alpha3.S3Bucket) (*client.Bucket, error)
-	MockCreateUser           func(username string, bucket *v1alpha3.S3Bucket) (*iam.AccessKey, string, error)
-	MockUpdateBucketACL      func(bucket *v1alpha3.S3Bucket) error
-	MockUpdateVersioning     func(bucket *v1alpha3.S3Bucket) error
-	MockUpdatePolicyDocument func(username string, bucket *v1alpha3.S3Bucket) (string, error)
-	MockDelete               func(bucket *v1alpha3.S3Bucket) error
+	MockCreateOrUpdateBucket func(ctx context.Context, bucket *v1alpha3.S3Bucket) error
+	MockGetBucketInfo        func(ctx context.Context, policyName string, bucket *v1alpha3.S3Bucket) (*client.Bucket, error)
+	MockCreateUser           func(ctx context.Context, username string, bucket *v1alpha3.S3Bucket) (*iam.AccessKey, string, error)
+	MockUpdateBucketACL      func(ctx context.Context, bucket *v1alpha3.S3Bucket) error
+	MockUpdateVersioning     func(ctx context.Context, bucket *v1alpha3.S3Bucket) error
+	MockUpdatePolicyDocument func(ctx context.Context, username string, bucket *v1alpha3.S3Bucket) (string, error)
+	MockDelete               func(ctx context.Context, bucket *v1alpha3.S3Bucket) error
+
+	MockUpdateLifecycleConfiguration func(ctx context.Context, bucket *v1alpha3.S3Bucket) error
+	MockUpdateServerSideEncryption   func(ctx context.Context, bucket *v1alpha3.S3Bucket) error
+	MockUpdatePublicAccessBlock      func(ctx context.Context, bucket *v1alpha3.S3Bucket) error
+	MockUpdateBucketPolicy           func(ctx context.Context, bucket *v1alpha3.S3Bucket) error
+	MockUpdateCORS                   func(ctx context.Context, bucket *v1alpha3.S3Bucket) error
+	MockUpdateWebsite                func(ctx context.Context, bucket *v1alpha3.S3Bucket) error
+	MockUpdateLogging                func(ctx context.Context, bucket *v1alpha3.S3Bucket) error
+	MockUpdateReplication            func(ctx context.Context, bucket *v1alpha3.S3Bucket) error
+	MockEmptyBucket                  func(ctx context.Context, bucket *v1alpha3.S3Bucket) (int, error)
+	MockUpdateTags                   func(ctx context.Context, bucket *v1alpha3.S3Bucket) error
+	MockUpdateObjectLock             func(ctx context.Context, bucket *v1alpha3.S3Bucket) error
+	MockUpdateRequestPayer           func(ctx context.Context, bucket *v1alpha3.S3Bucket) error
+	MockCreateAccessPolicy           func(ctx context.Context, policyName string, bucket *v1alpha3.S3Bucket) (string, string, error)
+	MockAttachAccessPolicy           func(ctx context.Context, policyName string, bucket *v1alpha3.S3Bucket) error
+	MockDetachAccessPolicy           func(ctx context.Context, policyName string, roleName string) error
 }
 
 // CreateOrUpdateBucket calls the underlying MockCreateOrUpdateBucket method.
-func (m *MockS3Client) CreateOrUpdateBucket(bucket *v1alpha3.S3Bucket) error {
-	return m.MockCreateOrUpdateBucket(bucket)
+func (m *MockS3Client) CreateOrUpdateBucket(ctx context.Context, bucket *v1alpha3.S3Bucket) error {
+	return m.MockCreateOrUpdateBucket(ctx, bucket)
 }
 
 // GetBucketInfo calls the underlying MockGetBucketInfo method.
-func (m *MockS3Client) GetBucketInfo(username string, bucket *v1alpha3.S3Bucket) (*client.Bucket, error) {
-	return m.MockGetBucketInfo(username, bucket)
+func (m *MockS3Client) GetBucketInfo(ctx context.Context, policyName string, bucket *v1alpha3.S3Bucket) (*client.Bucket, error) {
+	return m.MockGetBucketInfo(ctx, policyName, bucket)
 }
 
 // CreateUser calls the underlying MockCreateUser method.
-func (m *MockS3Client) CreateUser(username string, bucket *v1alpha3.S3Bucket) (*iam.AccessKey, string, error) {
-	return m.MockCreateUser(username, bucket)
+func (m *MockS3Client) CreateUser(ctx context.Context, username string, bucket *v1alpha3.S3Bucket) (*iam.AccessKey, string, error) {
+	return m.MockCreateUser(ctx, username, bucket)
 }
 
 // UpdateBucketACL calls the underlying MockUpdateBucketACL method.
-func (m *MockS3Client) UpdateBucketACL(bucket *v1alpha3.S3Bucket) error {
-	return m.MockUpdateBucketACL(bucket)
+func (m *MockS3Client) UpdateBucketACL(ctx context.Context, bucket *v1alpha3.S3Bucket) error {
+	return m.MockUpdateBucketACL(ctx, bucket)
 }
 
 // UpdateVersioning calls the underlying MockUpdateVersioning method.
-func (m *MockS3Client) UpdateVersioning(bucket *v1alpha3.S3Bucket) error {
-	return m.MockUpdateVersioning(bucket)
+func (m *MockS3Client) UpdateVersioning(ctx context.Context, bucket *v1alpha3.S3Bucket) error {
+	return m.MockUpdateVersioning(ctx, bucket)
 }
 
 // UpdatePolicyDocument calls the underlying MockUpdatePolicyDocument method.
-func (m *MockS3Client) UpdatePolicyDocument(username string, bucket *v1alpha3.S3Bucket) (string, error) {
-	return m.MockUpdatePolicyDocument(username, bucket)
+func (m *MockS3Client) UpdatePolicyDocument(ctx context.Context, username string, bucket *v1alpha3.S3Bucket) (string, error) {
+	return m.MockUpdatePolicyDocument(ctx, username, bucket)
 }
 
 // DeleteBucket calls the underlying MockDeleteBucket method.
-func (m *MockS3Client) DeleteBucket(bucket *v1alpha3.S3Bucket) error {
-	return m.MockDelete(bucket)
+func (m *MockS3Client) DeleteBucket(ctx context.Context, bucket *v1alpha3.S3Bucket) error {
+	return m.MockDelete(ctx, bucket)
+}
+
+// UpdateLifecycleConfiguration calls the underlying
+// MockUpdateLifecycleConfiguration method.
+func (m *MockS3Client) UpdateLifecycleConfiguration(ctx context.Context, bucket *v1alpha3.S3Bucket) error {
+	return m.MockUpdateLifecycleConfiguration(ctx, bucket)
+}
+
+// UpdateServerSideEncryption calls the underlying
+// MockUpdateServerSideEncryption method.
+func (m *MockS3Client) UpdateServerSideEncryption(ctx context.Context, bucket *v1alpha3.S3Bucket) error {
+	return m.MockUpdateServerSideEncryption(ctx, bucket)
+}
+
+// UpdatePublicAccessBlock calls the underlying MockUpdatePublicAccessBlock
+// method.
+func (m *MockS3Client) UpdatePublicAccessBlock(ctx context.Context, bucket *v1alpha3.S3Bucket) error {
+	return m.MockUpdatePublicAccessBlock(ctx, bucket)
+}
+
+// UpdateBucketPolicy calls the underlying MockUpdateBucketPolicy method.
+func (m *MockS3Client) UpdateBucketPolicy(ctx context.Context, bucket *v1alpha3.S3Bucket) error {
+	return m.MockUpdateBucketPolicy(ctx, bucket)
+}
+
+// UpdateCORS calls the underlying MockUpdateCORS method.
+func (m *MockS3Client) UpdateCORS(ctx context.Context, bucket *v1alpha3.S3Bucket) error {
+	return m.MockUpdateCORS(ctx, bucket)
+}
+
+// UpdateWebsite calls the underlying MockUpdateWebsite method.
+func (m *MockS3Client) UpdateWebsite(ctx context.Context, bucket *v1alpha3.S3Bucket) error {
+	return m.MockUpdateWebsite(ctx, bucket)
+}
+
+// UpdateLogging calls the underlying MockUpdateLogging method.
+func (m *MockS3Client) UpdateLogging(ctx context.Context, bucket *v1alpha3.S3Bucket) error {
+	return m.MockUpdateLogging(ctx, bucket)
+}
+
+// UpdateReplication calls the underlying MockUpdateReplication method.
+func (m *MockS3Client) UpdateReplication(ctx context.Context, bucket *v1alpha3.S3Bucket) error {
+	return m.MockUpdateReplication(ctx, bucket)
+}
+
+// EmptyBucket calls the underlying MockEmptyBucket method.
+func (m *MockS3Client) EmptyBucket(ctx context.Context, bucket *v1alpha3.S3Bucket) (int, error) {
+	return m.MockEmptyBucket(ctx, bucket)
+}
+
+// UpdateTags calls the underlying MockUpdateTags method.
+func (m *MockS3Client) UpdateTags(ctx context.Context, bucket *v1alpha3.S3Bucket) error {
+	return m.MockUpdateTags(ctx, bucket)
+}
+
+// UpdateObjectLock calls the underlying MockUpdateObjectLock method.
+func (m *MockS3Client) UpdateObjectLock(ctx context.Context, bucket *v1alpha3.S3Bucket) error {
+	return m.MockUpdateObjectLock(ctx, bucket)
+}
+
+// UpdateRequestPayer calls the underlying MockUpdateRequestPayer method.
+func (m *MockS3Client) UpdateRequestPayer(ctx context.Context, bucket *v1alpha3.S3Bucket) error {
+	return m.MockUpdateRequestPayer(ctx, bucket)
+}
+
+// CreateAccessPolicy calls the underlying MockCreateAccessPolicy method.
+func (m *MockS3Client) CreateAccessPolicy(ctx context.Context, policyName string, bucket *v1alpha3.S3Bucket) (string, string, error) {
+	return m.MockCreateAccessPolicy(ctx, policyName, bucket)
+}
+
+// AttachAccessPolicy calls the underlying MockAttachAccessPolicy method.
+func (m *MockS3Client) AttachAccessPolicy(ctx context.Context, policyName string, bucket *v1alpha3.S3Bucket) error {
+	return m.MockAttachAccessPolicy(ctx, policyName, bucket)
+}
+
+// DetachAccessPolicy calls the underlying MockDetachAccessPolicy method.
+func (m *MockS3Client) DetachAccessPolicy(ctx context.Context, policyName string, roleName string) error {
+	return m.MockDetachAccessPolicy(ctx, policyName, roleName)
 }
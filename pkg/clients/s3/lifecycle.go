@@ -0,0 +1,190 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/storage/v1alpha3"
+)
+
+// LifecycleNotFoundErrCode is the error code returned by S3 when a bucket has
+// no lifecycle configuration.
+const LifecycleNotFoundErrCode = "NoSuchLifecycleConfiguration"
+
+// IsLifecycleNotFound returns true if the error indicates that a bucket has no
+// lifecycle configuration.
+func IsLifecycleNotFound(err error) bool {
+	return isErrorCode(err, LifecycleNotFoundErrCode)
+}
+
+// GenerateLifecycleRules returns the S3 lifecycle rules described by the
+// supplied configuration.
+func GenerateLifecycleRules(in *v1alpha3.BucketLifecycleConfiguration) []s3.LifecycleRule {
+	if in == nil {
+		return nil
+	}
+	rules := make([]s3.LifecycleRule, len(in.Rules))
+	for i, r := range in.Rules {
+		rule := s3.LifecycleRule{
+			ID:     r.ID,
+			Status: s3.ExpirationStatus(r.Status),
+			// S3 requires a filter; an empty prefix matches every object.
+			Filter: &s3.LifecycleRuleFilter{Prefix: aws.String("")},
+		}
+		if r.Filter != nil {
+			rule.Filter = &s3.LifecycleRuleFilter{Prefix: r.Filter.Prefix}
+			if r.Filter.Tag != nil {
+				rule.Filter.Tag = &s3.Tag{Key: aws.String(r.Filter.Tag.Key), Value: aws.String(r.Filter.Tag.Value)}
+			}
+			if r.Filter.And != nil {
+				rule.Filter.And = &s3.LifecycleRuleAndOperator{Prefix: r.Filter.And.Prefix}
+				for _, t := range r.Filter.And.Tags {
+					rule.Filter.And.Tags = append(rule.Filter.And.Tags, s3.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)})
+				}
+			}
+		}
+		for _, t := range r.Transitions {
+			rule.Transitions = append(rule.Transitions, s3.Transition{
+				Date:         timeFromMeta(t.Date),
+				Days:         t.Days,
+				StorageClass: s3.TransitionStorageClass(t.StorageClass),
+			})
+		}
+		if r.Expiration != nil {
+			rule.Expiration = &s3.LifecycleExpiration{
+				Date:                      timeFromMeta(r.Expiration.Date),
+				Days:                      r.Expiration.Days,
+				ExpiredObjectDeleteMarker: r.Expiration.ExpiredObjectDeleteMarker,
+			}
+		}
+		for _, t := range r.NoncurrentVersionTransitions {
+			rule.NoncurrentVersionTransitions = append(rule.NoncurrentVersionTransitions, s3.NoncurrentVersionTransition{
+				NoncurrentDays: aws.Int64(t.NoncurrentDays),
+				StorageClass:   s3.TransitionStorageClass(t.StorageClass),
+			})
+		}
+		if r.NoncurrentVersionExpiration != nil {
+			rule.NoncurrentVersionExpiration = &s3.NoncurrentVersionExpiration{
+				NoncurrentDays: aws.Int64(r.NoncurrentVersionExpiration.NoncurrentDays),
+			}
+		}
+		if r.AbortIncompleteMultipartUpload != nil {
+			rule.AbortIncompleteMultipartUpload = &s3.AbortIncompleteMultipartUpload{
+				DaysAfterInitiation: aws.Int64(r.AbortIncompleteMultipartUpload.DaysAfterInitiation),
+			}
+		}
+		rules[i] = rule
+	}
+	return rules
+}
+
+// GenerateLifecycleConfiguration returns the lifecycle configuration described
+// by the supplied S3 lifecycle rules.
+func GenerateLifecycleConfiguration(in []s3.LifecycleRule) *v1alpha3.BucketLifecycleConfiguration {
+	if len(in) == 0 {
+		return nil
+	}
+	cfg := &v1alpha3.BucketLifecycleConfiguration{Rules: make([]v1alpha3.LifecycleRule, len(in))}
+	for i, r := range in {
+		rule := v1alpha3.LifecycleRule{
+			ID:     r.ID,
+			Status: string(r.Status),
+		}
+		if f := r.Filter; f != nil && (aws.StringValue(f.Prefix) != "" || f.Tag != nil || f.And != nil) {
+			rule.Filter = &v1alpha3.LifecycleRuleFilter{Prefix: f.Prefix}
+			if f.Tag != nil {
+				rule.Filter.Tag = &v1alpha3.Tag{Key: aws.StringValue(f.Tag.Key), Value: aws.StringValue(f.Tag.Value)}
+			}
+			if f.And != nil {
+				rule.Filter.And = &v1alpha3.LifecycleRuleAndOperator{Prefix: f.And.Prefix}
+				for _, t := range f.And.Tags {
+					rule.Filter.And.Tags = append(rule.Filter.And.Tags, v1alpha3.Tag{Key: aws.StringValue(t.Key), Value: aws.StringValue(t.Value)})
+				}
+			}
+		}
+		for _, t := range r.Transitions {
+			rule.Transitions = append(rule.Transitions, v1alpha3.Transition{
+				Date:         metaFromTime(t.Date),
+				Days:         t.Days,
+				StorageClass: string(t.StorageClass),
+			})
+		}
+		if e := r.Expiration; e != nil {
+			rule.Expiration = &v1alpha3.LifecycleExpiration{
+				Date:                      metaFromTime(e.Date),
+				Days:                      e.Days,
+				ExpiredObjectDeleteMarker: e.ExpiredObjectDeleteMarker,
+			}
+		}
+		for _, t := range r.NoncurrentVersionTransitions {
+			rule.NoncurrentVersionTransitions = append(rule.NoncurrentVersionTransitions, v1alpha3.NoncurrentVersionTransition{
+				NoncurrentDays: aws.Int64Value(t.NoncurrentDays),
+				StorageClass:   string(t.StorageClass),
+			})
+		}
+		if e := r.NoncurrentVersionExpiration; e != nil {
+			rule.NoncurrentVersionExpiration = &v1alpha3.NoncurrentVersionExpiration{NoncurrentDays: aws.Int64Value(e.NoncurrentDays)}
+		}
+		if a := r.AbortIncompleteMultipartUpload; a != nil {
+			rule.AbortIncompleteMultipartUpload = &v1alpha3.AbortIncompleteMultipartUpload{DaysAfterInitiation: aws.Int64Value(a.DaysAfterInitiation)}
+		}
+		cfg.Rules[i] = rule
+	}
+	return cfg
+}
+
+// IsLifecycleConfigurationUpToDate returns true if the observed lifecycle rules
+// match the desired configuration. Lifecycle rules are considered up to date
+// when no configuration is desired.
+func IsLifecycleConfigurationUpToDate(desired *v1alpha3.BucketLifecycleConfiguration, observed []s3.LifecycleRule) bool {
+	if desired == nil {
+		return true
+	}
+	current := GenerateLifecycleConfiguration(observed)
+	if current == nil || len(current.Rules) != len(desired.Rules) {
+		return false
+	}
+	// S3 generates an ID for rules that don't specify one.
+	for i := range desired.Rules {
+		if desired.Rules[i].ID == nil {
+			current.Rules[i].ID = nil
+		}
+	}
+	return cmp.Equal(desired, current, cmpopts.EquateEmpty())
+}
+
+func timeFromMeta(t *metav1.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	return &t.Time
+}
+
+func metaFromTime(t *time.Time) *metav1.Time {
+	if t == nil {
+		return nil
+	}
+	m := metav1.NewTime(*t)
+	return &m
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/storage/v1alpha3"
+)
+
+func lifecycleConfiguration() *v1alpha3.BucketLifecycleConfiguration {
+	return &v1alpha3.BucketLifecycleConfiguration{
+		Rules: []v1alpha3.LifecycleRule{
+			{
+				ID:     aws.String("logs"),
+				Status: v1alpha3.LifecycleRuleStatusEnabled,
+				Filter: &v1alpha3.LifecycleRuleFilter{Prefix: aws.String("logs/")},
+				Transitions: []v1alpha3.Transition{
+					{Days: aws.Int64(30), StorageClass: string(s3.TransitionStorageClassStandardIa)},
+					{Days: aws.Int64(90), StorageClass: string(s3.TransitionStorageClassGlacier)},
+				},
+				Expiration:                     &v1alpha3.LifecycleExpiration{Days: aws.Int64(365)},
+				NoncurrentVersionExpiration:    &v1alpha3.NoncurrentVersionExpiration{NoncurrentDays: 30},
+				AbortIncompleteMultipartUpload: &v1alpha3.AbortIncompleteMultipartUpload{DaysAfterInitiation: 7},
+			},
+			{
+				Status: v1alpha3.LifecycleRuleStatusDisabled,
+				Filter: &v1alpha3.LifecycleRuleFilter{
+					And: &v1alpha3.LifecycleRuleAndOperator{
+						Prefix: aws.String("tmp/"),
+						Tags:   []v1alpha3.Tag{{Key: "ephemeral", Value: "true"}},
+					},
+				},
+				Expiration: &v1alpha3.LifecycleExpiration{Days: aws.Int64(1)},
+			},
+		},
+	}
+}
+
+func lifecycleRules() []s3.LifecycleRule {
+	return []s3.LifecycleRule{
+		{
+			ID:     aws.String("logs"),
+			Status: s3.ExpirationStatusEnabled,
+			Filter: &s3.LifecycleRuleFilter{Prefix: aws.String("logs/")},
+			Transitions: []s3.Transition{
+				{Days: aws.Int64(30), StorageClass: s3.TransitionStorageClassStandardIa},
+				{Days: aws.Int64(90), StorageClass: s3.TransitionStorageClassGlacier},
+			},
+			Expiration:                     &s3.LifecycleExpiration{Days: aws.Int64(365)},
+			NoncurrentVersionExpiration:    &s3.NoncurrentVersionExpiration{NoncurrentDays: aws.Int64(30)},
+			AbortIncompleteMultipartUpload: &s3.AbortIncompleteMultipartUpload{DaysAfterInitiation: aws.Int64(7)},
+		},
+		{
+			Status: s3.ExpirationStatusDisabled,
+			Filter: &s3.LifecycleRuleFilter{
+				And: &s3.LifecycleRuleAndOperator{
+					Prefix: aws.String("tmp/"),
+					Tags:   []s3.Tag{{Key: aws.String("ephemeral"), Value: aws.String("true")}},
+				},
+			},
+			Expiration: &s3.LifecycleExpiration{Days: aws.Int64(1)},
+		},
+	}
+}
+
+func TestGenerateLifecycleRules(t *testing.T) {
+	cases := map[string]struct {
+		in   *v1alpha3.BucketLifecycleConfiguration
+		want []s3.LifecycleRule
+	}{
+		"Nil": {},
+		"Full": {
+			in:   lifecycleConfiguration(),
+			want: lifecycleRules(),
+		},
+		"NoFilter": {
+			in: &v1alpha3.BucketLifecycleConfiguration{Rules: []v1alpha3.LifecycleRule{{Status: v1alpha3.LifecycleRuleStatusEnabled}}},
+			want: []s3.LifecycleRule{{
+				Status: s3.ExpirationStatusEnabled,
+				Filter: &s3.LifecycleRuleFilter{Prefix: aws.String("")},
+			}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateLifecycleRules(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateLifecycleRules(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsLifecycleConfigurationUpToDate(t *testing.T) {
+	type args struct {
+		desired  *v1alpha3.BucketLifecycleConfiguration
+		observed []s3.LifecycleRule
+	}
+
+	cases := map[string]struct {
+		args
+		want bool
+	}{
+		"NotManaged": {
+			args: args{
+				observed: lifecycleRules(),
+			},
+			want: true,
+		},
+		"UpToDate": {
+			args: args{
+				desired:  lifecycleConfiguration(),
+				observed: lifecycleRules(),
+			},
+			want: true,
+		},
+		"GeneratedID": {
+			args: args{
+				desired: lifecycleConfiguration(),
+				observed: func() []s3.LifecycleRule {
+					r := lifecycleRules()
+					r[1].ID = aws.String("generated")
+					return r
+				}(),
+			},
+			want: true,
+		},
+		"EmptyFilter": {
+			args: args{
+				desired:  &v1alpha3.BucketLifecycleConfiguration{Rules: []v1alpha3.LifecycleRule{{Status: v1alpha3.LifecycleRuleStatusEnabled}}},
+				observed: []s3.LifecycleRule{{Status: s3.ExpirationStatusEnabled, Filter: &s3.LifecycleRuleFilter{Prefix: aws.String("")}}},
+			},
+			want: true,
+		},
+		"Missing": {
+			args: args{
+				desired: lifecycleConfiguration(),
+			},
+			want: false,
+		},
+		"Changed": {
+			args: args{
+				desired: lifecycleConfiguration(),
+				observed: func() []s3.LifecycleRule {
+					r := lifecycleRules()
+					r[0].Expiration.Days = aws.Int64(30)
+					return r
+				}(),
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsLifecycleConfigurationUpToDate(tc.args.desired, tc.args.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsLifecycleConfigurationUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
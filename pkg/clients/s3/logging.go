@@ -0,0 +1,52 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	"github.com/crossplane/provider-aws/apis/storage/v1alpha3"
+)
+
+// GenerateLoggingStatus returns the S3 logging status described by the
+// supplied configuration.
+func GenerateLoggingStatus(in *v1alpha3.LoggingConfiguration) *s3.BucketLoggingStatus {
+	if in == nil {
+		return nil
+	}
+	return &s3.BucketLoggingStatus{
+		LoggingEnabled: &s3.LoggingEnabled{
+			TargetBucket: in.TargetBucket,
+			TargetPrefix: aws.String(in.TargetPrefix),
+		},
+	}
+}
+
+// IsLoggingConfigurationUpToDate returns true if the observed logging
+// configuration matches the desired one. Logging is considered up to date when
+// no configuration is desired.
+func IsLoggingConfigurationUpToDate(desired *v1alpha3.LoggingConfiguration, observed *s3.LoggingEnabled) bool {
+	if desired == nil {
+		return true
+	}
+	if observed == nil {
+		return false
+	}
+	return aws.StringValue(desired.TargetBucket) == aws.StringValue(observed.TargetBucket) &&
+		desired.TargetPrefix == aws.StringValue(observed.TargetPrefix)
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	"github.com/crossplane/provider-aws/apis/storage/v1alpha3"
+)
+
+// ObjectLockNotFoundErrCode is the error code returned by S3 when a bucket has
+// no Object Lock configuration.
+const ObjectLockNotFoundErrCode = "ObjectLockConfigurationNotFoundError"
+
+// IsObjectLockNotFound returns true if the error indicates that a bucket has no
+// Object Lock configuration.
+func IsObjectLockNotFound(err error) bool {
+	return isErrorCode(err, ObjectLockNotFoundErrCode)
+}
+
+// GenerateObjectLock returns the S3 Object Lock configuration described by the
+// supplied configuration.
+func GenerateObjectLock(in *v1alpha3.ObjectLockConfiguration) *s3.ObjectLockConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := &s3.ObjectLockConfiguration{ObjectLockEnabled: s3.ObjectLockEnabledEnabled}
+	if r := in.DefaultRetention; r != nil {
+		out.Rule = &s3.ObjectLockRule{DefaultRetention: &s3.DefaultRetention{
+			Mode:  s3.ObjectLockRetentionMode(r.Mode),
+			Days:  r.Days,
+			Years: r.Years,
+		}}
+	}
+	return out
+}
+
+// IsObjectLockUpToDate returns true if Object Lock is enabled for the bucket
+// and its default retention matches the desired one. Object Lock is
+// considered up to date when no configuration is desired.
+func IsObjectLockUpToDate(desired *v1alpha3.ObjectLockConfiguration, observed *s3.ObjectLockConfiguration) bool {
+	if desired == nil {
+		return true
+	}
+	if observed == nil || observed.ObjectLockEnabled != s3.ObjectLockEnabledEnabled {
+		return false
+	}
+	var current *s3.DefaultRetention
+	if observed.Rule != nil {
+		current = observed.Rule.DefaultRetention
+	}
+	want := desired.DefaultRetention
+	if want == nil || current == nil {
+		return want == nil && current == nil
+	}
+	return want.Mode == string(current.Mode) &&
+		aws.Int64Value(want.Days) == aws.Int64Value(current.Days) &&
+		aws.Int64Value(want.Years) == aws.Int64Value(current.Years)
+}
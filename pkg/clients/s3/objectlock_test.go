@@ -0,0 +1,92 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/storage/v1alpha3"
+)
+
+func TestIsObjectLockUpToDate(t *testing.T) {
+	lock := &v1alpha3.ObjectLockConfiguration{
+		DefaultRetention: &v1alpha3.DefaultRetention{Mode: "GOVERNANCE", Days: aws.Int64(30)},
+	}
+
+	type args struct {
+		desired  *v1alpha3.ObjectLockConfiguration
+		observed *s3.ObjectLockConfiguration
+	}
+
+	cases := map[string]struct {
+		args
+		want bool
+	}{
+		"NotManaged": {
+			args: args{observed: GenerateObjectLock(lock)},
+			want: true,
+		},
+		"UpToDate": {
+			args: args{desired: lock, observed: GenerateObjectLock(lock)},
+			want: true,
+		},
+		"NotEnabled": {
+			args: args{desired: lock},
+			want: false,
+		},
+		"NoRetention": {
+			args: args{
+				desired:  &v1alpha3.ObjectLockConfiguration{},
+				observed: &s3.ObjectLockConfiguration{ObjectLockEnabled: s3.ObjectLockEnabledEnabled},
+			},
+			want: true,
+		},
+		"RetentionRemoved": {
+			args: args{
+				desired:  &v1alpha3.ObjectLockConfiguration{},
+				observed: GenerateObjectLock(lock),
+			},
+			want: false,
+		},
+		"ModeChanged": {
+			args: args{
+				desired: lock,
+				observed: &s3.ObjectLockConfiguration{
+					ObjectLockEnabled: s3.ObjectLockEnabledEnabled,
+					Rule: &s3.ObjectLockRule{DefaultRetention: &s3.DefaultRetention{
+						Mode: s3.ObjectLockRetentionModeCompliance,
+						Days: aws.Int64(30),
+					}},
+				},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsObjectLockUpToDate(tc.args.desired, tc.args.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsObjectLockUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -45,6 +45,166 @@ func (_m *Operations) DeleteBucketRequest(_a0 *s3.DeleteBucketInput) operations.
 	return r0
 }
 
+// DeleteBucketTaggingRequest provides a mock function with given fields: _a0
+func (_m *Operations) DeleteBucketTaggingRequest(_a0 *s3.DeleteBucketTaggingInput) operations.DeleteBucketTaggingRequest {
+	ret := _m.Called(_a0)
+
+	var r0 operations.DeleteBucketTaggingRequest
+	if rf, ok := ret.Get(0).(func(*s3.DeleteBucketTaggingInput) operations.DeleteBucketTaggingRequest); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(operations.DeleteBucketTaggingRequest)
+		}
+	}
+
+	return r0
+}
+
+// DeleteObjectsRequest provides a mock function with given fields: _a0
+func (_m *Operations) DeleteObjectsRequest(_a0 *s3.DeleteObjectsInput) operations.DeleteObjectsRequest {
+	ret := _m.Called(_a0)
+
+	var r0 operations.DeleteObjectsRequest
+	if rf, ok := ret.Get(0).(func(*s3.DeleteObjectsInput) operations.DeleteObjectsRequest); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(operations.DeleteObjectsRequest)
+		}
+	}
+
+	return r0
+}
+
+// GetBucketCORSRequest provides a mock function with given fields: _a0
+func (_m *Operations) GetBucketCORSRequest(_a0 *s3.GetBucketCorsInput) operations.GetBucketCORSRequest {
+	ret := _m.Called(_a0)
+
+	var r0 operations.GetBucketCORSRequest
+	if rf, ok := ret.Get(0).(func(*s3.GetBucketCorsInput) operations.GetBucketCORSRequest); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(operations.GetBucketCORSRequest)
+		}
+	}
+
+	return r0
+}
+
+// GetBucketEncryptionRequest provides a mock function with given fields: _a0
+func (_m *Operations) GetBucketEncryptionRequest(_a0 *s3.GetBucketEncryptionInput) operations.GetBucketEncryptionRequest {
+	ret := _m.Called(_a0)
+
+	var r0 operations.GetBucketEncryptionRequest
+	if rf, ok := ret.Get(0).(func(*s3.GetBucketEncryptionInput) operations.GetBucketEncryptionRequest); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(operations.GetBucketEncryptionRequest)
+		}
+	}
+
+	return r0
+}
+
+// GetBucketLifecycleConfigurationRequest provides a mock function with given fields: _a0
+func (_m *Operations) GetBucketLifecycleConfigurationRequest(_a0 *s3.GetBucketLifecycleConfigurationInput) operations.GetBucketLifecycleConfigurationRequest {
+	ret := _m.Called(_a0)
+
+	var r0 operations.GetBucketLifecycleConfigurationRequest
+	if rf, ok := ret.Get(0).(func(*s3.GetBucketLifecycleConfigurationInput) operations.GetBucketLifecycleConfigurationRequest); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(operations.GetBucketLifecycleConfigurationRequest)
+		}
+	}
+
+	return r0
+}
+
+// GetBucketLoggingRequest provides a mock function with given fields: _a0
+func (_m *Operations) GetBucketLoggingRequest(_a0 *s3.GetBucketLoggingInput) operations.GetBucketLoggingRequest {
+	ret := _m.Called(_a0)
+
+	var r0 operations.GetBucketLoggingRequest
+	if rf, ok := ret.Get(0).(func(*s3.GetBucketLoggingInput) operations.GetBucketLoggingRequest); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(operations.GetBucketLoggingRequest)
+		}
+	}
+
+	return r0
+}
+
+// GetBucketPolicyRequest provides a mock function with given fields: _a0
+func (_m *Operations) GetBucketPolicyRequest(_a0 *s3.GetBucketPolicyInput) operations.GetBucketPolicyRequest {
+	ret := _m.Called(_a0)
+
+	var r0 operations.GetBucketPolicyRequest
+	if rf, ok := ret.Get(0).(func(*s3.GetBucketPolicyInput) operations.GetBucketPolicyRequest); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(operations.GetBucketPolicyRequest)
+		}
+	}
+
+	return r0
+}
+
+// GetBucketReplicationRequest provides a mock function with given fields: _a0
+func (_m *Operations) GetBucketReplicationRequest(_a0 *s3.GetBucketReplicationInput) operations.GetBucketReplicationRequest {
+	ret := _m.Called(_a0)
+
+	var r0 operations.GetBucketReplicationRequest
+	if rf, ok := ret.Get(0).(func(*s3.GetBucketReplicationInput) operations.GetBucketReplicationRequest); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(operations.GetBucketReplicationRequest)
+		}
+	}
+
+	return r0
+}
+
+// GetBucketRequestPaymentRequest provides a mock function with given fields: _a0
+func (_m *Operations) GetBucketRequestPaymentRequest(_a0 *s3.GetBucketRequestPaymentInput) operations.GetBucketRequestPaymentRequest {
+	ret := _m.Called(_a0)
+
+	var r0 operations.GetBucketRequestPaymentRequest
+	if rf, ok := ret.Get(0).(func(*s3.GetBucketRequestPaymentInput) operations.GetBucketRequestPaymentRequest); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(operations.GetBucketRequestPaymentRequest)
+		}
+	}
+
+	return r0
+}
+
+// GetBucketTaggingRequest provides a mock function with given fields: _a0
+func (_m *Operations) GetBucketTaggingRequest(_a0 *s3.GetBucketTaggingInput) operations.GetBucketTaggingRequest {
+	ret := _m.Called(_a0)
+
+	var r0 operations.GetBucketTaggingRequest
+	if rf, ok := ret.Get(0).(func(*s3.GetBucketTaggingInput) operations.GetBucketTaggingRequest); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(operations.GetBucketTaggingRequest)
+		}
+	}
+
+	return r0
+}
+
 // GetBucketVersioningRequest provides a mock function with given fields: _a0
 func (_m *Operations) GetBucketVersioningRequest(_a0 *s3.GetBucketVersioningInput) operations.GetBucketVersioningRequest {
 	ret := _m.Called(_a0)
@@ -61,6 +221,70 @@ func (_m *Operations) GetBucketVersioningRequest(_a0 *s3.GetBucketVersioningInpu
 	return r0
 }
 
+// GetBucketWebsiteRequest provides a mock function with given fields: _a0
+func (_m *Operations) GetBucketWebsiteRequest(_a0 *s3.GetBucketWebsiteInput) operations.GetBucketWebsiteRequest {
+	ret := _m.Called(_a0)
+
+	var r0 operations.GetBucketWebsiteRequest
+	if rf, ok := ret.Get(0).(func(*s3.GetBucketWebsiteInput) operations.GetBucketWebsiteRequest); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(operations.GetBucketWebsiteRequest)
+		}
+	}
+
+	return r0
+}
+
+// GetObjectLockConfigurationRequest provides a mock function with given fields: _a0
+func (_m *Operations) GetObjectLockConfigurationRequest(_a0 *s3.GetObjectLockConfigurationInput) operations.GetObjectLockConfigurationRequest {
+	ret := _m.Called(_a0)
+
+	var r0 operations.GetObjectLockConfigurationRequest
+	if rf, ok := ret.Get(0).(func(*s3.GetObjectLockConfigurationInput) operations.GetObjectLockConfigurationRequest); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(operations.GetObjectLockConfigurationRequest)
+		}
+	}
+
+	return r0
+}
+
+// GetPublicAccessBlockRequest provides a mock function with given fields: _a0
+func (_m *Operations) GetPublicAccessBlockRequest(_a0 *s3.GetPublicAccessBlockInput) operations.GetPublicAccessBlockRequest {
+	ret := _m.Called(_a0)
+
+	var r0 operations.GetPublicAccessBlockRequest
+	if rf, ok := ret.Get(0).(func(*s3.GetPublicAccessBlockInput) operations.GetPublicAccessBlockRequest); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(operations.GetPublicAccessBlockRequest)
+		}
+	}
+
+	return r0
+}
+
+// ListObjectVersionsRequest provides a mock function with given fields: _a0
+func (_m *Operations) ListObjectVersionsRequest(_a0 *s3.ListObjectVersionsInput) operations.ListObjectVersionsRequest {
+	ret := _m.Called(_a0)
+
+	var r0 operations.ListObjectVersionsRequest
+	if rf, ok := ret.Get(0).(func(*s3.ListObjectVersionsInput) operations.ListObjectVersionsRequest); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(operations.ListObjectVersionsRequest)
+		}
+	}
+
+	return r0
+}
+
 // PutBucketACLRequest provides a mock function with given fields: _a0
 func (_m *Operations) PutBucketACLRequest(_a0 *s3.PutBucketAclInput) operations.PutBucketACLRequest {
 	ret := _m.Called(_a0)
@@ -77,6 +301,134 @@ func (_m *Operations) PutBucketACLRequest(_a0 *s3.PutBucketAclInput) operations.
 	return r0
 }
 
+// PutBucketCORSRequest provides a mock function with given fields: _a0
+func (_m *Operations) PutBucketCORSRequest(_a0 *s3.PutBucketCorsInput) operations.PutBucketCORSRequest {
+	ret := _m.Called(_a0)
+
+	var r0 operations.PutBucketCORSRequest
+	if rf, ok := ret.Get(0).(func(*s3.PutBucketCorsInput) operations.PutBucketCORSRequest); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(operations.PutBucketCORSRequest)
+		}
+	}
+
+	return r0
+}
+
+// PutBucketEncryptionRequest provides a mock function with given fields: _a0
+func (_m *Operations) PutBucketEncryptionRequest(_a0 *s3.PutBucketEncryptionInput) operations.PutBucketEncryptionRequest {
+	ret := _m.Called(_a0)
+
+	var r0 operations.PutBucketEncryptionRequest
+	if rf, ok := ret.Get(0).(func(*s3.PutBucketEncryptionInput) operations.PutBucketEncryptionRequest); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(operations.PutBucketEncryptionRequest)
+		}
+	}
+
+	return r0
+}
+
+// PutBucketLifecycleConfigurationRequest provides a mock function with given fields: _a0
+func (_m *Operations) PutBucketLifecycleConfigurationRequest(_a0 *s3.PutBucketLifecycleConfigurationInput) operations.PutBucketLifecycleConfigurationRequest {
+	ret := _m.Called(_a0)
+
+	var r0 operations.PutBucketLifecycleConfigurationRequest
+	if rf, ok := ret.Get(0).(func(*s3.PutBucketLifecycleConfigurationInput) operations.PutBucketLifecycleConfigurationRequest); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(operations.PutBucketLifecycleConfigurationRequest)
+		}
+	}
+
+	return r0
+}
+
+// PutBucketLoggingRequest provides a mock function with given fields: _a0
+func (_m *Operations) PutBucketLoggingRequest(_a0 *s3.PutBucketLoggingInput) operations.PutBucketLoggingRequest {
+	ret := _m.Called(_a0)
+
+	var r0 operations.PutBucketLoggingRequest
+	if rf, ok := ret.Get(0).(func(*s3.PutBucketLoggingInput) operations.PutBucketLoggingRequest); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(operations.PutBucketLoggingRequest)
+		}
+	}
+
+	return r0
+}
+
+// PutBucketPolicyRequest provides a mock function with given fields: _a0
+func (_m *Operations) PutBucketPolicyRequest(_a0 *s3.PutBucketPolicyInput) operations.PutBucketPolicyRequest {
+	ret := _m.Called(_a0)
+
+	var r0 operations.PutBucketPolicyRequest
+	if rf, ok := ret.Get(0).(func(*s3.PutBucketPolicyInput) operations.PutBucketPolicyRequest); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(operations.PutBucketPolicyRequest)
+		}
+	}
+
+	return r0
+}
+
+// PutBucketReplicationRequest provides a mock function with given fields: _a0
+func (_m *Operations) PutBucketReplicationRequest(_a0 *s3.PutBucketReplicationInput) operations.PutBucketReplicationRequest {
+	ret := _m.Called(_a0)
+
+	var r0 operations.PutBucketReplicationRequest
+	if rf, ok := ret.Get(0).(func(*s3.PutBucketReplicationInput) operations.PutBucketReplicationRequest); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(operations.PutBucketReplicationRequest)
+		}
+	}
+
+	return r0
+}
+
+// PutBucketRequestPaymentRequest provides a mock function with given fields: _a0
+func (_m *Operations) PutBucketRequestPaymentRequest(_a0 *s3.PutBucketRequestPaymentInput) operations.PutBucketRequestPaymentRequest {
+	ret := _m.Called(_a0)
+
+	var r0 operations.PutBucketRequestPaymentRequest
+	if rf, ok := ret.Get(0).(func(*s3.PutBucketRequestPaymentInput) operations.PutBucketRequestPaymentRequest); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(operations.PutBucketRequestPaymentRequest)
+		}
+	}
+
+	return r0
+}
+
+// PutBucketTaggingRequest provides a mock function with given fields: _a0
+func (_m *Operations) PutBucketTaggingRequest(_a0 *s3.PutBucketTaggingInput) operations.PutBucketTaggingRequest {
+	ret := _m.Called(_a0)
+
+	var r0 operations.PutBucketTaggingRequest
+	if rf, ok := ret.Get(0).(func(*s3.PutBucketTaggingInput) operations.PutBucketTaggingRequest); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(operations.PutBucketTaggingRequest)
+		}
+	}
+
+	return r0
+}
+
 // PutBucketVersioningRequest provides a mock function with given fields: _a0
 func (_m *Operations) PutBucketVersioningRequest(_a0 *s3.PutBucketVersioningInput) operations.PutBucketVersioningRequest {
 	ret := _m.Called(_a0)
@@ -92,3 +444,51 @@ func (_m *Operations) PutBucketVersioningRequest(_a0 *s3.PutBucketVersioningInpu
 
 	return r0
 }
+
+// PutBucketWebsiteRequest provides a mock function with given fields: _a0
+func (_m *Operations) PutBucketWebsiteRequest(_a0 *s3.PutBucketWebsiteInput) operations.PutBucketWebsiteRequest {
+	ret := _m.Called(_a0)
+
+	var r0 operations.PutBucketWebsiteRequest
+	if rf, ok := ret.Get(0).(func(*s3.PutBucketWebsiteInput) operations.PutBucketWebsiteRequest); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(operations.PutBucketWebsiteRequest)
+		}
+	}
+
+	return r0
+}
+
+// PutObjectLockConfigurationRequest provides a mock function with given fields: _a0
+func (_m *Operations) PutObjectLockConfigurationRequest(_a0 *s3.PutObjectLockConfigurationInput) operations.PutObjectLockConfigurationRequest {
+	ret := _m.Called(_a0)
+
+	var r0 operations.PutObjectLockConfigurationRequest
+	if rf, ok := ret.Get(0).(func(*s3.PutObjectLockConfigurationInput) operations.PutObjectLockConfigurationRequest); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(operations.PutObjectLockConfigurationRequest)
+		}
+	}
+
+	return r0
+}
+
+// PutPublicAccessBlockRequest provides a mock function with given fields: _a0
+func (_m *Operations) PutPublicAccessBlockRequest(_a0 *s3.PutPublicAccessBlockInput) operations.PutPublicAccessBlockRequest {
+	ret := _m.Called(_a0)
+
+	var r0 operations.PutPublicAccessBlockRequest
+	if rf, ok := ret.Get(0).(func(*s3.PutPublicAccessBlockInput) operations.PutPublicAccessBlockRequest); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(operations.PutPublicAccessBlockRequest)
+		}
+	}
+
+	return r0
+}
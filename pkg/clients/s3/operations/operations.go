@@ -26,4 +26,29 @@ type Operations interface {
 	PutBucketACLRequest(*s3.PutBucketAclInput) PutBucketACLRequest
 	PutBucketVersioningRequest(*s3.PutBucketVersioningInput) PutBucketVersioningRequest
 	DeleteBucketRequest(*s3.DeleteBucketInput) DeleteBucketRequest
+	GetBucketLifecycleConfigurationRequest(*s3.GetBucketLifecycleConfigurationInput) GetBucketLifecycleConfigurationRequest
+	PutBucketLifecycleConfigurationRequest(*s3.PutBucketLifecycleConfigurationInput) PutBucketLifecycleConfigurationRequest
+	GetBucketEncryptionRequest(*s3.GetBucketEncryptionInput) GetBucketEncryptionRequest
+	PutBucketEncryptionRequest(*s3.PutBucketEncryptionInput) PutBucketEncryptionRequest
+	GetPublicAccessBlockRequest(*s3.GetPublicAccessBlockInput) GetPublicAccessBlockRequest
+	PutPublicAccessBlockRequest(*s3.PutPublicAccessBlockInput) PutPublicAccessBlockRequest
+	GetBucketPolicyRequest(*s3.GetBucketPolicyInput) GetBucketPolicyRequest
+	PutBucketPolicyRequest(*s3.PutBucketPolicyInput) PutBucketPolicyRequest
+	GetBucketCORSRequest(*s3.GetBucketCorsInput) GetBucketCORSRequest
+	PutBucketCORSRequest(*s3.PutBucketCorsInput) PutBucketCORSRequest
+	GetBucketWebsiteRequest(*s3.GetBucketWebsiteInput) GetBucketWebsiteRequest
+	PutBucketWebsiteRequest(*s3.PutBucketWebsiteInput) PutBucketWebsiteRequest
+	GetBucketLoggingRequest(*s3.GetBucketLoggingInput) GetBucketLoggingRequest
+	PutBucketLoggingRequest(*s3.PutBucketLoggingInput) PutBucketLoggingRequest
+	GetBucketReplicationRequest(*s3.GetBucketReplicationInput) GetBucketReplicationRequest
+	PutBucketReplicationRequest(*s3.PutBucketReplicationInput) PutBucketReplicationRequest
+	ListObjectVersionsRequest(*s3.ListObjectVersionsInput) ListObjectVersionsRequest
+	DeleteObjectsRequest(*s3.DeleteObjectsInput) DeleteObjectsRequest
+	GetBucketTaggingRequest(*s3.GetBucketTaggingInput) GetBucketTaggingRequest
+	PutBucketTaggingRequest(*s3.PutBucketTaggingInput) PutBucketTaggingRequest
+	DeleteBucketTaggingRequest(*s3.DeleteBucketTaggingInput) DeleteBucketTaggingRequest
+	GetObjectLockConfigurationRequest(*s3.GetObjectLockConfigurationInput) GetObjectLockConfigurationRequest
+	PutObjectLockConfigurationRequest(*s3.PutObjectLockConfigurationInput) PutObjectLockConfigurationRequest
+	GetBucketRequestPaymentRequest(*s3.GetBucketRequestPaymentInput) GetBucketRequestPaymentRequest
+	PutBucketRequestPaymentRequest(*s3.PutBucketRequestPaymentInput) PutBucketRequestPaymentRequest
 }
@@ -48,3 +48,128 @@ type PutBucketVersioningRequest interface {
 type DeleteBucketRequest interface {
 	Send(context.Context) (*s3.DeleteBucketResponse, error)
 }
+
+// GetBucketLifecycleConfigurationRequest is a API request type for the GetBucketLifecycleConfiguration API operation.
+type GetBucketLifecycleConfigurationRequest interface {
+	Send(context.Context) (*s3.GetBucketLifecycleConfigurationResponse, error)
+}
+
+// PutBucketLifecycleConfigurationRequest is a API request type for the PutBucketLifecycleConfiguration API operation.
+type PutBucketLifecycleConfigurationRequest interface {
+	Send(context.Context) (*s3.PutBucketLifecycleConfigurationResponse, error)
+}
+
+// GetBucketEncryptionRequest is a API request type for the GetBucketEncryption API operation.
+type GetBucketEncryptionRequest interface {
+	Send(context.Context) (*s3.GetBucketEncryptionResponse, error)
+}
+
+// PutBucketEncryptionRequest is a API request type for the PutBucketEncryption API operation.
+type PutBucketEncryptionRequest interface {
+	Send(context.Context) (*s3.PutBucketEncryptionResponse, error)
+}
+
+// GetPublicAccessBlockRequest is a API request type for the GetPublicAccessBlock API operation.
+type GetPublicAccessBlockRequest interface {
+	Send(context.Context) (*s3.GetPublicAccessBlockResponse, error)
+}
+
+// PutPublicAccessBlockRequest is a API request type for the PutPublicAccessBlock API operation.
+type PutPublicAccessBlockRequest interface {
+	Send(context.Context) (*s3.PutPublicAccessBlockResponse, error)
+}
+
+// GetBucketPolicyRequest is a API request type for the GetBucketPolicy API operation.
+type GetBucketPolicyRequest interface {
+	Send(context.Context) (*s3.GetBucketPolicyResponse, error)
+}
+
+// PutBucketPolicyRequest is a API request type for the PutBucketPolicy API operation.
+type PutBucketPolicyRequest interface {
+	Send(context.Context) (*s3.PutBucketPolicyResponse, error)
+}
+
+// GetBucketCORSRequest is a API request type for the GetBucketCors API operation.
+type GetBucketCORSRequest interface {
+	Send(context.Context) (*s3.GetBucketCorsResponse, error)
+}
+
+// PutBucketCORSRequest is a API request type for the PutBucketCors API operation.
+type PutBucketCORSRequest interface {
+	Send(context.Context) (*s3.PutBucketCorsResponse, error)
+}
+
+// GetBucketWebsiteRequest is a API request type for the GetBucketWebsite API operation.
+type GetBucketWebsiteRequest interface {
+	Send(context.Context) (*s3.GetBucketWebsiteResponse, error)
+}
+
+// PutBucketWebsiteRequest is a API request type for the PutBucketWebsite API operation.
+type PutBucketWebsiteRequest interface {
+	Send(context.Context) (*s3.PutBucketWebsiteResponse, error)
+}
+
+// GetBucketLoggingRequest is a API request type for the GetBucketLogging API operation.
+type GetBucketLoggingRequest interface {
+	Send(context.Context) (*s3.GetBucketLoggingResponse, error)
+}
+
+// PutBucketLoggingRequest is a API request type for the PutBucketLogging API operation.
+type PutBucketLoggingRequest interface {
+	Send(context.Context) (*s3.PutBucketLoggingResponse, error)
+}
+
+// GetBucketReplicationRequest is a API request type for the GetBucketReplication API operation.
+type GetBucketReplicationRequest interface {
+	Send(context.Context) (*s3.GetBucketReplicationResponse, error)
+}
+
+// PutBucketReplicationRequest is a API request type for the PutBucketReplication API operation.
+type PutBucketReplicationRequest interface {
+	Send(context.Context) (*s3.PutBucketReplicationResponse, error)
+}
+
+// ListObjectVersionsRequest is a API request type for the ListObjectVersions API operation.
+type ListObjectVersionsRequest interface {
+	Send(context.Context) (*s3.ListObjectVersionsResponse, error)
+}
+
+// DeleteObjectsRequest is a API request type for the DeleteObjects API operation.
+type DeleteObjectsRequest interface {
+	Send(context.Context) (*s3.DeleteObjectsResponse, error)
+}
+
+// GetBucketTaggingRequest is a API request type for the GetBucketTagging API operation.
+type GetBucketTaggingRequest interface {
+	Send(context.Context) (*s3.GetBucketTaggingResponse, error)
+}
+
+// PutBucketTaggingRequest is a API request type for the PutBucketTagging API operation.
+type PutBucketTaggingRequest interface {
+	Send(context.Context) (*s3.PutBucketTaggingResponse, error)
+}
+
+// DeleteBucketTaggingRequest is a API request type for the DeleteBucketTagging API operation.
+type DeleteBucketTaggingRequest interface {
+	Send(context.Context) (*s3.DeleteBucketTaggingResponse, error)
+}
+
+// GetObjectLockConfigurationRequest is a API request type for the GetObjectLockConfiguration API operation.
+type GetObjectLockConfigurationRequest interface {
+	Send(context.Context) (*s3.GetObjectLockConfigurationResponse, error)
+}
+
+// PutObjectLockConfigurationRequest is a API request type for the PutObjectLockConfiguration API operation.
+type PutObjectLockConfigurationRequest interface {
+	Send(context.Context) (*s3.PutObjectLockConfigurationResponse, error)
+}
+
+// GetBucketRequestPaymentRequest is a API request type for the GetBucketRequestPayment API operation.
+type GetBucketRequestPaymentRequest interface {
+	Send(context.Context) (*s3.GetBucketRequestPaymentResponse, error)
+}
+
+// PutBucketRequestPaymentRequest is a API request type for the PutBucketRequestPayment API operation.
+type PutBucketRequestPaymentRequest interface {
+	Send(context.Context) (*s3.PutBucketRequestPaymentResponse, error)
+}
@@ -55,3 +55,128 @@ func (api *S3Operations) DeleteBucketRequest(i *s3.DeleteBucketInput) DeleteBuck
 func (api *S3Operations) CreateBucketRequest(i *s3.CreateBucketInput) CreateBucketRequest {
 	return api.s3.CreateBucketRequest(i)
 }
+
+// GetBucketLifecycleConfigurationRequest creates a get bucket lifecycle configuration request
+func (api *S3Operations) GetBucketLifecycleConfigurationRequest(i *s3.GetBucketLifecycleConfigurationInput) GetBucketLifecycleConfigurationRequest {
+	return api.s3.GetBucketLifecycleConfigurationRequest(i)
+}
+
+// PutBucketLifecycleConfigurationRequest creates a put bucket lifecycle configuration request
+func (api *S3Operations) PutBucketLifecycleConfigurationRequest(i *s3.PutBucketLifecycleConfigurationInput) PutBucketLifecycleConfigurationRequest {
+	return api.s3.PutBucketLifecycleConfigurationRequest(i)
+}
+
+// GetBucketEncryptionRequest creates a get bucket encryption request
+func (api *S3Operations) GetBucketEncryptionRequest(i *s3.GetBucketEncryptionInput) GetBucketEncryptionRequest {
+	return api.s3.GetBucketEncryptionRequest(i)
+}
+
+// PutBucketEncryptionRequest creates a put bucket encryption request
+func (api *S3Operations) PutBucketEncryptionRequest(i *s3.PutBucketEncryptionInput) PutBucketEncryptionRequest {
+	return api.s3.PutBucketEncryptionRequest(i)
+}
+
+// GetPublicAccessBlockRequest creates a get public access block request
+func (api *S3Operations) GetPublicAccessBlockRequest(i *s3.GetPublicAccessBlockInput) GetPublicAccessBlockRequest {
+	return api.s3.GetPublicAccessBlockRequest(i)
+}
+
+// PutPublicAccessBlockRequest creates a put public access block request
+func (api *S3Operations) PutPublicAccessBlockRequest(i *s3.PutPublicAccessBlockInput) PutPublicAccessBlockRequest {
+	return api.s3.PutPublicAccessBlockRequest(i)
+}
+
+// GetBucketPolicyRequest creates a get bucket policy request
+func (api *S3Operations) GetBucketPolicyRequest(i *s3.GetBucketPolicyInput) GetBucketPolicyRequest {
+	return api.s3.GetBucketPolicyRequest(i)
+}
+
+// PutBucketPolicyRequest creates a put bucket policy request
+func (api *S3Operations) PutBucketPolicyRequest(i *s3.PutBucketPolicyInput) PutBucketPolicyRequest {
+	return api.s3.PutBucketPolicyRequest(i)
+}
+
+// GetBucketCORSRequest creates a get bucket CORS request
+func (api *S3Operations) GetBucketCORSRequest(i *s3.GetBucketCorsInput) GetBucketCORSRequest {
+	return api.s3.GetBucketCorsRequest(i)
+}
+
+// PutBucketCORSRequest creates a put bucket CORS request
+func (api *S3Operations) PutBucketCORSRequest(i *s3.PutBucketCorsInput) PutBucketCORSRequest {
+	return api.s3.PutBucketCorsRequest(i)
+}
+
+// GetBucketWebsiteRequest creates a get bucket website request
+func (api *S3Operations) GetBucketWebsiteRequest(i *s3.GetBucketWebsiteInput) GetBucketWebsiteRequest {
+	return api.s3.GetBucketWebsiteRequest(i)
+}
+
+// PutBucketWebsiteRequest creates a put bucket website request
+func (api *S3Operations) PutBucketWebsiteRequest(i *s3.PutBucketWebsiteInput) PutBucketWebsiteRequest {
+	return api.s3.PutBucketWebsiteRequest(i)
+}
+
+// GetBucketLoggingRequest creates a get bucket logging request
+func (api *S3Operations) GetBucketLoggingRequest(i *s3.GetBucketLoggingInput) GetBucketLoggingRequest {
+	return api.s3.GetBucketLoggingRequest(i)
+}
+
+// PutBucketLoggingRequest creates a put bucket logging request
+func (api *S3Operations) PutBucketLoggingRequest(i *s3.PutBucketLoggingInput) PutBucketLoggingRequest {
+	return api.s3.PutBucketLoggingRequest(i)
+}
+
+// GetBucketReplicationRequest creates a get bucket replication request
+func (api *S3Operations) GetBucketReplicationRequest(i *s3.GetBucketReplicationInput) GetBucketReplicationRequest {
+	return api.s3.GetBucketReplicationRequest(i)
+}
+
+// PutBucketReplicationRequest creates a put bucket replication request
+func (api *S3Operations) PutBucketReplicationRequest(i *s3.PutBucketReplicationInput) PutBucketReplicationRequest {
+	return api.s3.PutBucketReplicationRequest(i)
+}
+
+// ListObjectVersionsRequest creates a list object versions request
+func (api *S3Operations) ListObjectVersionsRequest(i *s3.ListObjectVersionsInput) ListObjectVersionsRequest {
+	return api.s3.ListObjectVersionsRequest(i)
+}
+
+// DeleteObjectsRequest creates a delete objects request
+func (api *S3Operations) DeleteObjectsRequest(i *s3.DeleteObjectsInput) DeleteObjectsRequest {
+	return api.s3.DeleteObjectsRequest(i)
+}
+
+// GetBucketTaggingRequest creates a get bucket tagging request
+func (api *S3Operations) GetBucketTaggingRequest(i *s3.GetBucketTaggingInput) GetBucketTaggingRequest {
+	return api.s3.GetBucketTaggingRequest(i)
+}
+
+// PutBucketTaggingRequest creates a put bucket tagging request
+func (api *S3Operations) PutBucketTaggingRequest(i *s3.PutBucketTaggingInput) PutBucketTaggingRequest {
+	return api.s3.PutBucketTaggingRequest(i)
+}
+
+// DeleteBucketTaggingRequest creates a delete bucket tagging request
+func (api *S3Operations) DeleteBucketTaggingRequest(i *s3.DeleteBucketTaggingInput) DeleteBucketTaggingRequest {
+	return api.s3.DeleteBucketTaggingRequest(i)
+}
+
+// GetObjectLockConfigurationRequest creates a get object lock configuration request
+func (api *S3Operations) GetObjectLockConfigurationRequest(i *s3.GetObjectLockConfigurationInput) GetObjectLockConfigurationRequest {
+	return api.s3.GetObjectLockConfigurationRequest(i)
+}
+
+// PutObjectLockConfigurationRequest creates a put object lock configuration request
+func (api *S3Operations) PutObjectLockConfigurationRequest(i *s3.PutObjectLockConfigurationInput) PutObjectLockConfigurationRequest {
+	return api.s3.PutObjectLockConfigurationRequest(i)
+}
+
+// GetBucketRequestPaymentRequest creates a get bucket request payment request
+func (api *S3Operations) GetBucketRequestPaymentRequest(i *s3.GetBucketRequestPaymentInput) GetBucketRequestPaymentRequest {
+	return api.s3.GetBucketRequestPaymentRequest(i)
+}
+
+// PutBucketRequestPaymentRequest creates a put bucket request payment request
+func (api *S3Operations) PutBucketRequestPaymentRequest(i *s3.PutBucketRequestPaymentInput) PutBucketRequestPaymentRequest {
+	return api.s3.PutBucketRequestPaymentRequest(i)
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+// BucketPolicyNotFoundErrCode is the error code returned by S3 when a bucket
+// has no bucket policy.
+const BucketPolicyNotFoundErrCode = "NoSuchBucketPolicy"
+
+// accountRootARN matches the ARN of the root user of an account, which IAM
+// policies may also refer to by the bare account ID.
+var accountRootARN = regexp.MustCompile(`^arn:[a-z-]+:iam::([0-9]{12}):root$`)
+
+// IsBucketPolicyNotFound returns true if the error indicates that a bucket has
+// no bucket policy.
+func IsBucketPolicyNotFound(err error) bool {
+	return isErrorCode(err, BucketPolicyNotFoundErrCode)
+}
+
+// IsBucketPolicyUpToDate returns true if the observed bucket policy is
+// semantically equal to the desired one. The bucket policy is considered up to
+// date when no policy is desired.
+func IsBucketPolicyUpToDate(desired, observed *string) (bool, error) {
+	if desired == nil {
+		return true, nil
+	}
+	if observed == nil {
+		return false, nil
+	}
+	d, err := normalizePolicy(aws.StringValue(desired))
+	if err != nil {
+		return false, err
+	}
+	o, err := normalizePolicy(aws.StringValue(observed))
+	if err != nil {
+		return false, err
+	}
+	return reflect.DeepEqual(d, o), nil
+}
+
+// normalizePolicy unmarshals the supplied IAM policy document into a form that
+// can be compared regardless of how S3 returns it. S3 may reorder statements
+// and their values, and may return a single value as a string rather than a
+// list.
+func normalizePolicy(policy string) (interface{}, error) {
+	var doc interface{}
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return nil, err
+	}
+	m, ok := doc.(map[string]interface{})
+	if !ok {
+		return doc, nil
+	}
+	s, ok := m["Statement"]
+	if !ok {
+		return doc, nil
+	}
+	statements, ok := toList(s).([]interface{})
+	if !ok {
+		return doc, nil
+	}
+	for _, st := range statements {
+		normalizeStatement(st)
+	}
+	m["Statement"] = sortList(statements)
+	return doc, nil
+}
+
+// normalizeStatement normalizes the values of the supplied statement that may
+// either be a string or a list of strings.
+func normalizeStatement(statement interface{}) {
+	st, ok := statement.(map[string]interface{})
+	if !ok {
+		return
+	}
+	for _, k := range []string{"Action", "NotAction", "Resource", "NotResource"} {
+		if v, ok := st[k]; ok {
+			st[k] = sortList(toList(v))
+		}
+	}
+	for _, k := range []string{"Principal", "NotPrincipal"} {
+		if v, ok := st[k]; ok {
+			st[k] = normalizePrincipal(v)
+		}
+	}
+	// Conditions map operators to keys, which in turn map to values.
+	if c, ok := st["Condition"].(map[string]interface{}); ok {
+		for _, v := range c {
+			normalizeValues(v)
+		}
+	}
+}
+
+// normalizePrincipal normalizes the supplied principal. The "*" principal is
+// the same as the {"AWS": "*"} one, and an account may either be referred to
+// by its ID or by the ARN of its root user, which S3 returns instead.
+func normalizePrincipal(v interface{}) interface{} {
+	if v == "*" {
+		v = map[string]interface{}{"AWS": "*"}
+	}
+	normalizeValues(v)
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return v
+	}
+	if l, ok := m["AWS"].([]interface{}); ok {
+		for i, p := range l {
+			s, ok := p.(string)
+			if !ok {
+				continue
+			}
+			if match := accountRootARN.FindStringSubmatch(s); match != nil {
+				l[i] = match[1]
+			}
+		}
+		m["AWS"] = sortList(l)
+	}
+	return v
+}
+
+// normalizeValues normalizes the values of the supplied map, if it is one.
+func normalizeValues(v interface{}) {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return
+	}
+	for k, v := range m {
+		m[k] = sortList(toList(v))
+	}
+}
+
+// toList returns a single element list of the supplied value unless it is a
+// list already.
+func toList(v interface{}) interface{} {
+	if _, ok := v.([]interface{}); ok {
+		return v
+	}
+	return []interface{}{v}
+}
+
+// sortList sorts the supplied list, if it is one, by the JSON encoding of its
+// elements. Maps are encoded with sorted keys, so the encoding of an element is
+// canonical once its own lists are sorted.
+func sortList(v interface{}) interface{} {
+	l, ok := v.([]interface{})
+	if !ok {
+		return v
+	}
+	sort.SliceStable(l, func(i, j int) bool { return jsonKey(l[i]) < jsonKey(l[j]) })
+	return l
+}
+
+func jsonKey(v interface{}) string {
+	b, _ := json.Marshal(v)
+	return string(b)
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIsBucketPolicyUpToDate(t *testing.T) {
+	policy := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`
+	reformatted := `{
+  "Statement": [
+    {
+      "Resource": "arn:aws:s3:::bucket/*",
+      "Action": "s3:GetObject",
+      "Principal": {"AWS": "arn:aws:iam::123456789012:root"},
+      "Effect": "Allow"
+    }
+  ],
+  "Version": "2012-10-17"
+}`
+
+	statements := `{"Version":"2012-10-17","Statement":[
+  {"Sid":"read","Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:root","arn:aws:iam::210987654321:root"]},"Action":["s3:GetObject","s3:ListBucket"],"Resource":["arn:aws:s3:::bucket","arn:aws:s3:::bucket/*"]},
+  {"Sid":"write","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":["s3:PutObject"],"Resource":["arn:aws:s3:::bucket/*"],"Condition":{"StringEquals":{"s3:x-amz-acl":["private"]}}}
+]}`
+	reordered := `{"Version":"2012-10-17","Statement":[
+  {"Sid":"write","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"s3:PutObject","Resource":"arn:aws:s3:::bucket/*","Condition":{"StringEquals":{"s3:x-amz-acl":"private"}}},
+  {"Sid":"read","Effect":"Allow","Principal":{"AWS":["arn:aws:iam::210987654321:root","arn:aws:iam::123456789012:root"]},"Action":["s3:ListBucket","s3:GetObject"],"Resource":["arn:aws:s3:::bucket/*","arn:aws:s3:::bucket"]}
+]}`
+
+	type args struct {
+		desired  *string
+		observed *string
+	}
+	type want struct {
+		upToDate bool
+		err      bool
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotManaged": {
+			args: args{observed: aws.String(policy)},
+			want: want{upToDate: true},
+		},
+		"Missing": {
+			args: args{desired: aws.String(policy)},
+			want: want{upToDate: false},
+		},
+		"Reformatted": {
+			args: args{desired: aws.String(reformatted), observed: aws.String(policy)},
+			want: want{upToDate: true},
+		},
+		"Reordered": {
+			args: args{desired: aws.String(statements), observed: aws.String(reordered)},
+			want: want{upToDate: true},
+		},
+		"StringAndList": {
+			args: args{
+				desired:  aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:root"]},"Action":["s3:GetObject"],"Resource":["arn:aws:s3:::bucket/*"]}]}`),
+				observed: aws.String(policy),
+			},
+			want: want{upToDate: true},
+		},
+		"SingleStatement": {
+			args: args{
+				desired:  aws.String(`{"Version":"2012-10-17","Statement":{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}}`),
+				observed: aws.String(policy),
+			},
+			want: want{upToDate: true},
+		},
+		"AccountID": {
+			args: args{
+				desired:  aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`),
+				observed: aws.String(policy),
+			},
+			want: want{upToDate: true},
+		},
+		"AccountIDs": {
+			args: args{
+				desired:  aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["210987654321","arn:aws:iam::123456789012:root"]},"Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`),
+				observed: aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:root","arn:aws:iam::210987654321:root"]},"Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`),
+			},
+			want: want{upToDate: true},
+		},
+		"OtherAccountID": {
+			args: args{
+				desired:  aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"210987654321"},"Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`),
+				observed: aws.String(policy),
+			},
+			want: want{upToDate: false},
+		},
+		"Anyone": {
+			args: args{
+				desired:  aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`),
+				observed: aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"*"},"Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`),
+			},
+			want: want{upToDate: true},
+		},
+		"AnyoneNotPrincipal": {
+			args: args{
+				desired:  aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Deny","NotPrincipal":{"AWS":["*"]},"Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`),
+				observed: aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Deny","NotPrincipal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`),
+			},
+			want: want{upToDate: true},
+		},
+		"AnyoneChanged": {
+			args: args{
+				desired:  aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`),
+				observed: aws.String(policy),
+			},
+			want: want{upToDate: false},
+		},
+		"ValueChanged": {
+			args: args{
+				desired:  aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":["s3:GetObject","s3:PutObject"],"Resource":"arn:aws:s3:::bucket/*"}]}`),
+				observed: aws.String(policy),
+			},
+			want: want{upToDate: false},
+		},
+		"Changed": {
+			args: args{desired: aws.String(`{"Version":"2012-10-17","Statement":[]}`), observed: aws.String(policy)},
+			want: want{upToDate: false},
+		},
+		"InvalidJSON": {
+			args: args{desired: aws.String("{"), observed: aws.String(policy)},
+			want: want{err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsBucketPolicyUpToDate(tc.args.desired, tc.args.observed)
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("IsBucketPolicyUpToDate(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.upToDate, got); diff != "" {
+				t.Errorf("IsBucketPolicyUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	"github.com/crossplane/provider-aws/apis/storage/v1alpha3"
+)
+
+// PublicAccessBlockNotFoundErrCode is the error code returned by S3 when a
+// bucket has no public access block configuration.
+const PublicAccessBlockNotFoundErrCode = "NoSuchPublicAccessBlockConfiguration"
+
+// IsPublicAccessBlockNotFound returns true if the error indicates that a bucket
+// has no public access block configuration.
+func IsPublicAccessBlockNotFound(err error) bool {
+	return isErrorCode(err, PublicAccessBlockNotFoundErrCode)
+}
+
+// GeneratePublicAccessBlock returns the S3 public access block configuration
+// described by the supplied configuration.
+func GeneratePublicAccessBlock(in *v1alpha3.PublicAccessBlockConfiguration) *s3.PublicAccessBlockConfiguration {
+	if in == nil {
+		return nil
+	}
+	return &s3.PublicAccessBlockConfiguration{
+		BlockPublicAcls:       in.BlockPublicAcls,
+		BlockPublicPolicy:     in.BlockPublicPolicy,
+		IgnorePublicAcls:      in.IgnorePublicAcls,
+		RestrictPublicBuckets: in.RestrictPublicBuckets,
+	}
+}
+
+// GeneratePublicAccessBlockConfiguration returns the public access block
+// configuration described by the supplied S3 configuration.
+func GeneratePublicAccessBlockConfiguration(in *s3.PublicAccessBlockConfiguration) *v1alpha3.PublicAccessBlockConfiguration {
+	if in == nil {
+		return nil
+	}
+	return &v1alpha3.PublicAccessBlockConfiguration{
+		BlockPublicAcls:       in.BlockPublicAcls,
+		BlockPublicPolicy:     in.BlockPublicPolicy,
+		IgnorePublicAcls:      in.IgnorePublicAcls,
+		RestrictPublicBuckets: in.RestrictPublicBuckets,
+	}
+}
+
+// IsPublicAccessBlockUpToDate returns true if the observed public access block
+// matches the desired configuration. Unset settings are treated as false, and
+// the public access block is considered up to date when no configuration is
+// desired.
+func IsPublicAccessBlockUpToDate(desired *v1alpha3.PublicAccessBlockConfiguration, observed *s3.PublicAccessBlockConfiguration) bool {
+	if desired == nil {
+		return true
+	}
+	if observed == nil {
+		observed = &s3.PublicAccessBlockConfiguration{}
+	}
+	return aws.BoolValue(desired.BlockPublicAcls) == aws.BoolValue(observed.BlockPublicAcls) &&
+		aws.BoolValue(desired.BlockPublicPolicy) == aws.BoolValue(observed.BlockPublicPolicy) &&
+		aws.BoolValue(desired.IgnorePublicAcls) == aws.BoolValue(observed.IgnorePublicAcls) &&
+		aws.BoolValue(desired.RestrictPublicBuckets) == aws.BoolValue(observed.RestrictPublicBuckets)
+}
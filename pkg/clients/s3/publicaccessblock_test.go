@@ -0,0 +1,86 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/storage/v1alpha3"
+)
+
+func TestIsPublicAccessBlockUpToDate(t *testing.T) {
+	type args struct {
+		desired  *v1alpha3.PublicAccessBlockConfiguration
+		observed *s3.PublicAccessBlockConfiguration
+	}
+
+	cases := map[string]struct {
+		args
+		want bool
+	}{
+		"NotManaged": {
+			args: args{
+				observed: &s3.PublicAccessBlockConfiguration{BlockPublicAcls: aws.Bool(true)},
+			},
+			want: true,
+		},
+		"UpToDate": {
+			args: args{
+				desired: &v1alpha3.PublicAccessBlockConfiguration{
+					BlockPublicAcls:       aws.Bool(true),
+					BlockPublicPolicy:     aws.Bool(true),
+					IgnorePublicAcls:      aws.Bool(true),
+					RestrictPublicBuckets: aws.Bool(true),
+				},
+				observed: &s3.PublicAccessBlockConfiguration{
+					BlockPublicAcls:       aws.Bool(true),
+					BlockPublicPolicy:     aws.Bool(true),
+					IgnorePublicAcls:      aws.Bool(true),
+					RestrictPublicBuckets: aws.Bool(true),
+				},
+			},
+			want: true,
+		},
+		"UnsetIsFalse": {
+			args: args{
+				desired:  &v1alpha3.PublicAccessBlockConfiguration{BlockPublicAcls: aws.Bool(false)},
+				observed: nil,
+			},
+			want: true,
+		},
+		"Changed": {
+			args: args{
+				desired:  &v1alpha3.PublicAccessBlockConfiguration{BlockPublicPolicy: aws.Bool(true)},
+				observed: &s3.PublicAccessBlockConfiguration{BlockPublicPolicy: aws.Bool(false)},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsPublicAccessBlockUpToDate(tc.args.desired, tc.args.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsPublicAccessBlockUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/storage/v1alpha3"
+)
+
+// ReplicationNotFoundErrCode is the error code returned by S3 when a bucket
+// has no replication configuration.
+const ReplicationNotFoundErrCode = "ReplicationConfigurationNotFoundError"
+
+// IsReplicationNotFound returns true if the error indicates that a bucket has
+// no replication configuration.
+func IsReplicationNotFound(err error) bool {
+	return isErrorCode(err, ReplicationNotFoundErrCode)
+}
+
+// GenerateReplication returns the S3 replication configuration described by
+// the supplied configuration.
+func GenerateReplication(in *v1alpha3.ReplicationConfiguration) *s3.ReplicationConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := &s3.ReplicationConfiguration{Role: in.Role, Rules: make([]s3.ReplicationRule, len(in.Rules))}
+	for i, r := range in.Rules {
+		rule := s3.ReplicationRule{
+			ID:       r.ID,
+			Priority: r.Priority,
+			Status:   s3.ReplicationRuleStatus(r.Status),
+			// S3 requires rules that use a filter to specify a priority and
+			// whether delete markers are replicated. An empty prefix matches
+			// every object.
+			Filter:                  &s3.ReplicationRuleFilter{Prefix: aws.String("")},
+			DeleteMarkerReplication: &s3.DeleteMarkerReplication{Status: s3.DeleteMarkerReplicationStatusDisabled},
+			Destination:             &s3.Destination{Bucket: r.Destination.Bucket},
+		}
+		if rule.Priority == nil {
+			rule.Priority = aws.Int64(0)
+		}
+		if r.Filter != nil {
+			rule.Filter = &s3.ReplicationRuleFilter{Prefix: r.Filter.Prefix}
+			if r.Filter.Tag != nil {
+				rule.Filter.Tag = &s3.Tag{Key: aws.String(r.Filter.Tag.Key), Value: aws.String(r.Filter.Tag.Value)}
+			}
+			if r.Filter.And != nil {
+				rule.Filter.And = &s3.ReplicationRuleAndOperator{Prefix: r.Filter.And.Prefix}
+				for _, t := range r.Filter.And.Tags {
+					rule.Filter.And.Tags = append(rule.Filter.And.Tags, s3.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)})
+				}
+			}
+		}
+		if r.DeleteMarkerReplication != nil {
+			rule.DeleteMarkerReplication.Status = s3.DeleteMarkerReplicationStatus(*r.DeleteMarkerReplication)
+		}
+		if r.Destination.StorageClass != nil {
+			rule.Destination.StorageClass = s3.StorageClass(*r.Destination.StorageClass)
+		}
+		out.Rules[i] = rule
+	}
+	return out
+}
+
+// GenerateReplicationConfiguration returns the replication configuration
+// described by the supplied S3 replication configuration.
+func GenerateReplicationConfiguration(in *s3.ReplicationConfiguration) *v1alpha3.ReplicationConfiguration {
+	if in == nil || len(in.Rules) == 0 {
+		return nil
+	}
+	cfg := &v1alpha3.ReplicationConfiguration{Role: in.Role, Rules: make([]v1alpha3.ReplicationRule, len(in.Rules))}
+	for i, r := range in.Rules {
+		rule := v1alpha3.ReplicationRule{
+			ID:       r.ID,
+			Priority: r.Priority,
+			Status:   string(r.Status),
+		}
+		if f := r.Filter; f != nil && (aws.StringValue(f.Prefix) != "" || f.Tag != nil || f.And != nil) {
+			rule.Filter = &v1alpha3.ReplicationRuleFilter{Prefix: f.Prefix}
+			if f.Tag != nil {
+				rule.Filter.Tag = &v1alpha3.Tag{Key: aws.StringValue(f.Tag.Key), Value: aws.StringValue(f.Tag.Value)}
+			}
+			if f.And != nil {
+				rule.Filter.And = &v1alpha3.ReplicationRuleAndOperator{Prefix: f.And.Prefix}
+				for _, t := range f.And.Tags {
+					rule.Filter.And.Tags = append(rule.Filter.And.Tags, v1alpha3.Tag{Key: aws.StringValue(t.Key), Value: aws.StringValue(t.Value)})
+				}
+			}
+		}
+		if d := r.DeleteMarkerReplication; d != nil && d.Status != "" {
+			rule.DeleteMarkerReplication = aws.String(string(d.Status))
+		}
+		if d := r.Destination; d != nil {
+			rule.Destination.Bucket = d.Bucket
+			if d.StorageClass != "" {
+				rule.Destination.StorageClass = aws.String(string(d.StorageClass))
+			}
+		}
+		cfg.Rules[i] = rule
+	}
+	return cfg
+}
+
+// IsReplicationConfigurationUpToDate returns true if the observed replication
+// configuration matches the desired one. Replication is considered up to date
+// when no configuration is desired.
+func IsReplicationConfigurationUpToDate(desired *v1alpha3.ReplicationConfiguration, observed *s3.ReplicationConfiguration) bool {
+	if desired == nil {
+		return true
+	}
+	current := GenerateReplicationConfiguration(observed)
+	if current == nil || len(current.Rules) != len(desired.Rules) {
+		return false
+	}
+	// Round tripping the desired configuration applies the defaults S3 would
+	// and drops references, which are never observed.
+	want := GenerateReplicationConfiguration(GenerateReplication(desired))
+
+	// S3 generates an ID for rules that don't specify one.
+	for i := range desired.Rules {
+		if desired.Rules[i].ID == nil {
+			current.Rules[i].ID = nil
+		}
+	}
+	return cmp.Equal(want, current, cmpopts.EquateEmpty())
+}
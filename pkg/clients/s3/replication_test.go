@@ -0,0 +1,108 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/go-cmp/cmp"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	"github.com/crossplane/provider-aws/apis/storage/v1alpha3"
+)
+
+func TestIsReplicationConfigurationUpToDate(t *testing.T) {
+	replication := &v1alpha3.ReplicationConfiguration{
+		Role:    aws.String("arn:aws:iam::123456789012:role/replication"),
+		RoleRef: &runtimev1alpha1.Reference{Name: "replication"},
+		Rules: []v1alpha3.ReplicationRule{{
+			Status: v1alpha3.ReplicationRuleStatusEnabled,
+			Filter: &v1alpha3.ReplicationRuleFilter{Prefix: aws.String("data/")},
+			Destination: v1alpha3.ReplicationDestination{
+				Bucket:       aws.String("arn:aws:s3:::replica"),
+				BucketRef:    &runtimev1alpha1.Reference{Name: "replica"},
+				StorageClass: aws.String("STANDARD_IA"),
+			},
+		}},
+	}
+
+	observed := func(m func(*s3.ReplicationRule)) *s3.ReplicationConfiguration {
+		r := s3.ReplicationRule{
+			ID:                      aws.String("generated"),
+			Priority:                aws.Int64(0),
+			Status:                  s3.ReplicationRuleStatusEnabled,
+			Filter:                  &s3.ReplicationRuleFilter{Prefix: aws.String("data/")},
+			DeleteMarkerReplication: &s3.DeleteMarkerReplication{Status: s3.DeleteMarkerReplicationStatusDisabled},
+			Destination:             &s3.Destination{Bucket: aws.String("arn:aws:s3:::replica"), StorageClass: s3.StorageClassStandardIa},
+		}
+		if m != nil {
+			m(&r)
+		}
+		return &s3.ReplicationConfiguration{Role: aws.String("arn:aws:iam::123456789012:role/replication"), Rules: []s3.ReplicationRule{r}}
+	}
+
+	type args struct {
+		desired  *v1alpha3.ReplicationConfiguration
+		observed *s3.ReplicationConfiguration
+	}
+
+	cases := map[string]struct {
+		args
+		want bool
+	}{
+		"NotManaged": {
+			args: args{observed: observed(nil)},
+			want: true,
+		},
+		"UpToDate": {
+			args: args{desired: replication, observed: observed(nil)},
+			want: true,
+		},
+		"Missing": {
+			args: args{desired: replication},
+			want: false,
+		},
+		"DestinationChanged": {
+			args: args{
+				desired:  replication,
+				observed: observed(func(r *s3.ReplicationRule) { r.Destination.Bucket = aws.String("arn:aws:s3:::other") }),
+			},
+			want: false,
+		},
+		"DeleteMarkerReplicationChanged": {
+			args: args{
+				desired: replication,
+				observed: observed(func(r *s3.ReplicationRule) {
+					r.DeleteMarkerReplication.Status = s3.DeleteMarkerReplicationStatusEnabled
+				}),
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsReplicationConfigurationUpToDate(tc.args.desired, tc.args.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsReplicationConfigurationUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
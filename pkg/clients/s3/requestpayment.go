@@ -0,0 +1,30 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// IsRequestPayerUpToDate returns true if the observed payer matches the
+// desired one. The payer is considered up to date when none is desired.
+func IsRequestPayerUpToDate(desired *string, observed s3.Payer) bool {
+	if desired == nil {
+		return true
+	}
+	return *desired == string(observed)
+}
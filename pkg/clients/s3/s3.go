@@ -42,17 +42,42 @@ const (
 	maxIAMUsernameLength = 64
 	// https://docs.aws.amazon.com/general/latest/gr/rande.html#s3_region
 	regionWithNoConstraint = "us-east-1"
+	// https://docs.aws.amazon.com/AmazonS3/latest/API/API_DeleteObjects.html
+	maxDeleteObjects = 1000
+
+	// AccessPolicyARNKey is the connection detail key of the ARN of the IAM
+	// policy that grants access to a bucket in policy access mode.
+	AccessPolicyARNKey = "policyARN"
+
+	// BucketNotEmptyErrCode is the error code returned by S3 when deleting a
+	// bucket that still contains objects.
+	BucketNotEmptyErrCode = "BucketNotEmpty"
 )
 
 // Service defines S3 Client operations
 type Service interface {
-	CreateOrUpdateBucket(bucket *v1alpha3.S3Bucket) error
-	GetBucketInfo(username string, bucket *v1alpha3.S3Bucket) (*Bucket, error)
-	CreateUser(username string, bucket *v1alpha3.S3Bucket) (*iam.AccessKey, string, error)
-	UpdateBucketACL(bucket *v1alpha3.S3Bucket) error
-	UpdateVersioning(bucket *v1alpha3.S3Bucket) error
-	UpdatePolicyDocument(username string, bucket *v1alpha3.S3Bucket) (string, error)
-	DeleteBucket(bucket *v1alpha3.S3Bucket) error
+	CreateOrUpdateBucket(ctx context.Context, bucket *v1alpha3.S3Bucket) error
+	GetBucketInfo(ctx context.Context, policyName string, bucket *v1alpha3.S3Bucket) (*Bucket, error)
+	CreateUser(ctx context.Context, username string, bucket *v1alpha3.S3Bucket) (*iam.AccessKey, string, error)
+	CreateAccessPolicy(ctx context.Context, policyName string, bucket *v1alpha3.S3Bucket) (string, string, error)
+	AttachAccessPolicy(ctx context.Context, policyName string, bucket *v1alpha3.S3Bucket) error
+	DetachAccessPolicy(ctx context.Context, policyName string, roleName string) error
+	UpdateBucketACL(ctx context.Context, bucket *v1alpha3.S3Bucket) error
+	UpdateVersioning(ctx context.Context, bucket *v1alpha3.S3Bucket) error
+	UpdatePolicyDocument(ctx context.Context, username string, bucket *v1alpha3.S3Bucket) (string, error)
+	DeleteBucket(ctx context.Context, bucket *v1alpha3.S3Bucket) error
+	UpdateLifecycleConfiguration(ctx context.Context, bucket *v1alpha3.S3Bucket) error
+	UpdateServerSideEncryption(ctx context.Context, bucket *v1alpha3.S3Bucket) error
+	UpdatePublicAccessBlock(ctx context.Context, bucket *v1alpha3.S3Bucket) error
+	UpdateBucketPolicy(ctx context.Context, bucket *v1alpha3.S3Bucket) error
+	UpdateCORS(ctx context.Context, bucket *v1alpha3.S3Bucket) error
+	UpdateWebsite(ctx context.Context, bucket *v1alpha3.S3Bucket) error
+	UpdateLogging(ctx context.Context, bucket *v1alpha3.S3Bucket) error
+	UpdateReplication(ctx context.Context, bucket *v1alpha3.S3Bucket) error
+	EmptyBucket(ctx context.Context, bucket *v1alpha3.S3Bucket) (int, error)
+	UpdateTags(ctx context.Context, bucket *v1alpha3.S3Bucket) error
+	UpdateObjectLock(ctx context.Context, bucket *v1alpha3.S3Bucket) error
+	UpdateRequestPayer(ctx context.Context, bucket *v1alpha3.S3Bucket) error
 }
 
 // Client implements S3 Client
@@ -68,53 +93,249 @@ func NewClient(config *aws.Config) Service {
 }
 
 // CreateOrUpdateBucket creates or updates the supplied S3 bucket with provided
-// specification, and returns access keys with permissions of localPermission
-func (c *Client) CreateOrUpdateBucket(bucket *v1alpha3.S3Bucket) error {
+// specification, and applies its default encryption, public access block,
+// tags, Object Lock configuration and request payer.
+func (c *Client) CreateOrUpdateBucket(ctx context.Context, bucket *v1alpha3.S3Bucket) error {
 	input := CreateBucketInput(bucket)
-	_, err := c.s3.CreateBucketRequest(input).Send(context.TODO())
+	_, err := c.s3.CreateBucketRequest(input).Send(ctx)
 	if err != nil {
-		if isErrorAlreadyExists(err) {
-			return c.UpdateBucketACL(bucket)
+		if !isErrorAlreadyExists(err) {
+			return err
+		}
+		if err := c.UpdateBucketACL(ctx, bucket); err != nil {
+			return err
 		}
 	}
-	return err
+	if err := c.UpdateServerSideEncryption(ctx, bucket); err != nil {
+		return err
+	}
+	if err := c.UpdatePublicAccessBlock(ctx, bucket); err != nil {
+		return err
+	}
+	// A new bucket has no tags, so there are none to delete when none are
+	// desired.
+	if len(bucket.Spec.Tags) > 0 {
+		if err := c.UpdateTags(ctx, bucket); err != nil {
+			return err
+		}
+	}
+	if err := c.UpdateObjectLock(ctx, bucket); err != nil {
+		return err
+	}
+	return c.UpdateRequestPayer(ctx, bucket)
 }
 
 // Bucket represents crossplane metadata about the bucket
 type Bucket struct {
 	Versioning        bool
 	UserPolicyVersion string
+	LifecycleRules    []s3.LifecycleRule
+
+	ServerSideEncryption *s3.ServerSideEncryptionConfiguration
+	PublicAccessBlock    *s3.PublicAccessBlockConfiguration
+	Policy               *string
+	CORSRules            []s3.CORSRule
+	Website              *s3.WebsiteConfiguration
+	Logging              *s3.LoggingEnabled
+	Replication          *s3.ReplicationConfiguration
+	Tags                 []s3.Tag
+	ObjectLock           *s3.ObjectLockConfiguration
+	RequestPayer         s3.Payer
+
+	AccessPolicyARN   string
+	AccessPolicyRoles []string
 }
 
-// GetBucketInfo returns the status of key bucket settings including user's policy version for permission status
-func (c *Client) GetBucketInfo(username string, bucket *v1alpha3.S3Bucket) (*Bucket, error) {
+// GetBucketInfo returns the status of key bucket settings including the
+// version of the supplied IAM policy, which is named like the bucket's IAM user
+// in user access mode.
+func (c *Client) GetBucketInfo(ctx context.Context, policyName string, bucket *v1alpha3.S3Bucket) (*Bucket, error) {
 	b := Bucket{}
-	bucketVersioning, err := c.s3.GetBucketVersioningRequest(&s3.GetBucketVersioningInput{Bucket: aws.String(meta.GetExternalName(bucket))}).Send(context.TODO())
+	bucketVersioning, err := c.s3.GetBucketVersioningRequest(&s3.GetBucketVersioningInput{Bucket: aws.String(meta.GetExternalName(bucket))}).Send(ctx)
 	if err != nil {
 		return nil, err
 	}
 	b.Versioning = bucketVersioning.Status == s3.BucketVersioningStatusEnabled
-	policyVersion, err := c.iamClient.GetPolicyVersion(username)
+	policyVersion, err := c.iamClient.GetPolicyVersion(ctx, policyName)
 	if err != nil {
 		return nil, err
 	}
 	b.UserPolicyVersion = policyVersion
 
-	return &b, err
+	if bucket.Spec.AccessMode == v1alpha3.AccessModePolicy {
+		if b.AccessPolicyARN, err = c.iamClient.GetPolicyARN(ctx, policyName); err != nil {
+			return nil, err
+		}
+		if b.AccessPolicyRoles, err = c.iamClient.GetPolicyRoles(ctx, policyName); err != nil {
+			return nil, err
+		}
+	}
+
+	// Lifecycle rules are only fetched when we manage them.
+	if bucket.Spec.LifecycleConfiguration != nil {
+		lifecycle, err := c.s3.GetBucketLifecycleConfigurationRequest(&s3.GetBucketLifecycleConfigurationInput{Bucket: aws.String(meta.GetExternalName(bucket))}).Send(ctx)
+		if resource.Ignore(IsLifecycleNotFound, err) != nil {
+			return nil, err
+		}
+		if err == nil {
+			b.LifecycleRules = lifecycle.Rules
+		}
+	}
+
+	sse, err := c.s3.GetBucketEncryptionRequest(&s3.GetBucketEncryptionInput{Bucket: aws.String(meta.GetExternalName(bucket))}).Send(ctx)
+	if resource.Ignore(IsSSENotFound, err) != nil {
+		return nil, err
+	}
+	if err == nil {
+		b.ServerSideEncryption = sse.ServerSideEncryptionConfiguration
+	}
+
+	pab, err := c.s3.GetPublicAccessBlockRequest(&s3.GetPublicAccessBlockInput{Bucket: aws.String(meta.GetExternalName(bucket))}).Send(ctx)
+	if resource.Ignore(IsPublicAccessBlockNotFound, err) != nil {
+		return nil, err
+	}
+	if err == nil {
+		b.PublicAccessBlock = pab.PublicAccessBlockConfiguration
+	}
+
+	// The bucket policy is only fetched when we manage it.
+	if bucket.Spec.BucketPolicy != nil {
+		policy, err := c.s3.GetBucketPolicyRequest(&s3.GetBucketPolicyInput{Bucket: aws.String(meta.GetExternalName(bucket))}).Send(ctx)
+		if resource.Ignore(IsBucketPolicyNotFound, err) != nil {
+			return nil, err
+		}
+		if err == nil {
+			b.Policy = policy.Policy
+		}
+	}
+
+	if bucket.Spec.CORSConfiguration != nil {
+		cors, err := c.s3.GetBucketCORSRequest(&s3.GetBucketCorsInput{Bucket: aws.String(meta.GetExternalName(bucket))}).Send(ctx)
+		if resource.Ignore(IsCORSNotFound, err) != nil {
+			return nil, err
+		}
+		if err == nil {
+			b.CORSRules = cors.CORSRules
+		}
+	}
+
+	if bucket.Spec.WebsiteConfiguration != nil {
+		website, err := c.s3.GetBucketWebsiteRequest(&s3.GetBucketWebsiteInput{Bucket: aws.String(meta.GetExternalName(bucket))}).Send(ctx)
+		if resource.Ignore(IsWebsiteNotFound, err) != nil {
+			return nil, err
+		}
+		if err == nil {
+			b.Website = &s3.WebsiteConfiguration{
+				ErrorDocument:         website.ErrorDocument,
+				IndexDocument:         website.IndexDocument,
+				RedirectAllRequestsTo: website.RedirectAllRequestsTo,
+				RoutingRules:          website.RoutingRules,
+			}
+		}
+	}
+
+	if bucket.Spec.LoggingConfiguration != nil {
+		logging, err := c.s3.GetBucketLoggingRequest(&s3.GetBucketLoggingInput{Bucket: aws.String(meta.GetExternalName(bucket))}).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		b.Logging = logging.LoggingEnabled
+	}
+
+	tagging, err := c.s3.GetBucketTaggingRequest(&s3.GetBucketTaggingInput{Bucket: aws.String(meta.GetExternalName(bucket))}).Send(ctx)
+	if resource.Ignore(IsTaggingNotFound, err) != nil {
+		return nil, err
+	}
+	if err == nil {
+		b.Tags = tagging.TagSet
+	}
+
+	if bucket.Spec.ObjectLockConfiguration != nil {
+		lock, err := c.s3.GetObjectLockConfigurationRequest(&s3.GetObjectLockConfigurationInput{Bucket: aws.String(meta.GetExternalName(bucket))}).Send(ctx)
+		if resource.Ignore(IsObjectLockNotFound, err) != nil {
+			return nil, err
+		}
+		if err == nil {
+			b.ObjectLock = lock.ObjectLockConfiguration
+		}
+	}
+
+	if bucket.Spec.RequestPayer != nil {
+		payment, err := c.s3.GetBucketRequestPaymentRequest(&s3.GetBucketRequestPaymentInput{Bucket: aws.String(meta.GetExternalName(bucket))}).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		b.RequestPayer = payment.Payer
+	}
+
+	if bucket.Spec.ReplicationConfiguration != nil {
+		replication, err := c.s3.GetBucketReplicationRequest(&s3.GetBucketReplicationInput{Bucket: aws.String(meta.GetExternalName(bucket))}).Send(ctx)
+		if resource.Ignore(IsReplicationNotFound, err) != nil {
+			return nil, err
+		}
+		if err == nil {
+			b.Replication = replication.ReplicationConfiguration
+		}
+	}
+
+	return &b, nil
+}
+
+// IsBucketUpToDate returns true if the supplied observed bucket matches the
+// supplied parameters. The IAM user policy is not considered.
+func IsBucketUpToDate(p v1alpha3.S3BucketParameters, b Bucket) (bool, error) {
+	policyUpToDate, err := IsBucketPolicyUpToDate(p.BucketPolicy, b.Policy)
+	if err != nil {
+		return false, err
+	}
+	return policyUpToDate && p.Versioning == b.Versioning &&
+		IsLifecycleConfigurationUpToDate(p.LifecycleConfiguration, b.LifecycleRules) &&
+		IsServerSideEncryptionUpToDate(p.ServerSideEncryptionConfiguration, b.ServerSideEncryption) &&
+		IsPublicAccessBlockUpToDate(p.PublicAccessBlockConfiguration, b.PublicAccessBlock) &&
+		IsCORSConfigurationUpToDate(p.CORSConfiguration, b.CORSRules) &&
+		IsWebsiteConfigurationUpToDate(p.WebsiteConfiguration, b.Website) &&
+		IsLoggingConfigurationUpToDate(p.LoggingConfiguration, b.Logging) &&
+		IsReplicationConfigurationUpToDate(p.ReplicationConfiguration, b.Replication) &&
+		AreTagsUpToDate(p.Tags, b.Tags) &&
+		IsObjectLockUpToDate(p.ObjectLockConfiguration, b.ObjectLock) &&
+		IsRequestPayerUpToDate(p.RequestPayer, b.RequestPayer) &&
+		AreAccessPolicyRolesUpToDate(p, b.AccessPolicyRoles), nil
+}
+
+// AreAccessPolicyRolesUpToDate returns true unless the bucket is in policy
+// access mode and its IAM policy is not attached to exactly the desired IAM
+// role, or to no role if none is desired.
+func AreAccessPolicyRolesUpToDate(p v1alpha3.S3BucketParameters, roles []string) bool {
+	if p.AccessMode != v1alpha3.AccessModePolicy {
+		return true
+	}
+	if p.IAMRoleName == nil {
+		return len(roles) == 0
+	}
+	return len(roles) == 1 && roles[0] == *p.IAMRoleName
+}
+
+// GenerateObservation produces an S3BucketObservation from the supplied
+// observed bucket.
+func GenerateObservation(b Bucket) v1alpha3.S3BucketObservation {
+	return v1alpha3.S3BucketObservation{
+		ServerSideEncryptionConfiguration: GenerateServerSideEncryptionConfiguration(b.ServerSideEncryption),
+		PublicAccessBlockConfiguration:    GeneratePublicAccessBlockConfiguration(b.PublicAccessBlock),
+	}
 }
 
 // CreateUser - Create as user to access bucket per permissions in BucketSpec returing access key and policy version
-func (c *Client) CreateUser(username string, bucket *v1alpha3.S3Bucket) (*iam.AccessKey, string, error) {
+func (c *Client) CreateUser(ctx context.Context, username string, bucket *v1alpha3.S3Bucket) (*iam.AccessKey, string, error) {
 	policyDocument, err := newPolicyDocument(bucket)
 	if err != nil {
 		return nil, "", fmt.Errorf("could not update policy, %s", err.Error())
 	}
-	accessKeys, err := c.iamClient.CreateUser(username)
+	accessKeys, err := c.iamClient.CreateUser(ctx, username)
 	if err != nil {
 		return nil, "", fmt.Errorf("could not create user %s", err)
 	}
 
-	currentVersion, err := c.iamClient.CreatePolicyAndAttach(username, username, policyDocument)
+	currentVersion, err := c.iamClient.CreatePolicyAndAttach(ctx, username, username, policyDocument)
 	if err != nil {
 		return nil, "", fmt.Errorf("could not create policy %s", err)
 	}
@@ -122,8 +343,51 @@ func (c *Client) CreateUser(username string, bucket *v1alpha3.S3Bucket) (*iam.Ac
 	return accessKeys, currentVersion, nil
 }
 
+// CreateAccessPolicy creates an IAM policy that grants access to the bucket per
+// its local permission, and attaches it to the bucket's IAM role if any. It
+// returns the ARN and current version of the policy.
+func (c *Client) CreateAccessPolicy(ctx context.Context, policyName string, bucket *v1alpha3.S3Bucket) (string, string, error) {
+	policyDocument, err := newPolicyDocument(bucket)
+	if err != nil {
+		return "", "", fmt.Errorf("could not generate policy, %s", err.Error())
+	}
+	currentVersion, err := c.iamClient.CreatePolicy(ctx, policyName, policyDocument)
+	if err != nil {
+		return "", "", err
+	}
+	if err := c.AttachAccessPolicy(ctx, policyName, bucket); err != nil {
+		return "", "", err
+	}
+	policyARN, err := c.iamClient.GetPolicyARN(ctx, policyName)
+	if err != nil {
+		return "", "", err
+	}
+	return policyARN, currentVersion, nil
+}
+
+// AttachAccessPolicy attaches the IAM policy that grants access to the bucket
+// to the bucket's IAM role, if any.
+func (c *Client) AttachAccessPolicy(ctx context.Context, policyName string, bucket *v1alpha3.S3Bucket) error {
+	if bucket.Spec.IAMRoleName == nil {
+		return nil
+	}
+	if err := c.iamClient.AttachPolicyToRole(ctx, policyName, *bucket.Spec.IAMRoleName); err != nil {
+		return fmt.Errorf("could not attach policy, %s", err)
+	}
+	return nil
+}
+
+// DetachAccessPolicy detaches the IAM policy that grants access to the bucket
+// from the supplied IAM role.
+func (c *Client) DetachAccessPolicy(ctx context.Context, policyName string, roleName string) error {
+	if err := c.iamClient.DetachPolicyFromRole(ctx, policyName, roleName); err != nil {
+		return fmt.Errorf("could not detach policy, %s", err)
+	}
+	return nil
+}
+
 // UpdateBucketACL - Updated CannedACL on Bucket
-func (c *Client) UpdateBucketACL(bucket *v1alpha3.S3Bucket) error {
+func (c *Client) UpdateBucketACL(ctx context.Context, bucket *v1alpha3.S3Bucket) error {
 	if bucket.Spec.CannedACL == nil {
 		return nil
 	}
@@ -131,18 +395,18 @@ func (c *Client) UpdateBucketACL(bucket *v1alpha3.S3Bucket) error {
 		ACL:    *bucket.Spec.CannedACL,
 		Bucket: aws.String(meta.GetExternalName(bucket)),
 	}
-	_, err := c.s3.PutBucketACLRequest(input).Send(context.TODO())
+	_, err := c.s3.PutBucketACLRequest(input).Send(ctx)
 	return err
 }
 
 // UpdateVersioning configuration for Bucket
-func (c *Client) UpdateVersioning(bucket *v1alpha3.S3Bucket) error {
+func (c *Client) UpdateVersioning(ctx context.Context, bucket *v1alpha3.S3Bucket) error {
 	versioningStatus := s3.BucketVersioningStatusSuspended
 	if bucket.Spec.Versioning {
 		versioningStatus = s3.BucketVersioningStatusEnabled
 	}
 	input := &s3.PutBucketVersioningInput{Bucket: aws.String(meta.GetExternalName(bucket)), VersioningConfiguration: &s3.VersioningConfiguration{Status: versioningStatus}}
-	_, err := c.s3.PutBucketVersioningRequest(input).Send(context.TODO())
+	_, err := c.s3.PutBucketVersioningRequest(input).Send(ctx)
 	if err != nil {
 		return err
 	}
@@ -150,31 +414,225 @@ func (c *Client) UpdateVersioning(bucket *v1alpha3.S3Bucket) error {
 }
 
 // UpdatePolicyDocument based on localPermissions
-func (c *Client) UpdatePolicyDocument(username string, bucket *v1alpha3.S3Bucket) (string, error) {
+func (c *Client) UpdatePolicyDocument(ctx context.Context, username string, bucket *v1alpha3.S3Bucket) (string, error) {
 	policyDocument, err := newPolicyDocument(bucket)
 	if err != nil {
 		return "", fmt.Errorf("could not generate policy, %s", err.Error())
 	}
-	currentVersion, err := c.iamClient.UpdatePolicy(username, policyDocument)
+	currentVersion, err := c.iamClient.UpdatePolicy(ctx, username, policyDocument)
 	if err != nil {
 		return "", fmt.Errorf("could not update policy, %s", err.Error())
 	}
 	return currentVersion, nil
 }
 
+// UpdateLifecycleConfiguration replaces the lifecycle rules of the bucket with
+// those in its spec.
+func (c *Client) UpdateLifecycleConfiguration(ctx context.Context, bucket *v1alpha3.S3Bucket) error {
+	if bucket.Spec.LifecycleConfiguration == nil {
+		return nil
+	}
+	input := &s3.PutBucketLifecycleConfigurationInput{
+		Bucket:                 aws.String(meta.GetExternalName(bucket)),
+		LifecycleConfiguration: &s3.BucketLifecycleConfiguration{Rules: GenerateLifecycleRules(bucket.Spec.LifecycleConfiguration)},
+	}
+	_, err := c.s3.PutBucketLifecycleConfigurationRequest(input).Send(ctx)
+	return err
+}
+
+// UpdateServerSideEncryption applies the default encryption in the spec of the
+// bucket.
+func (c *Client) UpdateServerSideEncryption(ctx context.Context, bucket *v1alpha3.S3Bucket) error {
+	if bucket.Spec.ServerSideEncryptionConfiguration == nil {
+		return nil
+	}
+	input := &s3.PutBucketEncryptionInput{
+		Bucket:                            aws.String(meta.GetExternalName(bucket)),
+		ServerSideEncryptionConfiguration: GenerateServerSideEncryption(bucket.Spec.ServerSideEncryptionConfiguration),
+	}
+	_, err := c.s3.PutBucketEncryptionRequest(input).Send(ctx)
+	return err
+}
+
+// UpdatePublicAccessBlock applies the public access block in the spec of the
+// bucket.
+func (c *Client) UpdatePublicAccessBlock(ctx context.Context, bucket *v1alpha3.S3Bucket) error {
+	if bucket.Spec.PublicAccessBlockConfiguration == nil {
+		return nil
+	}
+	input := &s3.PutPublicAccessBlockInput{
+		Bucket:                         aws.String(meta.GetExternalName(bucket)),
+		PublicAccessBlockConfiguration: GeneratePublicAccessBlock(bucket.Spec.PublicAccessBlockConfiguration),
+	}
+	_, err := c.s3.PutPublicAccessBlockRequest(input).Send(ctx)
+	return err
+}
+
+// UpdateBucketPolicy applies the bucket policy in the spec of the bucket.
+func (c *Client) UpdateBucketPolicy(ctx context.Context, bucket *v1alpha3.S3Bucket) error {
+	if bucket.Spec.BucketPolicy == nil {
+		return nil
+	}
+	input := &s3.PutBucketPolicyInput{
+		Bucket: aws.String(meta.GetExternalName(bucket)),
+		Policy: bucket.Spec.BucketPolicy,
+	}
+	_, err := c.s3.PutBucketPolicyRequest(input).Send(ctx)
+	return err
+}
+
+// UpdateCORS applies the CORS rules in the spec of the bucket.
+func (c *Client) UpdateCORS(ctx context.Context, bucket *v1alpha3.S3Bucket) error {
+	if bucket.Spec.CORSConfiguration == nil {
+		return nil
+	}
+	input := &s3.PutBucketCorsInput{
+		Bucket:            aws.String(meta.GetExternalName(bucket)),
+		CORSConfiguration: &s3.CORSConfiguration{CORSRules: GenerateCORSRules(bucket.Spec.CORSConfiguration)},
+	}
+	_, err := c.s3.PutBucketCORSRequest(input).Send(ctx)
+	return err
+}
+
+// UpdateWebsite applies the website configuration in the spec of the bucket.
+func (c *Client) UpdateWebsite(ctx context.Context, bucket *v1alpha3.S3Bucket) error {
+	if bucket.Spec.WebsiteConfiguration == nil {
+		return nil
+	}
+	input := &s3.PutBucketWebsiteInput{
+		Bucket:               aws.String(meta.GetExternalName(bucket)),
+		WebsiteConfiguration: GenerateWebsite(bucket.Spec.WebsiteConfiguration),
+	}
+	_, err := c.s3.PutBucketWebsiteRequest(input).Send(ctx)
+	return err
+}
+
+// UpdateLogging applies the logging configuration in the spec of the bucket.
+func (c *Client) UpdateLogging(ctx context.Context, bucket *v1alpha3.S3Bucket) error {
+	if bucket.Spec.LoggingConfiguration == nil {
+		return nil
+	}
+	input := &s3.PutBucketLoggingInput{
+		Bucket:              aws.String(meta.GetExternalName(bucket)),
+		BucketLoggingStatus: GenerateLoggingStatus(bucket.Spec.LoggingConfiguration),
+	}
+	_, err := c.s3.PutBucketLoggingRequest(input).Send(ctx)
+	return err
+}
+
+// UpdateReplication applies the replication configuration in the spec of the
+// bucket.
+func (c *Client) UpdateReplication(ctx context.Context, bucket *v1alpha3.S3Bucket) error {
+	if bucket.Spec.ReplicationConfiguration == nil {
+		return nil
+	}
+	input := &s3.PutBucketReplicationInput{
+		Bucket:                   aws.String(meta.GetExternalName(bucket)),
+		ReplicationConfiguration: GenerateReplication(bucket.Spec.ReplicationConfiguration),
+	}
+	_, err := c.s3.PutBucketReplicationRequest(input).Send(ctx)
+	return err
+}
+
+// UpdateTags replaces the tags of the bucket with those in its spec, or deletes
+// them if its spec has none.
+func (c *Client) UpdateTags(ctx context.Context, bucket *v1alpha3.S3Bucket) error {
+	if len(bucket.Spec.Tags) == 0 {
+		_, err := c.s3.DeleteBucketTaggingRequest(&s3.DeleteBucketTaggingInput{Bucket: aws.String(meta.GetExternalName(bucket))}).Send(ctx)
+		return err
+	}
+	input := &s3.PutBucketTaggingInput{
+		Bucket:  aws.String(meta.GetExternalName(bucket)),
+		Tagging: GenerateTagging(bucket.Spec.Tags),
+	}
+	_, err := c.s3.PutBucketTaggingRequest(input).Send(ctx)
+	return err
+}
+
+// UpdateObjectLock applies the Object Lock configuration in the spec of the
+// bucket. It fails for buckets that were created without Object Lock.
+func (c *Client) UpdateObjectLock(ctx context.Context, bucket *v1alpha3.S3Bucket) error {
+	if bucket.Spec.ObjectLockConfiguration == nil {
+		return nil
+	}
+	input := &s3.PutObjectLockConfigurationInput{
+		Bucket:                  aws.String(meta.GetExternalName(bucket)),
+		ObjectLockConfiguration: GenerateObjectLock(bucket.Spec.ObjectLockConfiguration),
+	}
+	_, err := c.s3.PutObjectLockConfigurationRequest(input).Send(ctx)
+	return err
+}
+
+// UpdateRequestPayer applies the request payer in the spec of the bucket.
+func (c *Client) UpdateRequestPayer(ctx context.Context, bucket *v1alpha3.S3Bucket) error {
+	if bucket.Spec.RequestPayer == nil {
+		return nil
+	}
+	input := &s3.PutBucketRequestPaymentInput{
+		Bucket:                      aws.String(meta.GetExternalName(bucket)),
+		RequestPaymentConfiguration: &s3.RequestPaymentConfiguration{Payer: s3.Payer(*bucket.Spec.RequestPayer)},
+	}
+	_, err := c.s3.PutBucketRequestPaymentRequest(input).Send(ctx)
+	return err
+}
+
+// EmptyBucket deletes a single page of objects, object versions and delete
+// markers from the bucket. It returns the number of keys that were deleted,
+// which is zero once the bucket is empty.
+func (c *Client) EmptyBucket(ctx context.Context, bucket *v1alpha3.S3Bucket) (int, error) {
+	list, err := c.s3.ListObjectVersionsRequest(&s3.ListObjectVersionsInput{
+		Bucket:  aws.String(meta.GetExternalName(bucket)),
+		MaxKeys: aws.Int64(maxDeleteObjects),
+	}).Send(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// Objects of buckets that have never been versioned are listed with a
+	// null version ID, which S3 accepts when deleting them.
+	objects := make([]s3.ObjectIdentifier, 0, len(list.Versions)+len(list.DeleteMarkers))
+	for _, v := range list.Versions {
+		objects = append(objects, s3.ObjectIdentifier{Key: v.Key, VersionId: v.VersionId})
+	}
+	for _, m := range list.DeleteMarkers {
+		objects = append(objects, s3.ObjectIdentifier{Key: m.Key, VersionId: m.VersionId})
+	}
+	if len(objects) == 0 {
+		return 0, nil
+	}
+
+	rsp, err := c.s3.DeleteObjectsRequest(&s3.DeleteObjectsInput{
+		Bucket: aws.String(meta.GetExternalName(bucket)),
+		Delete: &s3.Delete{Objects: objects, Quiet: aws.Bool(true)},
+	}).Send(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if len(rsp.Errors) > 0 {
+		e := rsp.Errors[0]
+		return len(objects) - len(rsp.Errors), fmt.Errorf("could not delete %d objects, first error for object %s: %s",
+			len(rsp.Errors), aws.StringValue(e.Key), aws.StringValue(e.Message))
+	}
+	return len(objects), nil
+}
+
 // DeleteBucket deletes s3 bucket, and related IAM
-func (c *Client) DeleteBucket(bucket *v1alpha3.S3Bucket) error {
-	_, err := c.s3.DeleteBucketRequest(&s3.DeleteBucketInput{Bucket: aws.String(meta.GetExternalName(bucket))}).Send(context.TODO())
-	if resource.Ignore(isErrorNotFound, err) != nil {
+func (c *Client) DeleteBucket(ctx context.Context, bucket *v1alpha3.S3Bucket) error {
+	_, err := c.s3.DeleteBucketRequest(&s3.DeleteBucketInput{Bucket: aws.String(meta.GetExternalName(bucket))}).Send(ctx)
+	if resource.Ignore(IsErrorNotFound, err) != nil {
 		return err
 	}
 
+	if bucket.Spec.IAMPolicyName != "" {
+		return c.iamClient.DeletePolicy(ctx, bucket.Spec.IAMPolicyName)
+	}
+
 	if bucket.Spec.IAMUsername != "" {
-		err := c.iamClient.DeletePolicyAndDetach(bucket.Spec.IAMUsername, bucket.Spec.IAMUsername)
+		err := c.iamClient.DeletePolicyAndDetach(ctx, bucket.Spec.IAMUsername, bucket.Spec.IAMUsername)
 		if err != nil {
 			return err
 		}
-		return c.iamClient.DeleteUser(bucket.Spec.IAMUsername)
+		return c.iamClient.DeleteUser(ctx, bucket.Spec.IAMUsername)
 	}
 
 	return nil
@@ -191,8 +649,23 @@ func isErrorAlreadyExists(err error) bool {
 	return false
 }
 
-// isErrorNotFound helper function to test for ErrCodeNoSuchEntityException error
-func isErrorNotFound(err error) bool {
+// isErrorCode returns true if the supplied error is an AWS error with the
+// supplied code.
+func isErrorCode(err error, code string) bool {
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == code {
+		return true
+	}
+	return false
+}
+
+// IsBucketNotEmpty returns true if the error indicates that a bucket could not
+// be deleted because it still contains objects.
+func IsBucketNotEmpty(err error) bool {
+	return isErrorCode(err, BucketNotEmptyErrCode)
+}
+
+// IsErrorNotFound helper function to test for ErrCodeNoSuchBucket error
+func IsErrorNotFound(err error) bool {
 	if err == nil {
 		return false
 	}
@@ -215,6 +688,11 @@ func CreateBucketInput(bucket *v1alpha3.S3Bucket) *s3.CreateBucketInput {
 	if bucket.Spec.CannedACL != nil {
 		bucketInput.ACL = *bucket.Spec.CannedACL
 	}
+
+	// Object Lock can only be enabled when a bucket is created.
+	if bucket.Spec.ObjectLockConfiguration != nil {
+		bucketInput.ObjectLockEnabledForBucket = aws.Bool(true)
+	}
 	return bucketInput
 }
 
@@ -229,6 +707,13 @@ func GenerateBucketUsername(bucket *v1alpha3.S3Bucket) string {
 	return fmt.Sprintf("%s-%s", name[:max], rand.String(5))
 }
 
+// GenerateBucketPolicyName generates a name for the IAM policy that grants
+// access to the bucket in policy access mode. Policies are named like the IAM
+// users of buckets in user access mode.
+func GenerateBucketPolicyName(bucket *v1alpha3.S3Bucket) string {
+	return GenerateBucketUsername(bucket)
+}
+
 func newPolicyDocument(bucket *v1alpha3.S3Bucket) (string, error) {
 	bucketARN := fmt.Sprintf(bucketObjectARN, meta.GetExternalName(bucket))
 	read := iamc.StatementEntry{
@@ -27,6 +27,7 @@ import (
 
 	storage "github.com/crossplane/crossplane/apis/storage/v1alpha1"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/awserr"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
@@ -41,12 +42,22 @@ func TestNewClient(t *testing.T) {
 
 func TestClient_CreateOrUpdateBucket(t *testing.T) {
 	ownedErr := awserr.New(s3.ErrCodeBucketAlreadyOwnedByYou, "", nil)
+	boom := errors.New("boom")
+	secureBucket := &awsstorage.S3Bucket{}
+	secureBucket.Spec.ServerSideEncryptionConfiguration = &awsstorage.ServerSideEncryptionConfiguration{
+		Rules: []awsstorage.ServerSideEncryptionRule{{
+			ApplyServerSideEncryptionByDefault: awsstorage.ServerSideEncryptionByDefault{SSEAlgorithm: string(s3.ServerSideEncryptionAes256)},
+		}},
+	}
+	secureBucket.Spec.PublicAccessBlockConfiguration = &awsstorage.PublicAccessBlockConfiguration{BlockPublicAcls: aws.Bool(true)}
 
 	// Define test cases
 	tests := map[string]struct {
 		bucket          *awsstorage.S3Bucket
 		createBucketRet []interface{}
 		putBucketACLRet []interface{}
+		putSSERet       []interface{}
+		putPABRet       []interface{}
 		ret             []types.GomegaMatcher
 	}{
 		"HappyPath": {
@@ -61,6 +72,26 @@ func TestClient_CreateOrUpdateBucket(t *testing.T) {
 			putBucketACLRet: []interface{}{nil, nil},
 			ret:             []types.GomegaMatcher{gomega.BeNil()},
 		},
+		"EncryptionAndPublicAccessBlock": {
+			bucket:          secureBucket,
+			createBucketRet: []interface{}{nil, nil},
+			putSSERet:       []interface{}{nil, nil},
+			putPABRet:       []interface{}{nil, nil},
+			ret:             []types.GomegaMatcher{gomega.BeNil()},
+		},
+		"EncryptionError": {
+			bucket:          secureBucket,
+			createBucketRet: []interface{}{nil, nil},
+			putSSERet:       []interface{}{nil, boom},
+			ret:             []types.GomegaMatcher{gomega.Equal(boom)},
+		},
+		"PublicAccessBlockError": {
+			bucket:          secureBucket,
+			createBucketRet: []interface{}{nil, nil},
+			putSSERet:       []interface{}{nil, nil},
+			putPABRet:       []interface{}{nil, boom},
+			ret:             []types.GomegaMatcher{gomega.Equal(boom)},
+		},
 	}
 
 	for testName, vals := range tests {
@@ -74,15 +105,23 @@ func TestClient_CreateOrUpdateBucket(t *testing.T) {
 			putBucketACLReq := new(fakeops.PutBucketACLRequest)
 			putBucketACLReq.On("Send", context.TODO()).Return(vals.putBucketACLRet...)
 
+			putSSEReq := new(fakeops.PutBucketEncryptionRequest)
+			putSSEReq.On("Send", context.TODO()).Return(vals.putSSERet...)
+
+			putPABReq := new(fakeops.PutPublicAccessBlockRequest)
+			putPABReq.On("Send", context.TODO()).Return(vals.putPABRet...)
+
 			ops := new(fakeops.Operations)
 			ops.On("CreateBucketRequest", mock.Anything).Return(createBucketReq)
 			ops.On("PutBucketACLRequest", mock.Anything).Return(putBucketACLReq)
+			ops.On("PutBucketEncryptionRequest", mock.Anything).Return(putSSEReq)
+			ops.On("PutPublicAccessBlockRequest", mock.Anything).Return(putPABReq)
 
 			// Create thing we are testing
 			c := Client{s3: ops}
 
 			// Call the method under test
-			err := c.CreateOrUpdateBucket(vals.bucket)
+			err := c.CreateOrUpdateBucket(context.TODO(), vals.bucket)
 
 			// Make assertions
 			g.Expect(err).To(vals.ret[0])
@@ -100,21 +139,40 @@ func TestClient_GetBucketInfo(t *testing.T) {
 			Status:    s3.BucketVersioningStatusEnabled,
 		},
 	}
+	pabRes := &s3.GetPublicAccessBlockResponse{
+		GetPublicAccessBlockOutput: &s3.GetPublicAccessBlockOutput{
+			PublicAccessBlockConfiguration: &s3.PublicAccessBlockConfiguration{},
+		},
+	}
 	boom := errors.New("boom")
+	sseNotFound := awserr.New(SSENotFoundErrCode, "", nil)
 
 	// Define test cases
 	tests := map[string]struct {
 		sendErr             error
 		getPolicyVersionErr error
+		sseErr              error
+		pabErr              error
 		bucketInfoRet1      types.GomegaMatcher
 		bucketInfoRet2      types.GomegaMatcher
 	}{
 		"HappyPath": {
 			sendErr:             nil,
 			getPolicyVersionErr: nil,
+			sseErr:              sseNotFound,
 			bucketInfoRet1:      gomega.Not(gomega.BeNil()),
 			bucketInfoRet2:      gomega.BeNil(),
 		},
+		"EncryptionError": {
+			sseErr:         boom,
+			bucketInfoRet1: gomega.BeNil(),
+			bucketInfoRet2: gomega.Equal(boom),
+		},
+		"PublicAccessBlockError": {
+			pabErr:         boom,
+			bucketInfoRet1: gomega.BeNil(),
+			bucketInfoRet2: gomega.Equal(boom),
+		},
 		"SendError": {
 			sendErr:             boom,
 			getPolicyVersionErr: nil,
@@ -137,17 +195,31 @@ func TestClient_GetBucketInfo(t *testing.T) {
 			versioningReq := new(fakeops.GetBucketVersioningRequest)
 			versioningReq.On("Send", context.TODO()).Return(versioningRes, vals.sendErr)
 
+			sseReq := new(fakeops.GetBucketEncryptionRequest)
+			sseReq.On("Send", context.TODO()).Return(&s3.GetBucketEncryptionResponse{
+				GetBucketEncryptionOutput: &s3.GetBucketEncryptionOutput{},
+			}, vals.sseErr)
+
+			pabReq := new(fakeops.GetPublicAccessBlockRequest)
+			pabReq.On("Send", context.TODO()).Return(pabRes, vals.pabErr)
+
+			taggingReq := new(fakeops.GetBucketTaggingRequest)
+			taggingReq.On("Send", context.TODO()).Return(nil, awserr.New(TaggingNotFoundErrCode, "", nil))
+
 			ops := new(fakeops.Operations)
 			ops.On("GetBucketVersioningRequest", mock.Anything).Return(versioningReq)
+			ops.On("GetBucketEncryptionRequest", mock.Anything).Return(sseReq)
+			ops.On("GetPublicAccessBlockRequest", mock.Anything).Return(pabReq)
+			ops.On("GetBucketTaggingRequest", mock.Anything).Return(taggingReq)
 
 			iamc := new(fakeiam.Client)
-			iamc.On("GetPolicyVersion", name).Return("han-is-cool", vals.getPolicyVersionErr)
+			iamc.On("GetPolicyVersion", mock.Anything, name).Return("han-is-cool", vals.getPolicyVersionErr)
 
 			// Create thing we are testing
 			c := Client{s3: ops, iamClient: iamc}
 
 			// Call the method under test
-			b, err := c.GetBucketInfo(name, s3Bucket)
+			b, err := c.GetBucketInfo(context.TODO(), name, s3Bucket)
 
 			// Make assertions
 			g.Expect(err).To(vals.bucketInfoRet2)
@@ -209,14 +281,14 @@ func TestClient_CreateUser(t *testing.T) {
 
 			// Set up mocks
 			iamc := new(fakeiam.Client)
-			iamc.On("CreateUser", name).Return(vals.createUserRet...)
-			iamc.On("CreatePolicyAndAttach", name, name, mock.Anything).Return(vals.createPolicyRet...)
+			iamc.On("CreateUser", mock.Anything, name).Return(vals.createUserRet...)
+			iamc.On("CreatePolicyAndAttach", mock.Anything, name, name, mock.Anything).Return(vals.createPolicyRet...)
 
 			// Create thing we are testing
 			c := Client{iamClient: iamc}
 
 			// Call the method under test
-			key, version, err := c.CreateUser(name, vals.s3Bucket)
+			key, version, err := c.CreateUser(context.TODO(), name, vals.s3Bucket)
 
 			// Make assertions
 			g.Expect(key).To(vals.ret[0])
@@ -227,6 +299,74 @@ func TestClient_CreateUser(t *testing.T) {
 	}
 }
 
+func TestClient_CreateAccessPolicy(t *testing.T) {
+	// Set up shared args
+	boom := errors.New("boom")
+	name := "han"
+	role := "falcon"
+	arn := "arn:aws:iam::123456789012:policy/han"
+	version := "v1"
+
+	withRole := &awsstorage.S3Bucket{}
+	withRole.Spec.IAMRoleName = &role
+
+	// Define test cases
+	tests := map[string]struct {
+		s3Bucket        *awsstorage.S3Bucket
+		createPolicyRet []interface{}
+		attachRet       []interface{}
+		ret             []types.GomegaMatcher
+	}{
+		"HappyPath": {
+			s3Bucket:        &awsstorage.S3Bucket{},
+			createPolicyRet: []interface{}{version, nil},
+			attachRet:       []interface{}{boom},
+			ret:             []types.GomegaMatcher{gomega.Equal(arn), gomega.Equal(version), gomega.BeNil()},
+		},
+		"AttachToRole": {
+			s3Bucket:        withRole,
+			createPolicyRet: []interface{}{version, nil},
+			attachRet:       []interface{}{nil},
+			ret:             []types.GomegaMatcher{gomega.Equal(arn), gomega.Equal(version), gomega.BeNil()},
+		},
+		"IAMCreatePolicyError": {
+			s3Bucket:        &awsstorage.S3Bucket{},
+			createPolicyRet: []interface{}{"", boom},
+			attachRet:       []interface{}{nil},
+			ret:             []types.GomegaMatcher{gomega.Equal(""), gomega.Equal(""), gomega.Equal(boom)},
+		},
+		"IAMAttachPolicyError": {
+			s3Bucket:        withRole,
+			createPolicyRet: []interface{}{version, nil},
+			attachRet:       []interface{}{boom},
+			ret:             []types.GomegaMatcher{gomega.Equal(""), gomega.Equal(""), gomega.Equal(errors.New("could not attach policy, boom"))},
+		},
+	}
+
+	for testName, vals := range tests {
+		t.Run(testName, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+
+			// Set up mocks
+			iamc := new(fakeiam.Client)
+			iamc.On("CreatePolicy", mock.Anything, name, mock.Anything).Return(vals.createPolicyRet...)
+			iamc.On("AttachPolicyToRole", mock.Anything, name, role).Return(vals.attachRet...)
+			iamc.On("GetPolicyARN", mock.Anything, name).Return(arn, nil)
+
+			// Create thing we are testing
+			c := Client{iamClient: iamc}
+
+			// Call the method under test
+			arn, version, err := c.CreateAccessPolicy(context.TODO(), name, vals.s3Bucket)
+
+			// Make assertions
+			g.Expect(arn).To(vals.ret[0])
+			g.Expect(version).To(vals.ret[1])
+			g.Expect(err).To(vals.ret[2])
+		})
+	}
+}
+
 func TestClient_UpdateBucketACL(t *testing.T) {
 	acl := s3.BucketCannedACLPrivate
 
@@ -269,7 +409,7 @@ func TestClient_UpdateBucketACL(t *testing.T) {
 			c := Client{s3: ops}
 
 			// Call the method under test
-			err := c.UpdateBucketACL(vals.bucket)
+			err := c.UpdateBucketACL(context.TODO(), vals.bucket)
 
 			// Make assertions
 			g.Expect(err).To(vals.ret[0])
@@ -318,7 +458,7 @@ func TestClient_UpdateVersioning(t *testing.T) {
 			c := Client{s3: ops}
 
 			// Call the method under test
-			err := c.UpdateVersioning(vals.bucket)
+			err := c.UpdateVersioning(context.TODO(), vals.bucket)
 
 			// Make assertions
 			g.Expect(err).To(vals.ret[0])
@@ -367,13 +507,13 @@ func TestClient_UpdatePolicyDocument(t *testing.T) {
 
 			// Set up mocks
 			iamc := new(fakeiam.Client)
-			iamc.On("UpdatePolicy", user, mock.AnythingOfType("string")).Return(vals.updateRet...)
+			iamc.On("UpdatePolicy", mock.Anything, user, mock.AnythingOfType("string")).Return(vals.updateRet...)
 
 			// Create thing we are testing
 			c := Client{iamClient: iamc}
 
 			// Call the method under test
-			ver, err := c.UpdatePolicyDocument(user, vals.bucket)
+			ver, err := c.UpdatePolicyDocument(context.TODO(), user, vals.bucket)
 
 			// Make assertions
 			g.Expect(ver).To(vals.ret[0])
@@ -415,6 +555,13 @@ func TestClient_DeleteBucket(t *testing.T) {
 			deleteUserRet:   []interface{}{nil},
 			ret:             []types.GomegaMatcher{gomega.Equal(boom)},
 		},
+		"PolicyAccessMode": {
+			bucket:          &awsstorage.S3Bucket{Spec: awsstorage.S3BucketSpec{S3BucketParameters: awsstorage.S3BucketParameters{IAMPolicyName: user}}},
+			deleteBucketRet: []interface{}{nil, nil},
+			deletePolicyRet: []interface{}{boom},
+			deleteUserRet:   []interface{}{boom},
+			ret:             []types.GomegaMatcher{gomega.Equal(boom)},
+		},
 		"DeletePolicyError": {
 			bucket:          &awsstorage.S3Bucket{Spec: awsstorage.S3BucketSpec{S3BucketParameters: awsstorage.S3BucketParameters{IAMUsername: user}}},
 			deleteBucketRet: []interface{}{nil, nil},
@@ -436,14 +583,15 @@ func TestClient_DeleteBucket(t *testing.T) {
 			ops.On("DeleteBucketRequest", mock.Anything).Return(delBucketReq)
 
 			iamc := new(fakeiam.Client)
-			iamc.On("DeletePolicyAndDetach", user, user).Return(vals.deletePolicyRet...)
-			iamc.On("DeleteUser", user).Return(vals.deleteUserRet...)
+			iamc.On("DeletePolicyAndDetach", mock.Anything, user, user).Return(vals.deletePolicyRet...)
+			iamc.On("DeletePolicy", mock.Anything, user).Return(vals.deletePolicyRet...)
+			iamc.On("DeleteUser", mock.Anything, user).Return(vals.deleteUserRet...)
 
 			// Create thing we are testing
 			c := Client{s3: ops, iamClient: iamc}
 
 			// Call the method under test
-			err := c.DeleteBucket(vals.bucket)
+			err := c.DeleteBucket(context.TODO(), vals.bucket)
 
 			// Make assertions
 			g.Expect(err).To(vals.ret[0])
@@ -451,6 +599,79 @@ func TestClient_DeleteBucket(t *testing.T) {
 	}
 }
 
+func TestClient_EmptyBucket(t *testing.T) {
+	boom := errors.New("boom")
+	list := &s3.ListObjectVersionsResponse{ListObjectVersionsOutput: &s3.ListObjectVersionsOutput{
+		Versions:      []s3.ObjectVersion{{Key: aws.String("a"), VersionId: aws.String("1")}, {Key: aws.String("a"), VersionId: aws.String("2")}},
+		DeleteMarkers: []s3.DeleteMarkerEntry{{Key: aws.String("b"), VersionId: aws.String("3")}},
+	}}
+
+	// Define test cases
+	tests := map[string]struct {
+		listRet   []interface{}
+		deleteRet []interface{}
+		deleted   int
+		err       types.GomegaMatcher
+	}{
+		"Empty": {
+			listRet: []interface{}{&s3.ListObjectVersionsResponse{ListObjectVersionsOutput: &s3.ListObjectVersionsOutput{}}, nil},
+			deleted: 0,
+			err:     gomega.BeNil(),
+		},
+		"HappyPath": {
+			listRet:   []interface{}{list, nil},
+			deleteRet: []interface{}{&s3.DeleteObjectsResponse{DeleteObjectsOutput: &s3.DeleteObjectsOutput{}}, nil},
+			deleted:   3,
+			err:       gomega.BeNil(),
+		},
+		"ListError": {
+			listRet: []interface{}{nil, boom},
+			deleted: 0,
+			err:     gomega.Equal(boom),
+		},
+		"DeleteError": {
+			listRet:   []interface{}{list, nil},
+			deleteRet: []interface{}{nil, boom},
+			deleted:   0,
+			err:       gomega.Equal(boom),
+		},
+		"PartialFailure": {
+			listRet: []interface{}{list, nil},
+			deleteRet: []interface{}{&s3.DeleteObjectsResponse{DeleteObjectsOutput: &s3.DeleteObjectsOutput{
+				Errors: []s3.Error{{Key: aws.String("b"), Message: aws.String("Access Denied")}},
+			}}, nil},
+			deleted: 2,
+			err:     gomega.HaveOccurred(),
+		},
+	}
+
+	for testName, vals := range tests {
+		t.Run(testName, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+
+			// Set up mocks
+			listReq := new(fakeops.ListObjectVersionsRequest)
+			listReq.On("Send", context.TODO()).Return(vals.listRet...)
+			deleteReq := new(fakeops.DeleteObjectsRequest)
+			deleteReq.On("Send", context.TODO()).Return(vals.deleteRet...)
+
+			ops := new(fakeops.Operations)
+			ops.On("ListObjectVersionsRequest", mock.Anything).Return(listReq)
+			ops.On("DeleteObjectsRequest", mock.Anything).Return(deleteReq)
+
+			// Create thing we are testing
+			c := Client{s3: ops}
+
+			// Call the method under test
+			deleted, err := c.EmptyBucket(context.TODO(), &awsstorage.S3Bucket{})
+
+			// Make assertions
+			g.Expect(deleted).To(gomega.Equal(vals.deleted))
+			g.Expect(err).To(vals.err)
+		})
+	}
+}
+
 func Test_isErrorAlreadyExists(t *testing.T) {
 	tests := map[string]struct {
 		input  error
@@ -480,7 +701,7 @@ func Test_isErrorAlreadyExists(t *testing.T) {
 	}
 }
 
-func Test_isErrorNotFound(t *testing.T) {
+func TestIsErrorNotFound(t *testing.T) {
 	tests := map[string]struct {
 		input  error
 		output bool
@@ -503,7 +724,7 @@ func Test_isErrorNotFound(t *testing.T) {
 		t.Run(testName, func(t *testing.T) {
 			g := gomega.NewGomegaWithT(t)
 
-			res := isErrorNotFound(vals.input)
+			res := IsErrorNotFound(vals.input)
 			g.Expect(res).To(gomega.Equal(vals.output))
 		})
 	}
@@ -562,6 +783,17 @@ func TestCreateBucketInput(t *testing.T) {
 			},
 			ret: &s3.CreateBucketInput{Bucket: new(string), CreateBucketConfiguration: &s3.CreateBucketConfiguration{LocationConstraint: "us-west-2"}},
 		},
+		"ObjectLock": {
+			bucket: &awsstorage.S3Bucket{
+				Spec: awsstorage.S3BucketSpec{
+					S3BucketParameters: awsstorage.S3BucketParameters{
+						Region:                  regionWithNoConstraint,
+						ObjectLockConfiguration: &awsstorage.ObjectLockConfiguration{},
+					},
+				},
+			},
+			ret: &s3.CreateBucketInput{Bucket: new(string), ObjectLockEnabledForBucket: aws.Bool(true)},
+		},
 	}
 
 	for testName, vals := range tests {
@@ -575,6 +807,7 @@ func TestCreateBucketInput(t *testing.T) {
 			g.Expect(res.Bucket).To(gomega.Equal(vals.ret.Bucket))
 			g.Expect(res.CreateBucketConfiguration).To(gomega.Equal(vals.ret.CreateBucketConfiguration))
 			g.Expect(res.ACL).To(gomega.Equal(vals.ret.ACL))
+			g.Expect(res.ObjectLockEnabledForBucket).To(gomega.Equal(vals.ret.ObjectLockEnabledForBucket))
 		})
 	}
 }
@@ -591,3 +824,172 @@ func TestGenerateBucketUsername(t *testing.T) {
 func Test_newPolicyDocument(t *testing.T) {
 
 }
+
+func TestClient_UpdateLifecycleConfiguration(t *testing.T) {
+	// Set up args
+	boom := errors.New("boom")
+	withLifecycle := &awsstorage.S3Bucket{}
+	withLifecycle.Spec.LifecycleConfiguration = &awsstorage.BucketLifecycleConfiguration{
+		Rules: []awsstorage.LifecycleRule{{Status: awsstorage.LifecycleRuleStatusEnabled}},
+	}
+
+	// Define test cases
+	tests := map[string]struct {
+		bucket  *awsstorage.S3Bucket
+		sendRet []interface{}
+		ret     types.GomegaMatcher
+	}{
+		"NoLifecycleConfiguration": {
+			bucket:  &awsstorage.S3Bucket{},
+			sendRet: []interface{}{nil, boom},
+			ret:     gomega.BeNil(),
+		},
+		"HappyPath": {
+			bucket:  withLifecycle,
+			sendRet: []interface{}{nil, nil},
+			ret:     gomega.BeNil(),
+		},
+		"SendError": {
+			bucket:  withLifecycle,
+			sendRet: []interface{}{nil, boom},
+			ret:     gomega.Equal(boom),
+		},
+	}
+
+	for testName, vals := range tests {
+		t.Run(testName, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+
+			// Set up mocks
+			putLifecycle := new(fakeops.PutBucketLifecycleConfigurationRequest)
+			putLifecycle.On("Send", context.TODO()).Return(vals.sendRet...)
+
+			ops := new(fakeops.Operations)
+			ops.On("PutBucketLifecycleConfigurationRequest", mock.Anything).Return(putLifecycle)
+
+			// Create thing we are testing
+			c := Client{s3: ops}
+
+			// Call the method under test
+			err := c.UpdateLifecycleConfiguration(context.TODO(), vals.bucket)
+
+			// Make assertions
+			g.Expect(err).To(vals.ret)
+		})
+	}
+}
+
+func TestClient_UpdateTags(t *testing.T) {
+	// Set up args
+	boom := errors.New("boom")
+	withTags := &awsstorage.S3Bucket{}
+	withTags.Spec.Tags = []awsstorage.Tag{{Key: "k", Value: "v"}}
+
+	// Define test cases
+	tests := map[string]struct {
+		bucket    *awsstorage.S3Bucket
+		putRet    []interface{}
+		deleteRet []interface{}
+		ret       types.GomegaMatcher
+	}{
+		"NoTags": {
+			bucket:    &awsstorage.S3Bucket{},
+			putRet:    []interface{}{nil, boom},
+			deleteRet: []interface{}{nil, nil},
+			ret:       gomega.BeNil(),
+		},
+		"DeleteError": {
+			bucket:    &awsstorage.S3Bucket{},
+			putRet:    []interface{}{nil, nil},
+			deleteRet: []interface{}{nil, boom},
+			ret:       gomega.Equal(boom),
+		},
+		"HappyPath": {
+			bucket:    withTags,
+			putRet:    []interface{}{nil, nil},
+			deleteRet: []interface{}{nil, boom},
+			ret:       gomega.BeNil(),
+		},
+		"SendError": {
+			bucket:    withTags,
+			putRet:    []interface{}{nil, boom},
+			deleteRet: []interface{}{nil, nil},
+			ret:       gomega.Equal(boom),
+		},
+	}
+
+	for testName, vals := range tests {
+		t.Run(testName, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+
+			// Set up mocks
+			putTagging := new(fakeops.PutBucketTaggingRequest)
+			putTagging.On("Send", context.TODO()).Return(vals.putRet...)
+			deleteTagging := new(fakeops.DeleteBucketTaggingRequest)
+			deleteTagging.On("Send", context.TODO()).Return(vals.deleteRet...)
+
+			ops := new(fakeops.Operations)
+			ops.On("PutBucketTaggingRequest", mock.Anything).Return(putTagging)
+			ops.On("DeleteBucketTaggingRequest", mock.Anything).Return(deleteTagging)
+
+			// Create thing we are testing
+			c := Client{s3: ops}
+
+			// Call the method under test
+			err := c.UpdateTags(context.TODO(), vals.bucket)
+
+			// Make assertions
+			g.Expect(err).To(vals.ret)
+		})
+	}
+}
+
+func TestAreAccessPolicyRolesUpToDate(t *testing.T) {
+	role := "role"
+	policyMode := func(role *string) awsstorage.S3BucketParameters {
+		return awsstorage.S3BucketParameters{AccessMode: awsstorage.AccessModePolicy, IAMRoleName: role}
+	}
+
+	// Define test cases
+	tests := map[string]struct {
+		params awsstorage.S3BucketParameters
+		roles  []string
+		ret    bool
+	}{
+		"UserAccessMode": {
+			params: awsstorage.S3BucketParameters{},
+			roles:  []string{"other"},
+			ret:    true,
+		},
+		"Attached": {
+			params: policyMode(&role),
+			roles:  []string{role},
+			ret:    true,
+		},
+		"NotAttached": {
+			params: policyMode(&role),
+			ret:    false,
+		},
+		"RoleChanged": {
+			params: policyMode(&role),
+			roles:  []string{role, "other"},
+			ret:    false,
+		},
+		"NoRole": {
+			params: policyMode(nil),
+			ret:    true,
+		},
+		"RoleRemoved": {
+			params: policyMode(nil),
+			roles:  []string{role},
+			ret:    false,
+		},
+	}
+
+	for testName, vals := range tests {
+		t.Run(testName, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			g.Expect(AreAccessPolicyRolesUpToDate(vals.params, vals.roles)).To(gomega.Equal(vals.ret))
+		})
+	}
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	"github.com/crossplane/provider-aws/apis/storage/v1alpha3"
+)
+
+// TaggingNotFoundErrCode is the error code returned by S3 when a bucket has no
+// tags.
+const TaggingNotFoundErrCode = "NoSuchTagSet"
+
+// IsTaggingNotFound returns true if the error indicates that a bucket has no
+// tags.
+func IsTaggingNotFound(err error) bool {
+	return isErrorCode(err, TaggingNotFoundErrCode)
+}
+
+// GenerateTagging returns the S3 tagging described by the supplied tags.
+func GenerateTagging(in []v1alpha3.Tag) *s3.Tagging {
+	t := &s3.Tagging{TagSet: make([]s3.Tag, len(in))}
+	for i, tag := range in {
+		t.TagSet[i] = s3.Tag{Key: aws.String(tag.Key), Value: aws.String(tag.Value)}
+	}
+	return t
+}
+
+// AreTagsUpToDate returns true if the observed tags match the desired tags,
+// regardless of their order.
+func AreTagsUpToDate(desired []v1alpha3.Tag, observed []s3.Tag) bool {
+	if len(desired) != len(observed) {
+		return false
+	}
+	current := make(map[string]string, len(observed))
+	for _, t := range observed {
+		current[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	for _, t := range desired {
+		if v, ok := current[t.Key]; !ok || v != t.Value {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/storage/v1alpha3"
+)
+
+func TestAreTagsUpToDate(t *testing.T) {
+	type args struct {
+		desired  []v1alpha3.Tag
+		observed []s3.Tag
+	}
+
+	cases := map[string]struct {
+		args
+		want bool
+	}{
+		"NoneDesired": {
+			args: args{},
+			want: true,
+		},
+		"NoneDesiredButTagged": {
+			args: args{observed: []s3.Tag{{Key: aws.String("k"), Value: aws.String("v")}}},
+			want: false,
+		},
+		"UpToDate": {
+			args: args{
+				desired: []v1alpha3.Tag{{Key: "a", Value: "1"}, {Key: "b", Value: "2"}},
+				observed: []s3.Tag{
+					{Key: aws.String("b"), Value: aws.String("2")},
+					{Key: aws.String("a"), Value: aws.String("1")},
+				},
+			},
+			want: true,
+		},
+		"Missing": {
+			args: args{desired: []v1alpha3.Tag{{Key: "a", Value: "1"}}},
+			want: false,
+		},
+		"ValueChanged": {
+			args: args{
+				desired:  []v1alpha3.Tag{{Key: "a", Value: "1"}},
+				observed: []s3.Tag{{Key: aws.String("a"), Value: aws.String("2")}},
+			},
+			want: false,
+		},
+		"Extra": {
+			args: args{
+				desired: []v1alpha3.Tag{{Key: "a", Value: "1"}},
+				observed: []s3.Tag{
+					{Key: aws.String("a"), Value: aws.String("1")},
+					{Key: aws.String("b"), Value: aws.String("2")},
+				},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := AreTagsUpToDate(tc.args.desired, tc.args.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("AreTagsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/storage/v1alpha3"
+)
+
+const (
+	// WebsiteNotFoundErrCode is the error code returned by S3 when a bucket
+	// has no website configuration.
+	WebsiteNotFoundErrCode = "NoSuchWebsiteConfiguration"
+
+	// WebsiteEndpointKey is the connection detail key of the website
+	// endpoint of a bucket.
+	WebsiteEndpointKey = "websiteEndpoint"
+)
+
+// https://docs.aws.amazon.com/general/latest/gr/s3.html#s3_website_region_endpoints
+var websiteDashRegions = map[string]bool{
+	"us-east-1":      true,
+	"us-west-1":      true,
+	"us-west-2":      true,
+	"ap-southeast-1": true,
+	"ap-southeast-2": true,
+	"ap-northeast-1": true,
+	"eu-west-1":      true,
+	"sa-east-1":      true,
+	"us-gov-west-1":  true,
+}
+
+// IsWebsiteNotFound returns true if the error indicates that a bucket has no
+// website configuration.
+func IsWebsiteNotFound(err error) bool {
+	return isErrorCode(err, WebsiteNotFoundErrCode)
+}
+
+// WebsiteEndpoint returns the static website endpoint of the supplied bucket
+// in the supplied region.
+func WebsiteEndpoint(bucket, region string) string {
+	if websiteDashRegions[region] {
+		return fmt.Sprintf("%s.s3-website-%s.amazonaws.com", bucket, region)
+	}
+	return fmt.Sprintf("%s.s3-website.%s.amazonaws.com", bucket, region)
+}
+
+// GenerateWebsite returns the S3 website configuration described by the
+// supplied configuration.
+func GenerateWebsite(in *v1alpha3.WebsiteConfiguration) *s3.WebsiteConfiguration {
+	if in == nil {
+		return nil
+	}
+	cfg := &s3.WebsiteConfiguration{}
+	if in.ErrorDocument != nil {
+		cfg.ErrorDocument = &s3.ErrorDocument{Key: aws.String(in.ErrorDocument.Key)}
+	}
+	if in.IndexDocument != nil {
+		cfg.IndexDocument = &s3.IndexDocument{Suffix: aws.String(in.IndexDocument.Suffix)}
+	}
+	if r := in.RedirectAllRequestsTo; r != nil {
+		cfg.RedirectAllRequestsTo = &s3.RedirectAllRequestsTo{
+			HostName: aws.String(r.HostName),
+			Protocol: s3.Protocol(aws.StringValue(r.Protocol)),
+		}
+	}
+	for _, r := range in.RoutingRules {
+		rule := s3.RoutingRule{
+			Redirect: &s3.Redirect{
+				HostName:             r.Redirect.HostName,
+				HttpRedirectCode:     r.Redirect.HTTPRedirectCode,
+				Protocol:             s3.Protocol(aws.StringValue(r.Redirect.Protocol)),
+				ReplaceKeyPrefixWith: r.Redirect.ReplaceKeyPrefixWith,
+				ReplaceKeyWith:       r.Redirect.ReplaceKeyWith,
+			},
+		}
+		if c := r.Condition; c != nil {
+			rule.Condition = &s3.Condition{
+				HttpErrorCodeReturnedEquals: c.HTTPErrorCodeReturnedEquals,
+				KeyPrefixEquals:             c.KeyPrefixEquals,
+			}
+		}
+		cfg.RoutingRules = append(cfg.RoutingRules, rule)
+	}
+	return cfg
+}
+
+// GenerateWebsiteConfiguration returns the website configuration described by
+// the supplied S3 website configuration.
+func GenerateWebsiteConfiguration(in *s3.WebsiteConfiguration) *v1alpha3.WebsiteConfiguration {
+	if in == nil {
+		return nil
+	}
+	cfg := &v1alpha3.WebsiteConfiguration{}
+	if in.ErrorDocument != nil {
+		cfg.ErrorDocument = &v1alpha3.ErrorDocument{Key: aws.StringValue(in.ErrorDocument.Key)}
+	}
+	if in.IndexDocument != nil {
+		cfg.IndexDocument = &v1alpha3.IndexDocument{Suffix: aws.StringValue(in.IndexDocument.Suffix)}
+	}
+	if r := in.RedirectAllRequestsTo; r != nil {
+		cfg.RedirectAllRequestsTo = &v1alpha3.RedirectAllRequestsTo{
+			HostName: aws.StringValue(r.HostName),
+			Protocol: protocol(r.Protocol),
+		}
+	}
+	for _, r := range in.RoutingRules {
+		rule := v1alpha3.RoutingRule{}
+		if rd := r.Redirect; rd != nil {
+			rule.Redirect = v1alpha3.Redirect{
+				HostName:             rd.HostName,
+				HTTPRedirectCode:     rd.HttpRedirectCode,
+				Protocol:             protocol(rd.Protocol),
+				ReplaceKeyPrefixWith: rd.ReplaceKeyPrefixWith,
+				ReplaceKeyWith:       rd.ReplaceKeyWith,
+			}
+		}
+		if c := r.Condition; c != nil {
+			rule.Condition = &v1alpha3.Condition{
+				HTTPErrorCodeReturnedEquals: c.HttpErrorCodeReturnedEquals,
+				KeyPrefixEquals:             c.KeyPrefixEquals,
+			}
+		}
+		cfg.RoutingRules = append(cfg.RoutingRules, rule)
+	}
+	return cfg
+}
+
+// IsWebsiteConfigurationUpToDate returns true if the observed website
+// configuration matches the desired one. Website hosting is considered up to
+// date when no configuration is desired.
+func IsWebsiteConfigurationUpToDate(desired *v1alpha3.WebsiteConfiguration, observed *s3.WebsiteConfiguration) bool {
+	if desired == nil {
+		return true
+	}
+	return cmp.Equal(desired, GenerateWebsiteConfiguration(observed), cmpopts.EquateEmpty())
+}
+
+func protocol(p s3.Protocol) *string {
+	if p == "" {
+		return nil
+	}
+	return aws.String(string(p))
+}
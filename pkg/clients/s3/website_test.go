@@ -0,0 +1,193 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/storage/v1alpha3"
+)
+
+func TestWebsiteEndpoint(t *testing.T) {
+	cases := map[string]struct {
+		region string
+		want   string
+	}{
+		"DashRegion": {
+			region: "us-west-2",
+			want:   "bucket.s3-website-us-west-2.amazonaws.com",
+		},
+		"DotRegion": {
+			region: "eu-central-1",
+			want:   "bucket.s3-website.eu-central-1.amazonaws.com",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := WebsiteEndpoint("bucket", tc.region)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("WebsiteEndpoint(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsWebsiteConfigurationUpToDate(t *testing.T) {
+	website := &v1alpha3.WebsiteConfiguration{
+		IndexDocument: &v1alpha3.IndexDocument{Suffix: "index.html"},
+		ErrorDocument: &v1alpha3.ErrorDocument{Key: "error.html"},
+		RoutingRules: []v1alpha3.RoutingRule{{
+			Condition: &v1alpha3.Condition{KeyPrefixEquals: aws.String("docs/")},
+			Redirect:  v1alpha3.Redirect{ReplaceKeyPrefixWith: aws.String("documents/"), Protocol: aws.String("https")},
+		}},
+	}
+
+	type args struct {
+		desired  *v1alpha3.WebsiteConfiguration
+		observed *s3.WebsiteConfiguration
+	}
+
+	cases := map[string]struct {
+		args
+		want bool
+	}{
+		"NotManaged": {
+			args: args{observed: GenerateWebsite(website)},
+			want: true,
+		},
+		"UpToDate": {
+			args: args{desired: website, observed: GenerateWebsite(website)},
+			want: true,
+		},
+		"Missing": {
+			args: args{desired: website},
+			want: false,
+		},
+		"Changed": {
+			args: args{
+				desired:  website,
+				observed: &s3.WebsiteConfiguration{IndexDocument: &s3.IndexDocument{Suffix: aws.String("index.htm")}},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsWebsiteConfigurationUpToDate(tc.args.desired, tc.args.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsWebsiteConfigurationUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsCORSConfigurationUpToDate(t *testing.T) {
+	cors := &v1alpha3.CORSConfiguration{
+		CORSRules: []v1alpha3.CORSRule{{
+			AllowedMethods: []string{"GET", "HEAD"},
+			AllowedOrigins: []string{"https://example.com"},
+			MaxAgeSeconds:  aws.Int64(3000),
+		}},
+	}
+
+	type args struct {
+		desired  *v1alpha3.CORSConfiguration
+		observed []s3.CORSRule
+	}
+
+	cases := map[string]struct {
+		args
+		want bool
+	}{
+		"NotManaged": {
+			args: args{observed: GenerateCORSRules(cors)},
+			want: true,
+		},
+		"UpToDate": {
+			args: args{desired: cors, observed: GenerateCORSRules(cors)},
+			want: true,
+		},
+		"Changed": {
+			args: args{
+				desired:  cors,
+				observed: []s3.CORSRule{{AllowedMethods: []string{"GET"}, AllowedOrigins: []string{"*"}}},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsCORSConfigurationUpToDate(tc.args.desired, tc.args.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsCORSConfigurationUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsLoggingConfigurationUpToDate(t *testing.T) {
+	type args struct {
+		desired  *v1alpha3.LoggingConfiguration
+		observed *s3.LoggingEnabled
+	}
+
+	cases := map[string]struct {
+		args
+		want bool
+	}{
+		"NotManaged": {
+			args: args{observed: &s3.LoggingEnabled{TargetBucket: aws.String("logs")}},
+			want: true,
+		},
+		"UpToDate": {
+			args: args{
+				desired:  &v1alpha3.LoggingConfiguration{TargetBucket: aws.String("logs"), TargetPrefix: "web/"},
+				observed: &s3.LoggingEnabled{TargetBucket: aws.String("logs"), TargetPrefix: aws.String("web/")},
+			},
+			want: true,
+		},
+		"Disabled": {
+			args: args{
+				desired: &v1alpha3.LoggingConfiguration{TargetBucket: aws.String("logs")},
+			},
+			want: false,
+		},
+		"PrefixChanged": {
+			args: args{
+				desired:  &v1alpha3.LoggingConfiguration{TargetBucket: aws.String("logs"), TargetPrefix: "web/"},
+				observed: &s3.LoggingEnabled{TargetBucket: aws.String("logs"), TargetPrefix: aws.String("")},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsLoggingConfigurationUpToDate(tc.args.desired, tc.args.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsLoggingConfigurationUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
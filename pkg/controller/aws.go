@@ -27,16 +27,31 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/database"
 	"github.com/crossplane/provider-aws/pkg/controller/database/dbsubnetgroup"
 	"github.com/crossplane/provider-aws/pkg/controller/database/dynamodb"
+	"github.com/crossplane/provider-aws/pkg/controller/dns/hostedzone"
+	"github.com/crossplane/provider-aws/pkg/controller/dns/resourcerecordset"
+	"github.com/crossplane/provider-aws/pkg/controller/elbv2/listener"
+	"github.com/crossplane/provider-aws/pkg/controller/elbv2/loadbalancer"
+	"github.com/crossplane/provider-aws/pkg/controller/elbv2/targetgroup"
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iampolicy"
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iamrole"
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iamrolepolicyattachment"
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iamuser"
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iamuserpolicyattachment"
+	"github.com/crossplane/provider-aws/pkg/controller/network/elasticip"
+	"github.com/crossplane/provider-aws/pkg/controller/network/flowlog"
 	"github.com/crossplane/provider-aws/pkg/controller/network/internetgateway"
+	"github.com/crossplane/provider-aws/pkg/controller/network/natgateway"
+	"github.com/crossplane/provider-aws/pkg/controller/network/networkacl"
 	"github.com/crossplane/provider-aws/pkg/controller/network/routetable"
 	"github.com/crossplane/provider-aws/pkg/controller/network/securitygroup"
+	"github.com/crossplane/provider-aws/pkg/controller/network/securitygrouprule"
 	"github.com/crossplane/provider-aws/pkg/controller/network/subnet"
+	"github.com/crossplane/provider-aws/pkg/controller/network/transitgateway"
+	"github.com/crossplane/provider-aws/pkg/controller/network/transitgatewayroutetable"
+	"github.com/crossplane/provider-aws/pkg/controller/network/transitgatewayvpcattachment"
 	"github.com/crossplane/provider-aws/pkg/controller/network/vpc"
+	"github.com/crossplane/provider-aws/pkg/controller/network/vpcendpoint"
+	"github.com/crossplane/provider-aws/pkg/controller/network/vpcpeeringconnection"
 	"github.com/crossplane/provider-aws/pkg/controller/s3"
 )
 
@@ -74,10 +89,25 @@ func Setup(mgr ctrl.Manager, l logging.Logger) error {
 		vpc.SetupVPC,
 		subnet.SetupSubnet,
 		securitygroup.SetupSecurityGroup,
+		securitygrouprule.SetupSecurityGroupRule,
+		flowlog.SetupFlowLog,
 		internetgateway.SetupInternetGateway,
 		routetable.SetupRouteTable,
+		elasticip.SetupElasticIP,
+		natgateway.SetupNATGateway,
+		vpcpeeringconnection.SetupVPCPeeringConnection,
+		vpcendpoint.SetupVPCEndpoint,
+		networkacl.SetupNetworkACL,
+		transitgateway.SetupTransitGateway,
+		transitgatewayvpcattachment.SetupTransitGatewayVPCAttachment,
+		transitgatewayroutetable.SetupTransitGatewayRouteTable,
 		dbsubnetgroup.SetupDBSubnetGroup,
 		dynamodb.SetupDynamoTable,
+		hostedzone.SetupHostedZone,
+		resourcerecordset.SetupResourceRecordSet,
+		loadbalancer.SetupLoadBalancer,
+		targetgroup.SetupTargetGroup,
+		listener.SetupListener,
 	} {
 		if err := setup(mgr, l); err != nil {
 			return err
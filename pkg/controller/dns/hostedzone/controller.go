@@ -0,0 +1,257 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostedzone
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsroute53 "github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/dns/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/route53"
+	"github.com/crossplane/provider-aws/pkg/controller/utils"
+)
+
+const (
+	errUnexpectedObject    = "The managed resource is not a HostedZone resource"
+	errClient              = "cannot create a new HostedZoneClient"
+	errGet                 = "failed to get HostedZone"
+	errListTags            = "failed to list the tags of the HostedZone"
+	errSpecUpdate          = "cannot update spec of HostedZone custom resource"
+	errCreate              = "failed to create the HostedZone resource"
+	errPersistExternalName = "failed to persist HostedZone ID"
+	errUpdateComment       = "failed to update the comment of the HostedZone"
+	errAssociateVPC        = "failed to associate the VPC with the HostedZone"
+	errDisassociateVPC     = "failed to disassociate the VPC from the HostedZone"
+	errChangeTags          = "failed to change the tags of the HostedZone"
+	errDelete              = "failed to delete the HostedZone resource"
+)
+
+// SetupHostedZone adds a controller that reconciles HostedZones.
+func SetupHostedZone(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.HostedZoneGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.HostedZone{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.HostedZoneGroupVersionKind),
+			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: route53.NewHostedZoneClient, awsConfigFn: utils.RetrieveAwsConfigFromProvider}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	client      client.Client
+	newClientFn func(*aws.Config) (route53.HostedZoneClient, error)
+	awsConfigFn func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error)
+}
+
+func (conn *connector) Connect(ctx context.Context, mgd resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mgd.(*v1alpha1.HostedZone)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	awsconfig, err := conn.awsConfigFn(ctx, conn.client, cr.Spec.ProviderReference)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := conn.newClientFn(awsconfig)
+	if err != nil {
+		return nil, errors.Wrap(err, errClient)
+	}
+
+	return &external{kube: conn.client, client: c, region: awsconfig.Region}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client route53.HostedZoneClient
+
+	// region is the region in which VPCs are associated with the hosted
+	// zone.
+	region string
+}
+
+// observe returns the hosted zone with the given ID along with its tags.
+func (e *external) observe(ctx context.Context, id string) (*awsroute53.GetHostedZoneOutput, []awsroute53.Tag, error) {
+	hz, err := e.client.GetHostedZoneRequest(&awsroute53.GetHostedZoneInput{Id: aws.String(id)}).Send(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errGet)
+	}
+	rsp, err := e.client.ListTagsForResourceRequest(&awsroute53.ListTagsForResourceInput{
+		ResourceId:   aws.String(id),
+		ResourceType: awsroute53.TagResourceTypeHostedzone,
+	}).Send(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errListTags)
+	}
+	var tags []awsroute53.Tag
+	if rsp.ResourceTagSet != nil {
+		tags = rsp.ResourceTagSet.Tags
+	}
+	return hz.GetHostedZoneOutput, tags, nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.HostedZone)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// Hosted zones are uniquely identified by an ID that is returned on
+	// create time; we can't tell whether they exist unless we have recorded
+	// their ID.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	observed, tags, err := e.observe(ctx, meta.GetExternalName(cr))
+	if route53.IsHostedZoneNotFoundErr(errors.Cause(err)) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	route53.LateInitializeHostedZone(&cr.Spec.ForProvider, observed.HostedZone)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = route53.GenerateHostedZoneObservation(*observed)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: route53.IsHostedZoneUpToDate(cr.Spec.ForProvider, *observed, tags),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.HostedZone)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	// the UID of the custom resource makes sure that retried requests don't
+	// create more than one hosted zone.
+	rsp, err := e.client.CreateHostedZoneRequest(route53.GenerateCreateHostedZoneInput(cr.Spec.ForProvider, string(cr.GetUID()), e.region)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, route53.TrimHostedZoneID(aws.StringValue(rsp.HostedZone.Id)))
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errPersistExternalName)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.HostedZone)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	id := aws.String(meta.GetExternalName(cr))
+	observed, tags, err := e.observe(ctx, *id)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	var comment *string
+	if observed.HostedZone.Config != nil {
+		comment = observed.HostedZone.Config.Comment
+	}
+	if aws.StringValue(comment) != aws.StringValue(cr.Spec.ForProvider.Comment) {
+		if _, err := e.client.UpdateHostedZoneCommentRequest(&awsroute53.UpdateHostedZoneCommentInput{
+			Id:      id,
+			Comment: cr.Spec.ForProvider.Comment,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateComment)
+		}
+	}
+
+	// VPCs are associated first so that a private hosted zone never loses
+	// its last VPC while they are replaced.
+	associate, disassociate := route53.DiffHostedZoneVPCs(cr.Spec.ForProvider, observed.VPCs, e.region)
+	for i := range associate {
+		if _, err := e.client.AssociateVPCWithHostedZoneRequest(&awsroute53.AssociateVPCWithHostedZoneInput{
+			HostedZoneId: id,
+			VPC:          &associate[i],
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAssociateVPC)
+		}
+	}
+	for i := range disassociate {
+		if _, err := e.client.DisassociateVPCFromHostedZoneRequest(&awsroute53.DisassociateVPCFromHostedZoneInput{
+			HostedZoneId: id,
+			VPC:          &disassociate[i],
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDisassociateVPC)
+		}
+	}
+
+	add, remove := route53.DiffHostedZoneTags(cr.Spec.ForProvider.Tags, tags)
+	if len(add) > 0 || len(remove) > 0 {
+		if _, err := e.client.ChangeTagsForResourceRequest(&awsroute53.ChangeTagsForResourceInput{
+			ResourceId:    id,
+			ResourceType:  awsroute53.TagResourceTypeHostedzone,
+			AddTags:       add,
+			RemoveTagKeys: remove,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errChangeTags)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.HostedZone)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+
+	_, err := e.client.DeleteHostedZoneRequest(&awsroute53.DeleteHostedZoneInput{
+		Id: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(route53.IsHostedZoneNotFoundErr, err), errDelete)
+}
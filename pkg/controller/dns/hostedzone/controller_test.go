@@ -0,0 +1,478 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostedzone
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsroute53 "github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/dns/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/route53"
+	"github.com/crossplane/provider-aws/pkg/clients/route53/fake"
+)
+
+var (
+	mockExternalClient external
+	mockClient         fake.MockHostedZoneClient
+
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+)
+
+func TestMain(m *testing.M) {
+
+	mockClient = fake.MockHostedZoneClient{}
+	mockExternalClient = external{
+		client: &mockClient,
+		kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+	}
+
+	os.Exit(m.Run())
+}
+
+func Test_Connect(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := &v1alpha1.HostedZone{}
+	var clientErr error
+	var configErr error
+
+	conn := connector{
+		client: nil,
+		newClientFn: func(conf *aws.Config) (route53.HostedZoneClient, error) {
+			return &mockClient, clientErr
+		},
+		awsConfigFn: func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error) {
+			return &aws.Config{}, configErr
+		},
+	}
+
+	for _, tc := range []struct {
+		description       string
+		managedObj        resource.Managed
+		configErr         error
+		clientErr         error
+		expectedClientNil bool
+		expectedErrNil    bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws config provider fails, should return error",
+			mockManaged,
+			errors.New("some error"),
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws client provider fails, should return error",
+			mockManaged,
+			nil,
+			errors.New("some error"),
+			true,
+			false,
+		},
+	} {
+		clientErr = tc.clientErr
+		configErr = tc.configErr
+
+		res, err := conn.Connect(context.Background(), tc.managedObj)
+		g.Expect(res == nil).To(gomega.Equal(tc.expectedClientNil), tc.description)
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+	}
+}
+
+func Test_Observe(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha1.HostedZone{
+		Spec: v1alpha1.HostedZoneSpec{
+			ForProvider: v1alpha1.HostedZoneParameters{
+				Name:   "example.internal",
+				VPCIDs: []string{"vpc-1"},
+			},
+		},
+	}
+	meta.SetExternalName(&mockManaged, "Z1")
+
+	var mockClientErr error
+	var vpcs []awsroute53.VPC
+	mockClient.MockGetHostedZoneRequest = func(input *awsroute53.GetHostedZoneInput) awsroute53.GetHostedZoneRequest {
+		g.Expect(aws.StringValue(input.Id)).To(gomega.Equal("Z1"), "the passed parameters are not valid")
+		return awsroute53.GetHostedZoneRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsroute53.GetHostedZoneOutput{
+					HostedZone: &awsroute53.HostedZone{
+						Id:              aws.String("/hostedzone/Z1"),
+						Name:            aws.String("example.internal."),
+						CallerReference: aws.String("uid"),
+						Config:          &awsroute53.HostedZoneConfig{Comment: aws.String("comment"), PrivateZone: aws.Bool(true)},
+					},
+					VPCs: vpcs,
+				},
+				Error: mockClientErr,
+			},
+		}
+	}
+	mockClient.MockListTagsForResourceRequest = func(input *awsroute53.ListTagsForResourceInput) awsroute53.ListTagsForResourceRequest {
+		return awsroute53.ListTagsForResourceRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsroute53.ListTagsForResourceOutput{ResourceTagSet: &awsroute53.ResourceTagSet{}},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description           string
+		managedObj            resource.Managed
+		vpcs                  []awsroute53.VPC
+		clientErr             error
+		expectedErrNil        bool
+		expectedResourceExist bool
+		expectedUpToDate      bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			[]awsroute53.VPC{{VPCId: aws.String("vpc-1")}},
+			nil,
+			true,
+			true,
+			true,
+		},
+		{
+			"zone with different VPCs should not be up to date",
+			mockManaged.DeepCopy(),
+			[]awsroute53.VPC{{VPCId: aws.String("vpc-2")}},
+			nil,
+			true,
+			true,
+			false,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			nil,
+			false,
+			false,
+			false,
+		},
+		{
+			"if item's identifier is not yet set, returns expected",
+			&v1alpha1.HostedZone{},
+			nil,
+			nil,
+			true,
+			false,
+			false,
+		},
+		{
+			"if external resource doesn't exist, it should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			awserr.New(awsroute53.ErrCodeNoSuchHostedZone, "", nil),
+			true,
+			false,
+			false,
+		},
+		{
+			"if external resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			nil,
+			errors.New("some error"),
+			false,
+			false,
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+		vpcs = tc.vpcs
+
+		result, err := mockExternalClient.Observe(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(result.ResourceExists).To(gomega.Equal(tc.expectedResourceExist), tc.description)
+		g.Expect(result.ResourceUpToDate).To(gomega.Equal(tc.expectedUpToDate), tc.description)
+		if tc.expectedResourceExist {
+			mgd := tc.managedObj.(*v1alpha1.HostedZone)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonAvailable), tc.description)
+			g.Expect(mgd.Status.AtProvider.PrivateZone).To(gomega.BeTrue(), tc.description)
+			g.Expect(aws.StringValue(mgd.Spec.ForProvider.Comment)).To(gomega.Equal("comment"), tc.description)
+		}
+	}
+}
+
+func Test_Create(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha1.HostedZone{
+		ObjectMeta: metav1.ObjectMeta{UID: "some-uid"},
+		Spec: v1alpha1.HostedZoneSpec{
+			ForProvider: v1alpha1.HostedZoneParameters{
+				Name: "example.com",
+			},
+		},
+	}
+	var mockClientErr error
+	mockClient.MockCreateHostedZoneRequest = func(input *awsroute53.CreateHostedZoneInput) awsroute53.CreateHostedZoneRequest {
+		g.Expect(aws.StringValue(input.Name)).To(gomega.Equal("example.com"), "the passed parameters are not valid")
+		g.Expect(aws.StringValue(input.CallerReference)).To(gomega.Equal("some-uid"), "the passed parameters are not valid")
+		return awsroute53.CreateHostedZoneRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsroute53.CreateHostedZoneOutput{
+					HostedZone: &awsroute53.HostedZone{Id: aws.String("/hostedzone/Z1")},
+				},
+				Error: mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			false,
+		},
+		{
+			"if creating resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+
+		_, err := mockExternalClient.Create(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha1.HostedZone)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonCreating), tc.description)
+			g.Expect(meta.GetExternalName(mgd)).To(gomega.Equal("Z1"), tc.description)
+		}
+	}
+}
+
+func Test_Update(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha1.HostedZone{
+		Spec: v1alpha1.HostedZoneSpec{
+			ForProvider: v1alpha1.HostedZoneParameters{
+				VPCIDs: []string{"vpc-2"},
+				Tags:   []v1alpha1.Tag{{Key: "k", Value: "v"}},
+			},
+		},
+	}
+	meta.SetExternalName(&mockManaged, "Z1")
+
+	mockClient.MockGetHostedZoneRequest = func(input *awsroute53.GetHostedZoneInput) awsroute53.GetHostedZoneRequest {
+		return awsroute53.GetHostedZoneRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsroute53.GetHostedZoneOutput{
+					HostedZone: &awsroute53.HostedZone{Id: aws.String("/hostedzone/Z1")},
+					VPCs:       []awsroute53.VPC{{VPCId: aws.String("vpc-1")}},
+				},
+			},
+		}
+	}
+	mockClient.MockListTagsForResourceRequest = func(input *awsroute53.ListTagsForResourceInput) awsroute53.ListTagsForResourceRequest {
+		return awsroute53.ListTagsForResourceRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsroute53.ListTagsForResourceOutput{},
+			},
+		}
+	}
+
+	var calls []string
+	var associateErr error
+	mockClient.MockAssociateVPCWithHostedZoneRequest = func(input *awsroute53.AssociateVPCWithHostedZoneInput) awsroute53.AssociateVPCWithHostedZoneRequest {
+		calls = append(calls, "associate "+aws.StringValue(input.VPC.VPCId))
+		return awsroute53.AssociateVPCWithHostedZoneRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsroute53.AssociateVPCWithHostedZoneOutput{},
+				Error:       associateErr,
+			},
+		}
+	}
+	mockClient.MockDisassociateVPCFromHostedZoneRequest = func(input *awsroute53.DisassociateVPCFromHostedZoneInput) awsroute53.DisassociateVPCFromHostedZoneRequest {
+		calls = append(calls, "disassociate "+aws.StringValue(input.VPC.VPCId))
+		return awsroute53.DisassociateVPCFromHostedZoneRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsroute53.DisassociateVPCFromHostedZoneOutput{},
+			},
+		}
+	}
+	mockClient.MockChangeTagsForResourceRequest = func(input *awsroute53.ChangeTagsForResourceInput) awsroute53.ChangeTagsForResourceRequest {
+		calls = append(calls, "tag "+aws.StringValue(input.AddTags[0].Key))
+		return awsroute53.ChangeTagsForResourceRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsroute53.ChangeTagsForResourceOutput{},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		associateErr   error
+		expectedErrNil bool
+		expectedCalls  []string
+	}{
+		{
+			"VPCs should be replaced and tags added",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+			[]string{"associate vpc-2", "disassociate vpc-1", "tag k"},
+		},
+		{
+			"if associating a VPC fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+			[]string{"associate vpc-2"},
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			false,
+			nil,
+		},
+	} {
+		associateErr = tc.associateErr
+		calls = nil
+
+		_, err := mockExternalClient.Update(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(calls).To(gomega.Equal(tc.expectedCalls), tc.description)
+	}
+}
+
+func Test_Delete(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha1.HostedZone{}
+	meta.SetExternalName(&mockManaged, "Z1")
+	var mockClientErr error
+	mockClient.MockDeleteHostedZoneRequest = func(input *awsroute53.DeleteHostedZoneInput) awsroute53.DeleteHostedZoneRequest {
+		g.Expect(aws.StringValue(input.Id)).To(gomega.Equal("Z1"), "the passed parameters are not valid")
+		return awsroute53.DeleteHostedZoneRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsroute53.DeleteHostedZoneOutput{},
+				Error:       mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			false,
+		},
+		{
+			"if the resource doesn't exist deleting resource should not return an error",
+			mockManaged.DeepCopy(),
+			awserr.New(awsroute53.ErrCodeNoSuchHostedZone, "", nil),
+			true,
+		},
+		{
+			"if deleting resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+
+		err := mockExternalClient.Delete(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha1.HostedZone)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonDeleting), tc.description)
+		}
+	}
+}
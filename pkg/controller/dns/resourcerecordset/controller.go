@@ -0,0 +1,276 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcerecordset
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsroute53 "github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/dns/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/route53"
+	"github.com/crossplane/provider-aws/pkg/controller/utils"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a ResourceRecordSet resource"
+	errClient           = "cannot create a new ResourceRecordSetClient"
+	errList             = "failed to list the ResourceRecordSets of the hosted zone"
+	errGetChange        = "failed to get the status of the last change of the ResourceRecordSet"
+	errCreate           = "failed to create the ResourceRecordSet resource"
+	errPersistName      = "failed to persist the external name of the ResourceRecordSet"
+	errUpdate           = "failed to update the ResourceRecordSet resource"
+	errDelete           = "failed to delete the ResourceRecordSet resource"
+)
+
+// SetupResourceRecordSet adds a controller that reconciles ResourceRecordSets.
+func SetupResourceRecordSet(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.ResourceRecordSetGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.ResourceRecordSet{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ResourceRecordSetGroupVersionKind),
+			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: route53.NewResourceRecordSetClient, awsConfigFn: utils.RetrieveAwsConfigFromProvider}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	client      client.Client
+	newClientFn func(*aws.Config) (route53.ResourceRecordSetClient, error)
+	awsConfigFn func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error)
+}
+
+func (conn *connector) Connect(ctx context.Context, mgd resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mgd.(*v1alpha1.ResourceRecordSet)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	awsconfig, err := conn.awsConfigFn(ctx, conn.client, cr.Spec.ProviderReference)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := conn.newClientFn(awsconfig)
+	if err != nil {
+		return nil, errors.Wrap(err, errClient)
+	}
+
+	return &external{kube: conn.client, client: c}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client route53.ResourceRecordSetClient
+}
+
+// find returns the record set described by the supplied parameters, or nil
+// if it doesn't exist.
+func (e *external) find(ctx context.Context, p v1alpha1.ResourceRecordSetParameters) (*awsroute53.ResourceRecordSet, error) {
+	rsp, err := e.client.ListResourceRecordSetsRequest(route53.GenerateListResourceRecordSetsInput(p)).Send(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errList)
+	}
+	return route53.FindResourceRecordSet(p, rsp.ResourceRecordSets), nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.ResourceRecordSet)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// record sets have no ID; they are identified by their hosted zone, name,
+	// type and set identifier, which are stored in the external name. The
+	// external name is only set once this resource created the record set, so
+	// that a record set that already exists, or that is managed by another
+	// ResourceRecordSet, is never taken over.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	current := route53.ParseExternalName(cr.Spec.ForProvider, meta.GetExternalName(cr))
+	observed, err := e.find(ctx, current)
+	if route53.IsHostedZoneNotFoundErr(errors.Cause(err)) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if observed == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	if cr.Status.AtProvider.ChangeStatus == string(awsroute53.ChangeStatusPending) {
+		rsp, err := e.client.GetChangeRequest(&awsroute53.GetChangeInput{
+			Id: aws.String(cr.Status.AtProvider.ChangeID),
+		}).Send(ctx)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetChange)
+		}
+		cr.Status.AtProvider = route53.GenerateResourceRecordSetObservation(rsp.ChangeInfo)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: route53.IsSameResourceRecordSet(cr.Spec.ForProvider, current) && route53.IsResourceRecordSetUpToDate(cr.Spec.ForProvider, *observed),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.ResourceRecordSet)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	// CREATE fails instead of taking over a record set that already exists.
+	// A record set with exactly the desired values is taken to be the one this
+	// resource created before it could persist its external name.
+	rsp, err := e.client.ChangeResourceRecordSetsRequest(route53.GenerateChangeResourceRecordSetsInput(
+		aws.StringValue(cr.Spec.ForProvider.HostedZoneID),
+		awsroute53.ChangeActionCreate,
+		route53.GenerateResourceRecordSet(cr.Spec.ForProvider),
+	)).Send(ctx)
+	if route53.IsResourceRecordSetAlreadyExistsErr(err) {
+		observed, ferr := e.find(ctx, cr.Spec.ForProvider)
+		if ferr != nil {
+			return managed.ExternalCreation{}, ferr
+		}
+		if observed == nil || !route53.IsResourceRecordSetUpToDate(cr.Spec.ForProvider, *observed) {
+			return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+		}
+		meta.SetExternalName(cr, route53.GenerateExternalName(cr.Spec.ForProvider))
+		return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errPersistName)
+	}
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, route53.GenerateExternalName(cr.Spec.ForProvider))
+	if err := e.kube.Update(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errPersistName)
+	}
+
+	cr.Status.AtProvider = route53.GenerateResourceRecordSetObservation(rsp.ChangeInfo)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.ResourceRecordSet)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	current := route53.ParseExternalName(cr.Spec.ForProvider, meta.GetExternalName(cr))
+	if route53.IsSameResourceRecordSet(cr.Spec.ForProvider, current) {
+		rsp, err := e.client.ChangeResourceRecordSetsRequest(route53.GenerateChangeResourceRecordSetsInput(
+			aws.StringValue(cr.Spec.ForProvider.HostedZoneID),
+			awsroute53.ChangeActionUpsert,
+			route53.GenerateResourceRecordSet(cr.Spec.ForProvider),
+		)).Send(ctx)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+		}
+		cr.Status.AtProvider = route53.GenerateResourceRecordSetObservation(rsp.ChangeInfo)
+		return managed.ExternalUpdate{}, nil
+	}
+
+	// the name, type or set identifier changed. The record set is replaced in
+	// a single change batch so that the old one is not left behind.
+	observed, err := e.find(ctx, current)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	in := route53.GenerateChangeResourceRecordSetsInput(
+		aws.StringValue(cr.Spec.ForProvider.HostedZoneID),
+		awsroute53.ChangeActionCreate,
+		route53.GenerateResourceRecordSet(cr.Spec.ForProvider),
+	)
+	if observed != nil {
+		in.ChangeBatch.Changes = append([]awsroute53.Change{{
+			Action:            awsroute53.ChangeActionDelete,
+			ResourceRecordSet: observed,
+		}}, in.ChangeBatch.Changes...)
+	}
+	rsp, err := e.client.ChangeResourceRecordSetsRequest(in).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
+
+	meta.SetExternalName(cr, route53.GenerateExternalName(cr.Spec.ForProvider))
+	if err := e.kube.Update(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errPersistName)
+	}
+
+	cr.Status.AtProvider = route53.GenerateResourceRecordSetObservation(rsp.ChangeInfo)
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.ResourceRecordSet)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+
+	// a record set that this resource didn't create is left alone.
+	if meta.GetExternalName(cr) == "" {
+		return nil
+	}
+
+	// the values of a record set must match exactly for it to be deleted, so
+	// the observed record set is deleted rather than the desired one.
+	observed, err := e.find(ctx, route53.ParseExternalName(cr.Spec.ForProvider, meta.GetExternalName(cr)))
+	if route53.IsHostedZoneNotFoundErr(errors.Cause(err)) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if observed == nil {
+		return nil
+	}
+
+	_, err = e.client.ChangeResourceRecordSetsRequest(route53.GenerateChangeResourceRecordSetsInput(
+		aws.StringValue(cr.Spec.ForProvider.HostedZoneID),
+		awsroute53.ChangeActionDelete,
+		observed,
+	)).Send(ctx)
+	return errors.Wrap(resource.Ignore(route53.IsHostedZoneNotFoundErr, err), errDelete)
+}
@@ -0,0 +1,608 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcerecordset
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsroute53 "github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/dns/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/route53"
+	"github.com/crossplane/provider-aws/pkg/clients/route53/fake"
+)
+
+var (
+	mockExternalClient external
+	mockClient         fake.MockResourceRecordSetClient
+
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+)
+
+func TestMain(m *testing.M) {
+
+	meta.SetExternalName(&mockManaged, "db.example.com/CNAME")
+
+	mockClient = fake.MockResourceRecordSetClient{}
+	mockExternalClient = external{
+		client: &mockClient,
+		kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+	}
+
+	os.Exit(m.Run())
+}
+
+func Test_Connect(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := &v1alpha1.ResourceRecordSet{}
+	var clientErr error
+	var configErr error
+
+	conn := connector{
+		client: nil,
+		newClientFn: func(conf *aws.Config) (route53.ResourceRecordSetClient, error) {
+			return &mockClient, clientErr
+		},
+		awsConfigFn: func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error) {
+			return &aws.Config{}, configErr
+		},
+	}
+
+	for _, tc := range []struct {
+		description       string
+		managedObj        resource.Managed
+		configErr         error
+		clientErr         error
+		expectedClientNil bool
+		expectedErrNil    bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws config provider fails, should return error",
+			mockManaged,
+			errors.New("some error"),
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws client provider fails, should return error",
+			mockManaged,
+			nil,
+			errors.New("some error"),
+			true,
+			false,
+		},
+	} {
+		clientErr = tc.clientErr
+		configErr = tc.configErr
+
+		res, err := conn.Connect(context.Background(), tc.managedObj)
+		g.Expect(res == nil).To(gomega.Equal(tc.expectedClientNil), tc.description)
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+	}
+}
+
+var (
+	// the desired CNAME record set of the tests
+	mockManaged = v1alpha1.ResourceRecordSet{
+		Spec: v1alpha1.ResourceRecordSetSpec{
+			ForProvider: v1alpha1.ResourceRecordSetParameters{
+				HostedZoneID:    aws.String("Z1"),
+				Name:            "db.example.com",
+				Type:            "CNAME",
+				TTL:             aws.Int64(300),
+				ResourceRecords: []v1alpha1.ResourceRecord{{Value: aws.String("db.amazonaws.com")}},
+			},
+		},
+	}
+)
+
+// recordSet returns the record set of mockManaged as it is returned by AWS,
+// with the supplied value.
+func recordSet(value string) awsroute53.ResourceRecordSet {
+	return awsroute53.ResourceRecordSet{
+		Name:            aws.String("db.example.com."),
+		Type:            awsroute53.RRTypeCname,
+		TTL:             aws.Int64(300),
+		ResourceRecords: []awsroute53.ResourceRecord{{Value: aws.String(value)}},
+	}
+}
+
+func Test_Observe(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	var mockClientErr error
+	var itemsList []awsroute53.ResourceRecordSet
+	mockClient.MockListResourceRecordSetsRequest = func(input *awsroute53.ListResourceRecordSetsInput) awsroute53.ListResourceRecordSetsRequest {
+		g.Expect(aws.StringValue(input.HostedZoneId)).To(gomega.Equal("Z1"), "the passed parameters are not valid")
+		return awsroute53.ListResourceRecordSetsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsroute53.ListResourceRecordSetsOutput{
+					ResourceRecordSets: itemsList,
+				},
+				Error: mockClientErr,
+			},
+		}
+	}
+	mockClient.MockGetChangeRequest = func(input *awsroute53.GetChangeInput) awsroute53.GetChangeRequest {
+		return awsroute53.GetChangeRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsroute53.GetChangeOutput{
+					ChangeInfo: &awsroute53.ChangeInfo{Id: input.Id, Status: awsroute53.ChangeStatusInsync},
+				},
+			},
+		}
+	}
+
+	pending := mockManaged.DeepCopy()
+	pending.Status.AtProvider = v1alpha1.ResourceRecordSetObservation{ChangeID: "C1", ChangeStatus: string(awsroute53.ChangeStatusPending)}
+
+	unowned := mockManaged.DeepCopy()
+	meta.SetExternalName(unowned, "")
+
+	renamed := mockManaged.DeepCopy()
+	renamed.Spec.ForProvider.Name = "database.example.com"
+
+	for _, tc := range []struct {
+		description           string
+		managedObj            resource.Managed
+		itemsReturned         []awsroute53.ResourceRecordSet
+		clientErr             error
+		expectedErrNil        bool
+		expectedResourceExist bool
+		expectedUpToDate      bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			[]awsroute53.ResourceRecordSet{recordSet("db.amazonaws.com")},
+			nil,
+			true,
+			true,
+			true,
+		},
+		{
+			"record set with a different value should not be up to date",
+			mockManaged.DeepCopy(),
+			[]awsroute53.ResourceRecordSet{recordSet("other.amazonaws.com")},
+			nil,
+			true,
+			true,
+			false,
+		},
+		{
+			"record set whose name changed should not be up to date",
+			renamed,
+			[]awsroute53.ResourceRecordSet{recordSet("db.amazonaws.com")},
+			nil,
+			true,
+			true,
+			false,
+		},
+		{
+			"pending change should be refreshed",
+			pending,
+			[]awsroute53.ResourceRecordSet{recordSet("db.amazonaws.com")},
+			nil,
+			true,
+			true,
+			true,
+		},
+		{
+			"record set that wasn't created by the resource should not exist",
+			unowned,
+			[]awsroute53.ResourceRecordSet{recordSet("db.amazonaws.com")},
+			nil,
+			true,
+			false,
+			false,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			nil,
+			false,
+			false,
+			false,
+		},
+		{
+			"if the record set is not listed, it should return expected",
+			mockManaged.DeepCopy(),
+			[]awsroute53.ResourceRecordSet{},
+			nil,
+			true,
+			false,
+			false,
+		},
+		{
+			"if the hosted zone doesn't exist, it should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			awserr.New(awsroute53.ErrCodeNoSuchHostedZone, "", nil),
+			true,
+			false,
+			false,
+		},
+		{
+			"if external resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			nil,
+			errors.New("some error"),
+			false,
+			false,
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+		itemsList = tc.itemsReturned
+
+		result, err := mockExternalClient.Observe(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(result.ResourceExists).To(gomega.Equal(tc.expectedResourceExist), tc.description)
+		g.Expect(result.ResourceUpToDate).To(gomega.Equal(tc.expectedUpToDate), tc.description)
+		if tc.expectedResourceExist {
+			mgd := tc.managedObj.(*v1alpha1.ResourceRecordSet)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonAvailable), tc.description)
+			g.Expect(mgd.Status.AtProvider.ChangeStatus).NotTo(gomega.Equal(string(awsroute53.ChangeStatusPending)), tc.description)
+		}
+	}
+}
+
+func Test_Create(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	var itemsList []awsroute53.ResourceRecordSet
+	mockClient.MockListResourceRecordSetsRequest = func(input *awsroute53.ListResourceRecordSetsInput) awsroute53.ListResourceRecordSetsRequest {
+		return awsroute53.ListResourceRecordSetsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsroute53.ListResourceRecordSetsOutput{
+					ResourceRecordSets: itemsList,
+				},
+			},
+		}
+	}
+
+	var mockClientErr error
+	mockClient.MockChangeResourceRecordSetsRequest = func(input *awsroute53.ChangeResourceRecordSetsInput) awsroute53.ChangeResourceRecordSetsRequest {
+		g.Expect(input.ChangeBatch.Changes[0].Action).To(gomega.Equal(awsroute53.ChangeActionCreate), "the passed parameters are not valid")
+		g.Expect(input.ChangeBatch.Changes[0].ResourceRecordSet.ResourceRecords).To(gomega.Equal([]awsroute53.ResourceRecord{{Value: aws.String("db.amazonaws.com")}}), "the passed parameters are not valid")
+		return awsroute53.ChangeResourceRecordSetsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsroute53.ChangeResourceRecordSetsOutput{
+					ChangeInfo: &awsroute53.ChangeInfo{Id: aws.String("C1"), Status: awsroute53.ChangeStatusPending},
+				},
+				Error: mockClientErr,
+			},
+		}
+	}
+
+	unowned := func() *v1alpha1.ResourceRecordSet {
+		mgd := mockManaged.DeepCopy()
+		meta.SetExternalName(mgd, "")
+		return mgd
+	}
+	alreadyExists := awserr.New(awsroute53.ErrCodeInvalidChangeBatch, "[Tried to create resource record set [name='db.example.com.', type='CNAME'] but it already exists]", nil)
+
+	for _, tc := range []struct {
+		description        string
+		managedObj         resource.Managed
+		itemsReturned      []awsroute53.ResourceRecordSet
+		clientErr          error
+		expectedErrNil     bool
+		expectedChangeID   string
+		expectedExternalID string
+	}{
+		{
+			"valid input should return expected",
+			unowned(),
+			nil,
+			nil,
+			true,
+			"C1",
+			"db.example.com/CNAME",
+		},
+		{
+			"record set that already exists with the desired values should be adopted",
+			unowned(),
+			[]awsroute53.ResourceRecordSet{recordSet("db.amazonaws.com")},
+			alreadyExists,
+			true,
+			"",
+			"db.example.com/CNAME",
+		},
+		{
+			"record set that already exists with other values should return error",
+			unowned(),
+			[]awsroute53.ResourceRecordSet{recordSet("other.amazonaws.com")},
+			alreadyExists,
+			false,
+			"",
+			"",
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			nil,
+			false,
+			"",
+			"",
+		},
+		{
+			"if creating resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			nil,
+			errors.New("some error"),
+			false,
+			"",
+			"",
+		},
+	} {
+		mockClientErr = tc.clientErr
+		itemsList = tc.itemsReturned
+
+		_, err := mockExternalClient.Create(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha1.ResourceRecordSet)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonCreating), tc.description)
+			g.Expect(mgd.Status.AtProvider.ChangeID).To(gomega.Equal(tc.expectedChangeID), tc.description)
+			g.Expect(meta.GetExternalName(mgd)).To(gomega.Equal(tc.expectedExternalID), tc.description)
+		}
+	}
+}
+
+func Test_Update(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockClient.MockListResourceRecordSetsRequest = func(input *awsroute53.ListResourceRecordSetsInput) awsroute53.ListResourceRecordSetsRequest {
+		g.Expect(aws.StringValue(input.StartRecordName)).To(gomega.Equal("db.example.com"), "the previous record set should be listed")
+		return awsroute53.ListResourceRecordSetsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsroute53.ListResourceRecordSetsOutput{
+					ResourceRecordSets: []awsroute53.ResourceRecordSet{recordSet("db.amazonaws.com")},
+				},
+			},
+		}
+	}
+
+	var mockClientErr error
+	var actions []awsroute53.ChangeAction
+	mockClient.MockChangeResourceRecordSetsRequest = func(input *awsroute53.ChangeResourceRecordSetsInput) awsroute53.ChangeResourceRecordSetsRequest {
+		actions = nil
+		for _, c := range input.ChangeBatch.Changes {
+			actions = append(actions, c.Action)
+		}
+		return awsroute53.ChangeResourceRecordSetsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsroute53.ChangeResourceRecordSetsOutput{
+					ChangeInfo: &awsroute53.ChangeInfo{Id: aws.String("C2"), Status: awsroute53.ChangeStatusPending},
+				},
+				Error: mockClientErr,
+			},
+		}
+	}
+
+	renamed := mockManaged.DeepCopy()
+	renamed.Spec.ForProvider.Name = "database.example.com"
+
+	for _, tc := range []struct {
+		description        string
+		managedObj         resource.Managed
+		clientErr          error
+		expectedErrNil     bool
+		expectedActions    []awsroute53.ChangeAction
+		expectedExternalID string
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+			[]awsroute53.ChangeAction{awsroute53.ChangeActionUpsert},
+			"db.example.com/CNAME",
+		},
+		{
+			"record set whose name changed should be replaced",
+			renamed,
+			nil,
+			true,
+			[]awsroute53.ChangeAction{awsroute53.ChangeActionDelete, awsroute53.ChangeActionCreate},
+			"database.example.com/CNAME",
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			false,
+			nil,
+			"",
+		},
+		{
+			"if updating resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+			nil,
+			"",
+		},
+	} {
+		mockClientErr = tc.clientErr
+
+		_, err := mockExternalClient.Update(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha1.ResourceRecordSet)
+			g.Expect(actions).To(gomega.Equal(tc.expectedActions), tc.description)
+			g.Expect(mgd.Status.AtProvider.ChangeID).To(gomega.Equal("C2"), tc.description)
+			g.Expect(meta.GetExternalName(mgd)).To(gomega.Equal(tc.expectedExternalID), tc.description)
+		}
+	}
+}
+
+func Test_Delete(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	var itemsList []awsroute53.ResourceRecordSet
+	var listErr error
+	mockClient.MockListResourceRecordSetsRequest = func(input *awsroute53.ListResourceRecordSetsInput) awsroute53.ListResourceRecordSetsRequest {
+		return awsroute53.ListResourceRecordSetsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsroute53.ListResourceRecordSetsOutput{
+					ResourceRecordSets: itemsList,
+				},
+				Error: listErr,
+			},
+		}
+	}
+
+	var deleted bool
+	var mockClientErr error
+	mockClient.MockChangeResourceRecordSetsRequest = func(input *awsroute53.ChangeResourceRecordSetsInput) awsroute53.ChangeResourceRecordSetsRequest {
+		deleted = true
+		g.Expect(input.ChangeBatch.Changes[0].Action).To(gomega.Equal(awsroute53.ChangeActionDelete), "the passed parameters are not valid")
+		g.Expect(input.ChangeBatch.Changes[0].ResourceRecordSet.ResourceRecords).To(gomega.Equal([]awsroute53.ResourceRecord{{Value: aws.String("observed.amazonaws.com")}}), "the observed record set should be deleted")
+		return awsroute53.ChangeResourceRecordSetsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsroute53.ChangeResourceRecordSetsOutput{},
+				Error:       mockClientErr,
+			},
+		}
+	}
+
+	unowned := mockManaged.DeepCopy()
+	meta.SetExternalName(unowned, "")
+
+	for _, tc := range []struct {
+		description     string
+		managedObj      resource.Managed
+		itemsReturned   []awsroute53.ResourceRecordSet
+		listErr         error
+		clientErr       error
+		expectedErrNil  bool
+		expectedDeleted bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			[]awsroute53.ResourceRecordSet{recordSet("observed.amazonaws.com")},
+			nil,
+			nil,
+			true,
+			true,
+		},
+		{
+			"record set that wasn't created by the resource should not be deleted",
+			unowned,
+			[]awsroute53.ResourceRecordSet{recordSet("observed.amazonaws.com")},
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"if the record set doesn't exist, it should not be deleted",
+			mockManaged.DeepCopy(),
+			nil,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"if the hosted zone doesn't exist, it should not return an error",
+			mockManaged.DeepCopy(),
+			nil,
+			awserr.New(awsroute53.ErrCodeNoSuchHostedZone, "", nil),
+			nil,
+			true,
+			false,
+		},
+		{
+			"if deleting resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			[]awsroute53.ResourceRecordSet{recordSet("observed.amazonaws.com")},
+			nil,
+			errors.New("some error"),
+			false,
+			true,
+		},
+	} {
+		itemsList = tc.itemsReturned
+		listErr = tc.listErr
+		mockClientErr = tc.clientErr
+		deleted = false
+
+		err := mockExternalClient.Delete(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(deleted).To(gomega.Equal(tc.expectedDeleted), tc.description)
+	}
+}
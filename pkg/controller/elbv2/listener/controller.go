@@ -0,0 +1,286 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package listener
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awselbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2"
+	"github.com/crossplane/provider-aws/pkg/controller/utils"
+)
+
+const (
+	errUnexpectedObject    = "The managed resource is not a Listener resource"
+	errClient              = "cannot create a new ListenerClient"
+	errDescribe            = "failed to describe Listener with ARN"
+	errMultipleItems       = "retrieved multiple Listeners for the given ARN"
+	errDescribeRules       = "failed to describe the rules of the Listener"
+	errDescribeCerts       = "failed to describe the certificates of the Listener"
+	errSpecUpdate          = "cannot update spec of Listener custom resource"
+	errCreate              = "failed to create the Listener resource"
+	errPersistExternalName = "failed to persist Listener ARN"
+	errModify              = "failed to modify the Listener resource"
+	errAddCerts            = "failed to add certificates to the Listener"
+	errRemoveCerts         = "failed to remove certificates from the Listener"
+	errCreateRule          = "failed to create a rule of the Listener"
+	errModifyRule          = "failed to modify a rule of the Listener"
+	errDeleteRule          = "failed to delete a rule of the Listener"
+	errDelete              = "failed to delete the Listener resource"
+)
+
+// SetupListener adds a controller that reconciles Listeners.
+func SetupListener(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.ListenerGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Listener{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ListenerGroupVersionKind),
+			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: elbv2.NewListenerClient, awsConfigFn: utils.RetrieveAwsConfigFromProvider}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	client      client.Client
+	newClientFn func(*aws.Config) (elbv2.ListenerClient, error)
+	awsConfigFn func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error)
+}
+
+func (conn *connector) Connect(ctx context.Context, mgd resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mgd.(*v1alpha1.Listener)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	awsconfig, err := conn.awsConfigFn(ctx, conn.client, cr.Spec.ProviderReference)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := conn.newClientFn(awsconfig)
+	if err != nil {
+		return nil, errors.Wrap(err, errClient)
+	}
+
+	return &external{kube: conn.client, client: c}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client elbv2.ListenerClient
+}
+
+// observe returns the listener with the given ARN along with its rules and
+// certificates.
+func (e *external) observe(ctx context.Context, arn string) (*awselbv2.Listener, []awselbv2.Rule, []awselbv2.Certificate, error) {
+	rsp, err := e.client.DescribeListenersRequest(&awselbv2.DescribeListenersInput{
+		ListenerArns: []string{arn},
+	}).Send(ctx)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, errDescribe)
+	}
+
+	// in a valid request, there should be exactly one matching listener.
+	if len(rsp.Listeners) != 1 {
+		return nil, nil, nil, errors.New(errMultipleItems)
+	}
+
+	var rules []awselbv2.Rule
+	var marker *string
+	for {
+		rrsp, err := e.client.DescribeRulesRequest(&awselbv2.DescribeRulesInput{
+			ListenerArn: aws.String(arn),
+			Marker:      marker,
+		}).Send(ctx)
+		if err != nil {
+			return nil, nil, nil, errors.Wrap(err, errDescribeRules)
+		}
+		rules = append(rules, rrsp.Rules...)
+		if marker = rrsp.NextMarker; marker == nil {
+			break
+		}
+	}
+
+	var certs []awselbv2.Certificate
+	for {
+		crsp, err := e.client.DescribeListenerCertificatesRequest(&awselbv2.DescribeListenerCertificatesInput{
+			ListenerArn: aws.String(arn),
+			Marker:      marker,
+		}).Send(ctx)
+		if err != nil {
+			return nil, nil, nil, errors.Wrap(err, errDescribeCerts)
+		}
+		certs = append(certs, crsp.Certificates...)
+		if marker = crsp.NextMarker; marker == nil {
+			break
+		}
+	}
+
+	return &rsp.Listeners[0], rules, certs, nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Listener)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// Listeners are uniquely identified by an ARN that is returned on create
+	// time; we can't tell whether they exist unless we have recorded their
+	// ARN.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	l, rules, certs, err := e.observe(ctx, meta.GetExternalName(cr))
+	if elbv2.IsListenerNotFoundErr(errors.Cause(err)) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	elbv2.LateInitializeListener(&cr.Spec.ForProvider, l)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = elbv2.GenerateListenerObservation(rules)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: elbv2.IsListenerUpToDate(cr.Spec.ForProvider, *l) &&
+			elbv2.IsListenerRulesAndCertificatesUpToDate(cr.Spec.ForProvider, rules, certs),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Listener)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	rsp, err := e.client.CreateListenerRequest(elbv2.GenerateCreateListenerInput(cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	if len(rsp.Listeners) == 0 {
+		return managed.ExternalCreation{}, errors.New(errCreate)
+	}
+
+	// the additional certificates and the rules are added by the next update.
+	meta.SetExternalName(cr, aws.StringValue(rsp.Listeners[0].ListenerArn))
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errPersistExternalName)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha1.Listener)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	arn := meta.GetExternalName(cr)
+	l, rules, certs, err := e.observe(ctx, arn)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if !elbv2.IsListenerUpToDate(cr.Spec.ForProvider, *l) {
+		if _, err := e.client.ModifyListenerRequest(elbv2.GenerateModifyListenerInput(arn, cr.Spec.ForProvider)).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errModify)
+		}
+	}
+
+	add, remove := elbv2.DiffListenerCertificates(cr.Spec.ForProvider, certs)
+	if len(add) > 0 {
+		if _, err := e.client.AddListenerCertificatesRequest(&awselbv2.AddListenerCertificatesInput{
+			ListenerArn:  aws.String(arn),
+			Certificates: add,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAddCerts)
+		}
+	}
+	if len(remove) > 0 {
+		if _, err := e.client.RemoveListenerCertificatesRequest(&awselbv2.RemoveListenerCertificatesInput{
+			ListenerArn:  aws.String(arn),
+			Certificates: remove,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRemoveCerts)
+		}
+	}
+
+	create, modify, deleteRules := elbv2.DiffListenerRules(arn, cr.Spec.ForProvider.Rules, rules)
+	for _, ruleARN := range deleteRules {
+		if _, err := e.client.DeleteRuleRequest(&awselbv2.DeleteRuleInput{RuleArn: aws.String(ruleARN)}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDeleteRule)
+		}
+	}
+	for i := range modify {
+		if _, err := e.client.ModifyRuleRequest(&modify[i]).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errModifyRule)
+		}
+	}
+	for i := range create {
+		if _, err := e.client.CreateRuleRequest(&create[i]).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errCreateRule)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Listener)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+
+	// the rules of the listener are deleted along with it.
+	_, err := e.client.DeleteListenerRequest(&awselbv2.DeleteListenerInput{
+		ListenerArn: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(elbv2.IsListenerNotFoundErr, err), errDelete)
+}
@@ -0,0 +1,536 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package listener
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awselbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2/fake"
+)
+
+var (
+	mockExternalClient external
+	mockClient         fake.MockListenerClient
+
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+)
+
+func TestMain(m *testing.M) {
+
+	mockClient = fake.MockListenerClient{}
+	mockExternalClient = external{
+		client: &mockClient,
+		kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+	}
+
+	os.Exit(m.Run())
+}
+
+func Test_Connect(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := &v1alpha1.Listener{}
+	var clientErr error
+	var configErr error
+
+	conn := connector{
+		client: nil,
+		newClientFn: func(conf *aws.Config) (elbv2.ListenerClient, error) {
+			return &mockClient, clientErr
+		},
+		awsConfigFn: func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error) {
+			return &aws.Config{}, configErr
+		},
+	}
+
+	for _, tc := range []struct {
+		description       string
+		managedObj        resource.Managed
+		configErr         error
+		clientErr         error
+		expectedClientNil bool
+		expectedErrNil    bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws config provider fails, should return error",
+			mockManaged,
+			errors.New("some error"),
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws client provider fails, should return error",
+			mockManaged,
+			nil,
+			errors.New("some error"),
+			true,
+			false,
+		},
+	} {
+		clientErr = tc.clientErr
+		configErr = tc.configErr
+
+		res, err := conn.Connect(context.Background(), tc.managedObj)
+		g.Expect(res == nil).To(gomega.Equal(tc.expectedClientNil), tc.description)
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+	}
+}
+
+var (
+	// the desired HTTP listener of the tests
+	listenerParams = v1alpha1.ListenerParameters{
+		LoadBalancerARN: aws.String("lb"),
+		Port:            80,
+		Protocol:        "HTTP",
+		DefaultActions:  []v1alpha1.Action{{Type: "forward", TargetGroupARN: aws.String("tg-1")}},
+		Rules: []v1alpha1.Rule{{
+			Priority:   1,
+			Conditions: []v1alpha1.RuleCondition{{Field: "path-pattern", Values: []string{"/api/*"}}},
+			Actions:    []v1alpha1.Action{{Type: "forward", TargetGroupARN: aws.String("tg-2")}},
+		}},
+	}
+)
+
+// mockDescribe sets up the listener, rule and certificate descriptions of the
+// mock client.
+func mockDescribe(listener *awselbv2.Listener, rules *[]awselbv2.Rule, err *error) {
+	mockClient.MockDescribeListenersRequest = func(input *awselbv2.DescribeListenersInput) awselbv2.DescribeListenersRequest {
+		return awselbv2.DescribeListenersRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awselbv2.DescribeListenersOutput{
+					Listeners: []awselbv2.Listener{*listener},
+				},
+				Error: *err,
+			},
+		}
+	}
+	mockClient.MockDescribeRulesRequest = func(input *awselbv2.DescribeRulesInput) awselbv2.DescribeRulesRequest {
+		return awselbv2.DescribeRulesRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awselbv2.DescribeRulesOutput{Rules: *rules},
+			},
+		}
+	}
+	mockClient.MockDescribeListenerCertificatesRequest = func(input *awselbv2.DescribeListenerCertificatesInput) awselbv2.DescribeListenerCertificatesRequest {
+		return awselbv2.DescribeListenerCertificatesRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awselbv2.DescribeListenerCertificatesOutput{},
+			},
+		}
+	}
+}
+
+func rule(arn, priority, tg string) awselbv2.Rule {
+	return awselbv2.Rule{
+		RuleArn:  aws.String(arn),
+		Priority: aws.String(priority),
+		Conditions: []awselbv2.RuleCondition{{
+			Field:  aws.String("path-pattern"),
+			Values: []string{"/api/*"},
+		}},
+		Actions: []awselbv2.Action{{Type: awselbv2.ActionTypeEnumForward, TargetGroupArn: aws.String(tg)}},
+	}
+}
+
+func Test_Observe(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha1.Listener{Spec: v1alpha1.ListenerSpec{ForProvider: listenerParams}}
+	meta.SetExternalName(&mockManaged, "arn")
+
+	var mockClientErr error
+	listener := awselbv2.Listener{
+		ListenerArn:    aws.String("arn"),
+		Port:           aws.Int64(80),
+		Protocol:       awselbv2.ProtocolEnumHttp,
+		DefaultActions: []awselbv2.Action{{Type: awselbv2.ActionTypeEnumForward, TargetGroupArn: aws.String("tg-1")}},
+	}
+	var rules []awselbv2.Rule
+	mockDescribe(&listener, &rules, &mockClientErr)
+
+	defaultRule := awselbv2.Rule{RuleArn: aws.String("default"), Priority: aws.String("default"), IsDefault: aws.Bool(true)}
+
+	for _, tc := range []struct {
+		description           string
+		managedObj            resource.Managed
+		rules                 []awselbv2.Rule
+		clientErr             error
+		expectedErrNil        bool
+		expectedResourceExist bool
+		expectedUpToDate      bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			[]awselbv2.Rule{defaultRule, rule("rule-1", "1", "tg-2")},
+			nil,
+			true,
+			true,
+			true,
+		},
+		{
+			"listener with a different rule should not be up to date",
+			mockManaged.DeepCopy(),
+			[]awselbv2.Rule{defaultRule, rule("rule-1", "1", "tg-3")},
+			nil,
+			true,
+			true,
+			false,
+		},
+		{
+			"listener without the rule should not be up to date",
+			mockManaged.DeepCopy(),
+			[]awselbv2.Rule{defaultRule},
+			nil,
+			true,
+			true,
+			false,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			nil,
+			false,
+			false,
+			false,
+		},
+		{
+			"if item's identifier is not yet set, returns expected",
+			&v1alpha1.Listener{},
+			nil,
+			nil,
+			true,
+			false,
+			false,
+		},
+		{
+			"if external resource doesn't exist, it should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			awserr.New(awselbv2.ErrCodeListenerNotFoundException, "", nil),
+			true,
+			false,
+			false,
+		},
+		{
+			"if external resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			nil,
+			errors.New("some error"),
+			false,
+			false,
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+		rules = tc.rules
+
+		result, err := mockExternalClient.Observe(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(result.ResourceExists).To(gomega.Equal(tc.expectedResourceExist), tc.description)
+		g.Expect(result.ResourceUpToDate).To(gomega.Equal(tc.expectedUpToDate), tc.description)
+		if tc.expectedResourceExist {
+			mgd := tc.managedObj.(*v1alpha1.Listener)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonAvailable), tc.description)
+		}
+	}
+}
+
+func Test_Create(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha1.Listener{Spec: v1alpha1.ListenerSpec{ForProvider: listenerParams}}
+
+	var mockClientErr error
+	mockClient.MockCreateListenerRequest = func(input *awselbv2.CreateListenerInput) awselbv2.CreateListenerRequest {
+		g.Expect(aws.StringValue(input.LoadBalancerArn)).To(gomega.Equal("lb"), "the passed parameters are not valid")
+		g.Expect(input.DefaultActions).To(gomega.Equal([]awselbv2.Action{{Type: awselbv2.ActionTypeEnumForward, TargetGroupArn: aws.String("tg-1")}}), "the passed parameters are not valid")
+		return awselbv2.CreateListenerRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awselbv2.CreateListenerOutput{
+					Listeners: []awselbv2.Listener{{ListenerArn: aws.String("arn")}},
+				},
+				Error: mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			false,
+		},
+		{
+			"if creating resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+
+		_, err := mockExternalClient.Create(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha1.Listener)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonCreating), tc.description)
+			g.Expect(meta.GetExternalName(mgd)).To(gomega.Equal("arn"), tc.description)
+		}
+	}
+}
+
+func Test_Update(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha1.Listener{Spec: v1alpha1.ListenerSpec{ForProvider: listenerParams}}
+	meta.SetExternalName(&mockManaged, "arn")
+
+	var noErr error
+	var listener awselbv2.Listener
+	var rules []awselbv2.Rule
+	mockDescribe(&listener, &rules, &noErr)
+
+	var calls []string
+	var mockClientErr error
+	mockClient.MockModifyListenerRequest = func(input *awselbv2.ModifyListenerInput) awselbv2.ModifyListenerRequest {
+		calls = append(calls, "modify listener")
+		return awselbv2.ModifyListenerRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awselbv2.ModifyListenerOutput{},
+				Error:       mockClientErr,
+			},
+		}
+	}
+	mockClient.MockCreateRuleRequest = func(input *awselbv2.CreateRuleInput) awselbv2.CreateRuleRequest {
+		calls = append(calls, "create rule")
+		g.Expect(aws.StringValue(input.ListenerArn)).To(gomega.Equal("arn"), "the passed parameters are not valid")
+		return awselbv2.CreateRuleRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awselbv2.CreateRuleOutput{},
+			},
+		}
+	}
+	mockClient.MockModifyRuleRequest = func(input *awselbv2.ModifyRuleInput) awselbv2.ModifyRuleRequest {
+		calls = append(calls, "modify "+aws.StringValue(input.RuleArn))
+		return awselbv2.ModifyRuleRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awselbv2.ModifyRuleOutput{},
+			},
+		}
+	}
+	mockClient.MockDeleteRuleRequest = func(input *awselbv2.DeleteRuleInput) awselbv2.DeleteRuleRequest {
+		calls = append(calls, "delete "+aws.StringValue(input.RuleArn))
+		return awselbv2.DeleteRuleRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awselbv2.DeleteRuleOutput{},
+			},
+		}
+	}
+
+	upToDate := awselbv2.Listener{
+		ListenerArn:    aws.String("arn"),
+		Port:           aws.Int64(80),
+		Protocol:       awselbv2.ProtocolEnumHttp,
+		DefaultActions: []awselbv2.Action{{Type: awselbv2.ActionTypeEnumForward, TargetGroupArn: aws.String("tg-1")}},
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		listener       awselbv2.Listener
+		rules          []awselbv2.Rule
+		clientErr      error
+		expectedErrNil bool
+		expectedCalls  []string
+	}{
+		{
+			"missing rule should be created",
+			mockManaged.DeepCopy(),
+			upToDate,
+			nil,
+			nil,
+			true,
+			[]string{"create rule"},
+		},
+		{
+			"changed rule should be modified and extra rule deleted",
+			mockManaged.DeepCopy(),
+			upToDate,
+			[]awselbv2.Rule{rule("rule-1", "1", "tg-3"), rule("rule-2", "2", "tg-2")},
+			nil,
+			true,
+			[]string{"delete rule-2", "modify rule-1"},
+		},
+		{
+			"changed listener should be modified",
+			mockManaged.DeepCopy(),
+			awselbv2.Listener{ListenerArn: aws.String("arn"), Port: aws.Int64(8080)},
+			[]awselbv2.Rule{rule("rule-1", "1", "tg-2")},
+			nil,
+			true,
+			[]string{"modify listener"},
+		},
+		{
+			"if modifying the listener fails, it should return error",
+			mockManaged.DeepCopy(),
+			awselbv2.Listener{ListenerArn: aws.String("arn"), Port: aws.Int64(8080)},
+			nil,
+			errors.New("some error"),
+			false,
+			[]string{"modify listener"},
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			awselbv2.Listener{},
+			nil,
+			nil,
+			false,
+			nil,
+		},
+	} {
+		listener = tc.listener
+		rules = tc.rules
+		mockClientErr = tc.clientErr
+		calls = nil
+
+		_, err := mockExternalClient.Update(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(calls).To(gomega.Equal(tc.expectedCalls), tc.description)
+	}
+}
+
+func Test_Delete(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha1.Listener{}
+	meta.SetExternalName(&mockManaged, "arn")
+	var mockClientErr error
+	mockClient.MockDeleteListenerRequest = func(input *awselbv2.DeleteListenerInput) awselbv2.DeleteListenerRequest {
+		g.Expect(aws.StringValue(input.ListenerArn)).To(gomega.Equal("arn"), "the passed parameters are not valid")
+		return awselbv2.DeleteListenerRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awselbv2.DeleteListenerOutput{},
+				Error:       mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			false,
+		},
+		{
+			"if the resource doesn't exist deleting resource should not return an error",
+			mockManaged.DeepCopy(),
+			awserr.New(awselbv2.ErrCodeListenerNotFoundException, "", nil),
+			true,
+		},
+		{
+			"if deleting resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+
+		err := mockExternalClient.Delete(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha1.Listener)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonDeleting), tc.description)
+		}
+	}
+}
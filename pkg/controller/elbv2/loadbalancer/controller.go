@@ -0,0 +1,262 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awselbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2"
+	"github.com/crossplane/provider-aws/pkg/controller/utils"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a LoadBalancer resource"
+	errClient           = "cannot create a new LoadBalancerClient"
+	errDescribe         = "failed to describe LoadBalancer with name"
+	errMultipleItems    = "retrieved multiple LoadBalancers for the given name"
+	errDescribeTags     = "failed to describe the tags of the LoadBalancer"
+	errSpecUpdate       = "cannot update spec of LoadBalancer custom resource"
+	errCreate           = "failed to create the LoadBalancer resource"
+	errSetIPAddressType = "failed to set the IP address type of the LoadBalancer"
+	errSetSubnets       = "failed to set the subnets of the LoadBalancer"
+	errSetSGs           = "failed to set the security groups of the LoadBalancer"
+	errAddTags          = "failed to add tags to the LoadBalancer"
+	errRemoveTags       = "failed to remove tags from the LoadBalancer"
+	errDelete           = "failed to delete the LoadBalancer resource"
+)
+
+// SetupLoadBalancer adds a controller that reconciles LoadBalancers.
+func SetupLoadBalancer(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.LoadBalancerGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.LoadBalancer{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.LoadBalancerGroupVersionKind),
+			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: elbv2.NewLoadBalancerClient, awsConfigFn: utils.RetrieveAwsConfigFromProvider}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	client      client.Client
+	newClientFn func(*aws.Config) (elbv2.LoadBalancerClient, error)
+	awsConfigFn func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error)
+}
+
+func (conn *connector) Connect(ctx context.Context, mgd resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mgd.(*v1alpha1.LoadBalancer)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	awsconfig, err := conn.awsConfigFn(ctx, conn.client, cr.Spec.ProviderReference)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := conn.newClientFn(awsconfig)
+	if err != nil {
+		return nil, errors.Wrap(err, errClient)
+	}
+
+	return &external{kube: conn.client, client: c}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client elbv2.LoadBalancerClient
+}
+
+// observe returns the load balancer with the given name along with its tags.
+func (e *external) observe(ctx context.Context, name string) (*awselbv2.LoadBalancer, []awselbv2.Tag, error) {
+	rsp, err := e.client.DescribeLoadBalancersRequest(&awselbv2.DescribeLoadBalancersInput{
+		Names: []string{name},
+	}).Send(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errDescribe)
+	}
+
+	// in a valid request, there should be exactly one matching load balancer.
+	if len(rsp.LoadBalancers) != 1 {
+		return nil, nil, errors.New(errMultipleItems)
+	}
+	lb := rsp.LoadBalancers[0]
+
+	trsp, err := e.client.DescribeTagsRequest(&awselbv2.DescribeTagsInput{
+		ResourceArns: []string{aws.StringValue(lb.LoadBalancerArn)},
+	}).Send(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errDescribeTags)
+	}
+	var tags []awselbv2.Tag
+	if len(trsp.TagDescriptions) > 0 {
+		tags = trsp.TagDescriptions[0].Tags
+	}
+	return &lb, tags, nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.LoadBalancer)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	lb, tags, err := e.observe(ctx, meta.GetExternalName(cr))
+	if elbv2.IsLoadBalancerNotFoundErr(errors.Cause(err)) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	elbv2.LateInitializeLoadBalancer(&cr.Spec.ForProvider, lb)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = elbv2.GenerateLoadBalancerObservation(*lb)
+
+	switch cr.Status.AtProvider.State {
+	case string(awselbv2.LoadBalancerStateEnumActive), string(awselbv2.LoadBalancerStateEnumActiveImpaired):
+		cr.SetConditions(runtimev1alpha1.Available())
+	case string(awselbv2.LoadBalancerStateEnumProvisioning):
+		cr.SetConditions(runtimev1alpha1.Creating())
+	case string(awselbv2.LoadBalancerStateEnumFailed):
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  elbv2.IsLoadBalancerUpToDate(cr.Spec.ForProvider, *lb, tags),
+		ConnectionDetails: elbv2.GetLoadBalancerConnectionDetails(*cr),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.LoadBalancer)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	rsp, err := e.client.CreateLoadBalancerRequest(elbv2.GenerateCreateLoadBalancerInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	if len(rsp.LoadBalancers) > 0 {
+		cr.Status.AtProvider = elbv2.GenerateLoadBalancerObservation(rsp.LoadBalancers[0])
+	}
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha1.LoadBalancer)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	lb, tags, err := e.observe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	p := cr.Spec.ForProvider
+
+	if p.IPAddressType != nil && aws.StringValue(p.IPAddressType) != string(lb.IpAddressType) {
+		if _, err := e.client.SetIpAddressTypeRequest(&awselbv2.SetIpAddressTypeInput{
+			LoadBalancerArn: lb.LoadBalancerArn,
+			IpAddressType:   awselbv2.IpAddressType(aws.StringValue(p.IPAddressType)),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errSetIPAddressType)
+		}
+	}
+
+	if !elbv2.IsLoadBalancerSubnetsUpToDate(p, *lb) {
+		if _, err := e.client.SetSubnetsRequest(&awselbv2.SetSubnetsInput{
+			LoadBalancerArn: lb.LoadBalancerArn,
+			Subnets:         p.SubnetIDs,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errSetSubnets)
+		}
+	}
+
+	if !elbv2.IsLoadBalancerSecurityGroupsUpToDate(p, *lb) {
+		if _, err := e.client.SetSecurityGroupsRequest(&awselbv2.SetSecurityGroupsInput{
+			LoadBalancerArn: lb.LoadBalancerArn,
+			SecurityGroups:  p.SecurityGroupIDs,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errSetSGs)
+		}
+	}
+
+	add, remove := elbv2.DiffTags(p.Tags, tags)
+	if len(add) > 0 {
+		if _, err := e.client.AddTagsRequest(&awselbv2.AddTagsInput{
+			ResourceArns: []string{aws.StringValue(lb.LoadBalancerArn)},
+			Tags:         add,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAddTags)
+		}
+	}
+	if len(remove) > 0 {
+		if _, err := e.client.RemoveTagsRequest(&awselbv2.RemoveTagsInput{
+			ResourceArns: []string{aws.StringValue(lb.LoadBalancerArn)},
+			TagKeys:      remove,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRemoveTags)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.LoadBalancer)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+
+	_, err := e.client.DeleteLoadBalancerRequest(&awselbv2.DeleteLoadBalancerInput{
+		LoadBalancerArn: aws.String(cr.Status.AtProvider.ARN),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(elbv2.IsLoadBalancerNotFoundErr, err), errDelete)
+}
@@ -0,0 +1,519 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awselbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2/fake"
+)
+
+var (
+	mockExternalClient external
+	mockClient         fake.MockLoadBalancerClient
+
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+)
+
+func TestMain(m *testing.M) {
+
+	mockClient = fake.MockLoadBalancerClient{}
+	mockExternalClient = external{
+		client: &mockClient,
+		kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+	}
+
+	os.Exit(m.Run())
+}
+
+func Test_Connect(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := &v1alpha1.LoadBalancer{}
+	var clientErr error
+	var configErr error
+
+	conn := connector{
+		client: nil,
+		newClientFn: func(conf *aws.Config) (elbv2.LoadBalancerClient, error) {
+			return &mockClient, clientErr
+		},
+		awsConfigFn: func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error) {
+			return &aws.Config{}, configErr
+		},
+	}
+
+	for _, tc := range []struct {
+		description       string
+		managedObj        resource.Managed
+		configErr         error
+		clientErr         error
+		expectedClientNil bool
+		expectedErrNil    bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws config provider fails, should return error",
+			mockManaged,
+			errors.New("some error"),
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws client provider fails, should return error",
+			mockManaged,
+			nil,
+			errors.New("some error"),
+			true,
+			false,
+		},
+	} {
+		clientErr = tc.clientErr
+		configErr = tc.configErr
+
+		res, err := conn.Connect(context.Background(), tc.managedObj)
+		g.Expect(res == nil).To(gomega.Equal(tc.expectedClientNil), tc.description)
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+	}
+}
+
+func Test_Observe(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha1.LoadBalancer{
+		Spec: v1alpha1.LoadBalancerSpec{
+			ForProvider: v1alpha1.LoadBalancerParameters{
+				SubnetIDs: []string{"subnet-1"},
+			},
+		},
+	}
+	meta.SetExternalName(&mockManaged, "example")
+
+	var mockClientErr error
+	var itemsList []awselbv2.LoadBalancer
+	mockClient.MockDescribeLoadBalancersRequest = func(input *awselbv2.DescribeLoadBalancersInput) awselbv2.DescribeLoadBalancersRequest {
+		g.Expect(input.Names).To(gomega.Equal([]string{"example"}), "the passed parameters are not valid")
+		return awselbv2.DescribeLoadBalancersRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awselbv2.DescribeLoadBalancersOutput{
+					LoadBalancers: itemsList,
+				},
+				Error: mockClientErr,
+			},
+		}
+	}
+	mockClient.MockDescribeTagsRequest = func(input *awselbv2.DescribeTagsInput) awselbv2.DescribeTagsRequest {
+		return awselbv2.DescribeTagsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awselbv2.DescribeTagsOutput{},
+			},
+		}
+	}
+
+	lb := func(state awselbv2.LoadBalancerStateEnum, subnets ...string) awselbv2.LoadBalancer {
+		o := awselbv2.LoadBalancer{
+			LoadBalancerArn: aws.String("arn"),
+			DNSName:         aws.String("example.elb.amazonaws.com"),
+			Type:            awselbv2.LoadBalancerTypeEnumApplication,
+			IpAddressType:   awselbv2.IpAddressTypeIpv4,
+			State:           &awselbv2.LoadBalancerState{Code: state},
+		}
+		for _, s := range subnets {
+			o.AvailabilityZones = append(o.AvailabilityZones, awselbv2.AvailabilityZone{SubnetId: aws.String(s)})
+		}
+		return o
+	}
+
+	for _, tc := range []struct {
+		description           string
+		managedObj            resource.Managed
+		itemsReturned         []awselbv2.LoadBalancer
+		clientErr             error
+		expectedErrNil        bool
+		expectedResourceExist bool
+		expectedUpToDate      bool
+		expectedReason        corev1alpha1.ConditionReason
+	}{
+		{
+			"active load balancer should return expected",
+			mockManaged.DeepCopy(),
+			[]awselbv2.LoadBalancer{lb(awselbv2.LoadBalancerStateEnumActive, "subnet-1")},
+			nil,
+			true,
+			true,
+			true,
+			corev1alpha1.ReasonAvailable,
+		},
+		{
+			"load balancer in different subnets should not be up to date",
+			mockManaged.DeepCopy(),
+			[]awselbv2.LoadBalancer{lb(awselbv2.LoadBalancerStateEnumActive, "subnet-2")},
+			nil,
+			true,
+			true,
+			false,
+			corev1alpha1.ReasonAvailable,
+		},
+		{
+			"provisioning load balancer should be creating",
+			mockManaged.DeepCopy(),
+			[]awselbv2.LoadBalancer{lb(awselbv2.LoadBalancerStateEnumProvisioning, "subnet-1")},
+			nil,
+			true,
+			true,
+			true,
+			corev1alpha1.ReasonCreating,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			nil,
+			false,
+			false,
+			false,
+			"",
+		},
+		{
+			"if external resource doesn't exist, it should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			awserr.New(awselbv2.ErrCodeLoadBalancerNotFoundException, "", nil),
+			true,
+			false,
+			false,
+			"",
+		},
+		{
+			"if external resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			nil,
+			errors.New("some error"),
+			false,
+			false,
+			false,
+			"",
+		},
+	} {
+		mockClientErr = tc.clientErr
+		itemsList = tc.itemsReturned
+
+		result, err := mockExternalClient.Observe(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(result.ResourceExists).To(gomega.Equal(tc.expectedResourceExist), tc.description)
+		g.Expect(result.ResourceUpToDate).To(gomega.Equal(tc.expectedUpToDate), tc.description)
+		if tc.expectedResourceExist {
+			mgd := tc.managedObj.(*v1alpha1.LoadBalancer)
+			g.Expect(mgd.Status.GetCondition(corev1alpha1.TypeReady).Reason).To(gomega.Equal(tc.expectedReason), tc.description)
+			g.Expect(mgd.Status.AtProvider.ARN).To(gomega.Equal("arn"), tc.description)
+			g.Expect(aws.StringValue(mgd.Spec.ForProvider.Type)).To(gomega.Equal("application"), tc.description)
+			g.Expect(string(result.ConnectionDetails[corev1alpha1.ResourceCredentialsSecretEndpointKey])).To(gomega.Equal("example.elb.amazonaws.com"), tc.description)
+		}
+	}
+}
+
+func Test_Create(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha1.LoadBalancer{
+		Spec: v1alpha1.LoadBalancerSpec{
+			ForProvider: v1alpha1.LoadBalancerParameters{
+				Type:             aws.String("application"),
+				SubnetIDs:        []string{"subnet-1", "subnet-2"},
+				SecurityGroupIDs: []string{"sg-1"},
+			},
+		},
+	}
+	meta.SetExternalName(&mockManaged, "example")
+
+	var mockClientErr error
+	mockClient.MockCreateLoadBalancerRequest = func(input *awselbv2.CreateLoadBalancerInput) awselbv2.CreateLoadBalancerRequest {
+		g.Expect(aws.StringValue(input.Name)).To(gomega.Equal("example"), "the passed parameters are not valid")
+		g.Expect(input.Subnets).To(gomega.Equal([]string{"subnet-1", "subnet-2"}), "the passed parameters are not valid")
+		g.Expect(input.SecurityGroups).To(gomega.Equal([]string{"sg-1"}), "the passed parameters are not valid")
+		return awselbv2.CreateLoadBalancerRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awselbv2.CreateLoadBalancerOutput{
+					LoadBalancers: []awselbv2.LoadBalancer{{
+						LoadBalancerArn: aws.String("arn"),
+						State:           &awselbv2.LoadBalancerState{Code: awselbv2.LoadBalancerStateEnumProvisioning},
+					}},
+				},
+				Error: mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			false,
+		},
+		{
+			"if creating resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+
+		_, err := mockExternalClient.Create(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha1.LoadBalancer)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonCreating), tc.description)
+			g.Expect(mgd.Status.AtProvider.ARN).To(gomega.Equal("arn"), tc.description)
+			g.Expect(mgd.Status.AtProvider.State).To(gomega.Equal(string(awselbv2.LoadBalancerStateEnumProvisioning)), tc.description)
+		}
+	}
+}
+
+func Test_Update(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha1.LoadBalancer{
+		Spec: v1alpha1.LoadBalancerSpec{
+			ForProvider: v1alpha1.LoadBalancerParameters{
+				SubnetIDs:        []string{"subnet-1", "subnet-2"},
+				SecurityGroupIDs: []string{"sg-1"},
+				Tags:             []v1alpha1.Tag{{Key: "k", Value: "v"}},
+			},
+		},
+	}
+	meta.SetExternalName(&mockManaged, "example")
+
+	var observed awselbv2.LoadBalancer
+	mockClient.MockDescribeLoadBalancersRequest = func(input *awselbv2.DescribeLoadBalancersInput) awselbv2.DescribeLoadBalancersRequest {
+		return awselbv2.DescribeLoadBalancersRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awselbv2.DescribeLoadBalancersOutput{
+					LoadBalancers: []awselbv2.LoadBalancer{observed},
+				},
+			},
+		}
+	}
+	mockClient.MockDescribeTagsRequest = func(input *awselbv2.DescribeTagsInput) awselbv2.DescribeTagsRequest {
+		return awselbv2.DescribeTagsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awselbv2.DescribeTagsOutput{
+					TagDescriptions: []awselbv2.TagDescription{{
+						Tags: []awselbv2.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+					}},
+				},
+			},
+		}
+	}
+
+	var calls []string
+	var mockClientErr error
+	mockClient.MockSetSubnetsRequest = func(input *awselbv2.SetSubnetsInput) awselbv2.SetSubnetsRequest {
+		calls = append(calls, "subnets")
+		g.Expect(input.Subnets).To(gomega.Equal([]string{"subnet-1", "subnet-2"}), "the passed parameters are not valid")
+		return awselbv2.SetSubnetsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awselbv2.SetSubnetsOutput{},
+				Error:       mockClientErr,
+			},
+		}
+	}
+	mockClient.MockSetSecurityGroupsRequest = func(input *awselbv2.SetSecurityGroupsInput) awselbv2.SetSecurityGroupsRequest {
+		calls = append(calls, "securitygroups")
+		return awselbv2.SetSecurityGroupsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awselbv2.SetSecurityGroupsOutput{},
+			},
+		}
+	}
+
+	upToDate := awselbv2.LoadBalancer{
+		LoadBalancerArn: aws.String("arn"),
+		AvailabilityZones: []awselbv2.AvailabilityZone{
+			{SubnetId: aws.String("subnet-2")},
+			{SubnetId: aws.String("subnet-1")},
+		},
+		SecurityGroups: []string{"sg-1"},
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		observed       awselbv2.LoadBalancer
+		clientErr      error
+		expectedErrNil bool
+		expectedCalls  []string
+	}{
+		{
+			"missing subnet and security group should be set",
+			mockManaged.DeepCopy(),
+			awselbv2.LoadBalancer{LoadBalancerArn: aws.String("arn")},
+			nil,
+			true,
+			[]string{"subnets", "securitygroups"},
+		},
+		{
+			"up to date load balancer should not be modified",
+			mockManaged.DeepCopy(),
+			upToDate,
+			nil,
+			true,
+			nil,
+		},
+		{
+			"if setting the subnets fails, it should return error",
+			mockManaged.DeepCopy(),
+			awselbv2.LoadBalancer{LoadBalancerArn: aws.String("arn")},
+			errors.New("some error"),
+			false,
+			[]string{"subnets"},
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			awselbv2.LoadBalancer{},
+			nil,
+			false,
+			nil,
+		},
+	} {
+		observed = tc.observed
+		mockClientErr = tc.clientErr
+		calls = nil
+
+		_, err := mockExternalClient.Update(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(calls).To(gomega.Equal(tc.expectedCalls), tc.description)
+	}
+}
+
+func Test_Delete(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha1.LoadBalancer{}
+	mockManaged.Status.AtProvider.ARN = "arn"
+	var mockClientErr error
+	mockClient.MockDeleteLoadBalancerRequest = func(input *awselbv2.DeleteLoadBalancerInput) awselbv2.DeleteLoadBalancerRequest {
+		g.Expect(aws.StringValue(input.LoadBalancerArn)).To(gomega.Equal("arn"), "the passed parameters are not valid")
+		return awselbv2.DeleteLoadBalancerRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awselbv2.DeleteLoadBalancerOutput{},
+				Error:       mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			false,
+		},
+		{
+			"if the resource doesn't exist deleting resource should not return an error",
+			mockManaged.DeepCopy(),
+			awserr.New(awselbv2.ErrCodeLoadBalancerNotFoundException, "", nil),
+			true,
+		},
+		{
+			"if deleting resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+
+		err := mockExternalClient.Delete(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha1.LoadBalancer)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonDeleting), tc.description)
+		}
+	}
+}
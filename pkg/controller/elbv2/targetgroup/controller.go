@@ -0,0 +1,233 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package targetgroup
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awselbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2"
+	"github.com/crossplane/provider-aws/pkg/controller/utils"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a TargetGroup resource"
+	errClient           = "cannot create a new TargetGroupClient"
+	errDescribe         = "failed to describe TargetGroup with name"
+	errMultipleItems    = "retrieved multiple TargetGroups for the given name"
+	errDescribeTags     = "failed to describe the tags of the TargetGroup"
+	errSpecUpdate       = "cannot update spec of TargetGroup custom resource"
+	errCreate           = "failed to create the TargetGroup resource"
+	errModify           = "failed to modify the TargetGroup resource"
+	errAddTags          = "failed to add tags to the TargetGroup"
+	errRemoveTags       = "failed to remove tags from the TargetGroup"
+	errDelete           = "failed to delete the TargetGroup resource"
+)
+
+// SetupTargetGroup adds a controller that reconciles TargetGroups.
+func SetupTargetGroup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.TargetGroupGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.TargetGroup{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.TargetGroupGroupVersionKind),
+			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: elbv2.NewTargetGroupClient, awsConfigFn: utils.RetrieveAwsConfigFromProvider}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	client      client.Client
+	newClientFn func(*aws.Config) (elbv2.TargetGroupClient, error)
+	awsConfigFn func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error)
+}
+
+func (conn *connector) Connect(ctx context.Context, mgd resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mgd.(*v1alpha1.TargetGroup)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	awsconfig, err := conn.awsConfigFn(ctx, conn.client, cr.Spec.ProviderReference)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := conn.newClientFn(awsconfig)
+	if err != nil {
+		return nil, errors.Wrap(err, errClient)
+	}
+
+	return &external{kube: conn.client, client: c}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client elbv2.TargetGroupClient
+}
+
+// observe returns the target group with the given name along with its tags.
+func (e *external) observe(ctx context.Context, name string) (*awselbv2.TargetGroup, []awselbv2.Tag, error) {
+	rsp, err := e.client.DescribeTargetGroupsRequest(&awselbv2.DescribeTargetGroupsInput{
+		Names: []string{name},
+	}).Send(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errDescribe)
+	}
+
+	// in a valid request, there should be exactly one matching target group.
+	if len(rsp.TargetGroups) != 1 {
+		return nil, nil, errors.New(errMultipleItems)
+	}
+	tg := rsp.TargetGroups[0]
+
+	trsp, err := e.client.DescribeTagsRequest(&awselbv2.DescribeTagsInput{
+		ResourceArns: []string{aws.StringValue(tg.TargetGroupArn)},
+	}).Send(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errDescribeTags)
+	}
+	var tags []awselbv2.Tag
+	if len(trsp.TagDescriptions) > 0 {
+		tags = trsp.TagDescriptions[0].Tags
+	}
+	return &tg, tags, nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.TargetGroup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	tg, tags, err := e.observe(ctx, meta.GetExternalName(cr))
+	if elbv2.IsTargetGroupNotFoundErr(errors.Cause(err)) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	elbv2.LateInitializeTargetGroup(&cr.Spec.ForProvider, tg)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = elbv2.GenerateTargetGroupObservation(*tg)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: elbv2.IsTargetGroupUpToDate(cr.Spec.ForProvider, *tg, tags),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.TargetGroup)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	rsp, err := e.client.CreateTargetGroupRequest(elbv2.GenerateCreateTargetGroupInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	if len(rsp.TargetGroups) > 0 {
+		cr.Status.AtProvider = elbv2.GenerateTargetGroupObservation(rsp.TargetGroups[0])
+	}
+
+	// tags can't be set when the target group is created; they are added by
+	// the next update.
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.TargetGroup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	tg, tags, err := e.observe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	arn := aws.StringValue(tg.TargetGroupArn)
+
+	if !elbv2.IsTargetGroupHealthCheckUpToDate(cr.Spec.ForProvider, *tg) {
+		if _, err := e.client.ModifyTargetGroupRequest(elbv2.GenerateModifyTargetGroupInput(arn, cr.Spec.ForProvider)).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errModify)
+		}
+	}
+
+	add, remove := elbv2.DiffTags(cr.Spec.ForProvider.Tags, tags)
+	if len(add) > 0 {
+		if _, err := e.client.AddTagsRequest(&awselbv2.AddTagsInput{
+			ResourceArns: []string{arn},
+			Tags:         add,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAddTags)
+		}
+	}
+	if len(remove) > 0 {
+		if _, err := e.client.RemoveTagsRequest(&awselbv2.RemoveTagsInput{
+			ResourceArns: []string{arn},
+			TagKeys:      remove,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRemoveTags)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.TargetGroup)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+
+	_, err := e.client.DeleteTargetGroupRequest(&awselbv2.DeleteTargetGroupInput{
+		TargetGroupArn: aws.String(cr.Status.AtProvider.ARN),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(elbv2.IsTargetGroupNotFoundErr, err), errDelete)
+}
@@ -0,0 +1,482 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package targetgroup
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awselbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2/fake"
+)
+
+var (
+	mockExternalClient external
+	mockClient         fake.MockTargetGroupClient
+
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+)
+
+func TestMain(m *testing.M) {
+
+	mockClient = fake.MockTargetGroupClient{}
+	mockExternalClient = external{
+		client: &mockClient,
+		kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+	}
+
+	os.Exit(m.Run())
+}
+
+func Test_Connect(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := &v1alpha1.TargetGroup{}
+	var clientErr error
+	var configErr error
+
+	conn := connector{
+		client: nil,
+		newClientFn: func(conf *aws.Config) (elbv2.TargetGroupClient, error) {
+			return &mockClient, clientErr
+		},
+		awsConfigFn: func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error) {
+			return &aws.Config{}, configErr
+		},
+	}
+
+	for _, tc := range []struct {
+		description       string
+		managedObj        resource.Managed
+		configErr         error
+		clientErr         error
+		expectedClientNil bool
+		expectedErrNil    bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws config provider fails, should return error",
+			mockManaged,
+			errors.New("some error"),
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws client provider fails, should return error",
+			mockManaged,
+			nil,
+			errors.New("some error"),
+			true,
+			false,
+		},
+	} {
+		clientErr = tc.clientErr
+		configErr = tc.configErr
+
+		res, err := conn.Connect(context.Background(), tc.managedObj)
+		g.Expect(res == nil).To(gomega.Equal(tc.expectedClientNil), tc.description)
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+	}
+}
+
+func Test_Observe(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha1.TargetGroup{
+		Spec: v1alpha1.TargetGroupSpec{
+			ForProvider: v1alpha1.TargetGroupParameters{
+				HealthCheckPath: aws.String("/healthz"),
+			},
+		},
+	}
+	meta.SetExternalName(&mockManaged, "example")
+
+	var mockClientErr error
+	var itemsList []awselbv2.TargetGroup
+	mockClient.MockDescribeTargetGroupsRequest = func(input *awselbv2.DescribeTargetGroupsInput) awselbv2.DescribeTargetGroupsRequest {
+		g.Expect(input.Names).To(gomega.Equal([]string{"example"}), "the passed parameters are not valid")
+		return awselbv2.DescribeTargetGroupsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awselbv2.DescribeTargetGroupsOutput{
+					TargetGroups: itemsList,
+				},
+				Error: mockClientErr,
+			},
+		}
+	}
+	mockClient.MockDescribeTagsRequest = func(input *awselbv2.DescribeTagsInput) awselbv2.DescribeTagsRequest {
+		return awselbv2.DescribeTagsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awselbv2.DescribeTagsOutput{},
+			},
+		}
+	}
+
+	tg := func(path string) awselbv2.TargetGroup {
+		return awselbv2.TargetGroup{
+			TargetGroupArn:   aws.String("arn"),
+			Protocol:         awselbv2.ProtocolEnumHttp,
+			Port:             aws.Int64(80),
+			HealthCheckPath:  aws.String(path),
+			LoadBalancerArns: []string{"lb"},
+		}
+	}
+
+	for _, tc := range []struct {
+		description           string
+		managedObj            resource.Managed
+		itemsReturned         []awselbv2.TargetGroup
+		clientErr             error
+		expectedErrNil        bool
+		expectedResourceExist bool
+		expectedUpToDate      bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			[]awselbv2.TargetGroup{tg("/healthz")},
+			nil,
+			true,
+			true,
+			true,
+		},
+		{
+			"target group with a different health check should not be up to date",
+			mockManaged.DeepCopy(),
+			[]awselbv2.TargetGroup{tg("/")},
+			nil,
+			true,
+			true,
+			false,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			nil,
+			false,
+			false,
+			false,
+		},
+		{
+			"if external resource doesn't exist, it should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			awserr.New(awselbv2.ErrCodeTargetGroupNotFoundException, "", nil),
+			true,
+			false,
+			false,
+		},
+		{
+			"if external resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			nil,
+			errors.New("some error"),
+			false,
+			false,
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+		itemsList = tc.itemsReturned
+
+		result, err := mockExternalClient.Observe(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(result.ResourceExists).To(gomega.Equal(tc.expectedResourceExist), tc.description)
+		g.Expect(result.ResourceUpToDate).To(gomega.Equal(tc.expectedUpToDate), tc.description)
+		if tc.expectedResourceExist {
+			mgd := tc.managedObj.(*v1alpha1.TargetGroup)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonAvailable), tc.description)
+			g.Expect(mgd.Status.AtProvider.LoadBalancerARNs).To(gomega.Equal([]string{"lb"}), tc.description)
+			g.Expect(aws.Int64Value(mgd.Spec.ForProvider.Port)).To(gomega.Equal(int64(80)), tc.description)
+		}
+	}
+}
+
+func Test_Create(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha1.TargetGroup{
+		Spec: v1alpha1.TargetGroupSpec{
+			ForProvider: v1alpha1.TargetGroupParameters{
+				Protocol:        aws.String("HTTP"),
+				Port:            aws.Int64(8080),
+				VPCID:           aws.String("vpc-1"),
+				MatcherHTTPCode: aws.String("200"),
+			},
+		},
+	}
+	meta.SetExternalName(&mockManaged, "example")
+
+	var mockClientErr error
+	mockClient.MockCreateTargetGroupRequest = func(input *awselbv2.CreateTargetGroupInput) awselbv2.CreateTargetGroupRequest {
+		g.Expect(aws.StringValue(input.Name)).To(gomega.Equal("example"), "the passed parameters are not valid")
+		g.Expect(aws.StringValue(input.VpcId)).To(gomega.Equal("vpc-1"), "the passed parameters are not valid")
+		g.Expect(aws.StringValue(input.Matcher.HttpCode)).To(gomega.Equal("200"), "the passed parameters are not valid")
+		return awselbv2.CreateTargetGroupRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awselbv2.CreateTargetGroupOutput{
+					TargetGroups: []awselbv2.TargetGroup{{TargetGroupArn: aws.String("arn")}},
+				},
+				Error: mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			false,
+		},
+		{
+			"if creating resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+
+		_, err := mockExternalClient.Create(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha1.TargetGroup)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonCreating), tc.description)
+			g.Expect(mgd.Status.AtProvider.ARN).To(gomega.Equal("arn"), tc.description)
+		}
+	}
+}
+
+func Test_Update(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha1.TargetGroup{
+		Spec: v1alpha1.TargetGroupSpec{
+			ForProvider: v1alpha1.TargetGroupParameters{
+				HealthCheckPath: aws.String("/healthz"),
+				Tags:            []v1alpha1.Tag{{Key: "k", Value: "v"}},
+			},
+		},
+	}
+	meta.SetExternalName(&mockManaged, "example")
+
+	var observed awselbv2.TargetGroup
+	mockClient.MockDescribeTargetGroupsRequest = func(input *awselbv2.DescribeTargetGroupsInput) awselbv2.DescribeTargetGroupsRequest {
+		return awselbv2.DescribeTargetGroupsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awselbv2.DescribeTargetGroupsOutput{
+					TargetGroups: []awselbv2.TargetGroup{observed},
+				},
+			},
+		}
+	}
+	mockClient.MockDescribeTagsRequest = func(input *awselbv2.DescribeTagsInput) awselbv2.DescribeTagsRequest {
+		return awselbv2.DescribeTagsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awselbv2.DescribeTagsOutput{},
+			},
+		}
+	}
+
+	var calls []string
+	var mockClientErr error
+	mockClient.MockModifyTargetGroupRequest = func(input *awselbv2.ModifyTargetGroupInput) awselbv2.ModifyTargetGroupRequest {
+		calls = append(calls, "modify")
+		g.Expect(aws.StringValue(input.HealthCheckPath)).To(gomega.Equal("/healthz"), "the passed parameters are not valid")
+		return awselbv2.ModifyTargetGroupRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awselbv2.ModifyTargetGroupOutput{},
+				Error:       mockClientErr,
+			},
+		}
+	}
+	mockClient.MockAddTagsRequest = func(input *awselbv2.AddTagsInput) awselbv2.AddTagsRequest {
+		calls = append(calls, "tags")
+		g.Expect(input.ResourceArns).To(gomega.Equal([]string{"arn"}), "the passed parameters are not valid")
+		return awselbv2.AddTagsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awselbv2.AddTagsOutput{},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		observed       awselbv2.TargetGroup
+		clientErr      error
+		expectedErrNil bool
+		expectedCalls  []string
+	}{
+		{
+			"health check and tags should be updated",
+			mockManaged.DeepCopy(),
+			awselbv2.TargetGroup{TargetGroupArn: aws.String("arn"), HealthCheckPath: aws.String("/")},
+			nil,
+			true,
+			[]string{"modify", "tags"},
+		},
+		{
+			"up to date health check should not be modified",
+			mockManaged.DeepCopy(),
+			awselbv2.TargetGroup{TargetGroupArn: aws.String("arn"), HealthCheckPath: aws.String("/healthz")},
+			nil,
+			true,
+			[]string{"tags"},
+		},
+		{
+			"if modifying the target group fails, it should return error",
+			mockManaged.DeepCopy(),
+			awselbv2.TargetGroup{TargetGroupArn: aws.String("arn"), HealthCheckPath: aws.String("/")},
+			errors.New("some error"),
+			false,
+			[]string{"modify"},
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			awselbv2.TargetGroup{},
+			nil,
+			false,
+			nil,
+		},
+	} {
+		observed = tc.observed
+		mockClientErr = tc.clientErr
+		calls = nil
+
+		_, err := mockExternalClient.Update(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(calls).To(gomega.Equal(tc.expectedCalls), tc.description)
+	}
+}
+
+func Test_Delete(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha1.TargetGroup{}
+	mockManaged.Status.AtProvider.ARN = "arn"
+	var mockClientErr error
+	mockClient.MockDeleteTargetGroupRequest = func(input *awselbv2.DeleteTargetGroupInput) awselbv2.DeleteTargetGroupRequest {
+		g.Expect(aws.StringValue(input.TargetGroupArn)).To(gomega.Equal("arn"), "the passed parameters are not valid")
+		return awselbv2.DeleteTargetGroupRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awselbv2.DeleteTargetGroupOutput{},
+				Error:       mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			false,
+		},
+		{
+			"if the resource doesn't exist deleting resource should not return an error",
+			mockManaged.DeepCopy(),
+			awserr.New(awselbv2.ErrCodeTargetGroupNotFoundException, "", nil),
+			true,
+		},
+		{
+			"if deleting resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+
+		err := mockExternalClient.Delete(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha1.TargetGroup)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonDeleting), tc.description)
+		}
+	}
+}
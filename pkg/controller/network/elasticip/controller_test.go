@@ -0,0 +1,396 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticip
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	v1alpha3 "github.com/crossplane/provider-aws/apis/network/v1alpha3"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	mockExternalClient external
+	mockClient         fake.MockElasticIPClient
+
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+)
+
+func TestMain(m *testing.M) {
+
+	mockClient = fake.MockElasticIPClient{}
+	mockExternalClient = external{
+		client: &mockClient,
+		kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+	}
+
+	os.Exit(m.Run())
+}
+
+func Test_Connect(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := &v1alpha3.ElasticIP{}
+	var clientErr error
+	var configErr error
+
+	conn := connector{
+		client: nil,
+		newClientFn: func(conf *aws.Config) (ec2.ElasticIPClient, error) {
+			return &mockClient, clientErr
+		},
+		awsConfigFn: func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error) {
+			return &aws.Config{}, configErr
+		},
+	}
+
+	for _, tc := range []struct {
+		description       string
+		managedObj        resource.Managed
+		configErr         error
+		clientErr         error
+		expectedClientNil bool
+		expectedErrNil    bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws config provider fails, should return error",
+			mockManaged,
+			errors.New("some error"),
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws client provider fails, should return error",
+			mockManaged,
+			nil,
+			errors.New("some error"),
+			true,
+			false,
+		},
+	} {
+		clientErr = tc.clientErr
+		configErr = tc.configErr
+
+		res, err := conn.Connect(context.Background(), tc.managedObj)
+		g.Expect(res == nil).To(gomega.Equal(tc.expectedClientNil), tc.description)
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+	}
+}
+
+func Test_Observe(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.ElasticIP{
+		Spec: v1alpha3.ElasticIPSpec{
+			ForProvider: v1alpha3.ElasticIPParameters{
+				Tags: []v1alpha3.Tag{{Key: "k", Value: "v"}},
+			},
+		},
+	}
+	meta.SetExternalName(&mockManaged, "some arbitrary id")
+
+	tag := awsec2.Tag{Key: aws.String("k"), Value: aws.String("v")}
+	mockExternal := awsec2.Address{
+		AllocationId:   aws.String("some arbitrary id"),
+		Domain:         awsec2.DomainTypeVpc,
+		PublicIp:       aws.String("1.2.3.4"),
+		PublicIpv4Pool: aws.String("amazon"),
+		Tags:           []awsec2.Tag{tag},
+	}
+	var mockClientErr error
+	var itemsList []awsec2.Address
+	mockClient.MockDescribeAddressesRequest = func(input *awsec2.DescribeAddressesInput) awsec2.DescribeAddressesRequest {
+		return awsec2.DescribeAddressesRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsec2.DescribeAddressesOutput{
+					Addresses: itemsList,
+				},
+				Error: mockClientErr,
+			},
+		}
+	}
+
+	untagged := mockExternal
+	untagged.Tags = nil
+
+	foreignTagged := mockExternal
+	foreignTagged.Tags = []awsec2.Tag{tag, {Key: aws.String("foreign"), Value: aws.String("v")}}
+
+	tagRemoved := mockManaged.DeepCopy()
+	tagRemoved.Spec.ForProvider.Tags = nil
+	tagRemoved.Status.ManagedTagKeys = []string{"k"}
+
+	for _, tc := range []struct {
+		description           string
+		managedObj            resource.Managed
+		itemsReturned         []awsec2.Address
+		clientErr             error
+		expectedErrNil        bool
+		expectedResourceExist bool
+		expectedUpToDate      bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			[]awsec2.Address{mockExternal},
+			nil,
+			true,
+			true,
+			true,
+		},
+		{
+			"address with different tags should not be up to date",
+			mockManaged.DeepCopy(),
+			[]awsec2.Address{untagged},
+			nil,
+			true,
+			true,
+			false,
+		},
+		{
+			"tags that were not set from the spec should be kept",
+			mockManaged.DeepCopy(),
+			[]awsec2.Address{foreignTagged},
+			nil,
+			true,
+			true,
+			true,
+		},
+		{
+			"tag that was removed from the spec should not be up to date",
+			tagRemoved,
+			[]awsec2.Address{mockExternal},
+			nil,
+			true,
+			true,
+			false,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			nil,
+			false,
+			false,
+			false,
+		},
+		{
+			"if item's identifier is not yet set, returns expected",
+			&v1alpha3.ElasticIP{},
+			nil,
+			nil,
+			true,
+			false,
+			false,
+		},
+		{
+			"if external resource doesn't exist, it should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			awserr.New(ec2.AllocationIDNotFound, "", nil),
+			true,
+			false,
+			false,
+		},
+		{
+			"if external resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			nil,
+			errors.New("some error"),
+			false,
+			false,
+			false,
+		},
+		{
+			"if external resource returns a list with other than one item, it should return error",
+			mockManaged.DeepCopy(),
+			[]awsec2.Address{},
+			nil,
+			false,
+			false,
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+		itemsList = tc.itemsReturned
+
+		result, err := mockExternalClient.Observe(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(result.ResourceExists).To(gomega.Equal(tc.expectedResourceExist), tc.description)
+		g.Expect(result.ResourceUpToDate).To(gomega.Equal(tc.expectedUpToDate), tc.description)
+		if tc.expectedResourceExist {
+			mgd := tc.managedObj.(*v1alpha3.ElasticIP)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonAvailable), tc.description)
+			g.Expect(mgd.Status.AtProvider.PublicIP).To(gomega.Equal("1.2.3.4"), tc.description)
+			g.Expect(aws.StringValue(mgd.Spec.ForProvider.Domain)).To(gomega.Equal(string(awsec2.DomainTypeVpc)), tc.description)
+			g.Expect(aws.StringValue(mgd.Spec.ForProvider.PublicIPv4Pool)).To(gomega.Equal("amazon"), tc.description)
+		}
+	}
+}
+
+func Test_Create(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.ElasticIP{}
+	var mockClientErr error
+	mockClient.MockAllocateAddressRequest = func(input *awsec2.AllocateAddressInput) awsec2.AllocateAddressRequest {
+		g.Expect(input.Domain).To(gomega.Equal(awsec2.DomainTypeVpc), "the passed parameters are not valid")
+		return awsec2.AllocateAddressRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsec2.AllocateAddressOutput{
+					AllocationId: aws.String("some arbitrary id"),
+				},
+				Error: mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			false,
+		},
+		{
+			"if allocating the address fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+
+		_, err := mockExternalClient.Create(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha3.ElasticIP)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonCreating), tc.description)
+			g.Expect(meta.GetExternalName(mgd)).To(gomega.Equal("some arbitrary id"), tc.description)
+		}
+	}
+}
+
+func Test_Delete(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.ElasticIP{}
+	meta.SetExternalName(&mockManaged, "some arbitrary id")
+	var mockClientErr error
+	mockClient.MockReleaseAddressRequest = func(input *awsec2.ReleaseAddressInput) awsec2.ReleaseAddressRequest {
+		g.Expect(aws.StringValue(input.AllocationId)).To(gomega.Equal(meta.GetExternalName(&mockManaged)), "the passed parameters are not valid")
+		return awsec2.ReleaseAddressRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.ReleaseAddressOutput{},
+				Error:       mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			false,
+		},
+		{
+			"if the resource doesn't exist deleting resource should not return an error",
+			mockManaged.DeepCopy(),
+			awserr.New(ec2.AllocationIDNotFound, "", nil),
+			true,
+		},
+		{
+			"if deleting resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+
+		err := mockExternalClient.Delete(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha3.ElasticIP)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonDeleting), tc.description)
+		}
+	}
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flowlog
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	v1alpha3 "github.com/crossplane/provider-aws/apis/network/v1alpha3"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/controller/utils"
+)
+
+const (
+	errUnexpectedObject    = "The managed resource is not a FlowLog resource"
+	errClient              = "cannot create a new FlowLogClient"
+	errDescribe            = "failed to describe FlowLog"
+	errMultipleItems       = "retrieved multiple FlowLogs for the given flowLogId"
+	errKubeUpdateFailed    = "cannot update FlowLog custom resource"
+	errCreate              = "failed to create the FlowLog resource"
+	errPersistExternalName = "failed to persist FlowLog ID"
+	errDelete              = "failed to delete the FlowLog resource"
+)
+
+// SetupFlowLog adds a controller that reconciles FlowLogs.
+func SetupFlowLog(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha3.FlowLogGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha3.FlowLog{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.FlowLogGroupVersionKind),
+			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: ec2.NewFlowLogClient, awsConfigFn: utils.RetrieveAwsConfigFromProvider}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	client      client.Client
+	newClientFn func(*aws.Config) (ec2.FlowLogClient, error)
+	awsConfigFn func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error)
+}
+
+func (conn *connector) Connect(ctx context.Context, mgd resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mgd.(*v1alpha3.FlowLog)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	awsconfig, err := conn.awsConfigFn(ctx, conn.client, cr.Spec.ProviderReference)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := conn.newClientFn(awsconfig)
+	if err != nil {
+		return nil, errors.Wrap(err, errClient)
+	}
+
+	return &external{kube: conn.client, client: c}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.FlowLogClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha3.FlowLog)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	rsp, err := e.client.DescribeFlowLogsRequest(&awsec2.DescribeFlowLogsInput{
+		FlowLogIds: []string{meta.GetExternalName(cr)},
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ec2.IsFlowLogNotFoundErr, err), errDescribe)
+	}
+	if len(rsp.FlowLogs) == 0 {
+		return managed.ExternalObservation{}, nil
+	}
+	if len(rsp.FlowLogs) != 1 {
+		return managed.ExternalObservation{}, errors.New(errMultipleItems)
+	}
+
+	observed := rsp.FlowLogs[0]
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeFlowLog(&cr.Spec.ForProvider, &observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+
+	cr.Status.AtProvider = ec2.GenerateFlowLogObservation(observed)
+	if aws.StringValue(observed.FlowLogStatus) == "ACTIVE" {
+		cr.SetConditions(runtimev1alpha1.Available())
+	} else {
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ec2.IsFlowLogUpToDate(cr.Spec.ForProvider, observed),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha3.FlowLog)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	return managed.ExternalCreation{}, e.create(ctx, cr)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha3.FlowLog)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	// Flow logs cannot be modified, so they are replaced instead. The old one
+	// is deleted first since EC2 refuses to create a flow log with the same
+	// resource, traffic type and destination as an existing one.
+	if err := e.delete(ctx, meta.GetExternalName(cr)); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	return managed.ExternalUpdate{}, e.create(ctx, cr)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha3.FlowLog)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+
+	return e.delete(ctx, meta.GetExternalName(cr))
+}
+
+// create creates the flow log described by the spec of the given FlowLog and
+// records its ID as the external name.
+func (e *external) create(ctx context.Context, cr *v1alpha3.FlowLog) error {
+	input, err := ec2.GenerateCreateFlowLogsInput(cr.Spec.ForProvider)
+	if err != nil {
+		return errors.Wrap(err, errCreate)
+	}
+	rsp, err := e.client.CreateFlowLogsRequest(input).Send(ctx)
+	if err != nil {
+		return errors.Wrap(err, errCreate)
+	}
+	if err := ec2.UnsuccessfulItemsErr(rsp.Unsuccessful); err != nil {
+		return errors.Wrap(err, errCreate)
+	}
+	if len(rsp.FlowLogIds) != 1 {
+		return errors.New(errCreate)
+	}
+
+	meta.SetExternalName(cr, rsp.FlowLogIds[0])
+	return errors.Wrap(e.kube.Update(ctx, cr), errPersistExternalName)
+}
+
+// delete deletes the flow log with the given ID, if it exists.
+func (e *external) delete(ctx context.Context, id string) error {
+	rsp, err := e.client.DeleteFlowLogsRequest(&awsec2.DeleteFlowLogsInput{
+		FlowLogIds: []string{id},
+	}).Send(ctx)
+	if err != nil {
+		return errors.Wrap(resource.Ignore(ec2.IsFlowLogNotFoundErr, err), errDelete)
+	}
+	return errors.Wrap(resource.Ignore(ec2.IsFlowLogNotFoundErr, ec2.UnsuccessfulItemsErr(rsp.Unsuccessful)), errDelete)
+}
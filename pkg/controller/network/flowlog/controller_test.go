@@ -0,0 +1,487 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flowlog
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	v1alpha3 "github.com/crossplane/provider-aws/apis/network/v1alpha3"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	mockExternalClient external
+	mockClient         fake.MockFlowLogClient
+
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+)
+
+func TestMain(m *testing.M) {
+
+	mockClient = fake.MockFlowLogClient{}
+	mockExternalClient = external{
+		client: &mockClient,
+		kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+	}
+
+	os.Exit(m.Run())
+}
+
+func Test_Connect(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := &v1alpha3.FlowLog{}
+	var clientErr error
+	var configErr error
+
+	conn := connector{
+		client: nil,
+		newClientFn: func(conf *aws.Config) (ec2.FlowLogClient, error) {
+			return &mockClient, clientErr
+		},
+		awsConfigFn: func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error) {
+			return &aws.Config{}, configErr
+		},
+	}
+
+	for _, tc := range []struct {
+		description       string
+		managedObj        resource.Managed
+		configErr         error
+		clientErr         error
+		expectedClientNil bool
+		expectedErrNil    bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws config provider fails, should return error",
+			mockManaged,
+			errors.New("some error"),
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws client provider fails, should return error",
+			mockManaged,
+			nil,
+			errors.New("some error"),
+			true,
+			false,
+		},
+	} {
+		clientErr = tc.clientErr
+		configErr = tc.configErr
+
+		res, err := conn.Connect(context.Background(), tc.managedObj)
+		g.Expect(res == nil).To(gomega.Equal(tc.expectedClientNil), tc.description)
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+	}
+}
+
+var (
+	// the desired flow log of the tests
+	flowLogParams = v1alpha3.FlowLogParameters{
+		VPCID:                    aws.String("vpc-1"),
+		TrafficType:              "ALL",
+		LogDestinationType:       aws.String(v1alpha3.FlowLogDestinationCloudWatchLogs),
+		LogGroupName:             aws.String("flow-logs"),
+		DeliverLogsPermissionARN: aws.String("arn:aws:iam::123456789012:role/flow-logs"),
+		LogFormat:                aws.String("${srcaddr} ${dstaddr}"),
+	}
+	flowLog = awsec2.FlowLog{
+		FlowLogId:                aws.String("fl-1"),
+		FlowLogStatus:            aws.String("ACTIVE"),
+		DeliverLogsStatus:        aws.String("SUCCESS"),
+		ResourceId:               aws.String("vpc-1"),
+		TrafficType:              awsec2.TrafficTypeAll,
+		LogDestinationType:       awsec2.LogDestinationTypeCloudWatchLogs,
+		LogGroupName:             aws.String("flow-logs"),
+		DeliverLogsPermissionArn: aws.String("arn:aws:iam::123456789012:role/flow-logs"),
+		LogFormat:                aws.String("${srcaddr} ${dstaddr}"),
+	}
+)
+
+func Test_Observe(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.FlowLog{Spec: v1alpha3.FlowLogSpec{ForProvider: flowLogParams}}
+	meta.SetExternalName(&mockManaged, "fl-1")
+
+	var mockClientErr error
+	var itemsList []awsec2.FlowLog
+	mockClient.MockDescribeFlowLogsRequest = func(input *awsec2.DescribeFlowLogsInput) awsec2.DescribeFlowLogsRequest {
+		g.Expect(input.FlowLogIds).To(gomega.Equal([]string{"fl-1"}), "the passed parameters are not valid")
+		return awsec2.DescribeFlowLogsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.DescribeFlowLogsOutput{FlowLogs: itemsList},
+				Error:       mockClientErr,
+			},
+		}
+	}
+
+	changed := flowLog
+	changed.TrafficType = awsec2.TrafficTypeReject
+
+	for _, tc := range []struct {
+		description           string
+		managedObj            resource.Managed
+		itemsReturned         []awsec2.FlowLog
+		clientErr             error
+		expectedErrNil        bool
+		expectedResourceExist bool
+		expectedUpToDate      bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			[]awsec2.FlowLog{flowLog},
+			nil,
+			true,
+			true,
+			true,
+		},
+		{
+			"flow log with a different traffic type should not be up to date",
+			mockManaged.DeepCopy(),
+			[]awsec2.FlowLog{changed},
+			nil,
+			true,
+			true,
+			false,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			nil,
+			false,
+			false,
+			false,
+		},
+		{
+			"if item's identifier is not yet set, returns expected",
+			&v1alpha3.FlowLog{},
+			nil,
+			nil,
+			true,
+			false,
+			false,
+		},
+		{
+			"if external resource doesn't exist, it should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			nil,
+			true,
+			false,
+			false,
+		},
+		{
+			"if the flow log ID is not valid, it should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			awserr.New(ec2.FlowLogIDNotFound, "", nil),
+			true,
+			false,
+			false,
+		},
+		{
+			"if external resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			nil,
+			errors.New("some error"),
+			false,
+			false,
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+		itemsList = tc.itemsReturned
+
+		result, err := mockExternalClient.Observe(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(result.ResourceExists).To(gomega.Equal(tc.expectedResourceExist), tc.description)
+		g.Expect(result.ResourceUpToDate).To(gomega.Equal(tc.expectedUpToDate), tc.description)
+		if tc.expectedResourceExist {
+			mgd := tc.managedObj.(*v1alpha3.FlowLog)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonAvailable), tc.description)
+			g.Expect(mgd.Status.AtProvider.DeliverLogsStatus).To(gomega.Equal("SUCCESS"), tc.description)
+		}
+	}
+}
+
+func Test_Create(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.FlowLog{Spec: v1alpha3.FlowLogSpec{ForProvider: flowLogParams}}
+
+	var mockClientErr error
+	var unsuccessful []awsec2.UnsuccessfulItem
+	mockClient.MockCreateFlowLogsRequest = func(input *awsec2.CreateFlowLogsInput) awsec2.CreateFlowLogsRequest {
+		g.Expect(input.ResourceIds).To(gomega.Equal([]string{"vpc-1"}), "the passed parameters are not valid")
+		g.Expect(input.ResourceType).To(gomega.Equal(awsec2.FlowLogsResourceTypeVpc), "the passed parameters are not valid")
+		g.Expect(aws.StringValue(input.LogGroupName)).To(gomega.Equal("flow-logs"), "the passed parameters are not valid")
+		out := &awsec2.CreateFlowLogsOutput{Unsuccessful: unsuccessful}
+		if unsuccessful == nil {
+			out.FlowLogIds = []string{"fl-1"}
+		}
+		return awsec2.CreateFlowLogsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        out,
+				Error:       mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		unsuccessful   []awsec2.UnsuccessfulItem
+		clientErr      error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			nil,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			nil,
+			false,
+		},
+		{
+			"if the flow log could not be created for the resource, it should return error",
+			mockManaged.DeepCopy(),
+			[]awsec2.UnsuccessfulItem{{ResourceId: aws.String("vpc-1"), Error: &awsec2.UnsuccessfulItemError{Code: aws.String("InvalidVpcID.NotFound"), Message: aws.String("not found")}}},
+			nil,
+			false,
+		},
+		{
+			"if creating resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			nil,
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+		unsuccessful = tc.unsuccessful
+
+		_, err := mockExternalClient.Create(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha3.FlowLog)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonCreating), tc.description)
+			g.Expect(meta.GetExternalName(mgd)).To(gomega.Equal("fl-1"), tc.description)
+		}
+	}
+}
+
+func Test_Update(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.FlowLog{Spec: v1alpha3.FlowLogSpec{ForProvider: flowLogParams}}
+	meta.SetExternalName(&mockManaged, "fl-old")
+
+	var calls []string
+	var mockDeleteErr error
+	mockClient.MockDeleteFlowLogsRequest = func(input *awsec2.DeleteFlowLogsInput) awsec2.DeleteFlowLogsRequest {
+		calls = append(calls, "delete "+input.FlowLogIds[0])
+		return awsec2.DeleteFlowLogsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.DeleteFlowLogsOutput{},
+				Error:       mockDeleteErr,
+			},
+		}
+	}
+	mockClient.MockCreateFlowLogsRequest = func(input *awsec2.CreateFlowLogsInput) awsec2.CreateFlowLogsRequest {
+		calls = append(calls, "create")
+		return awsec2.CreateFlowLogsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.CreateFlowLogsOutput{FlowLogIds: []string{"fl-new"}},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description          string
+		managedObj           resource.Managed
+		deleteErr            error
+		expectedErrNil       bool
+		expectedCalls        []string
+		expectedExternalName string
+	}{
+		{
+			"flow log should be replaced",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+			[]string{"delete fl-old", "create"},
+			"fl-new",
+		},
+		{
+			"if deleting the old flow log fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+			[]string{"delete fl-old"},
+			"fl-old",
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			false,
+			nil,
+			"",
+		},
+	} {
+		calls = nil
+		mockDeleteErr = tc.deleteErr
+
+		_, err := mockExternalClient.Update(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(calls).To(gomega.Equal(tc.expectedCalls), tc.description)
+		if tc.expectedExternalName != "" {
+			g.Expect(meta.GetExternalName(tc.managedObj)).To(gomega.Equal(tc.expectedExternalName), tc.description)
+		}
+	}
+}
+
+func Test_Delete(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.FlowLog{}
+	meta.SetExternalName(&mockManaged, "fl-1")
+
+	var mockClientErr error
+	var unsuccessful []awsec2.UnsuccessfulItem
+	mockClient.MockDeleteFlowLogsRequest = func(input *awsec2.DeleteFlowLogsInput) awsec2.DeleteFlowLogsRequest {
+		g.Expect(input.FlowLogIds).To(gomega.Equal([]string{"fl-1"}), "the passed parameters are not valid")
+		return awsec2.DeleteFlowLogsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.DeleteFlowLogsOutput{Unsuccessful: unsuccessful},
+				Error:       mockClientErr,
+			},
+		}
+	}
+
+	notFound := []awsec2.UnsuccessfulItem{{ResourceId: aws.String("fl-1"), Error: &awsec2.UnsuccessfulItemError{Code: aws.String(ec2.FlowLogIDNotFound)}}}
+	failed := []awsec2.UnsuccessfulItem{{ResourceId: aws.String("fl-1"), Error: &awsec2.UnsuccessfulItemError{Code: aws.String("UnauthorizedOperation")}}}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		unsuccessful   []awsec2.UnsuccessfulItem
+		clientErr      error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			nil,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			nil,
+			false,
+		},
+		{
+			"if the resource doesn't exist deleting resource should not return an error",
+			mockManaged.DeepCopy(),
+			notFound,
+			nil,
+			true,
+		},
+		{
+			"if the flow log could not be deleted, it should return error",
+			mockManaged.DeepCopy(),
+			failed,
+			nil,
+			false,
+		},
+		{
+			"if deleting resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			nil,
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+		unsuccessful = tc.unsuccessful
+
+		err := mockExternalClient.Delete(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha3.FlowLog)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonDeleting), tc.description)
+		}
+	}
+}
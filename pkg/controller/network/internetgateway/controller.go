@@ -46,6 +46,9 @@ const (
 	errCreate              = "failed to create the InternetGateway resource"
 	errPersistExternalName = "failed to persist InternetGateway ID"
 	errDetach              = "failed to detach the InternetGateway from VPC"
+	errAttach              = "failed to attach the InternetGateway to VPC"
+	errCreateTags          = "failed to create tags for the InternetGateway resource"
+	errDeleteTags          = "failed to delete tags for the InternetGateway resource"
 	errDelete              = "failed to delete the InternetGateway resource"
 )
 
@@ -146,6 +149,7 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	return managed.ExternalObservation{
 		ResourceExists:    true,
 		ConnectionDetails: managed.ConnectionDetails{},
+		ResourceUpToDate:  ec2.IsInternetGatewayUpToDate(cr.Spec.InternetGatewayParameters, cr.Status.InternetGatewayExternalStatus, cr.Status.ManagedTagKeys),
 	}, nil
 }
 
@@ -181,10 +185,54 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 }
 
 func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
-	// TODO(soorena776): add more sophisticated Update logic, once we
-	// categorize immutable vs mutable fields (see #727)
+	cr, ok := mgd.(*v1alpha3.InternetGateway)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	// an internet gateway can only be attached to one VPC at a time, so the
+	// old attachments are removed first.
+	attach, detach := ec2.DiffInternetGatewayAttachments(cr.Spec.VPCID, cr.Status.Attachments)
+	for _, id := range detach {
+		if _, err := e.client.DetachInternetGatewayRequest(&awsec2.DetachInternetGatewayInput{
+			InternetGatewayId: aws.String(meta.GetExternalName(cr)),
+			VpcId:             aws.String(id),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDetach)
+		}
+	}
+	if attach {
+		if _, err := e.client.AttachInternetGatewayRequest(&awsec2.AttachInternetGatewayInput{
+			InternetGatewayId: aws.String(meta.GetExternalName(cr)),
+			VpcId:             aws.String(cr.Spec.VPCID),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAttach)
+		}
+	}
+
+	return managed.ExternalUpdate{}, e.updateTags(ctx, cr)
+}
 
-	return managed.ExternalUpdate{}, nil
+func (e *external) updateTags(ctx context.Context, cr *v1alpha3.InternetGateway) error {
+	add, remove := ec2.DiffManagedEC2Tags(cr.Spec.Tags, v1alpha3.GenerateEC2Tags(cr.Status.Tags), cr.Status.ManagedTagKeys)
+	if len(remove) > 0 {
+		if _, err := e.client.DeleteTagsRequest(&awsec2.DeleteTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      remove,
+		}).Send(ctx); err != nil {
+			return errors.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      add,
+		}).Send(ctx); err != nil {
+			return errors.Wrap(err, errCreateTags)
+		}
+	}
+	cr.Status.ManagedTagKeys = ec2.TagKeys(cr.Spec.Tags)
+	return nil
 }
 
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
@@ -365,11 +365,97 @@ func Test_Create(t *testing.T) {
 func Test_Update(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 
-	mockManaged := v1alpha3.InternetGateway{}
+	mockManaged := v1alpha3.InternetGateway{
+		Spec: v1alpha3.InternetGatewaySpec{
+			InternetGatewayParameters: v1alpha3.InternetGatewayParameters{
+				VPCID: "vpc-new",
+				Tags:  []v1alpha3.Tag{{Key: "k", Value: "v"}},
+			},
+		},
+		Status: v1alpha3.InternetGatewayStatus{
+			InternetGatewayExternalStatus: v1alpha3.InternetGatewayExternalStatus{
+				Attachments: []v1alpha3.InternetGatewayAttachment{
+					{AttachmentStatus: "available", VPCID: "vpc-old"},
+				},
+				Tags: []v1alpha3.Tag{
+					{Key: "old", Value: "v"},
+					{Key: "kubernetes.io/cluster/eks", Value: "shared"},
+				},
+			},
+			ManagedTagKeys: []string{"old"},
+		},
+	}
+	meta.SetExternalName(&mockManaged, "some arbitrary id")
 
-	_, err := mockExternalClient.Update(context.Background(), &mockManaged)
+	var detached, attached []string
+	var mockClientErr error
+	mockClient.MockDetachInternetGatewayRequest = func(input *awsec2.DetachInternetGatewayInput) awsec2.DetachInternetGatewayRequest {
+		detached = append(detached, aws.StringValue(input.VpcId))
+		return awsec2.DetachInternetGatewayRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.DetachInternetGatewayOutput{}, Error: mockClientErr},
+		}
+	}
+	mockClient.MockAttachInternetGatewayRequest = func(input *awsec2.AttachInternetGatewayInput) awsec2.AttachInternetGatewayRequest {
+		attached = append(attached, aws.StringValue(input.VpcId))
+		return awsec2.AttachInternetGatewayRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.AttachInternetGatewayOutput{}},
+		}
+	}
+	var createdTags []awsec2.Tag
+	mockClient.MockCreateTagsRequest = func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+		createdTags = input.Tags
+		return awsec2.CreateTagsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.CreateTagsOutput{}},
+		}
+	}
 
-	g.Expect(err).To(gomega.BeNil())
+	var deletedTags []awsec2.Tag
+	mockClient.MockDeleteTagsRequest = func(input *awsec2.DeleteTagsInput) awsec2.DeleteTagsRequest {
+		deletedTags = input.Tags
+		return awsec2.DeleteTagsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.DeleteTagsOutput{}},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should move the attachment and update tags",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			false,
+		},
+		{
+			"if detaching fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+		detached, attached, createdTags, deletedTags = nil, nil, nil, nil
+
+		_, err := mockExternalClient.Update(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			g.Expect(detached).To(gomega.Equal([]string{"vpc-old"}), tc.description)
+			g.Expect(attached).To(gomega.Equal([]string{"vpc-new"}), tc.description)
+			g.Expect(createdTags).To(gomega.Equal([]awsec2.Tag{{Key: aws.String("k"), Value: aws.String("v")}}), tc.description)
+			g.Expect(deletedTags).To(gomega.Equal([]awsec2.Tag{{Key: aws.String("old")}}), tc.description)
+			g.Expect(tc.managedObj.(*v1alpha3.InternetGateway).Status.ManagedTagKeys).To(gomega.Equal([]string{"k"}), tc.description)
+		}
+	}
 }
 
 func Test_Delete(t *testing.T) {
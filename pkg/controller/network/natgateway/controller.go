@@ -0,0 +1,250 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package natgateway
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	v1alpha3 "github.com/crossplane/provider-aws/apis/network/v1alpha3"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/controller/utils"
+)
+
+const (
+	errUnexpectedObject    = "The managed resource is not a NATGateway resource"
+	errClient              = "cannot create a new NATGatewayClient"
+	errDescribe            = "failed to describe NATGateway"
+	errMultipleItems       = "retrieved multiple NATGateways for the given natGatewayId"
+	errCreate              = "failed to create the NATGateway resource"
+	errPersistExternalName = "failed to persist NATGateway ID"
+	errCreateTags          = "failed to create tags for the NATGateway resource"
+	errDeleteTags          = "failed to delete tags for the NATGateway resource"
+	errDelete              = "failed to delete the NATGateway resource"
+)
+
+// SetupNATGateway adds a controller that reconciles NATGateways.
+func SetupNATGateway(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha3.NATGatewayGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha3.NATGateway{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.NATGatewayGroupVersionKind),
+			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: ec2.NewNATGatewayClient, awsConfigFn: utils.RetrieveAwsConfigFromProvider}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	client      client.Client
+	newClientFn func(*aws.Config) (ec2.NATGatewayClient, error)
+	awsConfigFn func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error)
+}
+
+func (conn *connector) Connect(ctx context.Context, mgd resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mgd.(*v1alpha3.NATGateway)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	awsconfig, err := conn.awsConfigFn(ctx, conn.client, cr.Spec.ProviderReference)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := conn.newClientFn(awsconfig)
+	if err != nil {
+		return nil, errors.Wrap(err, errClient)
+	}
+
+	return &external{kube: conn.client, client: c}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.NATGatewayClient
+}
+
+func (e *external) describe(ctx context.Context, id string) (*awsec2.NatGateway, error) {
+	response, err := e.client.DescribeNatGatewaysRequest(&awsec2.DescribeNatGatewaysInput{
+		NatGatewayIds: []string{id},
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.NatGateways) != 1 {
+		return nil, errors.New(errMultipleItems)
+	}
+	return &response.NatGateways[0], nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha3.NATGateway)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// AWS network resources are uniquely identified by an ID that is returned
+	// on create time; we can't tell whether they exist unless we have recorded
+	// their ID.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if ec2.IsNATGatewayNotFoundErr(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribe)
+	}
+
+	cr.Status.AtProvider = ec2.GenerateNATGatewayObservation(*observed)
+
+	// deleted NAT gateways remain visible for a while; a NAT gateway that is
+	// still being deleted is reported as existing so that the managed
+	// resource is not removed before it is gone.
+	switch observed.State {
+	case awsec2.NatGatewayStateDeleted:
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	case awsec2.NatGatewayStateDeleting:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+	case awsec2.NatGatewayStateAvailable:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case awsec2.NatGatewayStatePending:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	case awsec2.NatGatewayStateFailed:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ec2.IsNATGatewayUpToDate(cr.Spec.ForProvider, *observed, cr.Status.ManagedTagKeys),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha3.NATGateway)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	rsp, err := e.client.CreateNatGatewayRequest(&awsec2.CreateNatGatewayInput{
+		AllocationId: cr.Spec.ForProvider.AllocationID,
+		SubnetId:     cr.Spec.ForProvider.SubnetID,
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, aws.StringValue(rsp.NatGateway.NatGatewayId))
+	if err := e.kube.Update(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errPersistExternalName)
+	}
+
+	cr.Status.AtProvider = ec2.GenerateNATGatewayObservation(*rsp.NatGateway)
+
+	add, _ := ec2.DiffEC2Tags(cr.Spec.ForProvider.Tags, nil)
+	if len(add) == 0 {
+		return managed.ExternalCreation{}, nil
+	}
+	if _, err := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+		Resources: []string{meta.GetExternalName(cr)},
+		Tags:      add,
+	}).Send(ctx); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateTags)
+	}
+	cr.Status.ManagedTagKeys = ec2.TagKeys(cr.Spec.ForProvider.Tags)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha3.NATGateway)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+
+	// only the tags of a NAT gateway can be changed after creation.
+	add, remove := ec2.DiffManagedEC2Tags(cr.Spec.ForProvider.Tags, observed.Tags, cr.Status.ManagedTagKeys)
+	if len(remove) > 0 {
+		if _, err := e.client.DeleteTagsRequest(&awsec2.DeleteTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      remove,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      add,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errCreateTags)
+		}
+	}
+
+	cr.Status.ManagedTagKeys = ec2.TagKeys(cr.Spec.ForProvider.Tags)
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha3.NATGateway)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+
+	// deletion takes a few minutes; there is no need to call the API again
+	// while it is in progress.
+	if cr.Status.AtProvider.State == string(awsec2.NatGatewayStateDeleting) ||
+		cr.Status.AtProvider.State == string(awsec2.NatGatewayStateDeleted) {
+		return nil
+	}
+
+	_, err := e.client.DeleteNatGatewayRequest(&awsec2.DeleteNatGatewayInput{
+		NatGatewayId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(ec2.IsNATGatewayNotFoundErr, err), errDelete)
+}
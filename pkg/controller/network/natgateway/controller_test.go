@@ -0,0 +1,579 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package natgateway
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	v1alpha3 "github.com/crossplane/provider-aws/apis/network/v1alpha3"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	mockExternalClient external
+	mockClient         fake.MockNATGatewayClient
+
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+)
+
+func TestMain(m *testing.M) {
+
+	mockClient = fake.MockNATGatewayClient{}
+	mockExternalClient = external{
+		client: &mockClient,
+		kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+	}
+
+	os.Exit(m.Run())
+}
+
+func Test_Connect(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := &v1alpha3.NATGateway{}
+	var clientErr error
+	var configErr error
+
+	conn := connector{
+		client: nil,
+		newClientFn: func(conf *aws.Config) (ec2.NATGatewayClient, error) {
+			return &mockClient, clientErr
+		},
+		awsConfigFn: func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error) {
+			return &aws.Config{}, configErr
+		},
+	}
+
+	for _, tc := range []struct {
+		description       string
+		managedObj        resource.Managed
+		configErr         error
+		clientErr         error
+		expectedClientNil bool
+		expectedErrNil    bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws config provider fails, should return error",
+			mockManaged,
+			errors.New("some error"),
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws client provider fails, should return error",
+			mockManaged,
+			nil,
+			errors.New("some error"),
+			true,
+			false,
+		},
+	} {
+		clientErr = tc.clientErr
+		configErr = tc.configErr
+
+		res, err := conn.Connect(context.Background(), tc.managedObj)
+		g.Expect(res == nil).To(gomega.Equal(tc.expectedClientNil), tc.description)
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+	}
+}
+
+func Test_Observe(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.NATGateway{
+		Spec: v1alpha3.NATGatewaySpec{
+			ForProvider: v1alpha3.NATGatewayParameters{
+				Tags: []v1alpha3.Tag{{Key: "k", Value: "v"}},
+			},
+		},
+	}
+	meta.SetExternalName(&mockManaged, "some arbitrary id")
+
+	var mockClientErr error
+	var itemsList []awsec2.NatGateway
+	mockClient.MockDescribeNatGatewaysRequest = func(input *awsec2.DescribeNatGatewaysInput) awsec2.DescribeNatGatewaysRequest {
+		return awsec2.DescribeNatGatewaysRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsec2.DescribeNatGatewaysOutput{
+					NatGateways: itemsList,
+				},
+				Error: mockClientErr,
+			},
+		}
+	}
+
+	natGateway := func(state awsec2.NatGatewayState, tags ...awsec2.Tag) awsec2.NatGateway {
+		return awsec2.NatGateway{
+			NatGatewayId: aws.String("some arbitrary id"),
+			State:        state,
+			NatGatewayAddresses: []awsec2.NatGatewayAddress{
+				{PublicIp: aws.String("1.2.3.4"), PrivateIp: aws.String("10.0.0.4")},
+			},
+			Tags: tags,
+		}
+	}
+	tag := awsec2.Tag{Key: aws.String("k"), Value: aws.String("v")}
+	foreignTag := awsec2.Tag{Key: aws.String("foreign"), Value: aws.String("v")}
+
+	for _, tc := range []struct {
+		description           string
+		managedObj            resource.Managed
+		itemsReturned         []awsec2.NatGateway
+		clientErr             error
+		expectedErrNil        bool
+		expectedResourceExist bool
+		expectedUpToDate      bool
+		expectedReason        corev1alpha1.ConditionReason
+	}{
+		{
+			"available NAT gateway should return expected",
+			mockManaged.DeepCopy(),
+			[]awsec2.NatGateway{natGateway(awsec2.NatGatewayStateAvailable, tag)},
+			nil,
+			true,
+			true,
+			true,
+			corev1alpha1.ReasonAvailable,
+		},
+		{
+			"tags that were not set from the spec should be kept",
+			mockManaged.DeepCopy(),
+			[]awsec2.NatGateway{natGateway(awsec2.NatGatewayStateAvailable, tag, foreignTag)},
+			nil,
+			true,
+			true,
+			true,
+			corev1alpha1.ReasonAvailable,
+		},
+		{
+			"pending NAT gateway should be creating",
+			mockManaged.DeepCopy(),
+			[]awsec2.NatGateway{natGateway(awsec2.NatGatewayStatePending, tag)},
+			nil,
+			true,
+			true,
+			true,
+			corev1alpha1.ReasonCreating,
+		},
+		{
+			"failed NAT gateway should be unavailable",
+			mockManaged.DeepCopy(),
+			[]awsec2.NatGateway{natGateway(awsec2.NatGatewayStateFailed, tag)},
+			nil,
+			true,
+			true,
+			true,
+			corev1alpha1.ReasonUnavailable,
+		},
+		{
+			"NAT gateway with different tags should not be up to date",
+			mockManaged.DeepCopy(),
+			[]awsec2.NatGateway{natGateway(awsec2.NatGatewayStateAvailable)},
+			nil,
+			true,
+			true,
+			false,
+			corev1alpha1.ReasonAvailable,
+		},
+		{
+			"deleting NAT gateway should still exist",
+			mockManaged.DeepCopy(),
+			[]awsec2.NatGateway{natGateway(awsec2.NatGatewayStateDeleting, tag)},
+			nil,
+			true,
+			true,
+			true,
+			corev1alpha1.ReasonDeleting,
+		},
+		{
+			"deleted NAT gateway should not exist",
+			mockManaged.DeepCopy(),
+			[]awsec2.NatGateway{natGateway(awsec2.NatGatewayStateDeleted, tag)},
+			nil,
+			true,
+			false,
+			false,
+			"",
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			nil,
+			false,
+			false,
+			false,
+			"",
+		},
+		{
+			"if item's identifier is not yet set, returns expected",
+			&v1alpha3.NATGateway{},
+			nil,
+			nil,
+			true,
+			false,
+			false,
+			"",
+		},
+		{
+			"if external resource doesn't exist, it should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			awserr.New(ec2.NATGatewayNotFound, "", nil),
+			true,
+			false,
+			false,
+			"",
+		},
+		{
+			"if external resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			nil,
+			errors.New("some error"),
+			false,
+			false,
+			false,
+			"",
+		},
+		{
+			"if external resource returns a list with other than one item, it should return error",
+			mockManaged.DeepCopy(),
+			[]awsec2.NatGateway{},
+			nil,
+			false,
+			false,
+			false,
+			"",
+		},
+	} {
+		mockClientErr = tc.clientErr
+		itemsList = tc.itemsReturned
+
+		result, err := mockExternalClient.Observe(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(result.ResourceExists).To(gomega.Equal(tc.expectedResourceExist), tc.description)
+		g.Expect(result.ResourceUpToDate).To(gomega.Equal(tc.expectedUpToDate), tc.description)
+		if tc.expectedResourceExist {
+			mgd := tc.managedObj.(*v1alpha3.NATGateway)
+			g.Expect(mgd.Status.GetCondition(corev1alpha1.TypeReady).Reason).To(gomega.Equal(tc.expectedReason), tc.description)
+			g.Expect(mgd.Status.AtProvider.NATGatewayAddresses[0].PublicIP).To(gomega.Equal("1.2.3.4"), tc.description)
+		}
+	}
+}
+
+func Test_Create(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.NATGateway{
+		Spec: v1alpha3.NATGatewaySpec{
+			ForProvider: v1alpha3.NATGatewayParameters{
+				AllocationID: aws.String("arbitrary allocationId"),
+				SubnetID:     aws.String("arbitrary subnetId"),
+				Tags:         []v1alpha3.Tag{{Key: "k", Value: "v"}},
+			},
+		},
+	}
+	mockExternal := &awsec2.NatGateway{
+		NatGatewayId: aws.String("some arbitrary id"),
+		State:        awsec2.NatGatewayStatePending,
+	}
+	var mockClientErr error
+	mockClient.MockCreateNatGatewayRequest = func(input *awsec2.CreateNatGatewayInput) awsec2.CreateNatGatewayRequest {
+		g.Expect(aws.StringValue(input.AllocationId)).To(gomega.Equal("arbitrary allocationId"), "the passed parameters are not valid")
+		g.Expect(aws.StringValue(input.SubnetId)).To(gomega.Equal("arbitrary subnetId"), "the passed parameters are not valid")
+		return awsec2.CreateNatGatewayRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsec2.CreateNatGatewayOutput{
+					NatGateway: mockExternal,
+				},
+				Error: mockClientErr,
+			},
+		}
+	}
+
+	var mockClientTagsErr error
+	mockClient.MockCreateTagsRequest = func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+		return awsec2.CreateTagsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.CreateTagsOutput{},
+				Error:       mockClientTagsErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		clientTagsErr  error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			nil,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			nil,
+			false,
+		},
+		{
+			"if creating resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			nil,
+			false,
+		},
+		{
+			"if tagging resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			nil,
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+		mockClientTagsErr = tc.clientTagsErr
+
+		_, err := mockExternalClient.Create(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha3.NATGateway)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonCreating), tc.description)
+			g.Expect(mgd.Status.AtProvider.State).To(gomega.Equal(string(awsec2.NatGatewayStatePending)), tc.description)
+			g.Expect(meta.GetExternalName(mgd)).To(gomega.Equal(aws.StringValue(mockExternal.NatGatewayId)), tc.description)
+			g.Expect(mgd.Status.ManagedTagKeys).To(gomega.Equal([]string{"k"}), tc.description)
+		}
+	}
+}
+
+func Test_Update(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.NATGateway{
+		Spec: v1alpha3.NATGatewaySpec{
+			ForProvider: v1alpha3.NATGatewayParameters{
+				Tags: []v1alpha3.Tag{{Key: "k", Value: "v"}},
+			},
+		},
+		Status: v1alpha3.NATGatewayStatus{
+			ManagedTagKeys: []string{"old"},
+		},
+	}
+	meta.SetExternalName(&mockManaged, "some arbitrary id")
+
+	mockClient.MockDescribeNatGatewaysRequest = func(input *awsec2.DescribeNatGatewaysInput) awsec2.DescribeNatGatewaysRequest {
+		return awsec2.DescribeNatGatewaysRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsec2.DescribeNatGatewaysOutput{
+					NatGateways: []awsec2.NatGateway{{
+						Tags: []awsec2.Tag{
+							{Key: aws.String("old"), Value: aws.String("v")},
+							{Key: aws.String("foreign"), Value: aws.String("v")},
+						},
+					}},
+				},
+			},
+		}
+	}
+
+	var createdTags, deletedTags []awsec2.Tag
+	var mockClientTagsErr error
+	mockClient.MockCreateTagsRequest = func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+		createdTags = input.Tags
+		return awsec2.CreateTagsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.CreateTagsOutput{},
+				Error:       mockClientTagsErr,
+			},
+		}
+	}
+	mockClient.MockDeleteTagsRequest = func(input *awsec2.DeleteTagsInput) awsec2.DeleteTagsRequest {
+		deletedTags = input.Tags
+		return awsec2.DeleteTagsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.DeleteTagsOutput{},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientTagsErr  error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should sync the tags",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			false,
+		},
+		{
+			"if tagging resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientTagsErr = tc.clientTagsErr
+		createdTags, deletedTags = nil, nil
+
+		_, err := mockExternalClient.Update(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			g.Expect(createdTags).To(gomega.Equal([]awsec2.Tag{{Key: aws.String("k"), Value: aws.String("v")}}), tc.description)
+			g.Expect(deletedTags).To(gomega.Equal([]awsec2.Tag{{Key: aws.String("old")}}), tc.description)
+			g.Expect(tc.managedObj.(*v1alpha3.NATGateway).Status.ManagedTagKeys).To(gomega.Equal([]string{"k"}), tc.description)
+		}
+	}
+}
+
+func Test_Delete(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.NATGateway{}
+	meta.SetExternalName(&mockManaged, "some arbitrary id")
+
+	deleting := mockManaged.DeepCopy()
+	deleting.Status.AtProvider.State = string(awsec2.NatGatewayStateDeleting)
+
+	var called bool
+	var mockClientErr error
+	mockClient.MockDeleteNatGatewayRequest = func(input *awsec2.DeleteNatGatewayInput) awsec2.DeleteNatGatewayRequest {
+		called = true
+		g.Expect(aws.StringValue(input.NatGatewayId)).To(gomega.Equal(meta.GetExternalName(&mockManaged)), "the passed parameters are not valid")
+		return awsec2.DeleteNatGatewayRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.DeleteNatGatewayOutput{},
+				Error:       mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedCalled bool
+		expectedErrNil bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			false,
+			false,
+		},
+		{
+			"if the NAT gateway is already being deleted, it should not be deleted again",
+			deleting,
+			nil,
+			false,
+			true,
+		},
+		{
+			"if the resource doesn't exist deleting resource should not return an error",
+			mockManaged.DeepCopy(),
+			awserr.New(ec2.NATGatewayNotFound, "", nil),
+			true,
+			true,
+		},
+		{
+			"if deleting resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			true,
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+		called = false
+
+		err := mockExternalClient.Delete(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(called).To(gomega.Equal(tc.expectedCalled), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha3.NATGateway)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonDeleting), tc.description)
+		}
+	}
+}
@@ -0,0 +1,358 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkacl
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	v1alpha3 "github.com/crossplane/provider-aws/apis/network/v1alpha3"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/controller/utils"
+)
+
+const (
+	errUnexpectedObject    = "The managed resource is not a NetworkACL resource"
+	errClient              = "cannot create a new NetworkACLClient"
+	errDescribe            = "failed to describe NetworkACL"
+	errMultipleItems       = "retrieved multiple NetworkACLs for the given networkAclId"
+	errDescribeDefault     = "failed to describe the default NetworkACL of the VPC"
+	errNoDefault           = "cannot find the default NetworkACL of the VPC"
+	errDescribeSubnets     = "failed to describe the current NetworkACL associations of the subnets"
+	errSpecUpdate          = "cannot update NetworkACL custom resource"
+	errCreate              = "failed to create the NetworkACL resource"
+	errPersistExternalName = "failed to persist NetworkACL ID"
+	errCreateEntry         = "failed to create the NetworkACL entry"
+	errReplaceEntry        = "failed to replace the NetworkACL entry"
+	errDeleteEntry         = "failed to delete the NetworkACL entry"
+	errAssociate           = "failed to associate the subnet with the NetworkACL"
+	errRestoreDefault      = "failed to associate the subnet with the default NetworkACL of the VPC"
+	errCreateTags          = "failed to create tags for the NetworkACL resource"
+	errDeleteTags          = "failed to delete tags for the NetworkACL resource"
+	errDelete              = "failed to delete the NetworkACL resource"
+)
+
+// SetupNetworkACL adds a controller that reconciles NetworkACLs.
+func SetupNetworkACL(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha3.NetworkACLGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha3.NetworkACL{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.NetworkACLGroupVersionKind),
+			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: ec2.NewNetworkACLClient, awsConfigFn: utils.RetrieveAwsConfigFromProvider}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	client      client.Client
+	newClientFn func(*aws.Config) (ec2.NetworkACLClient, error)
+	awsConfigFn func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error)
+}
+
+func (conn *connector) Connect(ctx context.Context, mgd resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mgd.(*v1alpha3.NetworkACL)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	awsconfig, err := conn.awsConfigFn(ctx, conn.client, cr.Spec.ProviderReference)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := conn.newClientFn(awsconfig)
+	if err != nil {
+		return nil, errors.Wrap(err, errClient)
+	}
+
+	return &external{kube: conn.client, client: c}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.NetworkACLClient
+}
+
+func (e *external) describe(ctx context.Context, id string) (*awsec2.NetworkAcl, error) {
+	response, err := e.client.DescribeNetworkAclsRequest(&awsec2.DescribeNetworkAclsInput{
+		NetworkAclIds: []string{id},
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.NetworkAcls) != 1 {
+		return nil, errors.New(errMultipleItems)
+	}
+	return &response.NetworkAcls[0], nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha3.NetworkACL)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// AWS network resources are uniquely identified by an ID that is returned
+	// on create time; we can't tell whether they exist unless we have recorded
+	// their ID.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if ec2.IsNetworkACLNotFoundErr(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribe)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeNetworkACL(&cr.Spec.ForProvider, observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = ec2.GenerateNetworkACLObservation(*observed)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ec2.IsNetworkACLUpToDate(cr.Spec.ForProvider, *observed, cr.Status.ManagedTagKeys),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha3.NetworkACL)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	rsp, err := e.client.CreateNetworkAclRequest(&awsec2.CreateNetworkAclInput{
+		VpcId: cr.Spec.ForProvider.VPCID,
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, aws.StringValue(rsp.NetworkAcl.NetworkAclId))
+	if err := e.kube.Update(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errPersistExternalName)
+	}
+
+	cr.Status.AtProvider = ec2.GenerateNetworkACLObservation(*rsp.NetworkAcl)
+
+	// entries and subnet associations are reconciled by Update once the
+	// network ACL is observed.
+	add, _ := ec2.DiffEC2Tags(cr.Spec.ForProvider.Tags, nil)
+	if len(add) == 0 {
+		return managed.ExternalCreation{}, nil
+	}
+	if _, err := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+		Resources: []string{meta.GetExternalName(cr)},
+		Tags:      add,
+	}).Send(ctx); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateTags)
+	}
+	cr.Status.ManagedTagKeys = ec2.TagKeys(cr.Spec.ForProvider.Tags)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha3.NetworkACL)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+
+	if err := e.updateEntries(ctx, cr, observed); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	associate, disassociate := ec2.DiffNetworkACLSubnets(cr.Spec.ForProvider, *observed)
+	if err := e.associateSubnets(ctx, meta.GetExternalName(cr), associate); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if err := e.restoreDefaultAssociations(ctx, aws.StringValue(observed.VpcId), disassociate); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	return managed.ExternalUpdate{}, e.updateTags(ctx, cr, observed)
+}
+
+// updateEntries deletes the entries first so that rule numbers that are
+// being freed up can be reused by the remaining changes.
+func (e *external) updateEntries(ctx context.Context, cr *v1alpha3.NetworkACL, observed *awsec2.NetworkAcl) error {
+	create, replace, remove := ec2.GenerateNetworkACLEntryInputs(meta.GetExternalName(cr), cr.Spec.ForProvider.Entries, observed.Entries)
+	for _, in := range remove {
+		if _, err := e.client.DeleteNetworkAclEntryRequest(in).Send(ctx); err != nil {
+			return errors.Wrap(err, errDeleteEntry)
+		}
+	}
+	for _, in := range replace {
+		if _, err := e.client.ReplaceNetworkAclEntryRequest(in).Send(ctx); err != nil {
+			return errors.Wrap(err, errReplaceEntry)
+		}
+	}
+	for _, in := range create {
+		if _, err := e.client.CreateNetworkAclEntryRequest(in).Send(ctx); err != nil {
+			return errors.Wrap(err, errCreateEntry)
+		}
+	}
+	return nil
+}
+
+// associateSubnets moves the given subnets from the network ACL they are
+// currently associated with to the network ACL with the given ID. Every
+// subnet is always associated with exactly one network ACL.
+func (e *external) associateSubnets(ctx context.Context, id string, subnetIDs []string) error {
+	if len(subnetIDs) == 0 {
+		return nil
+	}
+	rsp, err := e.client.DescribeNetworkAclsRequest(&awsec2.DescribeNetworkAclsInput{
+		Filters: []awsec2.Filter{{Name: aws.String("association.subnet-id"), Values: subnetIDs}},
+	}).Send(ctx)
+	if err != nil {
+		return errors.Wrap(err, errDescribeSubnets)
+	}
+	desired := make(map[string]struct{}, len(subnetIDs))
+	for _, s := range subnetIDs {
+		desired[s] = struct{}{}
+	}
+	for _, acl := range rsp.NetworkAcls {
+		for _, a := range acl.Associations {
+			if _, ok := desired[aws.StringValue(a.SubnetId)]; !ok {
+				continue
+			}
+			if _, err := e.client.ReplaceNetworkAclAssociationRequest(&awsec2.ReplaceNetworkAclAssociationInput{
+				AssociationId: a.NetworkAclAssociationId,
+				NetworkAclId:  aws.String(id),
+			}).Send(ctx); err != nil {
+				return errors.Wrap(err, errAssociate)
+			}
+		}
+	}
+	return nil
+}
+
+// restoreDefaultAssociations moves the given associations back to the
+// default network ACL of the VPC.
+func (e *external) restoreDefaultAssociations(ctx context.Context, vpcID string, associationIDs []string) error {
+	if len(associationIDs) == 0 {
+		return nil
+	}
+	rsp, err := e.client.DescribeNetworkAclsRequest(&awsec2.DescribeNetworkAclsInput{
+		Filters: []awsec2.Filter{
+			{Name: aws.String("vpc-id"), Values: []string{vpcID}},
+			{Name: aws.String("default"), Values: []string{"true"}},
+		},
+	}).Send(ctx)
+	if err != nil {
+		return errors.Wrap(err, errDescribeDefault)
+	}
+	if len(rsp.NetworkAcls) != 1 {
+		return errors.New(errNoDefault)
+	}
+	for _, id := range associationIDs {
+		if _, err := e.client.ReplaceNetworkAclAssociationRequest(&awsec2.ReplaceNetworkAclAssociationInput{
+			AssociationId: aws.String(id),
+			NetworkAclId:  rsp.NetworkAcls[0].NetworkAclId,
+		}).Send(ctx); err != nil {
+			return errors.Wrap(err, errRestoreDefault)
+		}
+	}
+	return nil
+}
+
+func (e *external) updateTags(ctx context.Context, cr *v1alpha3.NetworkACL, observed *awsec2.NetworkAcl) error {
+	add, remove := ec2.DiffManagedEC2Tags(cr.Spec.ForProvider.Tags, observed.Tags, cr.Status.ManagedTagKeys)
+	if len(remove) > 0 {
+		if _, err := e.client.DeleteTagsRequest(&awsec2.DeleteTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      remove,
+		}).Send(ctx); err != nil {
+			return errors.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      add,
+		}).Send(ctx); err != nil {
+			return errors.Wrap(err, errCreateTags)
+		}
+	}
+	cr.Status.ManagedTagKeys = ec2.TagKeys(cr.Spec.ForProvider.Tags)
+	return nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha3.NetworkACL)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+
+	// a network ACL cannot be deleted while it is associated with subnets.
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return errors.Wrap(resource.Ignore(ec2.IsNetworkACLNotFoundErr, err), errDescribe)
+	}
+	associationIDs := make([]string, len(observed.Associations))
+	for i, a := range observed.Associations {
+		associationIDs[i] = aws.StringValue(a.NetworkAclAssociationId)
+	}
+	if err := e.restoreDefaultAssociations(ctx, aws.StringValue(observed.VpcId), associationIDs); err != nil {
+		return err
+	}
+
+	_, err = e.client.DeleteNetworkAclRequest(&awsec2.DeleteNetworkAclInput{
+		NetworkAclId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(ec2.IsNetworkACLNotFoundErr, err), errDelete)
+}
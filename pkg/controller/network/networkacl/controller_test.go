@@ -0,0 +1,636 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkacl
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	v1alpha3 "github.com/crossplane/provider-aws/apis/network/v1alpha3"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	mockExternalClient external
+	mockClient         fake.MockNetworkACLClient
+
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+)
+
+func TestMain(m *testing.M) {
+
+	mockClient = fake.MockNetworkACLClient{}
+	mockExternalClient = external{
+		client: &mockClient,
+		kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+	}
+
+	os.Exit(m.Run())
+}
+
+func Test_Connect(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := &v1alpha3.NetworkACL{}
+	var clientErr error
+	var configErr error
+
+	conn := connector{
+		client: nil,
+		newClientFn: func(conf *aws.Config) (ec2.NetworkACLClient, error) {
+			return &mockClient, clientErr
+		},
+		awsConfigFn: func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error) {
+			return &aws.Config{}, configErr
+		},
+	}
+
+	for _, tc := range []struct {
+		description       string
+		managedObj        resource.Managed
+		configErr         error
+		clientErr         error
+		expectedClientNil bool
+		expectedErrNil    bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws config provider fails, should return error",
+			mockManaged,
+			errors.New("some error"),
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws client provider fails, should return error",
+			mockManaged,
+			nil,
+			errors.New("some error"),
+			true,
+			false,
+		},
+	} {
+		clientErr = tc.clientErr
+		configErr = tc.configErr
+
+		res, err := conn.Connect(context.Background(), tc.managedObj)
+		g.Expect(res == nil).To(gomega.Equal(tc.expectedClientNil), tc.description)
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+	}
+}
+
+func Test_Observe(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.NetworkACL{
+		Spec: v1alpha3.NetworkACLSpec{
+			ForProvider: v1alpha3.NetworkACLParameters{
+				Entries: []v1alpha3.NetworkACLEntry{
+					{RuleNumber: 100, Protocol: "tcp", RuleAction: "allow", CIDRBlock: aws.String("0.0.0.0/0"), PortRange: &v1alpha3.NetworkACLPortRange{From: 443, To: 443}},
+				},
+				SubnetIDs: []string{"subnet-1"},
+			},
+		},
+	}
+	meta.SetExternalName(&mockManaged, "some arbitrary id")
+
+	upToDate := awsec2.NetworkAcl{
+		NetworkAclId: aws.String("some arbitrary id"),
+		VpcId:        aws.String("vpc-1"),
+		Entries: []awsec2.NetworkAclEntry{
+			{RuleNumber: aws.Int64(100), Egress: aws.Bool(false), Protocol: aws.String("6"), RuleAction: awsec2.RuleActionAllow, CidrBlock: aws.String("0.0.0.0/0"), PortRange: &awsec2.PortRange{From: aws.Int64(443), To: aws.Int64(443)}},
+			{RuleNumber: aws.Int64(32767), Egress: aws.Bool(false), Protocol: aws.String("-1"), RuleAction: awsec2.RuleActionDeny, CidrBlock: aws.String("0.0.0.0/0")},
+		},
+		Associations: []awsec2.NetworkAclAssociation{
+			{NetworkAclAssociationId: aws.String("aclassoc-1"), SubnetId: aws.String("subnet-1")},
+		},
+	}
+	outdated := upToDate
+	outdated.Associations = nil
+
+	var mockClientErr error
+	var itemsList []awsec2.NetworkAcl
+	mockClient.MockDescribeNetworkAclsRequest = func(input *awsec2.DescribeNetworkAclsInput) awsec2.DescribeNetworkAclsRequest {
+		return awsec2.DescribeNetworkAclsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.DescribeNetworkAclsOutput{NetworkAcls: itemsList},
+				Error:       mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description           string
+		managedObj            resource.Managed
+		itemsReturned         []awsec2.NetworkAcl
+		clientErr             error
+		expectedErrNil        bool
+		expectedResourceExist bool
+		expectedUpToDate      bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			[]awsec2.NetworkAcl{upToDate},
+			nil,
+			true,
+			true,
+			true,
+		},
+		{
+			"missing subnet association should not be up to date",
+			mockManaged.DeepCopy(),
+			[]awsec2.NetworkAcl{outdated},
+			nil,
+			true,
+			true,
+			false,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			nil,
+			false,
+			false,
+			false,
+		},
+		{
+			"if item's identifier is not yet set, returns expected",
+			&v1alpha3.NetworkACL{},
+			nil,
+			nil,
+			true,
+			false,
+			false,
+		},
+		{
+			"if external resource doesn't exist, it should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			awserr.New(ec2.NetworkACLIDNotFound, "", nil),
+			true,
+			false,
+			false,
+		},
+		{
+			"if external resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			nil,
+			errors.New("some error"),
+			false,
+			false,
+			false,
+		},
+		{
+			"if external resource returns a list with other than one item, it should return error",
+			mockManaged.DeepCopy(),
+			[]awsec2.NetworkAcl{},
+			nil,
+			false,
+			false,
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+		itemsList = tc.itemsReturned
+
+		result, err := mockExternalClient.Observe(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(result.ResourceExists).To(gomega.Equal(tc.expectedResourceExist), tc.description)
+		g.Expect(result.ResourceUpToDate).To(gomega.Equal(tc.expectedUpToDate), tc.description)
+		if tc.expectedResourceExist {
+			mgd := tc.managedObj.(*v1alpha3.NetworkACL)
+			g.Expect(mgd.Status.Conditions[0].Type).To(gomega.Equal(corev1alpha1.TypeReady), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Status).To(gomega.Equal(corev1.ConditionTrue), tc.description)
+			g.Expect(aws.StringValue(mgd.Spec.ForProvider.VPCID)).To(gomega.Equal("vpc-1"), tc.description)
+		}
+	}
+}
+
+func Test_Create(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.NetworkACL{
+		Spec: v1alpha3.NetworkACLSpec{
+			ForProvider: v1alpha3.NetworkACLParameters{
+				VPCID: aws.String("vpc-1"),
+				Tags:  []v1alpha3.Tag{{Key: "k", Value: "v"}},
+			},
+		},
+	}
+	mockExternal := &awsec2.NetworkAcl{
+		NetworkAclId: aws.String("some arbitrary id"),
+	}
+	var mockClientErr error
+	mockClient.MockCreateNetworkAclRequest = func(input *awsec2.CreateNetworkAclInput) awsec2.CreateNetworkAclRequest {
+		g.Expect(aws.StringValue(input.VpcId)).To(gomega.Equal("vpc-1"), "the passed parameters are not valid")
+		return awsec2.CreateNetworkAclRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.CreateNetworkAclOutput{NetworkAcl: mockExternal},
+				Error:       mockClientErr,
+			},
+		}
+	}
+	var tagged bool
+	mockClient.MockCreateTagsRequest = func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+		tagged = true
+		return awsec2.CreateTagsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.CreateTagsOutput{}},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			false,
+		},
+		{
+			"if creating resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+		tagged = false
+
+		_, err := mockExternalClient.Create(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha3.NetworkACL)
+			g.Expect(meta.GetExternalName(mgd)).To(gomega.Equal(aws.StringValue(mockExternal.NetworkAclId)), tc.description)
+			g.Expect(tagged).To(gomega.BeTrue(), tc.description)
+		}
+	}
+}
+
+func Test_Update(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.NetworkACL{
+		Spec: v1alpha3.NetworkACLSpec{
+			ForProvider: v1alpha3.NetworkACLParameters{
+				Entries: []v1alpha3.NetworkACLEntry{
+					{RuleNumber: 100, Protocol: "tcp", RuleAction: "allow", CIDRBlock: aws.String("0.0.0.0/0"), PortRange: &v1alpha3.NetworkACLPortRange{From: 443, To: 443}},
+					{RuleNumber: 200, Protocol: "-1", RuleAction: "deny", CIDRBlock: aws.String("10.0.0.0/8")},
+				},
+				SubnetIDs: []string{"subnet-new"},
+			},
+		},
+	}
+	meta.SetExternalName(&mockManaged, "acl-1")
+
+	observed := awsec2.NetworkAcl{
+		NetworkAclId: aws.String("acl-1"),
+		VpcId:        aws.String("vpc-1"),
+		Entries: []awsec2.NetworkAclEntry{
+			{RuleNumber: aws.Int64(100), Egress: aws.Bool(false), Protocol: aws.String("6"), RuleAction: awsec2.RuleActionAllow, CidrBlock: aws.String("0.0.0.0/0"), PortRange: &awsec2.PortRange{From: aws.Int64(80), To: aws.Int64(80)}},
+			{RuleNumber: aws.Int64(300), Egress: aws.Bool(true), Protocol: aws.String("-1"), RuleAction: awsec2.RuleActionAllow, CidrBlock: aws.String("0.0.0.0/0")},
+			{RuleNumber: aws.Int64(32767), Egress: aws.Bool(true), Protocol: aws.String("-1"), RuleAction: awsec2.RuleActionDeny, CidrBlock: aws.String("0.0.0.0/0")},
+		},
+		Associations: []awsec2.NetworkAclAssociation{
+			{NetworkAclAssociationId: aws.String("aclassoc-old"), SubnetId: aws.String("subnet-old")},
+		},
+	}
+	mockClient.MockDescribeNetworkAclsRequest = func(input *awsec2.DescribeNetworkAclsInput) awsec2.DescribeNetworkAclsRequest {
+		var acls []awsec2.NetworkAcl
+		switch {
+		case len(input.NetworkAclIds) > 0:
+			acls = []awsec2.NetworkAcl{observed}
+		case aws.StringValue(input.Filters[0].Name) == "association.subnet-id":
+			acls = []awsec2.NetworkAcl{{
+				NetworkAclId: aws.String("acl-other"),
+				Associations: []awsec2.NetworkAclAssociation{
+					{NetworkAclAssociationId: aws.String("aclassoc-new"), SubnetId: aws.String("subnet-new")},
+					{NetworkAclAssociationId: aws.String("aclassoc-unrelated"), SubnetId: aws.String("subnet-unrelated")},
+				},
+			}}
+		default:
+			acls = []awsec2.NetworkAcl{{NetworkAclId: aws.String("acl-default")}}
+		}
+		return awsec2.DescribeNetworkAclsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.DescribeNetworkAclsOutput{NetworkAcls: acls}},
+		}
+	}
+	var deleted, replaced, created []int64
+	var mockClientErr error
+	mockClient.MockDeleteNetworkAclEntryRequest = func(input *awsec2.DeleteNetworkAclEntryInput) awsec2.DeleteNetworkAclEntryRequest {
+		deleted = append(deleted, aws.Int64Value(input.RuleNumber))
+		return awsec2.DeleteNetworkAclEntryRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.DeleteNetworkAclEntryOutput{}},
+		}
+	}
+	mockClient.MockReplaceNetworkAclEntryRequest = func(input *awsec2.ReplaceNetworkAclEntryInput) awsec2.ReplaceNetworkAclEntryRequest {
+		replaced = append(replaced, aws.Int64Value(input.RuleNumber))
+		return awsec2.ReplaceNetworkAclEntryRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.ReplaceNetworkAclEntryOutput{}},
+		}
+	}
+	mockClient.MockCreateNetworkAclEntryRequest = func(input *awsec2.CreateNetworkAclEntryInput) awsec2.CreateNetworkAclEntryRequest {
+		created = append(created, aws.Int64Value(input.RuleNumber))
+		return awsec2.CreateNetworkAclEntryRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.CreateNetworkAclEntryOutput{}, Error: mockClientErr},
+		}
+	}
+	associations := map[string]string{}
+	mockClient.MockReplaceNetworkAclAssociationRequest = func(input *awsec2.ReplaceNetworkAclAssociationInput) awsec2.ReplaceNetworkAclAssociationRequest {
+		associations[aws.StringValue(input.AssociationId)] = aws.StringValue(input.NetworkAclId)
+		return awsec2.ReplaceNetworkAclAssociationRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.ReplaceNetworkAclAssociationOutput{}},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should reconcile entries and associations",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			false,
+		},
+		{
+			"if creating an entry fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+		deleted, replaced, created = nil, nil, nil
+		associations = map[string]string{}
+
+		_, err := mockExternalClient.Update(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			g.Expect(deleted).To(gomega.Equal([]int64{300}), tc.description)
+			g.Expect(replaced).To(gomega.Equal([]int64{100}), tc.description)
+			g.Expect(created).To(gomega.Equal([]int64{200}), tc.description)
+			g.Expect(associations).To(gomega.Equal(map[string]string{
+				"aclassoc-new": "acl-1",
+				"aclassoc-old": "acl-default",
+			}), tc.description)
+		}
+	}
+}
+
+func Test_UpdateTags(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.NetworkACL{
+		Spec: v1alpha3.NetworkACLSpec{
+			ForProvider: v1alpha3.NetworkACLParameters{
+				Tags: []v1alpha3.Tag{{Key: "k", Value: "v"}},
+			},
+		},
+		Status: v1alpha3.NetworkACLStatus{
+			ManagedTagKeys: []string{"old"},
+		},
+	}
+	meta.SetExternalName(&mockManaged, "some arbitrary id")
+
+	mockClient.MockDescribeNetworkAclsRequest = func(input *awsec2.DescribeNetworkAclsInput) awsec2.DescribeNetworkAclsRequest {
+		return awsec2.DescribeNetworkAclsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsec2.DescribeNetworkAclsOutput{
+					NetworkAcls: []awsec2.NetworkAcl{{
+						Tags: []awsec2.Tag{
+							{Key: aws.String("old"), Value: aws.String("v")},
+							{Key: aws.String("foreign"), Value: aws.String("v")},
+						},
+					}},
+				},
+			},
+		}
+	}
+
+	var createdTags, deletedTags []awsec2.Tag
+	var mockClientTagsErr error
+	mockClient.MockCreateTagsRequest = func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+		createdTags = input.Tags
+		return awsec2.CreateTagsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.CreateTagsOutput{},
+				Error:       mockClientTagsErr,
+			},
+		}
+	}
+	mockClient.MockDeleteTagsRequest = func(input *awsec2.DeleteTagsInput) awsec2.DeleteTagsRequest {
+		deletedTags = input.Tags
+		return awsec2.DeleteTagsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.DeleteTagsOutput{},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientTagsErr  error
+		expectedErrNil bool
+	}{
+		{
+			"only the tags that were set from the spec should be removed",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"if tagging resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientTagsErr = tc.clientTagsErr
+		createdTags, deletedTags = nil, nil
+
+		_, err := mockExternalClient.Update(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			g.Expect(createdTags).To(gomega.Equal([]awsec2.Tag{{Key: aws.String("k"), Value: aws.String("v")}}), tc.description)
+			g.Expect(deletedTags).To(gomega.Equal([]awsec2.Tag{{Key: aws.String("old")}}), tc.description)
+			g.Expect(tc.managedObj.(*v1alpha3.NetworkACL).Status.ManagedTagKeys).To(gomega.Equal([]string{"k"}), tc.description)
+		}
+	}
+}
+
+func Test_Delete(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.NetworkACL{}
+	meta.SetExternalName(&mockManaged, "acl-1")
+
+	var describeErr error
+	mockClient.MockDescribeNetworkAclsRequest = func(input *awsec2.DescribeNetworkAclsInput) awsec2.DescribeNetworkAclsRequest {
+		acl := awsec2.NetworkAcl{NetworkAclId: aws.String("acl-default")}
+		if len(input.NetworkAclIds) > 0 {
+			acl = awsec2.NetworkAcl{
+				NetworkAclId: aws.String("acl-1"),
+				VpcId:        aws.String("vpc-1"),
+				Associations: []awsec2.NetworkAclAssociation{
+					{NetworkAclAssociationId: aws.String("aclassoc-1"), SubnetId: aws.String("subnet-1")},
+				},
+			}
+		}
+		return awsec2.DescribeNetworkAclsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.DescribeNetworkAclsOutput{NetworkAcls: []awsec2.NetworkAcl{acl}},
+				Error:       describeErr,
+			},
+		}
+	}
+	var restored bool
+	mockClient.MockReplaceNetworkAclAssociationRequest = func(input *awsec2.ReplaceNetworkAclAssociationInput) awsec2.ReplaceNetworkAclAssociationRequest {
+		restored = aws.StringValue(input.AssociationId) == "aclassoc-1" && aws.StringValue(input.NetworkAclId) == "acl-default"
+		return awsec2.ReplaceNetworkAclAssociationRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.ReplaceNetworkAclAssociationOutput{}},
+		}
+	}
+	var mockClientErr error
+	mockClient.MockDeleteNetworkAclRequest = func(input *awsec2.DeleteNetworkAclInput) awsec2.DeleteNetworkAclRequest {
+		return awsec2.DeleteNetworkAclRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.DeleteNetworkAclOutput{},
+				Error:       mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description     string
+		managedObj      resource.Managed
+		describeErr     error
+		clientErr       error
+		expectedErrNil  bool
+		expectedRestore bool
+	}{
+		{
+			"valid input should restore the default association and delete",
+			mockManaged.DeepCopy(),
+			nil,
+			nil,
+			true,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"if the resource doesn't exist, deleting resource should not return an error",
+			mockManaged.DeepCopy(),
+			awserr.New(ec2.NetworkACLIDNotFound, "", nil),
+			nil,
+			true,
+			false,
+		},
+		{
+			"if deleting resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			nil,
+			errors.New("some error"),
+			false,
+			true,
+		},
+	} {
+		describeErr = tc.describeErr
+		mockClientErr = tc.clientErr
+		restored = false
+
+		err := mockExternalClient.Delete(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(restored).To(gomega.Equal(tc.expectedRestore), tc.description)
+		if tc.expectedErrNil && tc.describeErr == nil {
+			mgd := tc.managedObj.(*v1alpha3.NetworkACL)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonDeleting), tc.description)
+		}
+	}
+}
@@ -42,10 +42,13 @@ const (
 	errUnexpectedObject    = "The managed resource is not an RouteTable resource"
 	errClient              = "cannot create a new RouteTable client"
 	errDescribe            = "failed to describe RouteTable"
+	errLookup              = "failed to look up an existing RouteTable"
+	errLookupMatches       = "%d RouteTables match the lookup parameters, exactly one must match"
 	errMultipleItems       = "retrieved multiple RouteTables for the given routeTableId"
 	errCreate              = "failed to create the RouteTable resource"
 	errDelete              = "failed to delete the RouteTable resource"
 	errCreateRoute         = "failed to create a route in the RouteTable resource"
+	errReplaceRoute        = "failed to replace a route in the RouteTable resource"
 	errPersistExternalName = "failed to persist RouteTable"
 	errDeleteRoute         = "failed to delete a route in the RouteTable resource"
 	errAssociateSubnet     = "failed to associate subnet with the RouteTable resource"
@@ -107,9 +110,14 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 
 	// AWS network resources are uniquely identified by an ID that is returned
 	// on create time; we can't tell whether they exist unless we have recorded
-	// their ID.
+	// their ID or are asked to look them up.
 	if meta.GetExternalName(cr) == "" {
-		return managed.ExternalObservation{ResourceExists: false}, nil
+		if cr.Spec.Lookup == nil {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		if err := e.lookup(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, err
+		}
 	}
 
 	req := e.client.DescribeRouteTablesRequest(&awsec2.DescribeRouteTablesInput{
@@ -147,9 +155,31 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	return managed.ExternalObservation{
 		ResourceExists:    true,
 		ConnectionDetails: managed.ConnectionDetails{},
+		ResourceUpToDate:  ec2.IsRtUpToDate(cr.Spec.RouteTableParameters, cr.Status.RouteTableExternalStatus),
 	}, nil
 }
 
+// lookup finds the existing RouteTable that matches the lookup parameters
+// and records its ID as the external name.
+func (e *external) lookup(ctx context.Context, cr *v1alpha3.RouteTable) error {
+	filters, err := ec2.GenerateLookupFilters(*cr.Spec.Lookup, cr.Spec.VPCID)
+	if err != nil {
+		return errors.Wrap(err, errLookup)
+	}
+	rsp, err := e.client.DescribeRouteTablesRequest(&awsec2.DescribeRouteTablesInput{
+		Filters: filters,
+	}).Send(ctx)
+	if err != nil {
+		return errors.Wrap(err, errLookup)
+	}
+	if len(rsp.RouteTables) != 1 {
+		return errors.Errorf(errLookupMatches, len(rsp.RouteTables))
+	}
+
+	meta.SetExternalName(cr, aws.StringValue(rsp.RouteTables[0].RouteTableId))
+	return errors.Wrap(e.kube.Update(ctx, cr), errPersistExternalName)
+}
+
 func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
 	cr, ok := mgd.(*v1alpha3.RouteTable)
 	if !ok {
@@ -184,10 +214,33 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 }
 
 func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
-	// TODO(soorena776): add more sophisticated Update logic, once we
-	// categorize immutable vs mutable fields (see #727)
+	cr, ok := mgd.(*v1alpha3.RouteTable)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	// the status has just been refreshed by Observe, so it reflects the
+	// current routes and associations of the table. Routes are managed only if
+	// the spec declares them, so that the routes of an adopted table are kept
+	// otherwise.
+	if len(cr.Spec.Routes) != 0 {
+		create, replace, remove := ec2.DiffRoutes(cr.Spec.Routes, cr.Status.Routes)
+		if err := e.deleteRoutes(ctx, meta.GetExternalName(cr), remove); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		if err := e.replaceRoutes(ctx, meta.GetExternalName(cr), replace); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		if err := e.createRoutes(ctx, meta.GetExternalName(cr), create, cr.Status.Routes); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
 
-	return managed.ExternalUpdate{}, nil
+	associate, disassociate := ec2.DiffAssociations(cr.Spec.Associations, cr.Status.Associations)
+	if err := e.deleteAssociations(ctx, disassociate); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	return managed.ExternalUpdate{}, e.createAssociations(ctx, meta.GetExternalName(cr), associate, cr.Status.Associations)
 }
 
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
@@ -221,18 +274,14 @@ func (e *external) createRoutes(ctx context.Context, tableID string, desired []v
 	for _, rt := range desired {
 		isObserved := false
 		for _, ob := range observed {
-			if ob.GatewayID == rt.GatewayID && ob.DestinationCIDRBlock == rt.DestinationCIDRBlock {
+			if ec2.RouteDestination(ob.Route) == ec2.RouteDestination(rt) {
 				isObserved = true
 				break
 			}
 		}
 		// if the route is already created (e.g. is observed), skip it
 		if !isObserved {
-			req := e.client.CreateRouteRequest(&awsec2.CreateRouteInput{
-				RouteTableId:         aws.String(tableID),
-				DestinationCidrBlock: aws.String(rt.DestinationCIDRBlock),
-				GatewayId:            aws.String(rt.GatewayID),
-			})
+			req := e.client.CreateRouteRequest(ec2.GenerateCreateRouteInput(tableID, rt))
 
 			if _, err := req.Send(ctx); err != nil {
 				return errors.Wrap(err, errCreateRoute)
@@ -243,6 +292,18 @@ func (e *external) createRoutes(ctx context.Context, tableID string, desired []v
 	return nil
 }
 
+func (e *external) replaceRoutes(ctx context.Context, tableID string, routes []v1alpha3.Route) error {
+	for _, rt := range routes {
+		req := e.client.ReplaceRouteRequest(ec2.GenerateReplaceRouteInput(tableID, rt))
+
+		if _, err := req.Send(ctx); err != nil {
+			return errors.Wrap(err, errReplaceRoute)
+		}
+	}
+
+	return nil
+}
+
 func (e *external) deleteRoutes(ctx context.Context, tableID string, observed []v1alpha3.RouteState) error {
 	for _, rt := range observed {
 		// "local" routes cannot be deleted
@@ -250,10 +311,12 @@ func (e *external) deleteRoutes(ctx context.Context, tableID string, observed []
 		if rt.GatewayID == ec2.LocalGatewayID {
 			continue
 		}
-		req := e.client.DeleteRouteRequest(&awsec2.DeleteRouteInput{
-			RouteTableId:         aws.String(tableID),
-			DestinationCidrBlock: aws.String(rt.DestinationCIDRBlock),
-		})
+		// routes to gateway VPC endpoints are removed by EC2 when the
+		// endpoint is detached from the route table.
+		if rt.DestinationPrefixListID != "" {
+			continue
+		}
+		req := e.client.DeleteRouteRequest(ec2.GenerateDeleteRouteInput(tableID, rt.Route))
 
 		if _, err := req.Send(ctx); err != nil {
 			if ec2.IsRouteNotFoundErr(err) {
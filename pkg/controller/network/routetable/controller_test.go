@@ -455,11 +455,216 @@ func Test_Create(t *testing.T) {
 func Test_Update(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 
-	mockManaged := v1alpha3.RouteTable{}
+	mockManaged := v1alpha3.RouteTable{
+		Spec: v1alpha3.RouteTableSpec{
+			RouteTableParameters: v1alpha3.RouteTableParameters{
+				VPCID: "arbitrary vpcId",
+				Routes: []v1alpha3.Route{
+					{
+						DestinationCIDRBlock: "arbitrary dcb 0",
+						GatewayID:            "arbitrary gi 0",
+					},
+					{
+						DestinationCIDRBlock: "arbitrary dcb 1",
+						GatewayID:            "arbitrary gi 1",
+					},
+				},
+				Associations: []v1alpha3.Association{
+					{
+						SubnetID: "arbitrary subnet 0",
+					},
+				},
+			},
+		},
+		Status: v1alpha3.RouteTableStatus{
+			RouteTableExternalStatus: v1alpha3.RouteTableExternalStatus{
+				Routes: []v1alpha3.RouteState{
+					{
+						Route: v1alpha3.Route{
+							DestinationCIDRBlock: "arbitrary local dcb",
+							GatewayID:            ec2.LocalGatewayID,
+						},
+					},
+					{
+						Route: v1alpha3.Route{
+							DestinationCIDRBlock: "arbitrary dcb 1",
+							GatewayID:            "arbitrary old gi",
+						},
+					},
+					{
+						Route: v1alpha3.Route{
+							DestinationCIDRBlock: "arbitrary dcb 2",
+							GatewayID:            "arbitrary gi 2",
+						},
+					},
+				},
+				Associations: []v1alpha3.AssociationState{
+					{
+						Main:          true,
+						AssociationID: "arbitrary main association",
+					},
+					{
+						AssociationID: "arbitrary association 1",
+						Association: v1alpha3.Association{
+							SubnetID: "arbitrary subnet 1",
+						},
+					},
+				},
+			},
+		},
+	}
+	meta.SetExternalName(&mockManaged, "some arbitrary id")
 
-	_, err := mockExternalClient.Update(context.Background(), &mockManaged)
+	var createdRoutes, replacedRoutes, deletedRoutes []string
+	var mockClientCreateRouteErr error
+	mockClient.MockCreateRouteRequest = func(input *awsec2.CreateRouteInput) awsec2.CreateRouteRequest {
+		createdRoutes = append(createdRoutes, aws.StringValue(input.DestinationCidrBlock))
+		return awsec2.CreateRouteRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.CreateRouteOutput{},
+				Error:       mockClientCreateRouteErr,
+			},
+		}
+	}
+	var mockClientReplaceRouteErr error
+	mockClient.MockReplaceRouteRequest = func(input *awsec2.ReplaceRouteInput) awsec2.ReplaceRouteRequest {
+		replacedRoutes = append(replacedRoutes, aws.StringValue(input.DestinationCidrBlock))
+		g.Expect(aws.StringValue(input.GatewayId)).To(gomega.Equal("arbitrary gi 1"), "the passed parameters are not valid")
+		return awsec2.ReplaceRouteRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.ReplaceRouteOutput{},
+				Error:       mockClientReplaceRouteErr,
+			},
+		}
+	}
+	mockClient.MockDeleteRouteRequest = func(input *awsec2.DeleteRouteInput) awsec2.DeleteRouteRequest {
+		deletedRoutes = append(deletedRoutes, aws.StringValue(input.DestinationCidrBlock))
+		return awsec2.DeleteRouteRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.DeleteRouteOutput{},
+			},
+		}
+	}
 
-	g.Expect(err).To(gomega.BeNil())
+	var associated, disassociated []string
+	var mockClientAssociateErr error
+	mockClient.MockAssociateRouteTableRequest = func(input *awsec2.AssociateRouteTableInput) awsec2.AssociateRouteTableRequest {
+		associated = append(associated, aws.StringValue(input.SubnetId))
+		return awsec2.AssociateRouteTableRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.AssociateRouteTableOutput{},
+				Error:       mockClientAssociateErr,
+			},
+		}
+	}
+	mockClient.MockDisassociateRouteTableRequest = func(input *awsec2.DisassociateRouteTableInput) awsec2.DisassociateRouteTableRequest {
+		disassociated = append(disassociated, aws.StringValue(input.AssociationId))
+		return awsec2.DisassociateRouteTableRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.DisassociateRouteTableOutput{},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description           string
+		managedObj            resource.Managed
+		clientCreateRouteErr  error
+		clientReplaceRouteErr error
+		clientAssociateErr    error
+		expectedErrNil        bool
+		expectedCreated       []string
+		expectedReplaced      []string
+		expectedDeleted       []string
+		expectedAssociated    []string
+		expectedDisassociated []string
+	}{
+		{
+			"valid input should create, replace and delete the differing routes and associations",
+			mockManaged.DeepCopy(),
+			nil,
+			nil,
+			nil,
+			true,
+			[]string{"arbitrary dcb 0"},
+			[]string{"arbitrary dcb 1"},
+			[]string{"arbitrary dcb 2"},
+			[]string{"arbitrary subnet 0"},
+			[]string{"arbitrary association 1"},
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			nil,
+			nil,
+			false,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"if replacing a route fails, it should return error",
+			mockManaged.DeepCopy(),
+			nil,
+			errors.New("some error"),
+			nil,
+			false,
+			nil,
+			[]string{"arbitrary dcb 1"},
+			[]string{"arbitrary dcb 2"},
+			nil,
+			nil,
+		},
+		{
+			"if creating a route fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			nil,
+			nil,
+			false,
+			[]string{"arbitrary dcb 0"},
+			[]string{"arbitrary dcb 1"},
+			[]string{"arbitrary dcb 2"},
+			nil,
+			nil,
+		},
+		{
+			"if associating a subnet fails, it should return error",
+			mockManaged.DeepCopy(),
+			nil,
+			nil,
+			errors.New("some error"),
+			false,
+			[]string{"arbitrary dcb 0"},
+			[]string{"arbitrary dcb 1"},
+			[]string{"arbitrary dcb 2"},
+			[]string{"arbitrary subnet 0"},
+			[]string{"arbitrary association 1"},
+		},
+	} {
+		createdRoutes, replacedRoutes, deletedRoutes = nil, nil, nil
+		associated, disassociated = nil, nil
+		mockClientCreateRouteErr = tc.clientCreateRouteErr
+		mockClientReplaceRouteErr = tc.clientReplaceRouteErr
+		mockClientAssociateErr = tc.clientAssociateErr
+
+		_, err := mockExternalClient.Update(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(createdRoutes).To(gomega.Equal(tc.expectedCreated), tc.description)
+		g.Expect(replacedRoutes).To(gomega.Equal(tc.expectedReplaced), tc.description)
+		g.Expect(deletedRoutes).To(gomega.Equal(tc.expectedDeleted), tc.description)
+		g.Expect(associated).To(gomega.Equal(tc.expectedAssociated), tc.description)
+		g.Expect(disassociated).To(gomega.Equal(tc.expectedDisassociated), tc.description)
+	}
 }
 
 func Test_Delete(t *testing.T) {
@@ -644,3 +849,126 @@ func Test_Delete(t *testing.T) {
 		g.Expect(disassociateCalled).To(gomega.Equal(tc.expectedDisassociateCall), tc.description)
 	}
 }
+
+func Test_ObserveLookup(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.RouteTable{
+		Spec: v1alpha3.RouteTableSpec{
+			RouteTableParameters: v1alpha3.RouteTableParameters{
+				VPCID:  "vpc-1",
+				Lookup: &v1alpha3.LookupParameters{Tags: []v1alpha3.Tag{{Key: "Name", Value: "public"}}},
+			},
+		},
+	}
+
+	var lookupFilters []awsec2.Filter
+	var itemsList []awsec2.RouteTable
+	mockClient.MockDescribeRouteTablesRequest = func(input *awsec2.DescribeRouteTablesInput) awsec2.DescribeRouteTablesRequest {
+		if len(input.Filters) > 0 {
+			lookupFilters = input.Filters
+		}
+		return awsec2.DescribeRouteTablesRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.DescribeRouteTablesOutput{RouteTables: itemsList},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description          string
+		lookup               *v1alpha3.LookupParameters
+		itemsReturned        []awsec2.RouteTable
+		expectedErrNil       bool
+		expectedExists       bool
+		expectedExternalName string
+	}{
+		{
+			"a single match should be adopted",
+			mockManaged.Spec.Lookup,
+			[]awsec2.RouteTable{{RouteTableId: aws.String("rtb-1"), VpcId: aws.String("vpc-1")}},
+			true,
+			true,
+			"rtb-1",
+		},
+		{
+			"if more than one route table matches, it should return error",
+			mockManaged.Spec.Lookup,
+			[]awsec2.RouteTable{{RouteTableId: aws.String("rtb-1")}, {RouteTableId: aws.String("rtb-2")}},
+			false,
+			false,
+			"",
+		},
+		{
+			"an empty lookup should return error",
+			&v1alpha3.LookupParameters{},
+			[]awsec2.RouteTable{{RouteTableId: aws.String("rtb-main"), VpcId: aws.String("vpc-1")}},
+			false,
+			false,
+			"",
+		},
+	} {
+		itemsList = tc.itemsReturned
+		lookupFilters = nil
+		mgd := mockManaged.DeepCopy()
+		mgd.Spec.Lookup = tc.lookup
+
+		result, err := mockExternalClient.Observe(context.Background(), mgd)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(result.ResourceExists).To(gomega.Equal(tc.expectedExists), tc.description)
+		g.Expect(meta.GetExternalName(mgd)).To(gomega.Equal(tc.expectedExternalName), tc.description)
+		if tc.expectedErrNil {
+			g.Expect(lookupFilters).To(gomega.Equal([]awsec2.Filter{
+				{Name: aws.String("tag:Name"), Values: []string{"public"}},
+				{Name: aws.String("vpc-id"), Values: []string{"vpc-1"}},
+			}), tc.description)
+		}
+	}
+}
+
+func Test_AdoptedWithoutRoutesKeepsRoutes(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mgd := &v1alpha3.RouteTable{
+		Spec: v1alpha3.RouteTableSpec{
+			RouteTableParameters: v1alpha3.RouteTableParameters{
+				VPCID:  "vpc-1",
+				Lookup: &v1alpha3.LookupParameters{Tags: []v1alpha3.Tag{{Key: "Name", Value: "public"}}},
+			},
+		},
+	}
+
+	mockClient.MockDescribeRouteTablesRequest = func(input *awsec2.DescribeRouteTablesInput) awsec2.DescribeRouteTablesRequest {
+		return awsec2.DescribeRouteTablesRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsec2.DescribeRouteTablesOutput{RouteTables: []awsec2.RouteTable{{
+					RouteTableId: aws.String("rtb-1"),
+					VpcId:        aws.String("vpc-1"),
+					Routes: []awsec2.Route{
+						{DestinationCidrBlock: aws.String("10.0.0.0/16"), GatewayId: aws.String("local"), State: awsec2.RouteStateActive},
+						{DestinationCidrBlock: aws.String("0.0.0.0/0"), GatewayId: aws.String("igw-1"), State: awsec2.RouteStateActive},
+					},
+				}}},
+			},
+		}
+	}
+	var deleted bool
+	mockClient.MockDeleteRouteRequest = func(input *awsec2.DeleteRouteInput) awsec2.DeleteRouteRequest {
+		deleted = true
+		return awsec2.DeleteRouteRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.DeleteRouteOutput{}},
+		}
+	}
+
+	obs, err := mockExternalClient.Observe(context.Background(), mgd)
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(meta.GetExternalName(mgd)).To(gomega.Equal("rtb-1"))
+	g.Expect(obs.ResourceUpToDate).To(gomega.BeTrue(), "the routes of the adopted table should not be reported as drift")
+
+	_, err = mockExternalClient.Update(context.Background(), mgd)
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(deleted).To(gomega.BeFalse(), "the routes of the adopted table should be kept")
+}
@@ -23,6 +23,7 @@ import (
 	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -42,18 +43,40 @@ const (
 	errUnexpectedObject    = "The managed resource is not an SecurityGroup resource"
 	errClient              = "cannot create a new SecurityGroupClient"
 	errDescribe            = "failed to describe SecurityGroup"
+	errLookup              = "failed to look up an existing SecurityGroup"
+	errLookupMatches       = "%d SecurityGroups match the lookup parameters, exactly one must match"
+	errListRules           = "cannot list the SecurityGroupRules of the SecurityGroup"
+	errIndexRules          = "cannot index SecurityGroupRules by their SecurityGroup"
 	errMultipleItems       = "retrieved multiple SecurityGroups for the given securityGroupId"
 	errCreate              = "failed to create the SecurityGroup resource"
 	errPersistExternalName = "failed to persist InternetGateway ID"
 	errAuthorizeIngress    = "failed to authorize ingress rules"
 	errAuthorizeEgress     = "failed to authorize egress rules"
+	errRevokeIngress       = "failed to revoke ingress rules"
+	errRevokeEgress        = "failed to revoke egress rules"
 	errDelete              = "failed to delete the SecurityGroup resource"
 )
 
+// ruleSecurityGroupIDField indexes SecurityGroupRules by the ID of the
+// security group they add a rule to.
+const ruleSecurityGroupIDField = "spec.forProvider.securityGroupId"
+
+func indexRuleSecurityGroupID(o runtime.Object) []string {
+	r, ok := o.(*v1alpha3.SecurityGroupRule)
+	if !ok || r.Spec.ForProvider.SecurityGroupID == nil {
+		return nil
+	}
+	return []string{aws.StringValue(r.Spec.ForProvider.SecurityGroupID)}
+}
+
 // SetupSecurityGroup adds a controller that reconciles SecurityGroups.
 func SetupSecurityGroup(mgr ctrl.Manager, l logging.Logger) error {
 	name := managed.ControllerName(v1alpha3.SecurityGroupGroupKind)
 
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &v1alpha3.SecurityGroupRule{}, ruleSecurityGroupIDField, indexRuleSecurityGroupID); err != nil {
+		return errors.Wrap(err, errIndexRules)
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		For(&v1alpha3.SecurityGroup{}).
@@ -103,7 +126,12 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
 	}
 	if meta.GetExternalName(cr) == "" {
-		return managed.ExternalObservation{}, nil
+		if cr.Spec.Lookup == nil {
+			return managed.ExternalObservation{}, nil
+		}
+		if err := e.lookup(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, err
+		}
 	}
 
 	response, err := e.sg.DescribeSecurityGroupsRequest(&awsec2.DescribeSecurityGroupsInput{
@@ -126,11 +154,38 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 
 	cr.UpdateExternalStatus(observed)
 
+	p, err := e.desiredParameters(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
 	return managed.ExternalObservation{
-		ResourceExists: true,
+		ResourceExists:   true,
+		ResourceUpToDate: ec2.IsSGUpToDate(p, observed),
 	}, nil
 }
 
+// lookup finds the existing SecurityGroup that matches the lookup parameters
+// and records its ID as the external name.
+func (e *external) lookup(ctx context.Context, cr *v1alpha3.SecurityGroup) error {
+	filters, err := ec2.GenerateLookupFilters(*cr.Spec.Lookup, aws.StringValue(cr.Spec.VPCID))
+	if err != nil {
+		return errors.Wrap(err, errLookup)
+	}
+	rsp, err := e.sg.DescribeSecurityGroupsRequest(&awsec2.DescribeSecurityGroupsInput{
+		Filters: filters,
+	}).Send(ctx)
+	if err != nil {
+		return errors.Wrap(err, errLookup)
+	}
+	if len(rsp.SecurityGroups) != 1 {
+		return errors.Errorf(errLookupMatches, len(rsp.SecurityGroups))
+	}
+
+	meta.SetExternalName(cr, aws.StringValue(rsp.SecurityGroups[0].GroupId))
+	return errors.Wrap(e.kube.Update(ctx, cr), errPersistExternalName)
+}
+
 func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
 	cr, ok := mgd.(*v1alpha3.SecurityGroup)
 	if !ok {
@@ -167,28 +222,110 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
 	}
-	// TODO(soorena776): add more sophisticated Update logic, once we
-	// categorize immutable vs mutable fields (see #727)
 
-	if len(cr.Spec.Ingress) > 0 {
+	response, err := e.sg.DescribeSecurityGroupsRequest(&awsec2.DescribeSecurityGroupsInput{
+		GroupIds: []string{meta.GetExternalName(cr)},
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+	if len(response.SecurityGroups) != 1 {
+		return managed.ExternalUpdate{}, errors.New(errMultipleItems)
+	}
+	observed := response.SecurityGroups[0]
+
+	p, err := e.desiredParameters(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	// Ingress rules are managed only if the spec declares them. Otherwise the
+	// rules of an adopted security group are kept.
+	if len(p.Ingress) != 0 {
+		if err := e.updateIngress(ctx, meta.GetExternalName(cr), ec2.GenerateEC2Permissions(p.Ingress), observed.IpPermissions); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
+	// Egress rules are managed only if the spec declares them. Otherwise the
+	// default allow-all egress rule that EC2 creates is kept.
+	if len(p.Egress) == 0 {
+		return managed.ExternalUpdate{}, nil
+	}
+	return managed.ExternalUpdate{}, e.updateEgress(ctx, meta.GetExternalName(cr), ec2.GenerateEC2Permissions(p.Egress), observed.IpPermissionsEgress)
+}
+
+// desiredParameters returns the parameters of the given security group with
+// the rules of the SecurityGroupRules that target it added, so that the rules
+// they own are neither reported as drift nor revoked. Their egress rules are
+// added only if the spec declares egress rules, since egress is left alone
+// otherwise.
+func (e *external) desiredParameters(ctx context.Context, cr *v1alpha3.SecurityGroup) (v1alpha3.SecurityGroupParameters, error) {
+	p := *cr.Spec.SecurityGroupParameters.DeepCopy()
+
+	rules := &v1alpha3.SecurityGroupRuleList{}
+	if err := e.kube.List(ctx, rules, client.MatchingFields{ruleSecurityGroupIDField: meta.GetExternalName(cr)}); err != nil {
+		return p, errors.Wrap(err, errListRules)
+	}
+	for _, r := range rules.Items {
+		switch {
+		case r.Spec.ForProvider.Type == v1alpha3.SecurityGroupRuleTypeIngress:
+			p.Ingress = append(p.Ingress, ec2.GenerateIPPermission(r.Spec.ForProvider))
+		case r.Spec.ForProvider.Type == v1alpha3.SecurityGroupRuleTypeEgress && len(cr.Spec.Egress) > 0:
+			p.Egress = append(p.Egress, ec2.GenerateIPPermission(r.Spec.ForProvider))
+		}
+	}
+	return p, nil
+}
+
+// updateIngress revokes the undesired ingress rules before authorizing the
+// missing ones, so that a rule whose description changed is replaced in a
+// single pass.
+func (e *external) updateIngress(ctx context.Context, groupID string, desired, observed []awsec2.IpPermission) error {
+	add, remove := ec2.DiffEC2Permissions(desired, observed)
+	if len(remove) > 0 {
+		_, err := e.sg.RevokeSecurityGroupIngressRequest(&awsec2.RevokeSecurityGroupIngressInput{
+			GroupId:       aws.String(groupID),
+			IpPermissions: remove,
+		}).Send(ctx)
+		if err != nil && !ec2.IsRuleNotFoundErr(err) {
+			return errors.Wrap(err, errRevokeIngress)
+		}
+	}
+	if len(add) > 0 {
 		_, err := e.sg.AuthorizeSecurityGroupIngressRequest(&awsec2.AuthorizeSecurityGroupIngressInput{
-			GroupId:       aws.String(meta.GetExternalName(cr)),
-			IpPermissions: ec2.GenerateEC2Permissions(cr.Spec.Ingress),
+			GroupId:       aws.String(groupID),
+			IpPermissions: add,
 		}).Send(ctx)
 		if err != nil && !ec2.IsRuleAlreadyExistsErr(err) {
-			return managed.ExternalUpdate{}, errors.Wrap(err, errAuthorizeIngress)
+			return errors.Wrap(err, errAuthorizeIngress)
+		}
+	}
+	return nil
+}
+
+// updateEgress is the egress counterpart of updateIngress.
+func (e *external) updateEgress(ctx context.Context, groupID string, desired, observed []awsec2.IpPermission) error {
+	add, remove := ec2.DiffEC2Permissions(desired, observed)
+	if len(remove) > 0 {
+		_, err := e.sg.RevokeSecurityGroupEgressRequest(&awsec2.RevokeSecurityGroupEgressInput{
+			GroupId:       aws.String(groupID),
+			IpPermissions: remove,
+		}).Send(ctx)
+		if err != nil && !ec2.IsRuleNotFoundErr(err) {
+			return errors.Wrap(err, errRevokeEgress)
 		}
 	}
-	if len(cr.Spec.Egress) > 0 {
+	if len(add) > 0 {
 		_, err := e.sg.AuthorizeSecurityGroupEgressRequest(&awsec2.AuthorizeSecurityGroupEgressInput{
-			GroupId:       aws.String(meta.GetExternalName(cr)),
-			IpPermissions: ec2.GenerateEC2Permissions(cr.Spec.Egress),
+			GroupId:       aws.String(groupID),
+			IpPermissions: add,
 		}).Send(ctx)
 		if err != nil && !ec2.IsRuleAlreadyExistsErr(err) {
-			return managed.ExternalUpdate{}, errors.Wrap(err, errAuthorizeEgress)
+			return errors.Wrap(err, errAuthorizeEgress)
 		}
 	}
-	return managed.ExternalUpdate{}, nil
+	return nil
 }
 
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
@@ -28,6 +28,7 @@ import (
 	"github.com/onsi/gomega"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
@@ -119,7 +120,7 @@ func Test_Connect(t *testing.T) {
 func Test_Observe(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 	mockClient := fake.MockSecurityGroupClient{}
-	mockExternalClient := external{sg: &mockClient}
+	mockExternalClient := external{sg: &mockClient, kube: test.NewMockClient()}
 	mockManaged := &v1alpha3.SecurityGroup{}
 	meta.SetExternalName(mockManaged, "some arbitrary id")
 
@@ -320,50 +321,70 @@ func Test_Create(t *testing.T) {
 func Test_Update(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 	mockClient := fake.MockSecurityGroupClient{}
-	mockExternalClient := external{sg: &mockClient}
-	mockManaged := &v1alpha3.SecurityGroup{
-		Spec: v1alpha3.SecurityGroupSpec{
-			SecurityGroupParameters: v1alpha3.SecurityGroupParameters{
-				VPCID:       aws.String("arbitrary vpcId"),
-				Description: "arbitrary description",
-				GroupName:   "arbitrary group name",
-				Ingress: []v1alpha3.IPPermission{
-					{
-						FromPort:   aws.Int64(7766),
-						ToPort:     aws.Int64(9988),
-						IPProtocol: "an arbitrary protocol",
-						IPRanges: []v1alpha3.IPRange{
-							{
-								CIDRIP:      "0.0.0.0/0",
-								Description: aws.String("an arbitrary cidr block"),
-							},
-						},
-					}, {}, {},
+	mockExternalClient := external{sg: &mockClient, kube: test.NewMockClient()}
+	ingress := []v1alpha3.IPPermission{
+		{
+			FromPort:   aws.Int64(7766),
+			ToPort:     aws.Int64(9988),
+			IPProtocol: "tcp",
+			IPRanges: []v1alpha3.IPRange{
+				{
+					CIDRIP:      "0.0.0.0/0",
+					Description: aws.String("an arbitrary cidr block"),
 				},
-				Egress: []v1alpha3.IPPermission{
-					{
-						FromPort:   aws.Int64(1122),
-						ToPort:     aws.Int64(3344),
-						IPProtocol: "an arbitrary protocol",
-						IPRanges: []v1alpha3.IPRange{
-							{
-								CIDRIP:      "0.0.0.0/0",
-								Description: aws.String("an arbitrary cidr block"),
-							},
-						},
-					}, {},
+			},
+		},
+	}
+	egress := []v1alpha3.IPPermission{
+		{
+			FromPort:   aws.Int64(1122),
+			ToPort:     aws.Int64(3344),
+			IPProtocol: "tcp",
+			IPRanges: []v1alpha3.IPRange{
+				{
+					CIDRIP:      "0.0.0.0/0",
+					Description: aws.String("an arbitrary cidr block"),
 				},
 			},
 		},
 	}
-	meta.SetExternalName(mockManaged, "some arbitrary id")
+	allowAll := awsec2.IpPermission{
+		IpProtocol: aws.String("-1"),
+		IpRanges:   []awsec2.IpRange{{CidrIp: aws.String("0.0.0.0/0")}},
+	}
+	sg := func(ingress, egress []v1alpha3.IPPermission) *v1alpha3.SecurityGroup {
+		g := &v1alpha3.SecurityGroup{
+			Spec: v1alpha3.SecurityGroupSpec{
+				SecurityGroupParameters: v1alpha3.SecurityGroupParameters{
+					VPCID:       aws.String("arbitrary vpcId"),
+					Description: "arbitrary description",
+					GroupName:   "arbitrary group name",
+					Ingress:     ingress,
+					Egress:      egress,
+				},
+			},
+		}
+		meta.SetExternalName(g, "some arbitrary id")
+		return g
+	}
+
+	var mockDescribeErr error
+	var observed awsec2.SecurityGroup
+	mockClient.MockDescribeSecurityGroupsRequest = func(input *awsec2.DescribeSecurityGroupsInput) awsec2.DescribeSecurityGroupsRequest {
+		return awsec2.DescribeSecurityGroupsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.DescribeSecurityGroupsOutput{SecurityGroups: []awsec2.SecurityGroup{observed}},
+				Error:       mockDescribeErr,
+			},
+		}
+	}
 
 	var mockClientIngressErr error
-	var ingressCalled bool
+	var authorizedIngress []awsec2.IpPermission
 	mockClient.MockAuthorizeSecurityGroupIngressRequest = func(input *awsec2.AuthorizeSecurityGroupIngressInput) awsec2.AuthorizeSecurityGroupIngressRequest {
-		ingressCalled = true
-		g.Expect(aws.StringValue(input.GroupId)).To(gomega.Equal(meta.GetExternalName(mockManaged)), "the passed parameters are not valid")
-		g.Expect(len(input.IpPermissions)).To(gomega.Equal(len(mockManaged.Spec.Ingress)), "the passed parameters are not valid")
+		authorizedIngress = input.IpPermissions
+		g.Expect(aws.StringValue(input.GroupId)).To(gomega.Equal("some arbitrary id"), "the passed parameters are not valid")
 		return awsec2.AuthorizeSecurityGroupIngressRequest{
 			Request: &aws.Request{
 				HTTPRequest: &http.Request{},
@@ -373,12 +394,23 @@ func Test_Update(t *testing.T) {
 		}
 	}
 
+	var revokedIngress []awsec2.IpPermission
+	mockClient.MockRevokeSecurityGroupIngressRequest = func(input *awsec2.RevokeSecurityGroupIngressInput) awsec2.RevokeSecurityGroupIngressRequest {
+		revokedIngress = input.IpPermissions
+		g.Expect(aws.StringValue(input.GroupId)).To(gomega.Equal("some arbitrary id"), "the passed parameters are not valid")
+		return awsec2.RevokeSecurityGroupIngressRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.RevokeSecurityGroupIngressOutput{},
+			},
+		}
+	}
+
 	var mockClientEgressErr error
-	var egressCalled bool
+	var authorizedEgress []awsec2.IpPermission
 	mockClient.MockAuthorizeSecurityGroupEgressRequest = func(input *awsec2.AuthorizeSecurityGroupEgressInput) awsec2.AuthorizeSecurityGroupEgressRequest {
-		egressCalled = true
-		g.Expect(aws.StringValue(input.GroupId)).To(gomega.Equal(meta.GetExternalName(mockManaged)), "the passed parameters are not valid")
-		g.Expect(len(input.IpPermissions)).To(gomega.Equal(len(mockManaged.Spec.Egress)), "the passed parameters are not valid")
+		authorizedEgress = input.IpPermissions
+		g.Expect(aws.StringValue(input.GroupId)).To(gomega.Equal("some arbitrary id"), "the passed parameters are not valid")
 		return awsec2.AuthorizeSecurityGroupEgressRequest{
 			Request: &aws.Request{
 				HTTPRequest: &http.Request{},
@@ -388,98 +420,145 @@ func Test_Update(t *testing.T) {
 		}
 	}
 
+	var mockClientRevokeEgressErr error
+	var revokedEgress []awsec2.IpPermission
+	mockClient.MockRevokeSecurityGroupEgressRequest = func(input *awsec2.RevokeSecurityGroupEgressInput) awsec2.RevokeSecurityGroupEgressRequest {
+		revokedEgress = input.IpPermissions
+		g.Expect(aws.StringValue(input.GroupId)).To(gomega.Equal("some arbitrary id"), "the passed parameters are not valid")
+		return awsec2.RevokeSecurityGroupEgressRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.RevokeSecurityGroupEgressOutput{},
+				Error:       mockClientRevokeEgressErr,
+			},
+		}
+	}
+
 	for _, tc := range []struct {
-		description         string
-		managedObj          resource.Managed
-		clientIngressErr    error
-		clientEgressErr     error
-		expectedIngressCall bool
-		expectedEgressCall  bool
-		expectedErrNil      bool
+		description           string
+		managedObj            resource.Managed
+		observed              awsec2.SecurityGroup
+		describeErr           error
+		clientIngressErr      error
+		clientEgressErr       error
+		clientRevokeEgressErr error
+		expectedAuthIngress   int
+		expectedRevokeIngress int
+		expectedAuthEgress    int
+		expectedRevokeEgress  int
+		expectedErrNil        bool
 	}{
 		{
-			"if creating ingress rules fails, it should return error",
-			mockManaged.DeepCopy(),
-			errors.New("some error"),
-			nil,
-			true,
-			false,
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			awsec2.SecurityGroup{},
+			nil, nil, nil, nil,
+			0, 0, 0, 0,
 			false,
 		},
 		{
-			"if there are no ingress rules fails, it should return expected",
-			func() *v1alpha3.SecurityGroup {
-				g := &v1alpha3.SecurityGroup{
-					Spec: v1alpha3.SecurityGroupSpec{
-						SecurityGroupParameters: v1alpha3.SecurityGroupParameters{
-							VPCID:       aws.String("arbitrary vpcId"),
-							Description: "arbitrary description",
-							GroupName:   "arbitrary group name",
-							Egress:      mockManaged.Spec.Egress,
-						},
-					},
-					Status: mockManaged.Status,
-				}
-				meta.SetExternalName(g, "some arbitrary id")
-				return g
-			}(),
-			nil,
-			nil,
+			"if describing the security group fails, it should return error",
+			sg(ingress, egress),
+			awsec2.SecurityGroup{},
+			errors.New("some error"), nil, nil, nil,
+			0, 0, 0, 0,
 			false,
+		},
+		{
+			"missing rules should be authorized and the default egress rule revoked",
+			sg(ingress, egress),
+			awsec2.SecurityGroup{IpPermissionsEgress: []awsec2.IpPermission{allowAll}},
+			nil, nil, nil, nil,
+			1, 0, 1, 1,
 			true,
+		},
+		{
+			"rules that are not in the spec should be revoked",
+			sg(ingress, egress),
+			awsec2.SecurityGroup{
+				IpPermissions:       append(ec2.GenerateEC2Permissions(ingress), allowAll),
+				IpPermissionsEgress: ec2.GenerateEC2Permissions(egress),
+			},
+			nil, nil, nil, nil,
+			0, 1, 0, 0,
 			true,
 		},
 		{
-			"if creating egress rules fails, it should return error",
-			mockManaged.DeepCopy(),
-			nil,
-			errors.New("some error"),
+			"if there are no ingress rules in the spec, ingress should be left alone",
+			sg(nil, egress),
+			awsec2.SecurityGroup{
+				IpPermissions:       []awsec2.IpPermission{allowAll},
+				IpPermissionsEgress: ec2.GenerateEC2Permissions(egress),
+			},
+			nil, nil, nil, nil,
+			0, 0, 0, 0,
 			true,
+		},
+		{
+			"if there are no egress rules in the spec, egress should be left alone",
+			sg(ingress, nil),
+			awsec2.SecurityGroup{
+				IpPermissions:       ec2.GenerateEC2Permissions(ingress),
+				IpPermissionsEgress: []awsec2.IpPermission{allowAll},
+			},
+			nil, nil, nil, nil,
+			0, 0, 0, 0,
 			true,
+		},
+		{
+			"if authorizing ingress rules fails, it should return error",
+			sg(ingress, egress),
+			awsec2.SecurityGroup{},
+			nil, errors.New("some error"), nil, nil,
+			1, 0, 0, 0,
 			false,
 		},
 		{
-			"if there are no egress rules fails, it should return expected",
-			func() *v1alpha3.SecurityGroup {
-				g := &v1alpha3.SecurityGroup{
-					Spec: v1alpha3.SecurityGroupSpec{
-						SecurityGroupParameters: v1alpha3.SecurityGroupParameters{
-							VPCID:       aws.String("arbitrary vpcId"),
-							Description: "arbitrary description",
-							GroupName:   "arbitrary group name",
-							Ingress:     mockManaged.Spec.Ingress,
-						},
-					},
-					Status: mockManaged.Status,
-				}
-				meta.SetExternalName(g, "some arbitrary id")
-				return g
-			}(),
-			nil,
-			nil,
+			"if the ingress rule already exists, it should not return error",
+			sg(ingress, nil),
+			awsec2.SecurityGroup{},
+			nil, awserr.New(ec2.InvalidPermissionDuplicate, "", nil), nil, nil,
+			1, 0, 0, 0,
 			true,
+		},
+		{
+			"if revoking egress rules fails, it should return error",
+			sg(nil, egress),
+			awsec2.SecurityGroup{IpPermissionsEgress: []awsec2.IpPermission{allowAll}},
+			nil, nil, nil, errors.New("some error"),
+			0, 0, 0, 1,
+			false,
+		},
+		{
+			"if authorizing egress rules fails, it should return error",
+			sg(nil, egress),
+			awsec2.SecurityGroup{},
+			nil, nil, errors.New("some error"), nil,
+			0, 0, 1, 0,
 			false,
-			true,
 		},
 	} {
-		ingressCalled = false
-		egressCalled = false
+		authorizedIngress, revokedIngress, authorizedEgress, revokedEgress = nil, nil, nil, nil
+		observed = tc.observed
+		mockDescribeErr = tc.describeErr
 		mockClientIngressErr = tc.clientIngressErr
 		mockClientEgressErr = tc.clientEgressErr
+		mockClientRevokeEgressErr = tc.clientRevokeEgressErr
 
 		_, err := mockExternalClient.Update(context.Background(), tc.managedObj)
 
 		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
-
-		g.Expect(ingressCalled).To(gomega.Equal(tc.expectedIngressCall), tc.description)
-		g.Expect(egressCalled).To(gomega.Equal(tc.expectedEgressCall), tc.description)
+		g.Expect(len(authorizedIngress)).To(gomega.Equal(tc.expectedAuthIngress), tc.description)
+		g.Expect(len(revokedIngress)).To(gomega.Equal(tc.expectedRevokeIngress), tc.description)
+		g.Expect(len(authorizedEgress)).To(gomega.Equal(tc.expectedAuthEgress), tc.description)
+		g.Expect(len(revokedEgress)).To(gomega.Equal(tc.expectedRevokeEgress), tc.description)
 	}
 }
 
 func Test_Delete(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 	mockClient := fake.MockSecurityGroupClient{}
-	mockExternalClient := external{sg: &mockClient}
+	mockExternalClient := external{sg: &mockClient, kube: test.NewMockClient()}
 	mockManaged := &v1alpha3.SecurityGroup{}
 	meta.SetExternalName(mockManaged, "some arbitrary id")
 
@@ -539,3 +618,208 @@ func Test_Delete(t *testing.T) {
 		}
 	}
 }
+
+func Test_UpdateWithSecurityGroupRules(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	mockClient := fake.MockSecurityGroupClient{}
+	rules := []v1alpha3.SecurityGroupRule{
+		{Spec: v1alpha3.SecurityGroupRuleSpec{ForProvider: v1alpha3.SecurityGroupRuleParameters{
+			SecurityGroupID: aws.String("some arbitrary id"),
+			Type:            v1alpha3.SecurityGroupRuleTypeIngress,
+			IPProtocol:      "tcp",
+			FromPort:        aws.Int64(5432),
+			ToPort:          aws.Int64(5432),
+			CIDRIP:          aws.String("10.0.0.0/16"),
+		}}},
+		{Spec: v1alpha3.SecurityGroupRuleSpec{ForProvider: v1alpha3.SecurityGroupRuleParameters{
+			SecurityGroupID: aws.String("another id"),
+			Type:            v1alpha3.SecurityGroupRuleTypeIngress,
+			IPProtocol:      "tcp",
+			FromPort:        aws.Int64(22),
+			ToPort:          aws.Int64(22),
+			CIDRIP:          aws.String("10.0.0.0/16"),
+		}}},
+	}
+	mockExternalClient := external{sg: &mockClient, kube: &test.MockClient{
+		MockList: func(_ context.Context, obj runtime.Object, opts ...client.ListOption) error {
+			// serve the rules like the field index of the cache does.
+			lo := &client.ListOptions{}
+			lo.ApplyOptions(opts)
+			id, ok := lo.FieldSelector.RequiresExactMatch(ruleSecurityGroupIDField)
+			g.Expect(ok).To(gomega.BeTrue(), "rules should be listed by their security group")
+			for _, r := range rules {
+				if indexRuleSecurityGroupID(r.DeepCopy())[0] == id {
+					obj.(*v1alpha3.SecurityGroupRuleList).Items = append(obj.(*v1alpha3.SecurityGroupRuleList).Items, r)
+				}
+			}
+			return nil
+		},
+	}}
+	mgd := &v1alpha3.SecurityGroup{}
+	meta.SetExternalName(mgd, "some arbitrary id")
+
+	owned := ec2.GenerateEC2Permissions([]v1alpha3.IPPermission{ec2.GenerateIPPermission(rules[0].Spec.ForProvider)})
+	unowned := ec2.GenerateEC2Permissions([]v1alpha3.IPPermission{ec2.GenerateIPPermission(rules[1].Spec.ForProvider)})
+	mockClient.MockDescribeSecurityGroupsRequest = func(input *awsec2.DescribeSecurityGroupsInput) awsec2.DescribeSecurityGroupsRequest {
+		return awsec2.DescribeSecurityGroupsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsec2.DescribeSecurityGroupsOutput{SecurityGroups: []awsec2.SecurityGroup{{
+					IpPermissions: append(owned, unowned...),
+				}}},
+			},
+		}
+	}
+	var revoked []awsec2.IpPermission
+	mockClient.MockRevokeSecurityGroupIngressRequest = func(input *awsec2.RevokeSecurityGroupIngressInput) awsec2.RevokeSecurityGroupIngressRequest {
+		revoked = input.IpPermissions
+		return awsec2.RevokeSecurityGroupIngressRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.RevokeSecurityGroupIngressOutput{},
+			},
+		}
+	}
+
+	obs, err := mockExternalClient.Observe(context.Background(), mgd)
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(obs.ResourceUpToDate).To(gomega.BeFalse(), "rules of other security groups should be reported as drift")
+
+	_, err = mockExternalClient.Update(context.Background(), mgd)
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(revoked).To(gomega.Equal(unowned), "only the rule that is not owned by a SecurityGroupRule should be revoked")
+}
+
+func Test_ObserveLookup(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	mockClient := fake.MockSecurityGroupClient{}
+	mockExternalClient := external{sg: &mockClient, kube: &test.MockClient{
+		MockUpdate: test.NewMockUpdateFn(nil),
+		MockList:   test.NewMockListFn(nil),
+	}}
+
+	mockManaged := v1alpha3.SecurityGroup{
+		Spec: v1alpha3.SecurityGroupSpec{
+			SecurityGroupParameters: v1alpha3.SecurityGroupParameters{
+				VPCID:  aws.String("vpc-1"),
+				Lookup: &v1alpha3.LookupParameters{Tags: []v1alpha3.Tag{{Key: "Name", Value: "web"}}},
+			},
+		},
+	}
+
+	var lookupFilters []awsec2.Filter
+	var itemsList []awsec2.SecurityGroup
+	mockClient.MockDescribeSecurityGroupsRequest = func(input *awsec2.DescribeSecurityGroupsInput) awsec2.DescribeSecurityGroupsRequest {
+		if len(input.Filters) > 0 {
+			lookupFilters = input.Filters
+		}
+		return awsec2.DescribeSecurityGroupsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.DescribeSecurityGroupsOutput{SecurityGroups: itemsList},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description          string
+		lookup               *v1alpha3.LookupParameters
+		itemsReturned        []awsec2.SecurityGroup
+		expectedErrNil       bool
+		expectedExists       bool
+		expectedExternalName string
+	}{
+		{
+			"a single match should be adopted",
+			mockManaged.Spec.Lookup,
+			[]awsec2.SecurityGroup{{GroupId: aws.String("sg-1"), VpcId: aws.String("vpc-1")}},
+			true,
+			true,
+			"sg-1",
+		},
+		{
+			"if nothing matches, it should return error",
+			mockManaged.Spec.Lookup,
+			nil,
+			false,
+			false,
+			"",
+		},
+		{
+			"an empty lookup should return error",
+			&v1alpha3.LookupParameters{},
+			[]awsec2.SecurityGroup{{GroupId: aws.String("sg-default"), VpcId: aws.String("vpc-1")}},
+			false,
+			false,
+			"",
+		},
+	} {
+		itemsList = tc.itemsReturned
+		lookupFilters = nil
+		mgd := mockManaged.DeepCopy()
+		mgd.Spec.Lookup = tc.lookup
+
+		result, err := mockExternalClient.Observe(context.Background(), mgd)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(result.ResourceExists).To(gomega.Equal(tc.expectedExists), tc.description)
+		g.Expect(meta.GetExternalName(mgd)).To(gomega.Equal(tc.expectedExternalName), tc.description)
+		if tc.expectedErrNil {
+			g.Expect(lookupFilters).To(gomega.Equal([]awsec2.Filter{
+				{Name: aws.String("tag:Name"), Values: []string{"web"}},
+				{Name: aws.String("vpc-id"), Values: []string{"vpc-1"}},
+			}), tc.description)
+		}
+	}
+}
+
+func Test_AdoptedWithoutIngressKeepsIngress(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	mockClient := fake.MockSecurityGroupClient{}
+	mockExternalClient := external{sg: &mockClient, kube: &test.MockClient{
+		MockUpdate: test.NewMockUpdateFn(nil),
+		MockList:   test.NewMockListFn(nil),
+	}}
+
+	mgd := &v1alpha3.SecurityGroup{
+		Spec: v1alpha3.SecurityGroupSpec{
+			SecurityGroupParameters: v1alpha3.SecurityGroupParameters{
+				VPCID:  aws.String("vpc-1"),
+				Lookup: &v1alpha3.LookupParameters{Tags: []v1alpha3.Tag{{Key: "Name", Value: "web"}}},
+			},
+		},
+	}
+
+	existing := ec2.GenerateEC2Permissions([]v1alpha3.IPPermission{
+		{IPProtocol: "tcp", FromPort: aws.Int64(22), ToPort: aws.Int64(22), IPRanges: []v1alpha3.IPRange{{CIDRIP: "0.0.0.0/0"}}},
+		{IPProtocol: "tcp", FromPort: aws.Int64(443), ToPort: aws.Int64(443), IPRanges: []v1alpha3.IPRange{{CIDRIP: "0.0.0.0/0"}}},
+	})
+	mockClient.MockDescribeSecurityGroupsRequest = func(input *awsec2.DescribeSecurityGroupsInput) awsec2.DescribeSecurityGroupsRequest {
+		return awsec2.DescribeSecurityGroupsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsec2.DescribeSecurityGroupsOutput{SecurityGroups: []awsec2.SecurityGroup{{
+					GroupId:       aws.String("sg-1"),
+					VpcId:         aws.String("vpc-1"),
+					IpPermissions: existing,
+				}}},
+			},
+		}
+	}
+	var revoked bool
+	mockClient.MockRevokeSecurityGroupIngressRequest = func(input *awsec2.RevokeSecurityGroupIngressInput) awsec2.RevokeSecurityGroupIngressRequest {
+		revoked = true
+		return awsec2.RevokeSecurityGroupIngressRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.RevokeSecurityGroupIngressOutput{}},
+		}
+	}
+
+	obs, err := mockExternalClient.Observe(context.Background(), mgd)
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(meta.GetExternalName(mgd)).To(gomega.Equal("sg-1"))
+	g.Expect(obs.ResourceUpToDate).To(gomega.BeTrue(), "the ingress rules of the adopted group should not be reported as drift")
+
+	_, err = mockExternalClient.Update(context.Background(), mgd)
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(revoked).To(gomega.BeFalse(), "the ingress rules of the adopted group should be kept")
+}
@@ -0,0 +1,251 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitygrouprule
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	v1alpha3 "github.com/crossplane/provider-aws/apis/network/v1alpha3"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/controller/utils"
+)
+
+const (
+	errUnexpectedObject    = "The managed resource is not a SecurityGroupRule resource"
+	errClient              = "cannot create a new SecurityGroupRuleClient"
+	errParseID             = "cannot parse the SecurityGroupRule ID"
+	errGenerateID          = "cannot generate the SecurityGroupRule ID"
+	errDescribe            = "failed to describe the SecurityGroup of the rule"
+	errMultipleItems       = "retrieved multiple SecurityGroups for the given securityGroupId"
+	errPersistExternalName = "failed to persist SecurityGroupRule ID"
+	errAuthorize           = "failed to authorize the SecurityGroupRule"
+	errRevoke              = "failed to revoke the SecurityGroupRule"
+	errUpdateDescription   = "failed to update the description of the SecurityGroupRule"
+)
+
+// SetupSecurityGroupRule adds a controller that reconciles SecurityGroupRules.
+func SetupSecurityGroupRule(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha3.SecurityGroupRuleGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha3.SecurityGroupRule{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.SecurityGroupRuleGroupVersionKind),
+			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: ec2.NewSecurityGroupRuleClient, awsConfigFn: utils.RetrieveAwsConfigFromProvider}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	client      client.Client
+	newClientFn func(*aws.Config) (ec2.SecurityGroupRuleClient, error)
+	awsConfigFn func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error)
+}
+
+func (conn *connector) Connect(ctx context.Context, mgd resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mgd.(*v1alpha3.SecurityGroupRule)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	awsconfig, err := conn.awsConfigFn(ctx, conn.client, cr.Spec.ProviderReference)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := conn.newClientFn(awsconfig)
+	if err != nil {
+		return nil, errors.Wrap(err, errClient)
+	}
+
+	return &external{kube: conn.client, client: c}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.SecurityGroupRuleClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha3.SecurityGroupRule)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	groupID, ruleType, rule, err := ec2.ParseSecurityGroupRuleID(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errParseID)
+	}
+
+	response, err := e.client.DescribeSecurityGroupsRequest(&awsec2.DescribeSecurityGroupsInput{
+		GroupIds: []string{groupID},
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ec2.IsSecurityGroupNotFoundErr, err), errDescribe)
+	}
+	if len(response.SecurityGroups) != 1 {
+		return managed.ExternalObservation{}, errors.New(errMultipleItems)
+	}
+
+	perms := response.SecurityGroups[0].IpPermissions
+	if ruleType == v1alpha3.SecurityGroupRuleTypeEgress {
+		perms = response.SecurityGroups[0].IpPermissionsEgress
+	}
+	observed := ec2.FindSecurityGroupRule(rule, perms)
+	if observed == nil {
+		return managed.ExternalObservation{}, nil
+	}
+
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ec2.IsSecurityGroupRuleUpToDate(cr.Spec.ForProvider, meta.GetExternalName(cr), *observed),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha3.SecurityGroupRule)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	return managed.ExternalCreation{}, e.authorize(ctx, cr)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha3.SecurityGroupRule)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	id, err := ec2.GenerateSecurityGroupRuleID(cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGenerateID)
+	}
+
+	// EC2 identifies a rule by everything but its description, so any other
+	// change replaces the rule.
+	if id != meta.GetExternalName(cr) {
+		if err := e.revoke(ctx, meta.GetExternalName(cr)); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		return managed.ExternalUpdate{}, e.authorize(ctx, cr)
+	}
+
+	perm := ec2.GenerateEC2Permissions([]v1alpha3.IPPermission{ec2.GenerateIPPermission(cr.Spec.ForProvider)})
+	if cr.Spec.ForProvider.Type == v1alpha3.SecurityGroupRuleTypeEgress {
+		_, err = e.client.UpdateSecurityGroupRuleDescriptionsEgressRequest(&awsec2.UpdateSecurityGroupRuleDescriptionsEgressInput{
+			GroupId:       cr.Spec.ForProvider.SecurityGroupID,
+			IpPermissions: perm,
+		}).Send(ctx)
+	} else {
+		_, err = e.client.UpdateSecurityGroupRuleDescriptionsIngressRequest(&awsec2.UpdateSecurityGroupRuleDescriptionsIngressInput{
+			GroupId:       cr.Spec.ForProvider.SecurityGroupID,
+			IpPermissions: perm,
+		}).Send(ctx)
+	}
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateDescription)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha3.SecurityGroupRule)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+
+	return e.revoke(ctx, meta.GetExternalName(cr))
+}
+
+// authorize adds the rule described by the spec of the given
+// SecurityGroupRule to its security group and records the ID of the rule as
+// the external name.
+func (e *external) authorize(ctx context.Context, cr *v1alpha3.SecurityGroupRule) error {
+	id, err := ec2.GenerateSecurityGroupRuleID(cr.Spec.ForProvider)
+	if err != nil {
+		return errors.Wrap(err, errGenerateID)
+	}
+
+	perm := ec2.GenerateEC2Permissions([]v1alpha3.IPPermission{ec2.GenerateIPPermission(cr.Spec.ForProvider)})
+	if cr.Spec.ForProvider.Type == v1alpha3.SecurityGroupRuleTypeEgress {
+		_, err = e.client.AuthorizeSecurityGroupEgressRequest(&awsec2.AuthorizeSecurityGroupEgressInput{
+			GroupId:       cr.Spec.ForProvider.SecurityGroupID,
+			IpPermissions: perm,
+		}).Send(ctx)
+	} else {
+		_, err = e.client.AuthorizeSecurityGroupIngressRequest(&awsec2.AuthorizeSecurityGroupIngressInput{
+			GroupId:       cr.Spec.ForProvider.SecurityGroupID,
+			IpPermissions: perm,
+		}).Send(ctx)
+	}
+	if err != nil && !ec2.IsRuleAlreadyExistsErr(err) {
+		return errors.Wrap(err, errAuthorize)
+	}
+
+	meta.SetExternalName(cr, id)
+	return errors.Wrap(e.kube.Update(ctx, cr), errPersistExternalName)
+}
+
+// revoke removes the rule with the given ID from its security group. Rules
+// and security groups that no longer exist are ignored.
+func (e *external) revoke(ctx context.Context, id string) error {
+	groupID, ruleType, rule, err := ec2.ParseSecurityGroupRuleID(id)
+	if err != nil {
+		return errors.Wrap(err, errParseID)
+	}
+
+	if ruleType == v1alpha3.SecurityGroupRuleTypeEgress {
+		_, err = e.client.RevokeSecurityGroupEgressRequest(&awsec2.RevokeSecurityGroupEgressInput{
+			GroupId:       aws.String(groupID),
+			IpPermissions: []awsec2.IpPermission{rule},
+		}).Send(ctx)
+	} else {
+		_, err = e.client.RevokeSecurityGroupIngressRequest(&awsec2.RevokeSecurityGroupIngressInput{
+			GroupId:       aws.String(groupID),
+			IpPermissions: []awsec2.IpPermission{rule},
+		}).Send(ctx)
+	}
+	if err != nil && !ec2.IsRuleNotFoundErr(err) && !ec2.IsSecurityGroupNotFoundErr(err) {
+		return errors.Wrap(err, errRevoke)
+	}
+	return nil
+}
@@ -0,0 +1,520 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitygrouprule
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	v1alpha3 "github.com/crossplane/provider-aws/apis/network/v1alpha3"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	mockExternalClient external
+	mockClient         fake.MockSecurityGroupRuleClient
+
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+)
+
+func TestMain(m *testing.M) {
+
+	mockClient = fake.MockSecurityGroupRuleClient{}
+	mockExternalClient = external{
+		client: &mockClient,
+		kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+	}
+
+	os.Exit(m.Run())
+}
+
+func Test_Connect(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := &v1alpha3.SecurityGroupRule{}
+	var clientErr error
+	var configErr error
+
+	conn := connector{
+		client: nil,
+		newClientFn: func(conf *aws.Config) (ec2.SecurityGroupRuleClient, error) {
+			return &mockClient, clientErr
+		},
+		awsConfigFn: func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error) {
+			return &aws.Config{}, configErr
+		},
+	}
+
+	for _, tc := range []struct {
+		description       string
+		managedObj        resource.Managed
+		configErr         error
+		clientErr         error
+		expectedClientNil bool
+		expectedErrNil    bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws config provider fails, should return error",
+			mockManaged,
+			errors.New("some error"),
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws client provider fails, should return error",
+			mockManaged,
+			nil,
+			errors.New("some error"),
+			true,
+			false,
+		},
+	} {
+		clientErr = tc.clientErr
+		configErr = tc.configErr
+
+		res, err := conn.Connect(context.Background(), tc.managedObj)
+		g.Expect(res == nil).To(gomega.Equal(tc.expectedClientNil), tc.description)
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+	}
+}
+
+var (
+	// the desired rule of the tests, whose ID is ruleID
+	ruleParams = v1alpha3.SecurityGroupRuleParameters{
+		SecurityGroupID: aws.String("sg-1"),
+		Type:            v1alpha3.SecurityGroupRuleTypeIngress,
+		IPProtocol:      "tcp",
+		FromPort:        aws.Int64(5432),
+		ToPort:          aws.Int64(5432),
+		UserIDGroupPair: &v1alpha3.UserIDGroupPair{GroupID: aws.String("sg-2")},
+		Description:     aws.String("app"),
+	}
+	ruleID = "sg-1_ingress_tcp_5432_5432_sg-2"
+)
+
+func Test_Observe(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.SecurityGroupRule{Spec: v1alpha3.SecurityGroupRuleSpec{ForProvider: ruleParams}}
+	meta.SetExternalName(&mockManaged, ruleID)
+
+	rule := func(group, description string) awsec2.IpPermission {
+		return awsec2.IpPermission{
+			IpProtocol:       aws.String("tcp"),
+			FromPort:         aws.Int64(5432),
+			ToPort:           aws.Int64(5432),
+			UserIdGroupPairs: []awsec2.UserIdGroupPair{{GroupId: aws.String(group), UserId: aws.String("123456789012"), Description: aws.String(description)}},
+		}
+	}
+
+	var mockClientErr error
+	var observed []awsec2.IpPermission
+	mockClient.MockDescribeSecurityGroupsRequest = func(input *awsec2.DescribeSecurityGroupsInput) awsec2.DescribeSecurityGroupsRequest {
+		g.Expect(input.GroupIds).To(gomega.Equal([]string{"sg-1"}), "the passed parameters are not valid")
+		return awsec2.DescribeSecurityGroupsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsec2.DescribeSecurityGroupsOutput{
+					SecurityGroups: []awsec2.SecurityGroup{{IpPermissions: observed}},
+				},
+				Error: mockClientErr,
+			},
+		}
+	}
+
+	withRule := func(id string) *v1alpha3.SecurityGroupRule {
+		cr := mockManaged.DeepCopy()
+		meta.SetExternalName(cr, id)
+		return cr
+	}
+
+	for _, tc := range []struct {
+		description           string
+		managedObj            resource.Managed
+		observed              []awsec2.IpPermission
+		clientErr             error
+		expectedErrNil        bool
+		expectedResourceExist bool
+		expectedUpToDate      bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			[]awsec2.IpPermission{rule("sg-3", "other"), rule("sg-2", "app")},
+			nil,
+			true,
+			true,
+			true,
+		},
+		{
+			"rule with a different description should not be up to date",
+			mockManaged.DeepCopy(),
+			[]awsec2.IpPermission{rule("sg-2", "")},
+			nil,
+			true,
+			true,
+			false,
+		},
+		{
+			"rule whose spec changed should not be up to date",
+			withRule("sg-1_ingress_tcp_5432_5432_sg-3"),
+			[]awsec2.IpPermission{rule("sg-3", "app")},
+			nil,
+			true,
+			true,
+			false,
+		},
+		{
+			"missing rule should not exist",
+			mockManaged.DeepCopy(),
+			[]awsec2.IpPermission{rule("sg-3", "app")},
+			nil,
+			true,
+			false,
+			false,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			nil,
+			false,
+			false,
+			false,
+		},
+		{
+			"if item's identifier is not yet set, returns expected",
+			&v1alpha3.SecurityGroupRule{},
+			nil,
+			nil,
+			true,
+			false,
+			false,
+		},
+		{
+			"invalid identifier should return error",
+			withRule("sg-1"),
+			nil,
+			nil,
+			false,
+			false,
+			false,
+		},
+		{
+			"if the security group doesn't exist, it should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			awserr.New(ec2.InvalidGroupNotFound, "", nil),
+			true,
+			false,
+			false,
+		},
+		{
+			"if describing the security group fails, it should return error",
+			mockManaged.DeepCopy(),
+			nil,
+			errors.New("some error"),
+			false,
+			false,
+			false,
+		},
+	} {
+		observed = tc.observed
+		mockClientErr = tc.clientErr
+
+		result, err := mockExternalClient.Observe(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(result.ResourceExists).To(gomega.Equal(tc.expectedResourceExist), tc.description)
+		g.Expect(result.ResourceUpToDate).To(gomega.Equal(tc.expectedUpToDate), tc.description)
+		if tc.expectedResourceExist {
+			mgd := tc.managedObj.(*v1alpha3.SecurityGroupRule)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonAvailable), tc.description)
+		}
+	}
+}
+
+func Test_Create(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.SecurityGroupRule{Spec: v1alpha3.SecurityGroupRuleSpec{ForProvider: ruleParams}}
+
+	var mockClientErr error
+	mockClient.MockAuthorizeSecurityGroupIngressRequest = func(input *awsec2.AuthorizeSecurityGroupIngressInput) awsec2.AuthorizeSecurityGroupIngressRequest {
+		g.Expect(aws.StringValue(input.GroupId)).To(gomega.Equal("sg-1"), "the passed parameters are not valid")
+		g.Expect(input.IpPermissions).To(gomega.Equal([]awsec2.IpPermission{{
+			IpProtocol:       aws.String("tcp"),
+			FromPort:         aws.Int64(5432),
+			ToPort:           aws.Int64(5432),
+			UserIdGroupPairs: []awsec2.UserIdGroupPair{{GroupId: aws.String("sg-2"), Description: aws.String("app")}},
+		}}), "the passed parameters are not valid")
+		return awsec2.AuthorizeSecurityGroupIngressRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.AuthorizeSecurityGroupIngressOutput{},
+				Error:       mockClientErr,
+			},
+		}
+	}
+
+	invalid := mockManaged.DeepCopy()
+	invalid.Spec.ForProvider.CIDRIP = aws.String("10.0.0.0/16")
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"if the rule already exists, it should return expected",
+			mockManaged.DeepCopy(),
+			awserr.New(ec2.InvalidPermissionDuplicate, "", nil),
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			false,
+		},
+		{
+			"rule with more than one source should return error",
+			invalid,
+			nil,
+			false,
+		},
+		{
+			"if authorizing the rule fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+
+		_, err := mockExternalClient.Create(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha3.SecurityGroupRule)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonCreating), tc.description)
+			g.Expect(meta.GetExternalName(mgd)).To(gomega.Equal(ruleID), tc.description)
+		}
+	}
+}
+
+func Test_Update(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.SecurityGroupRule{Spec: v1alpha3.SecurityGroupRuleSpec{ForProvider: ruleParams}}
+	meta.SetExternalName(&mockManaged, ruleID)
+
+	var calls []string
+	mockClient.MockAuthorizeSecurityGroupIngressRequest = func(input *awsec2.AuthorizeSecurityGroupIngressInput) awsec2.AuthorizeSecurityGroupIngressRequest {
+		calls = append(calls, "authorize")
+		return awsec2.AuthorizeSecurityGroupIngressRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.AuthorizeSecurityGroupIngressOutput{},
+			},
+		}
+	}
+	var mockRevokeErr error
+	mockClient.MockRevokeSecurityGroupIngressRequest = func(input *awsec2.RevokeSecurityGroupIngressInput) awsec2.RevokeSecurityGroupIngressRequest {
+		calls = append(calls, "revoke "+aws.StringValue(input.IpPermissions[0].UserIdGroupPairs[0].GroupId))
+		return awsec2.RevokeSecurityGroupIngressRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.RevokeSecurityGroupIngressOutput{},
+				Error:       mockRevokeErr,
+			},
+		}
+	}
+	mockClient.MockUpdateSecurityGroupRuleDescriptionsIngressRequest = func(input *awsec2.UpdateSecurityGroupRuleDescriptionsIngressInput) awsec2.UpdateSecurityGroupRuleDescriptionsIngressRequest {
+		calls = append(calls, "update description")
+		return awsec2.UpdateSecurityGroupRuleDescriptionsIngressRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.UpdateSecurityGroupRuleDescriptionsIngressOutput{},
+			},
+		}
+	}
+
+	changed := mockManaged.DeepCopy()
+	meta.SetExternalName(changed, "sg-1_ingress_tcp_5432_5432_sg-3")
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		revokeErr      error
+		expectedErrNil bool
+		expectedCalls  []string
+		expectedID     string
+	}{
+		{
+			"rule with the same ID should only have its description updated",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+			[]string{"update description"},
+			ruleID,
+		},
+		{
+			"rule whose spec changed should be replaced",
+			changed.DeepCopy(),
+			nil,
+			true,
+			[]string{"revoke sg-3", "authorize"},
+			ruleID,
+		},
+		{
+			"if revoking the old rule fails, it should return error",
+			changed.DeepCopy(),
+			errors.New("some error"),
+			false,
+			[]string{"revoke sg-3"},
+			"sg-1_ingress_tcp_5432_5432_sg-3",
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			false,
+			nil,
+			"",
+		},
+	} {
+		calls = nil
+		mockRevokeErr = tc.revokeErr
+
+		_, err := mockExternalClient.Update(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(calls).To(gomega.Equal(tc.expectedCalls), tc.description)
+		if tc.expectedID != "" {
+			g.Expect(meta.GetExternalName(tc.managedObj)).To(gomega.Equal(tc.expectedID), tc.description)
+		}
+	}
+}
+
+func Test_Delete(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.SecurityGroupRule{}
+	meta.SetExternalName(&mockManaged, ruleID)
+
+	var mockClientErr error
+	mockClient.MockRevokeSecurityGroupIngressRequest = func(input *awsec2.RevokeSecurityGroupIngressInput) awsec2.RevokeSecurityGroupIngressRequest {
+		g.Expect(aws.StringValue(input.GroupId)).To(gomega.Equal("sg-1"), "the passed parameters are not valid")
+		return awsec2.RevokeSecurityGroupIngressRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.RevokeSecurityGroupIngressOutput{},
+				Error:       mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			false,
+		},
+		{
+			"if the rule doesn't exist deleting it should not return an error",
+			mockManaged.DeepCopy(),
+			awserr.New(ec2.InvalidPermissionNotFound, "", nil),
+			true,
+		},
+		{
+			"if the security group doesn't exist deleting the rule should not return an error",
+			mockManaged.DeepCopy(),
+			awserr.New(ec2.InvalidGroupNotFound, "", nil),
+			true,
+		},
+		{
+			"if revoking the rule fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+
+		err := mockExternalClient.Delete(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha3.SecurityGroupRule)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonDeleting), tc.description)
+		}
+	}
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -42,10 +43,18 @@ const (
 	errUnexpectedObject    = "The managed resource is not an Subnet resource"
 	errClient              = "cannot create a new SubnetClient"
 	errDescribe            = "failed to describe Subnet with id"
+	errLookup              = "failed to look up an existing Subnet"
+	errLookupMatches       = "%d Subnets match the lookup parameters, exactly one must match"
 	errMultipleItems       = "retrieved multiple Subnet for the given subnetId"
 	errCreate              = "failed to create the Subnet resource"
 	errPersistExternalName = "failed to persist InternetGateway ID"
 	errDelete              = "failed to delete the Subnet resource"
+	errKubeUpdateFailed    = "cannot update Subnet custom resource"
+	errModifyAttribute     = "failed to modify the Subnet attribute"
+	errAssociateCIDR       = "failed to associate the IPv6 CIDR block with the Subnet"
+	errDisassociateCIDR    = "failed to disassociate the IPv6 CIDR block from the Subnet"
+	errCreateTags          = "failed to create tags for the Subnet resource"
+	errDeleteTags          = "failed to delete tags for the Subnet resource"
 )
 
 // SetupSubnet adds a controller that reconciles Subnets.
@@ -102,9 +111,14 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 
 	// AWS network resources are uniquely identified by an ID that is returned
 	// on create time; we can't tell whether they exist unless we have recorded
-	// their ID.
+	// their ID or are asked to look them up.
 	if meta.GetExternalName(cr) == "" {
-		return managed.ExternalObservation{ResourceExists: false}, nil
+		if cr.Spec.Lookup == nil {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		if err := e.lookup(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, err
+		}
 	}
 
 	req := e.client.DescribeSubnetsRequest(&awsec2.DescribeSubnetsInput{
@@ -126,6 +140,14 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 
 	observed := response.Subnets[0]
 
+	current := cr.Spec.SubnetParameters.DeepCopy()
+	ec2.LateInitializeSubnet(&cr.Spec.SubnetParameters, &observed)
+	if !cmp.Equal(current, &cr.Spec.SubnetParameters) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+
 	if observed.State == awsec2.SubnetStateAvailable {
 		cr.SetConditions(runtimev1alpha1.Available())
 	} else if observed.State == awsec2.SubnetStatePending {
@@ -137,20 +159,38 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	return managed.ExternalObservation{
 		ResourceExists:    true,
 		ConnectionDetails: managed.ConnectionDetails{},
+		ResourceUpToDate:  ec2.IsSubnetUpToDate(cr.Spec.SubnetParameters, observed, cr.Status.ManagedIPv6CIDRBlock, cr.Status.ManagedTagKeys),
 	}, nil
 }
 
+// lookup finds the existing Subnet that matches the lookup parameters
+// and records its ID as the external name.
+func (e *external) lookup(ctx context.Context, cr *v1alpha3.Subnet) error {
+	filters, err := ec2.GenerateLookupFilters(*cr.Spec.Lookup, cr.Spec.VPCID)
+	if err != nil {
+		return errors.Wrap(err, errLookup)
+	}
+	rsp, err := e.client.DescribeSubnetsRequest(&awsec2.DescribeSubnetsInput{
+		Filters: filters,
+	}).Send(ctx)
+	if err != nil {
+		return errors.Wrap(err, errLookup)
+	}
+	if len(rsp.Subnets) != 1 {
+		return errors.Errorf(errLookupMatches, len(rsp.Subnets))
+	}
+
+	meta.SetExternalName(cr, aws.StringValue(rsp.Subnets[0].SubnetId))
+	return errors.Wrap(e.kube.Update(ctx, cr), errPersistExternalName)
+}
+
 func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
 	cr, ok := mgd.(*v1alpha3.Subnet)
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
 	}
 
-	req := e.client.CreateSubnetRequest(&awsec2.CreateSubnetInput{
-		VpcId:            aws.String(cr.Spec.VPCID),
-		AvailabilityZone: aws.String(cr.Spec.AvailabilityZone),
-		CidrBlock:        aws.String(cr.Spec.CIDRBlock),
-	})
+	req := e.client.CreateSubnetRequest(ec2.GenerateCreateSubnetInput(cr.Spec.SubnetParameters))
 
 	rsp, err := req.Send(ctx)
 	if err != nil {
@@ -163,15 +203,84 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 	}
 
 	cr.Status.SetConditions(runtimev1alpha1.Creating())
+	cr.Status.ManagedIPv6CIDRBlock = cr.Spec.IPv6CIDRBlock
 	cr.UpdateExternalStatus(*rsp.Subnet)
 	return managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{}}, nil
 }
 
 func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
-	// TODO(soorena776): add more sophisticated Update logic, once we
-	// categorize immutable vs mutable fields (see #727)
+	cr, ok := mgd.(*v1alpha3.Subnet)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	response, err := e.client.DescribeSubnetsRequest(&awsec2.DescribeSubnetsInput{
+		SubnetIds: []string{meta.GetExternalName(cr)},
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+	if len(response.Subnets) != 1 {
+		return managed.ExternalUpdate{}, errors.New(errMultipleItems)
+	}
+	observed := response.Subnets[0]
+
+	// The IPv6 CIDR block has to be associated before addresses can be
+	// assigned from it on creation.
+	if err := e.updateIPv6CIDRBlock(ctx, cr, observed); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	for _, input := range ec2.GenerateModifySubnetAttributeInputs(cr.Spec.SubnetParameters, observed) {
+		if _, err := e.client.ModifySubnetAttributeRequest(input).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errModifyAttribute)
+		}
+	}
 
-	return managed.ExternalUpdate{}, nil
+	return managed.ExternalUpdate{}, e.updateTags(ctx, cr, observed)
+}
+
+func (e *external) updateIPv6CIDRBlock(ctx context.Context, cr *v1alpha3.Subnet, observed awsec2.Subnet) error {
+	associate, disassociate := ec2.DiffSubnetIPv6CIDRBlock(cr.Spec.SubnetParameters, observed, cr.Status.ManagedIPv6CIDRBlock)
+	if disassociate != "" {
+		if _, err := e.client.DisassociateSubnetCidrBlockRequest(&awsec2.DisassociateSubnetCidrBlockInput{
+			AssociationId: aws.String(disassociate),
+		}).Send(ctx); err != nil {
+			return errors.Wrap(err, errDisassociateCIDR)
+		}
+	}
+	if associate != "" {
+		if _, err := e.client.AssociateSubnetCidrBlockRequest(&awsec2.AssociateSubnetCidrBlockInput{
+			SubnetId:      aws.String(meta.GetExternalName(cr)),
+			Ipv6CidrBlock: aws.String(associate),
+		}).Send(ctx); err != nil {
+			return errors.Wrap(err, errAssociateCIDR)
+		}
+	}
+	cr.Status.ManagedIPv6CIDRBlock = cr.Spec.IPv6CIDRBlock
+	return nil
+}
+
+func (e *external) updateTags(ctx context.Context, cr *v1alpha3.Subnet, observed awsec2.Subnet) error {
+	add, remove := ec2.DiffManagedEC2Tags(cr.Spec.Tags, observed.Tags, cr.Status.ManagedTagKeys)
+	if len(remove) > 0 {
+		if _, err := e.client.DeleteTagsRequest(&awsec2.DeleteTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      remove,
+		}).Send(ctx); err != nil {
+			return errors.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      add,
+		}).Send(ctx); err != nil {
+			return errors.Wrap(err, errCreateTags)
+		}
+	}
+	cr.Status.ManagedTagKeys = ec2.TagKeys(cr.Spec.Tags)
+	return nil
 }
 
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
@@ -296,11 +296,158 @@ func Test_Create(t *testing.T) {
 func Test_Update(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 
-	mockManaged := v1alpha3.Subnet{}
+	mockManaged := v1alpha3.Subnet{
+		Spec: v1alpha3.SubnetSpec{
+			SubnetParameters: v1alpha3.SubnetParameters{
+				MapPublicIPOnLaunch:         aws.Bool(true),
+				AssignIPv6AddressOnCreation: aws.Bool(false),
+				IPv6CIDRBlock:               aws.String("2600::/64"),
+				Tags:                        []v1alpha3.Tag{{Key: "k", Value: "v"}},
+			},
+		},
+		Status: v1alpha3.SubnetStatus{
+			ManagedTagKeys: []string{"old"},
+		},
+	}
+	meta.SetExternalName(&mockManaged, "some arbitrary id")
+
+	mockClient.MockDescribeSubnetsRequest = func(input *awsec2.DescribeSubnetsInput) awsec2.DescribeSubnetsRequest {
+		return awsec2.DescribeSubnetsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsec2.DescribeSubnetsOutput{
+					Subnets: []awsec2.Subnet{{
+						SubnetId:                    aws.String("some arbitrary id"),
+						MapPublicIpOnLaunch:         aws.Bool(false),
+						AssignIpv6AddressOnCreation: aws.Bool(false),
+						Tags: []awsec2.Tag{
+							{Key: aws.String("old"), Value: aws.String("v")},
+							{Key: aws.String("kubernetes.io/role/elb"), Value: aws.String("1")},
+						},
+					}},
+				},
+			},
+		}
+	}
+	var modified []*awsec2.ModifySubnetAttributeInput
+	var mockClientErr error
+	mockClient.MockModifySubnetAttributeRequest = func(input *awsec2.ModifySubnetAttributeInput) awsec2.ModifySubnetAttributeRequest {
+		modified = append(modified, input)
+		return awsec2.ModifySubnetAttributeRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.ModifySubnetAttributeOutput{}, Error: mockClientErr},
+		}
+	}
+	var associated string
+	mockClient.MockAssociateSubnetCidrBlockRequest = func(input *awsec2.AssociateSubnetCidrBlockInput) awsec2.AssociateSubnetCidrBlockRequest {
+		associated = aws.StringValue(input.Ipv6CidrBlock)
+		return awsec2.AssociateSubnetCidrBlockRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.AssociateSubnetCidrBlockOutput{}},
+		}
+	}
+	var createdTags []awsec2.Tag
+	mockClient.MockCreateTagsRequest = func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+		createdTags = input.Tags
+		return awsec2.CreateTagsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.CreateTagsOutput{}},
+		}
+	}
+
+	var deletedTags []awsec2.Tag
+	mockClient.MockDeleteTagsRequest = func(input *awsec2.DeleteTagsInput) awsec2.DeleteTagsRequest {
+		deletedTags = input.Tags
+		return awsec2.DeleteTagsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.DeleteTagsOutput{}},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should update attributes, IPv6 CIDR block and tags",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			false,
+		},
+		{
+			"if modifying an attribute fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+		modified, associated, createdTags, deletedTags = nil, "", nil, nil
+
+		_, err := mockExternalClient.Update(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			g.Expect(modified).To(gomega.Equal([]*awsec2.ModifySubnetAttributeInput{{
+				SubnetId:            aws.String("some arbitrary id"),
+				MapPublicIpOnLaunch: &awsec2.AttributeBooleanValue{Value: aws.Bool(true)},
+			}}), tc.description)
+			g.Expect(associated).To(gomega.Equal("2600::/64"), tc.description)
+			g.Expect(createdTags).To(gomega.Equal([]awsec2.Tag{{Key: aws.String("k"), Value: aws.String("v")}}), tc.description)
+			g.Expect(deletedTags).To(gomega.Equal([]awsec2.Tag{{Key: aws.String("old")}}), tc.description)
+			g.Expect(tc.managedObj.(*v1alpha3.Subnet).Status.ManagedTagKeys).To(gomega.Equal([]string{"k"}), tc.description)
+			g.Expect(tc.managedObj.(*v1alpha3.Subnet).Status.ManagedIPv6CIDRBlock).To(gomega.Equal(aws.String("2600::/64")), tc.description)
+		}
+	}
+}
+
+func Test_RemovedIPv6CIDRBlockIsDisassociated(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
 
-	_, err := mockExternalClient.Update(context.Background(), &mockManaged)
+	mgd := &v1alpha3.Subnet{
+		Status: v1alpha3.SubnetStatus{
+			ManagedIPv6CIDRBlock: aws.String("2600::/64"),
+		},
+	}
+	meta.SetExternalName(mgd, "subnet-1")
 
+	mockClient.MockDescribeSubnetsRequest = func(input *awsec2.DescribeSubnetsInput) awsec2.DescribeSubnetsRequest {
+		return awsec2.DescribeSubnetsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsec2.DescribeSubnetsOutput{Subnets: []awsec2.Subnet{{
+					SubnetId: aws.String("subnet-1"),
+					State:    awsec2.SubnetStateAvailable,
+					Ipv6CidrBlockAssociationSet: []awsec2.SubnetIpv6CidrBlockAssociation{{
+						AssociationId:      aws.String("a-1"),
+						Ipv6CidrBlock:      aws.String("2600::/64"),
+						Ipv6CidrBlockState: &awsec2.SubnetCidrBlockState{State: awsec2.SubnetCidrBlockStateCodeAssociated},
+					}},
+				}}},
+			},
+		}
+	}
+	var disassociated string
+	mockClient.MockDisassociateSubnetCidrBlockRequest = func(input *awsec2.DisassociateSubnetCidrBlockInput) awsec2.DisassociateSubnetCidrBlockRequest {
+		disassociated = aws.StringValue(input.AssociationId)
+		return awsec2.DisassociateSubnetCidrBlockRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.DisassociateSubnetCidrBlockOutput{}},
+		}
+	}
+
+	obs, err := mockExternalClient.Observe(context.Background(), mgd)
 	g.Expect(err).To(gomega.BeNil())
+	g.Expect(mgd.Spec.IPv6CIDRBlock).To(gomega.BeNil(), "the removed IPv6 CIDR block should not be late-initialized")
+	g.Expect(obs.ResourceUpToDate).To(gomega.BeFalse())
+
+	_, err = mockExternalClient.Update(context.Background(), mgd)
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(disassociated).To(gomega.Equal("a-1"))
+	g.Expect(mgd.Status.ManagedIPv6CIDRBlock).To(gomega.BeNil())
 }
 
 func Test_Delete(t *testing.T) {
@@ -370,3 +517,89 @@ func Test_Delete(t *testing.T) {
 		}
 	}
 }
+
+func Test_Lookup(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.Subnet{
+		Spec: v1alpha3.SubnetSpec{
+			SubnetParameters: v1alpha3.SubnetParameters{
+				VPCID: "vpc-1",
+				Lookup: &v1alpha3.LookupParameters{
+					Tags:    []v1alpha3.Tag{{Key: "Name", Value: "private"}},
+					Filters: []v1alpha3.Filter{{Name: "availability-zone", Values: []string{"us-east-1a"}}},
+				},
+			},
+		},
+	}
+
+	var mockClientErr error
+	var itemsList []awsec2.Subnet
+	mockClient.MockDescribeSubnetsRequest = func(input *awsec2.DescribeSubnetsInput) awsec2.DescribeSubnetsRequest {
+		g.Expect(input.Filters).To(gomega.Equal([]awsec2.Filter{
+			{Name: aws.String("tag:Name"), Values: []string{"private"}},
+			{Name: aws.String("availability-zone"), Values: []string{"us-east-1a"}},
+			{Name: aws.String("vpc-id"), Values: []string{"vpc-1"}},
+		}), "the passed parameters are not valid")
+		return awsec2.DescribeSubnetsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsec2.DescribeSubnetsOutput{
+					Subnets: itemsList,
+				},
+				Error: mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description          string
+		itemsReturned        []awsec2.Subnet
+		clientErr            error
+		expectedErrNil       bool
+		expectedExternalName string
+	}{
+		{
+			"a single match should be adopted",
+			[]awsec2.Subnet{{SubnetId: aws.String("subnet-1")}},
+			nil,
+			true,
+			"subnet-1",
+		},
+		{
+			"if nothing matches, it should return error",
+			nil,
+			nil,
+			false,
+			"",
+		},
+		{
+			"if more than one subnet matches, it should return error",
+			[]awsec2.Subnet{{SubnetId: aws.String("subnet-1")}, {SubnetId: aws.String("subnet-2")}},
+			nil,
+			false,
+			"",
+		},
+		{
+			"if describing the subnets fails, it should return error",
+			nil,
+			errors.New("some error"),
+			false,
+			"",
+		},
+	} {
+		mockClientErr = tc.clientErr
+		itemsList = tc.itemsReturned
+		mgd := mockManaged.DeepCopy()
+
+		err := mockExternalClient.lookup(context.Background(), mgd)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(meta.GetExternalName(mgd)).To(gomega.Equal(tc.expectedExternalName), tc.description)
+	}
+
+	mgd := mockManaged.DeepCopy()
+	mgd.Spec.Lookup = &v1alpha3.LookupParameters{}
+	g.Expect(mockExternalClient.lookup(context.Background(), mgd)).NotTo(gomega.BeNil(), "an empty lookup should return error")
+	g.Expect(meta.GetExternalName(mgd)).To(gomega.Equal(""), "an empty lookup should adopt nothing")
+}
@@ -0,0 +1,492 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transitgateway
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	v1alpha3 "github.com/crossplane/provider-aws/apis/network/v1alpha3"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	mockExternalClient external
+	mockClient         fake.MockTransitGatewayClient
+
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+)
+
+func TestMain(m *testing.M) {
+
+	mockClient = fake.MockTransitGatewayClient{}
+	mockExternalClient = external{
+		client: &mockClient,
+		kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+	}
+
+	os.Exit(m.Run())
+}
+
+func Test_Connect(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := &v1alpha3.TransitGateway{}
+	var clientErr error
+	var configErr error
+
+	conn := connector{
+		client: nil,
+		newClientFn: func(conf *aws.Config) (ec2.TransitGatewayClient, error) {
+			return &mockClient, clientErr
+		},
+		awsConfigFn: func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error) {
+			return &aws.Config{}, configErr
+		},
+	}
+
+	for _, tc := range []struct {
+		description       string
+		managedObj        resource.Managed
+		configErr         error
+		clientErr         error
+		expectedClientNil bool
+		expectedErrNil    bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws config provider fails, should return error",
+			mockManaged,
+			errors.New("some error"),
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws client provider fails, should return error",
+			mockManaged,
+			nil,
+			errors.New("some error"),
+			true,
+			false,
+		},
+	} {
+		clientErr = tc.clientErr
+		configErr = tc.configErr
+
+		res, err := conn.Connect(context.Background(), tc.managedObj)
+		g.Expect(res == nil).To(gomega.Equal(tc.expectedClientNil), tc.description)
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+	}
+}
+
+func Test_Observe(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.TransitGateway{
+		Spec: v1alpha3.TransitGatewaySpec{
+			ForProvider: v1alpha3.TransitGatewayParameters{
+				Tags: []v1alpha3.Tag{{Key: "k", Value: "v"}},
+			},
+		},
+	}
+	meta.SetExternalName(&mockManaged, "some arbitrary id")
+
+	upToDate := awsec2.TransitGateway{
+		TransitGatewayId: aws.String("some arbitrary id"),
+		State:            awsec2.TransitGatewayStateAvailable,
+		Options: &awsec2.TransitGatewayOptions{
+			AmazonSideAsn: aws.Int64(64512),
+			DnsSupport:    awsec2.DnsSupportValueEnable,
+		},
+		Tags: []awsec2.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+	}
+	outdated := upToDate
+	outdated.Tags = nil
+	deleted := upToDate
+	deleted.State = awsec2.TransitGatewayStateDeleted
+
+	var mockClientErr error
+	var itemsList []awsec2.TransitGateway
+	mockClient.MockDescribeTransitGatewaysRequest = func(input *awsec2.DescribeTransitGatewaysInput) awsec2.DescribeTransitGatewaysRequest {
+		return awsec2.DescribeTransitGatewaysRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.DescribeTransitGatewaysOutput{TransitGateways: itemsList},
+				Error:       mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description           string
+		managedObj            resource.Managed
+		itemsReturned         []awsec2.TransitGateway
+		clientErr             error
+		expectedErrNil        bool
+		expectedResourceExist bool
+		expectedUpToDate      bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			[]awsec2.TransitGateway{upToDate},
+			nil,
+			true,
+			true,
+			true,
+		},
+		{
+			"missing tags should not be up to date",
+			mockManaged.DeepCopy(),
+			[]awsec2.TransitGateway{outdated},
+			nil,
+			true,
+			true,
+			false,
+		},
+		{
+			"a deleted transit gateway should not exist",
+			mockManaged.DeepCopy(),
+			[]awsec2.TransitGateway{deleted},
+			nil,
+			true,
+			false,
+			false,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			nil,
+			false,
+			false,
+			false,
+		},
+		{
+			"if item's identifier is not yet set, returns expected",
+			&v1alpha3.TransitGateway{},
+			nil,
+			nil,
+			true,
+			false,
+			false,
+		},
+		{
+			"if external resource doesn't exist, it should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			awserr.New(ec2.TransitGatewayIDNotFound, "", nil),
+			true,
+			false,
+			false,
+		},
+		{
+			"if external resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			nil,
+			errors.New("some error"),
+			false,
+			false,
+			false,
+		},
+		{
+			"if external resource returns a list with other than one item, it should return error",
+			mockManaged.DeepCopy(),
+			[]awsec2.TransitGateway{},
+			nil,
+			false,
+			false,
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+		itemsList = tc.itemsReturned
+
+		result, err := mockExternalClient.Observe(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(result.ResourceExists).To(gomega.Equal(tc.expectedResourceExist), tc.description)
+		g.Expect(result.ResourceUpToDate).To(gomega.Equal(tc.expectedUpToDate), tc.description)
+		if tc.expectedResourceExist {
+			mgd := tc.managedObj.(*v1alpha3.TransitGateway)
+			g.Expect(mgd.Status.Conditions[0].Type).To(gomega.Equal(corev1alpha1.TypeReady), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Status).To(gomega.Equal(corev1.ConditionTrue), tc.description)
+			g.Expect(aws.Int64Value(mgd.Spec.ForProvider.AmazonSideASN)).To(gomega.Equal(int64(64512)), tc.description)
+			g.Expect(aws.StringValue(mgd.Spec.ForProvider.DNSSupport)).To(gomega.Equal("enable"), tc.description)
+		}
+	}
+}
+
+func Test_Create(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.TransitGateway{
+		Spec: v1alpha3.TransitGatewaySpec{
+			ForProvider: v1alpha3.TransitGatewayParameters{
+				Description: aws.String("some description"),
+				Tags:        []v1alpha3.Tag{{Key: "k", Value: "v"}},
+			},
+		},
+	}
+	mockExternal := &awsec2.TransitGateway{
+		TransitGatewayId: aws.String("some arbitrary id"),
+		State:            awsec2.TransitGatewayStatePending,
+	}
+	var mockClientErr error
+	mockClient.MockCreateTransitGatewayRequest = func(input *awsec2.CreateTransitGatewayInput) awsec2.CreateTransitGatewayRequest {
+		g.Expect(aws.StringValue(input.Description)).To(gomega.Equal("some description"), "the passed parameters are not valid")
+		g.Expect(input.TagSpecifications).To(gomega.HaveLen(1), "the passed parameters are not valid")
+		return awsec2.CreateTransitGatewayRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.CreateTransitGatewayOutput{TransitGateway: mockExternal},
+				Error:       mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			false,
+		},
+		{
+			"if creating resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+
+		_, err := mockExternalClient.Create(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha3.TransitGateway)
+			g.Expect(meta.GetExternalName(mgd)).To(gomega.Equal(aws.StringValue(mockExternal.TransitGatewayId)), tc.description)
+			g.Expect(mgd.Status.AtProvider.State).To(gomega.Equal(string(awsec2.TransitGatewayStatePending)), tc.description)
+		}
+	}
+}
+
+func Test_Update(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.TransitGateway{
+		Spec: v1alpha3.TransitGatewaySpec{
+			ForProvider: v1alpha3.TransitGatewayParameters{
+				Tags: []v1alpha3.Tag{{Key: "k", Value: "v"}},
+			},
+		},
+		Status: v1alpha3.TransitGatewayStatus{
+			ManagedTagKeys: []string{"old"},
+		},
+	}
+	meta.SetExternalName(&mockManaged, "tgw-1")
+
+	mockClient.MockDescribeTransitGatewaysRequest = func(input *awsec2.DescribeTransitGatewaysInput) awsec2.DescribeTransitGatewaysRequest {
+		return awsec2.DescribeTransitGatewaysRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsec2.DescribeTransitGatewaysOutput{TransitGateways: []awsec2.TransitGateway{{
+					TransitGatewayId: aws.String("tgw-1"),
+					Tags: []awsec2.Tag{
+						{Key: aws.String("old"), Value: aws.String("v")},
+						{Key: aws.String("foreign"), Value: aws.String("v")},
+					},
+				}}},
+			},
+		}
+	}
+	var mockClientErr error
+	var added, removed []awsec2.Tag
+	mockClient.MockCreateTagsRequest = func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+		added = input.Tags
+		return awsec2.CreateTagsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.CreateTagsOutput{}, Error: mockClientErr},
+		}
+	}
+	mockClient.MockDeleteTagsRequest = func(input *awsec2.DeleteTagsInput) awsec2.DeleteTagsRequest {
+		removed = input.Tags
+		return awsec2.DeleteTagsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.DeleteTagsOutput{}},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should sync the tags",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			false,
+		},
+		{
+			"if creating tags fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+		added, removed = nil, nil
+
+		_, err := mockExternalClient.Update(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			g.Expect(added).To(gomega.Equal([]awsec2.Tag{{Key: aws.String("k"), Value: aws.String("v")}}), tc.description)
+			g.Expect(removed).To(gomega.Equal([]awsec2.Tag{{Key: aws.String("old")}}), tc.description)
+			g.Expect(tc.managedObj.(*v1alpha3.TransitGateway).Status.ManagedTagKeys).To(gomega.Equal([]string{"k"}), tc.description)
+		}
+	}
+}
+
+func Test_Delete(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.TransitGateway{}
+	meta.SetExternalName(&mockManaged, "tgw-1")
+	deleting := mockManaged.DeepCopy()
+	deleting.Status.AtProvider.State = string(awsec2.TransitGatewayStateDeleting)
+
+	var mockClientErr error
+	var called bool
+	mockClient.MockDeleteTransitGatewayRequest = func(input *awsec2.DeleteTransitGatewayInput) awsec2.DeleteTransitGatewayRequest {
+		called = true
+		return awsec2.DeleteTransitGatewayRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.DeleteTransitGatewayOutput{},
+				Error:       mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedErrNil bool
+		expectedCalled bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			false,
+			false,
+		},
+		{
+			"if the transit gateway is already deleting, it should not be deleted again",
+			deleting,
+			nil,
+			true,
+			false,
+		},
+		{
+			"if the resource doesn't exist, deleting resource should not return an error",
+			mockManaged.DeepCopy(),
+			awserr.New(ec2.TransitGatewayIDNotFound, "", nil),
+			true,
+			true,
+		},
+		{
+			"if deleting resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+			true,
+		},
+	} {
+		mockClientErr = tc.clientErr
+		called = false
+
+		err := mockExternalClient.Delete(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(called).To(gomega.Equal(tc.expectedCalled), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha3.TransitGateway)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonDeleting), tc.description)
+		}
+	}
+}
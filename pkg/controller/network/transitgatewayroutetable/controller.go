@@ -0,0 +1,332 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transitgatewayroutetable
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	v1alpha3 "github.com/crossplane/provider-aws/apis/network/v1alpha3"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/controller/utils"
+)
+
+const (
+	errUnexpectedObject    = "The managed resource is not a TransitGatewayRouteTable resource"
+	errClient              = "cannot create a new TransitGatewayRouteTableClient"
+	errDescribe            = "failed to describe TransitGatewayRouteTable"
+	errMultipleItems       = "retrieved multiple TransitGatewayRouteTables for the given transitGatewayRouteTableId"
+	errGetAssociations     = "failed to get the associations of the TransitGatewayRouteTable"
+	errGetPropagations     = "failed to get the propagations of the TransitGatewayRouteTable"
+	errCreate              = "failed to create the TransitGatewayRouteTable resource"
+	errPersistExternalName = "failed to persist TransitGatewayRouteTable ID"
+	errAssociate           = "failed to associate the attachment with the TransitGatewayRouteTable"
+	errDisassociate        = "failed to disassociate the attachment from the TransitGatewayRouteTable"
+	errEnablePropagation   = "failed to enable the propagation of the attachment to the TransitGatewayRouteTable"
+	errDisablePropagation  = "failed to disable the propagation of the attachment to the TransitGatewayRouteTable"
+	errCreateTags          = "failed to create tags for the TransitGatewayRouteTable resource"
+	errDeleteTags          = "failed to delete tags for the TransitGatewayRouteTable resource"
+	errDelete              = "failed to delete the TransitGatewayRouteTable resource"
+)
+
+// SetupTransitGatewayRouteTable adds a controller that reconciles TransitGatewayRouteTables.
+func SetupTransitGatewayRouteTable(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha3.TransitGatewayRouteTableGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha3.TransitGatewayRouteTable{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.TransitGatewayRouteTableGroupVersionKind),
+			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: ec2.NewTransitGatewayRouteTableClient, awsConfigFn: utils.RetrieveAwsConfigFromProvider}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	client      client.Client
+	newClientFn func(*aws.Config) (ec2.TransitGatewayRouteTableClient, error)
+	awsConfigFn func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error)
+}
+
+func (conn *connector) Connect(ctx context.Context, mgd resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mgd.(*v1alpha3.TransitGatewayRouteTable)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	awsconfig, err := conn.awsConfigFn(ctx, conn.client, cr.Spec.ProviderReference)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := conn.newClientFn(awsconfig)
+	if err != nil {
+		return nil, errors.Wrap(err, errClient)
+	}
+
+	return &external{kube: conn.client, client: c}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.TransitGatewayRouteTableClient
+}
+
+// observe returns the route table with the given ID along with its current
+// associations and propagations.
+func (e *external) observe(ctx context.Context, id string) (*awsec2.TransitGatewayRouteTable, v1alpha3.TransitGatewayRouteTableObservation, error) {
+	response, err := e.client.DescribeTransitGatewayRouteTablesRequest(&awsec2.DescribeTransitGatewayRouteTablesInput{
+		TransitGatewayRouteTableIds: []string{id},
+	}).Send(ctx)
+	if err != nil {
+		return nil, v1alpha3.TransitGatewayRouteTableObservation{}, errors.Wrap(err, errDescribe)
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.TransitGatewayRouteTables) != 1 {
+		return nil, v1alpha3.TransitGatewayRouteTableObservation{}, errors.New(errMultipleItems)
+	}
+	rt := &response.TransitGatewayRouteTables[0]
+
+	var associations []awsec2.TransitGatewayRouteTableAssociation
+	var token *string
+	for {
+		rsp, err := e.client.GetTransitGatewayRouteTableAssociationsRequest(&awsec2.GetTransitGatewayRouteTableAssociationsInput{
+			TransitGatewayRouteTableId: aws.String(id),
+			NextToken:                  token,
+		}).Send(ctx)
+		if err != nil {
+			return nil, v1alpha3.TransitGatewayRouteTableObservation{}, errors.Wrap(err, errGetAssociations)
+		}
+		associations = append(associations, rsp.Associations...)
+		if token = rsp.NextToken; token == nil {
+			break
+		}
+	}
+
+	var propagations []awsec2.TransitGatewayRouteTablePropagation
+	for {
+		rsp, err := e.client.GetTransitGatewayRouteTablePropagationsRequest(&awsec2.GetTransitGatewayRouteTablePropagationsInput{
+			TransitGatewayRouteTableId: aws.String(id),
+			NextToken:                  token,
+		}).Send(ctx)
+		if err != nil {
+			return nil, v1alpha3.TransitGatewayRouteTableObservation{}, errors.Wrap(err, errGetPropagations)
+		}
+		propagations = append(propagations, rsp.TransitGatewayRouteTablePropagations...)
+		if token = rsp.NextToken; token == nil {
+			break
+		}
+	}
+
+	return rt, ec2.GenerateTransitGatewayRouteTableObservation(*rt, associations, propagations), nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha3.TransitGatewayRouteTable)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// AWS network resources are uniquely identified by an ID that is returned
+	// on create time; we can't tell whether they exist unless we have recorded
+	// their ID.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	observed, o, err := e.observe(ctx, meta.GetExternalName(cr))
+	if ec2.IsTransitGatewayRouteTableNotFoundErr(errors.Cause(err)) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	// deleted route tables remain visible for a while.
+	if observed.State == awsec2.TransitGatewayRouteTableStateDeleted {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider = o
+
+	switch observed.State {
+	case awsec2.TransitGatewayRouteTableStateAvailable:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case awsec2.TransitGatewayRouteTableStatePending:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	case awsec2.TransitGatewayRouteTableStateDeleting:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ec2.IsTransitGatewayRouteTableUpToDate(cr.Spec.ForProvider, *observed, o, cr.Status.ManagedTagKeys),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha3.TransitGatewayRouteTable)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	rsp, err := e.client.CreateTransitGatewayRouteTableRequest(ec2.GenerateCreateTransitGatewayRouteTableInput(cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, aws.StringValue(rsp.TransitGatewayRouteTable.TransitGatewayRouteTableId))
+	if err := e.kube.Update(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errPersistExternalName)
+	}
+
+	cr.Status.AtProvider = ec2.GenerateTransitGatewayRouteTableObservation(*rsp.TransitGatewayRouteTable, nil, nil)
+	cr.Status.ManagedTagKeys = ec2.TagKeys(cr.Spec.ForProvider.Tags)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha3.TransitGatewayRouteTable)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, o, err := e.observe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if err := e.updateAttachments(ctx, cr, o); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	return managed.ExternalUpdate{}, e.updateTags(ctx, cr, observed)
+}
+
+func (e *external) updateAttachments(ctx context.Context, cr *v1alpha3.TransitGatewayRouteTable, o v1alpha3.TransitGatewayRouteTableObservation) error {
+	associate, disassociate, enable, disable := ec2.DiffTransitGatewayRouteTableAttachments(o, cr.Spec.ForProvider)
+	id := aws.String(meta.GetExternalName(cr))
+
+	for _, a := range disassociate {
+		if _, err := e.client.DisassociateTransitGatewayRouteTableRequest(&awsec2.DisassociateTransitGatewayRouteTableInput{
+			TransitGatewayRouteTableId: id,
+			TransitGatewayAttachmentId: aws.String(a),
+		}).Send(ctx); err != nil {
+			return errors.Wrap(err, errDisassociate)
+		}
+	}
+	for _, a := range associate {
+		if _, err := e.client.AssociateTransitGatewayRouteTableRequest(&awsec2.AssociateTransitGatewayRouteTableInput{
+			TransitGatewayRouteTableId: id,
+			TransitGatewayAttachmentId: aws.String(a),
+		}).Send(ctx); err != nil {
+			return errors.Wrap(err, errAssociate)
+		}
+	}
+	for _, a := range disable {
+		if _, err := e.client.DisableTransitGatewayRouteTablePropagationRequest(&awsec2.DisableTransitGatewayRouteTablePropagationInput{
+			TransitGatewayRouteTableId: id,
+			TransitGatewayAttachmentId: aws.String(a),
+		}).Send(ctx); err != nil {
+			return errors.Wrap(err, errDisablePropagation)
+		}
+	}
+	for _, a := range enable {
+		if _, err := e.client.EnableTransitGatewayRouteTablePropagationRequest(&awsec2.EnableTransitGatewayRouteTablePropagationInput{
+			TransitGatewayRouteTableId: id,
+			TransitGatewayAttachmentId: aws.String(a),
+		}).Send(ctx); err != nil {
+			return errors.Wrap(err, errEnablePropagation)
+		}
+	}
+	return nil
+}
+
+func (e *external) updateTags(ctx context.Context, cr *v1alpha3.TransitGatewayRouteTable, observed *awsec2.TransitGatewayRouteTable) error {
+	add, remove := ec2.DiffManagedEC2Tags(cr.Spec.ForProvider.Tags, observed.Tags, cr.Status.ManagedTagKeys)
+	if len(remove) > 0 {
+		if _, err := e.client.DeleteTagsRequest(&awsec2.DeleteTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      remove,
+		}).Send(ctx); err != nil {
+			return errors.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      add,
+		}).Send(ctx); err != nil {
+			return errors.Wrap(err, errCreateTags)
+		}
+	}
+	cr.Status.ManagedTagKeys = ec2.TagKeys(cr.Spec.ForProvider.Tags)
+	return nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha3.TransitGatewayRouteTable)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+
+	if cr.Status.AtProvider.State == string(awsec2.TransitGatewayRouteTableStateDeleting) {
+		return nil
+	}
+
+	// a route table cannot be deleted while attachments are associated with
+	// it.
+	for _, a := range cr.Status.AtProvider.Associations {
+		if a.State != string(awsec2.TransitGatewayAssociationStateAssociated) {
+			continue
+		}
+		_, err := e.client.DisassociateTransitGatewayRouteTableRequest(&awsec2.DisassociateTransitGatewayRouteTableInput{
+			TransitGatewayRouteTableId: aws.String(meta.GetExternalName(cr)),
+			TransitGatewayAttachmentId: aws.String(a.AttachmentID),
+		}).Send(ctx)
+		if resource.Ignore(ec2.IsTransitGatewayRouteTableNotFoundErr, err) != nil {
+			return errors.Wrap(err, errDisassociate)
+		}
+	}
+
+	_, err := e.client.DeleteTransitGatewayRouteTableRequest(&awsec2.DeleteTransitGatewayRouteTableInput{
+		TransitGatewayRouteTableId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(ec2.IsTransitGatewayRouteTableNotFoundErr, err), errDelete)
+}
@@ -0,0 +1,553 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transitgatewayroutetable
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	v1alpha3 "github.com/crossplane/provider-aws/apis/network/v1alpha3"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	mockExternalClient external
+	mockClient         fake.MockTransitGatewayRouteTableClient
+
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+)
+
+func TestMain(m *testing.M) {
+
+	mockClient = fake.MockTransitGatewayRouteTableClient{}
+	mockExternalClient = external{
+		client: &mockClient,
+		kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+	}
+
+	os.Exit(m.Run())
+}
+
+func Test_Connect(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := &v1alpha3.TransitGatewayRouteTable{}
+	var clientErr error
+	var configErr error
+
+	conn := connector{
+		client: nil,
+		newClientFn: func(conf *aws.Config) (ec2.TransitGatewayRouteTableClient, error) {
+			return &mockClient, clientErr
+		},
+		awsConfigFn: func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error) {
+			return &aws.Config{}, configErr
+		},
+	}
+
+	for _, tc := range []struct {
+		description       string
+		managedObj        resource.Managed
+		configErr         error
+		clientErr         error
+		expectedClientNil bool
+		expectedErrNil    bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws config provider fails, should return error",
+			mockManaged,
+			errors.New("some error"),
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws client provider fails, should return error",
+			mockManaged,
+			nil,
+			errors.New("some error"),
+			true,
+			false,
+		},
+	} {
+		clientErr = tc.clientErr
+		configErr = tc.configErr
+
+		res, err := conn.Connect(context.Background(), tc.managedObj)
+		g.Expect(res == nil).To(gomega.Equal(tc.expectedClientNil), tc.description)
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+	}
+}
+
+func mockAttachments(associations []awsec2.TransitGatewayRouteTableAssociation, propagations []awsec2.TransitGatewayRouteTablePropagation) {
+	mockClient.MockGetTransitGatewayRouteTableAssociationsRequest = func(input *awsec2.GetTransitGatewayRouteTableAssociationsInput) awsec2.GetTransitGatewayRouteTableAssociationsRequest {
+		return awsec2.GetTransitGatewayRouteTableAssociationsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.GetTransitGatewayRouteTableAssociationsOutput{Associations: associations}},
+		}
+	}
+	mockClient.MockGetTransitGatewayRouteTablePropagationsRequest = func(input *awsec2.GetTransitGatewayRouteTablePropagationsInput) awsec2.GetTransitGatewayRouteTablePropagationsRequest {
+		return awsec2.GetTransitGatewayRouteTablePropagationsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.GetTransitGatewayRouteTablePropagationsOutput{TransitGatewayRouteTablePropagations: propagations}},
+		}
+	}
+}
+
+func Test_Observe(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.TransitGatewayRouteTable{
+		Spec: v1alpha3.TransitGatewayRouteTableSpec{
+			ForProvider: v1alpha3.TransitGatewayRouteTableParameters{
+				AssociatedAttachmentIDs: []string{"tgw-attach-1"},
+			},
+		},
+	}
+	meta.SetExternalName(&mockManaged, "some arbitrary id")
+
+	available := awsec2.TransitGatewayRouteTable{
+		TransitGatewayRouteTableId: aws.String("some arbitrary id"),
+		State:                      awsec2.TransitGatewayRouteTableStateAvailable,
+	}
+	deleted := available
+	deleted.State = awsec2.TransitGatewayRouteTableStateDeleted
+
+	var mockClientErr error
+	var itemsList []awsec2.TransitGatewayRouteTable
+	mockClient.MockDescribeTransitGatewayRouteTablesRequest = func(input *awsec2.DescribeTransitGatewayRouteTablesInput) awsec2.DescribeTransitGatewayRouteTablesRequest {
+		return awsec2.DescribeTransitGatewayRouteTablesRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.DescribeTransitGatewayRouteTablesOutput{TransitGatewayRouteTables: itemsList},
+				Error:       mockClientErr,
+			},
+		}
+	}
+	associated := []awsec2.TransitGatewayRouteTableAssociation{
+		{TransitGatewayAttachmentId: aws.String("tgw-attach-1"), State: awsec2.TransitGatewayAssociationStateAssociated},
+	}
+
+	for _, tc := range []struct {
+		description           string
+		managedObj            resource.Managed
+		itemsReturned         []awsec2.TransitGatewayRouteTable
+		associations          []awsec2.TransitGatewayRouteTableAssociation
+		clientErr             error
+		expectedErrNil        bool
+		expectedResourceExist bool
+		expectedUpToDate      bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			[]awsec2.TransitGatewayRouteTable{available},
+			associated,
+			nil,
+			true,
+			true,
+			true,
+		},
+		{
+			"missing association should not be up to date",
+			mockManaged.DeepCopy(),
+			[]awsec2.TransitGatewayRouteTable{available},
+			nil,
+			nil,
+			true,
+			true,
+			false,
+		},
+		{
+			"a deleted route table should not exist",
+			mockManaged.DeepCopy(),
+			[]awsec2.TransitGatewayRouteTable{deleted},
+			nil,
+			nil,
+			true,
+			false,
+			false,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+			false,
+		},
+		{
+			"if item's identifier is not yet set, returns expected",
+			&v1alpha3.TransitGatewayRouteTable{},
+			nil,
+			nil,
+			nil,
+			true,
+			false,
+			false,
+		},
+		{
+			"if external resource doesn't exist, it should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			nil,
+			awserr.New(ec2.TransitGatewayRouteTableIDNotFound, "", nil),
+			true,
+			false,
+			false,
+		},
+		{
+			"if external resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			nil,
+			nil,
+			errors.New("some error"),
+			false,
+			false,
+			false,
+		},
+		{
+			"if external resource returns a list with other than one item, it should return error",
+			mockManaged.DeepCopy(),
+			[]awsec2.TransitGatewayRouteTable{},
+			nil,
+			nil,
+			false,
+			false,
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+		itemsList = tc.itemsReturned
+		mockAttachments(tc.associations, nil)
+
+		result, err := mockExternalClient.Observe(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(result.ResourceExists).To(gomega.Equal(tc.expectedResourceExist), tc.description)
+		g.Expect(result.ResourceUpToDate).To(gomega.Equal(tc.expectedUpToDate), tc.description)
+		if tc.expectedResourceExist {
+			mgd := tc.managedObj.(*v1alpha3.TransitGatewayRouteTable)
+			g.Expect(mgd.Status.Conditions[0].Type).To(gomega.Equal(corev1alpha1.TypeReady), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Status).To(gomega.Equal(corev1.ConditionTrue), tc.description)
+			g.Expect(mgd.Status.AtProvider.Associations).To(gomega.HaveLen(len(tc.associations)), tc.description)
+		}
+	}
+}
+
+func Test_Create(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.TransitGatewayRouteTable{
+		Spec: v1alpha3.TransitGatewayRouteTableSpec{
+			ForProvider: v1alpha3.TransitGatewayRouteTableParameters{
+				TransitGatewayID: aws.String("tgw-1"),
+			},
+		},
+	}
+	mockExternal := &awsec2.TransitGatewayRouteTable{
+		TransitGatewayRouteTableId: aws.String("some arbitrary id"),
+		State:                      awsec2.TransitGatewayRouteTableStatePending,
+	}
+	var mockClientErr error
+	mockClient.MockCreateTransitGatewayRouteTableRequest = func(input *awsec2.CreateTransitGatewayRouteTableInput) awsec2.CreateTransitGatewayRouteTableRequest {
+		g.Expect(aws.StringValue(input.TransitGatewayId)).To(gomega.Equal("tgw-1"), "the passed parameters are not valid")
+		return awsec2.CreateTransitGatewayRouteTableRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.CreateTransitGatewayRouteTableOutput{TransitGatewayRouteTable: mockExternal},
+				Error:       mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			false,
+		},
+		{
+			"if creating resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+
+		_, err := mockExternalClient.Create(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha3.TransitGatewayRouteTable)
+			g.Expect(meta.GetExternalName(mgd)).To(gomega.Equal(aws.StringValue(mockExternal.TransitGatewayRouteTableId)), tc.description)
+		}
+	}
+}
+
+func Test_Update(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.TransitGatewayRouteTable{
+		Spec: v1alpha3.TransitGatewayRouteTableSpec{
+			ForProvider: v1alpha3.TransitGatewayRouteTableParameters{
+				AssociatedAttachmentIDs: []string{"tgw-attach-new"},
+				PropagatedAttachmentIDs: []string{"tgw-attach-new"},
+				Tags:                    []v1alpha3.Tag{{Key: "k", Value: "v"}},
+			},
+		},
+		Status: v1alpha3.TransitGatewayRouteTableStatus{
+			ManagedTagKeys: []string{"old"},
+		},
+	}
+	meta.SetExternalName(&mockManaged, "tgw-rtb-1")
+
+	mockClient.MockDescribeTransitGatewayRouteTablesRequest = func(input *awsec2.DescribeTransitGatewayRouteTablesInput) awsec2.DescribeTransitGatewayRouteTablesRequest {
+		return awsec2.DescribeTransitGatewayRouteTablesRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsec2.DescribeTransitGatewayRouteTablesOutput{TransitGatewayRouteTables: []awsec2.TransitGatewayRouteTable{{
+					TransitGatewayRouteTableId: aws.String("tgw-rtb-1"),
+					State:                      awsec2.TransitGatewayRouteTableStateAvailable,
+					Tags: []awsec2.Tag{
+						{Key: aws.String("old"), Value: aws.String("v")},
+						{Key: aws.String("foreign"), Value: aws.String("v")},
+					},
+				}}},
+			},
+		}
+	}
+	mockAttachments(
+		[]awsec2.TransitGatewayRouteTableAssociation{
+			{TransitGatewayAttachmentId: aws.String("tgw-attach-old"), State: awsec2.TransitGatewayAssociationStateAssociated},
+		},
+		[]awsec2.TransitGatewayRouteTablePropagation{
+			{TransitGatewayAttachmentId: aws.String("tgw-attach-old"), State: awsec2.TransitGatewayPropagationStateEnabled},
+		},
+	)
+	var calls []string
+	var mockClientErr error
+	mockClient.MockDisassociateTransitGatewayRouteTableRequest = func(input *awsec2.DisassociateTransitGatewayRouteTableInput) awsec2.DisassociateTransitGatewayRouteTableRequest {
+		calls = append(calls, "disassociate "+aws.StringValue(input.TransitGatewayAttachmentId))
+		return awsec2.DisassociateTransitGatewayRouteTableRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.DisassociateTransitGatewayRouteTableOutput{}},
+		}
+	}
+	mockClient.MockAssociateTransitGatewayRouteTableRequest = func(input *awsec2.AssociateTransitGatewayRouteTableInput) awsec2.AssociateTransitGatewayRouteTableRequest {
+		calls = append(calls, "associate "+aws.StringValue(input.TransitGatewayAttachmentId))
+		return awsec2.AssociateTransitGatewayRouteTableRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.AssociateTransitGatewayRouteTableOutput{}, Error: mockClientErr},
+		}
+	}
+	mockClient.MockDisableTransitGatewayRouteTablePropagationRequest = func(input *awsec2.DisableTransitGatewayRouteTablePropagationInput) awsec2.DisableTransitGatewayRouteTablePropagationRequest {
+		calls = append(calls, "disable "+aws.StringValue(input.TransitGatewayAttachmentId))
+		return awsec2.DisableTransitGatewayRouteTablePropagationRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.DisableTransitGatewayRouteTablePropagationOutput{}},
+		}
+	}
+	mockClient.MockEnableTransitGatewayRouteTablePropagationRequest = func(input *awsec2.EnableTransitGatewayRouteTablePropagationInput) awsec2.EnableTransitGatewayRouteTablePropagationRequest {
+		calls = append(calls, "enable "+aws.StringValue(input.TransitGatewayAttachmentId))
+		return awsec2.EnableTransitGatewayRouteTablePropagationRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.EnableTransitGatewayRouteTablePropagationOutput{}},
+		}
+	}
+	mockClient.MockDeleteTagsRequest = func(input *awsec2.DeleteTagsInput) awsec2.DeleteTagsRequest {
+		for _, tag := range input.Tags {
+			calls = append(calls, "untag "+aws.StringValue(tag.Key))
+		}
+		return awsec2.DeleteTagsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.DeleteTagsOutput{}},
+		}
+	}
+	mockClient.MockCreateTagsRequest = func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+		for _, tag := range input.Tags {
+			calls = append(calls, "tag "+aws.StringValue(tag.Key))
+		}
+		return awsec2.CreateTagsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.CreateTagsOutput{}},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should reconcile associations, propagations and tags",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			false,
+		},
+		{
+			"if associating an attachment fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+		calls = nil
+
+		_, err := mockExternalClient.Update(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			g.Expect(calls).To(gomega.Equal([]string{
+				"disassociate tgw-attach-old",
+				"associate tgw-attach-new",
+				"disable tgw-attach-old",
+				"enable tgw-attach-new",
+				"untag old",
+				"tag k",
+			}), tc.description)
+			g.Expect(tc.managedObj.(*v1alpha3.TransitGatewayRouteTable).Status.ManagedTagKeys).To(gomega.Equal([]string{"k"}), tc.description)
+		}
+	}
+}
+
+func Test_Delete(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.TransitGatewayRouteTable{
+		Status: v1alpha3.TransitGatewayRouteTableStatus{
+			AtProvider: v1alpha3.TransitGatewayRouteTableObservation{
+				Associations: []v1alpha3.TransitGatewayRouteTableAttachment{
+					{AttachmentID: "tgw-attach-1", State: string(awsec2.TransitGatewayAssociationStateAssociated)},
+				},
+			},
+		},
+	}
+	meta.SetExternalName(&mockManaged, "tgw-rtb-1")
+
+	var disassociated bool
+	mockClient.MockDisassociateTransitGatewayRouteTableRequest = func(input *awsec2.DisassociateTransitGatewayRouteTableInput) awsec2.DisassociateTransitGatewayRouteTableRequest {
+		disassociated = aws.StringValue(input.TransitGatewayAttachmentId) == "tgw-attach-1"
+		return awsec2.DisassociateTransitGatewayRouteTableRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.DisassociateTransitGatewayRouteTableOutput{}},
+		}
+	}
+	var mockClientErr error
+	mockClient.MockDeleteTransitGatewayRouteTableRequest = func(input *awsec2.DeleteTransitGatewayRouteTableInput) awsec2.DeleteTransitGatewayRouteTableRequest {
+		return awsec2.DeleteTransitGatewayRouteTableRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.DeleteTransitGatewayRouteTableOutput{},
+				Error:       mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should disassociate the attachments and delete",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			false,
+		},
+		{
+			"if the resource doesn't exist, deleting resource should not return an error",
+			mockManaged.DeepCopy(),
+			awserr.New(ec2.TransitGatewayRouteTableIDNotFound, "", nil),
+			true,
+		},
+		{
+			"if deleting resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+		disassociated = false
+
+		err := mockExternalClient.Delete(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.managedObj != unexpectedItem {
+			g.Expect(disassociated).To(gomega.BeTrue(), tc.description)
+		}
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha3.TransitGatewayRouteTable)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonDeleting), tc.description)
+		}
+	}
+}
@@ -0,0 +1,256 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transitgatewayvpcattachment
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	v1alpha3 "github.com/crossplane/provider-aws/apis/network/v1alpha3"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/controller/utils"
+)
+
+const (
+	errUnexpectedObject    = "The managed resource is not a TransitGatewayVPCAttachment resource"
+	errClient              = "cannot create a new TransitGatewayVPCAttachmentClient"
+	errDescribe            = "failed to describe TransitGatewayVPCAttachment"
+	errMultipleItems       = "retrieved multiple TransitGatewayVPCAttachments for the given transitGatewayAttachmentId"
+	errSpecUpdate          = "cannot update TransitGatewayVPCAttachment custom resource"
+	errCreate              = "failed to create the TransitGatewayVPCAttachment resource"
+	errPersistExternalName = "failed to persist TransitGatewayVPCAttachment ID"
+	errModify              = "failed to modify the TransitGatewayVPCAttachment resource"
+	errCreateTags          = "failed to create tags for the TransitGatewayVPCAttachment resource"
+	errDeleteTags          = "failed to delete tags for the TransitGatewayVPCAttachment resource"
+	errDelete              = "failed to delete the TransitGatewayVPCAttachment resource"
+)
+
+// SetupTransitGatewayVPCAttachment adds a controller that reconciles TransitGatewayVPCAttachments.
+func SetupTransitGatewayVPCAttachment(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha3.TransitGatewayVPCAttachmentGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha3.TransitGatewayVPCAttachment{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.TransitGatewayVPCAttachmentGroupVersionKind),
+			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: ec2.NewTransitGatewayVPCAttachmentClient, awsConfigFn: utils.RetrieveAwsConfigFromProvider}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	client      client.Client
+	newClientFn func(*aws.Config) (ec2.TransitGatewayVPCAttachmentClient, error)
+	awsConfigFn func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error)
+}
+
+func (conn *connector) Connect(ctx context.Context, mgd resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mgd.(*v1alpha3.TransitGatewayVPCAttachment)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	awsconfig, err := conn.awsConfigFn(ctx, conn.client, cr.Spec.ProviderReference)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := conn.newClientFn(awsconfig)
+	if err != nil {
+		return nil, errors.Wrap(err, errClient)
+	}
+
+	return &external{kube: conn.client, client: c}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.TransitGatewayVPCAttachmentClient
+}
+
+func (e *external) describe(ctx context.Context, id string) (*awsec2.TransitGatewayVpcAttachment, error) {
+	response, err := e.client.DescribeTransitGatewayVpcAttachmentsRequest(&awsec2.DescribeTransitGatewayVpcAttachmentsInput{
+		TransitGatewayAttachmentIds: []string{id},
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.TransitGatewayVpcAttachments) != 1 {
+		return nil, errors.New(errMultipleItems)
+	}
+	return &response.TransitGatewayVpcAttachments[0], nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha3.TransitGatewayVPCAttachment)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// AWS network resources are uniquely identified by an ID that is returned
+	// on create time; we can't tell whether they exist unless we have recorded
+	// their ID.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if ec2.IsTransitGatewayAttachmentNotFoundErr(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribe)
+	}
+
+	// deleted attachments remain visible for a while.
+	if observed.State == awsec2.TransitGatewayAttachmentStateDeleted {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeTransitGatewayVPCAttachment(&cr.Spec.ForProvider, observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = ec2.GenerateTransitGatewayVPCAttachmentObservation(*observed)
+
+	switch observed.State {
+	case awsec2.TransitGatewayAttachmentStateAvailable, awsec2.TransitGatewayAttachmentStateModifying:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case awsec2.TransitGatewayAttachmentStateInitiating, awsec2.TransitGatewayAttachmentStatePending, awsec2.TransitGatewayAttachmentStatePendingAcceptance:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	case awsec2.TransitGatewayAttachmentStateDeleting:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+	default:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	// an attachment can only be modified once it is available.
+	upToDate := observed.State != awsec2.TransitGatewayAttachmentStateAvailable ||
+		ec2.IsTransitGatewayVPCAttachmentUpToDate(cr.Spec.ForProvider, *observed, cr.Status.ManagedTagKeys)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha3.TransitGatewayVPCAttachment)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	rsp, err := e.client.CreateTransitGatewayVpcAttachmentRequest(ec2.GenerateCreateTransitGatewayVPCAttachmentInput(cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, aws.StringValue(rsp.TransitGatewayVpcAttachment.TransitGatewayAttachmentId))
+	if err := e.kube.Update(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errPersistExternalName)
+	}
+
+	cr.Status.AtProvider = ec2.GenerateTransitGatewayVPCAttachmentObservation(*rsp.TransitGatewayVpcAttachment)
+	cr.Status.ManagedTagKeys = ec2.TagKeys(cr.Spec.ForProvider.Tags)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha3.TransitGatewayVPCAttachment)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+
+	if in := ec2.GenerateModifyTransitGatewayVPCAttachmentInput(meta.GetExternalName(cr), cr.Spec.ForProvider, *observed); in != nil {
+		if _, err := e.client.ModifyTransitGatewayVpcAttachmentRequest(in).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errModify)
+		}
+	}
+
+	return managed.ExternalUpdate{}, e.updateTags(ctx, cr, observed)
+}
+
+func (e *external) updateTags(ctx context.Context, cr *v1alpha3.TransitGatewayVPCAttachment, observed *awsec2.TransitGatewayVpcAttachment) error {
+	add, remove := ec2.DiffManagedEC2Tags(cr.Spec.ForProvider.Tags, observed.Tags, cr.Status.ManagedTagKeys)
+	if len(remove) > 0 {
+		if _, err := e.client.DeleteTagsRequest(&awsec2.DeleteTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      remove,
+		}).Send(ctx); err != nil {
+			return errors.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      add,
+		}).Send(ctx); err != nil {
+			return errors.Wrap(err, errCreateTags)
+		}
+	}
+	cr.Status.ManagedTagKeys = ec2.TagKeys(cr.Spec.ForProvider.Tags)
+	return nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha3.TransitGatewayVPCAttachment)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+
+	if cr.Status.AtProvider.State == string(awsec2.TransitGatewayAttachmentStateDeleting) {
+		return nil
+	}
+
+	_, err := e.client.DeleteTransitGatewayVpcAttachmentRequest(&awsec2.DeleteTransitGatewayVpcAttachmentInput{
+		TransitGatewayAttachmentId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(ec2.IsTransitGatewayAttachmentNotFoundErr, err), errDelete)
+}
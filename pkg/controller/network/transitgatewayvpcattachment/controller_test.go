@@ -0,0 +1,513 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transitgatewayvpcattachment
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	v1alpha3 "github.com/crossplane/provider-aws/apis/network/v1alpha3"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	mockExternalClient external
+	mockClient         fake.MockTransitGatewayVPCAttachmentClient
+
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+)
+
+func TestMain(m *testing.M) {
+
+	mockClient = fake.MockTransitGatewayVPCAttachmentClient{}
+	mockExternalClient = external{
+		client: &mockClient,
+		kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+	}
+
+	os.Exit(m.Run())
+}
+
+func Test_Connect(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := &v1alpha3.TransitGatewayVPCAttachment{}
+	var clientErr error
+	var configErr error
+
+	conn := connector{
+		client: nil,
+		newClientFn: func(conf *aws.Config) (ec2.TransitGatewayVPCAttachmentClient, error) {
+			return &mockClient, clientErr
+		},
+		awsConfigFn: func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error) {
+			return &aws.Config{}, configErr
+		},
+	}
+
+	for _, tc := range []struct {
+		description       string
+		managedObj        resource.Managed
+		configErr         error
+		clientErr         error
+		expectedClientNil bool
+		expectedErrNil    bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws config provider fails, should return error",
+			mockManaged,
+			errors.New("some error"),
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws client provider fails, should return error",
+			mockManaged,
+			nil,
+			errors.New("some error"),
+			true,
+			false,
+		},
+	} {
+		clientErr = tc.clientErr
+		configErr = tc.configErr
+
+		res, err := conn.Connect(context.Background(), tc.managedObj)
+		g.Expect(res == nil).To(gomega.Equal(tc.expectedClientNil), tc.description)
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+	}
+}
+
+func Test_Observe(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.TransitGatewayVPCAttachment{
+		Spec: v1alpha3.TransitGatewayVPCAttachmentSpec{
+			ForProvider: v1alpha3.TransitGatewayVPCAttachmentParameters{
+				SubnetIDs: []string{"subnet-1"},
+			},
+		},
+	}
+	meta.SetExternalName(&mockManaged, "some arbitrary id")
+
+	upToDate := awsec2.TransitGatewayVpcAttachment{
+		TransitGatewayAttachmentId: aws.String("some arbitrary id"),
+		State:                      awsec2.TransitGatewayAttachmentStateAvailable,
+		SubnetIds:                  []string{"subnet-1"},
+		Options: &awsec2.TransitGatewayVpcAttachmentOptions{
+			DnsSupport:  awsec2.DnsSupportValueEnable,
+			Ipv6Support: awsec2.Ipv6SupportValueDisable,
+		},
+	}
+	outdated := upToDate
+	outdated.SubnetIds = []string{"subnet-2"}
+	pending := outdated
+	pending.State = awsec2.TransitGatewayAttachmentStatePending
+	deleted := upToDate
+	deleted.State = awsec2.TransitGatewayAttachmentStateDeleted
+
+	var mockClientErr error
+	var itemsList []awsec2.TransitGatewayVpcAttachment
+	mockClient.MockDescribeTransitGatewayVpcAttachmentsRequest = func(input *awsec2.DescribeTransitGatewayVpcAttachmentsInput) awsec2.DescribeTransitGatewayVpcAttachmentsRequest {
+		return awsec2.DescribeTransitGatewayVpcAttachmentsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.DescribeTransitGatewayVpcAttachmentsOutput{TransitGatewayVpcAttachments: itemsList},
+				Error:       mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description           string
+		managedObj            resource.Managed
+		itemsReturned         []awsec2.TransitGatewayVpcAttachment
+		clientErr             error
+		expectedErrNil        bool
+		expectedResourceExist bool
+		expectedUpToDate      bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			[]awsec2.TransitGatewayVpcAttachment{upToDate},
+			nil,
+			true,
+			true,
+			true,
+		},
+		{
+			"different subnets should not be up to date",
+			mockManaged.DeepCopy(),
+			[]awsec2.TransitGatewayVpcAttachment{outdated},
+			nil,
+			true,
+			true,
+			false,
+		},
+		{
+			"a pending attachment should not be modified",
+			mockManaged.DeepCopy(),
+			[]awsec2.TransitGatewayVpcAttachment{pending},
+			nil,
+			true,
+			true,
+			true,
+		},
+		{
+			"a deleted attachment should not exist",
+			mockManaged.DeepCopy(),
+			[]awsec2.TransitGatewayVpcAttachment{deleted},
+			nil,
+			true,
+			false,
+			false,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			nil,
+			false,
+			false,
+			false,
+		},
+		{
+			"if item's identifier is not yet set, returns expected",
+			&v1alpha3.TransitGatewayVPCAttachment{},
+			nil,
+			nil,
+			true,
+			false,
+			false,
+		},
+		{
+			"if external resource doesn't exist, it should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			awserr.New(ec2.TransitGatewayAttachmentIDNotFound, "", nil),
+			true,
+			false,
+			false,
+		},
+		{
+			"if external resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			nil,
+			errors.New("some error"),
+			false,
+			false,
+			false,
+		},
+		{
+			"if external resource returns a list with other than one item, it should return error",
+			mockManaged.DeepCopy(),
+			[]awsec2.TransitGatewayVpcAttachment{},
+			nil,
+			false,
+			false,
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+		itemsList = tc.itemsReturned
+
+		result, err := mockExternalClient.Observe(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(result.ResourceExists).To(gomega.Equal(tc.expectedResourceExist), tc.description)
+		g.Expect(result.ResourceUpToDate).To(gomega.Equal(tc.expectedUpToDate), tc.description)
+		if tc.expectedResourceExist {
+			mgd := tc.managedObj.(*v1alpha3.TransitGatewayVPCAttachment)
+			g.Expect(mgd.Status.Conditions[0].Type).To(gomega.Equal(corev1alpha1.TypeReady), tc.description)
+			g.Expect(aws.StringValue(mgd.Spec.ForProvider.DNSSupport)).To(gomega.Equal("enable"), tc.description)
+			g.Expect(aws.StringValue(mgd.Spec.ForProvider.IPv6Support)).To(gomega.Equal("disable"), tc.description)
+		}
+	}
+}
+
+func Test_Create(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.TransitGatewayVPCAttachment{
+		Spec: v1alpha3.TransitGatewayVPCAttachmentSpec{
+			ForProvider: v1alpha3.TransitGatewayVPCAttachmentParameters{
+				TransitGatewayID: aws.String("tgw-1"),
+				VPCID:            aws.String("vpc-1"),
+				SubnetIDs:        []string{"subnet-1"},
+			},
+		},
+	}
+	mockExternal := &awsec2.TransitGatewayVpcAttachment{
+		TransitGatewayAttachmentId: aws.String("some arbitrary id"),
+		State:                      awsec2.TransitGatewayAttachmentStatePending,
+	}
+	var mockClientErr error
+	mockClient.MockCreateTransitGatewayVpcAttachmentRequest = func(input *awsec2.CreateTransitGatewayVpcAttachmentInput) awsec2.CreateTransitGatewayVpcAttachmentRequest {
+		g.Expect(aws.StringValue(input.TransitGatewayId)).To(gomega.Equal("tgw-1"), "the passed parameters are not valid")
+		g.Expect(aws.StringValue(input.VpcId)).To(gomega.Equal("vpc-1"), "the passed parameters are not valid")
+		g.Expect(input.SubnetIds).To(gomega.Equal([]string{"subnet-1"}), "the passed parameters are not valid")
+		return awsec2.CreateTransitGatewayVpcAttachmentRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.CreateTransitGatewayVpcAttachmentOutput{TransitGatewayVpcAttachment: mockExternal},
+				Error:       mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			false,
+		},
+		{
+			"if creating resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+
+		_, err := mockExternalClient.Create(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha3.TransitGatewayVPCAttachment)
+			g.Expect(meta.GetExternalName(mgd)).To(gomega.Equal(aws.StringValue(mockExternal.TransitGatewayAttachmentId)), tc.description)
+		}
+	}
+}
+
+func Test_Update(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.TransitGatewayVPCAttachment{
+		Spec: v1alpha3.TransitGatewayVPCAttachmentSpec{
+			ForProvider: v1alpha3.TransitGatewayVPCAttachmentParameters{
+				SubnetIDs:  []string{"subnet-1", "subnet-new"},
+				DNSSupport: aws.String("disable"),
+				Tags:       []v1alpha3.Tag{{Key: "k", Value: "v"}},
+			},
+		},
+	}
+	meta.SetExternalName(&mockManaged, "tgw-attach-1")
+
+	mockClient.MockDescribeTransitGatewayVpcAttachmentsRequest = func(input *awsec2.DescribeTransitGatewayVpcAttachmentsInput) awsec2.DescribeTransitGatewayVpcAttachmentsRequest {
+		return awsec2.DescribeTransitGatewayVpcAttachmentsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsec2.DescribeTransitGatewayVpcAttachmentsOutput{TransitGatewayVpcAttachments: []awsec2.TransitGatewayVpcAttachment{{
+					TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+					SubnetIds:                  []string{"subnet-1", "subnet-old"},
+					Options:                    &awsec2.TransitGatewayVpcAttachmentOptions{DnsSupport: awsec2.DnsSupportValueEnable},
+					Tags:                       []awsec2.Tag{{Key: aws.String("foreign"), Value: aws.String("v")}},
+				}}},
+			},
+		}
+	}
+	var mockClientErr error
+	var modified *awsec2.ModifyTransitGatewayVpcAttachmentInput
+	mockClient.MockModifyTransitGatewayVpcAttachmentRequest = func(input *awsec2.ModifyTransitGatewayVpcAttachmentInput) awsec2.ModifyTransitGatewayVpcAttachmentRequest {
+		modified = input
+		return awsec2.ModifyTransitGatewayVpcAttachmentRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.ModifyTransitGatewayVpcAttachmentOutput{}, Error: mockClientErr},
+		}
+	}
+	var tagged bool
+	mockClient.MockCreateTagsRequest = func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+		tagged = true
+		return awsec2.CreateTagsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.CreateTagsOutput{}},
+		}
+	}
+	var untagged bool
+	mockClient.MockDeleteTagsRequest = func(input *awsec2.DeleteTagsInput) awsec2.DeleteTagsRequest {
+		untagged = true
+		return awsec2.DeleteTagsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.DeleteTagsOutput{}},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should modify the attachment and sync the tags",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			false,
+		},
+		{
+			"if modifying the attachment fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+		modified = nil
+		tagged, untagged = false, false
+
+		_, err := mockExternalClient.Update(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			g.Expect(modified.AddSubnetIds).To(gomega.Equal([]string{"subnet-new"}), tc.description)
+			g.Expect(modified.RemoveSubnetIds).To(gomega.Equal([]string{"subnet-old"}), tc.description)
+			g.Expect(modified.Options.DnsSupport).To(gomega.Equal(awsec2.DnsSupportValueDisable), tc.description)
+			g.Expect(tagged).To(gomega.BeTrue(), tc.description)
+			g.Expect(untagged).To(gomega.BeFalse(), "tags that were not set from the spec should be kept")
+			g.Expect(tc.managedObj.(*v1alpha3.TransitGatewayVPCAttachment).Status.ManagedTagKeys).To(gomega.Equal([]string{"k"}), tc.description)
+		}
+	}
+}
+
+func Test_Delete(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.TransitGatewayVPCAttachment{}
+	meta.SetExternalName(&mockManaged, "tgw-attach-1")
+	deleting := mockManaged.DeepCopy()
+	deleting.Status.AtProvider.State = string(awsec2.TransitGatewayAttachmentStateDeleting)
+
+	var mockClientErr error
+	var called bool
+	mockClient.MockDeleteTransitGatewayVpcAttachmentRequest = func(input *awsec2.DeleteTransitGatewayVpcAttachmentInput) awsec2.DeleteTransitGatewayVpcAttachmentRequest {
+		called = true
+		return awsec2.DeleteTransitGatewayVpcAttachmentRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.DeleteTransitGatewayVpcAttachmentOutput{},
+				Error:       mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedErrNil bool
+		expectedCalled bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			false,
+			false,
+		},
+		{
+			"if the attachment is already deleting, it should not be deleted again",
+			deleting,
+			nil,
+			true,
+			false,
+		},
+		{
+			"if the resource doesn't exist, deleting resource should not return an error",
+			mockManaged.DeepCopy(),
+			awserr.New(ec2.TransitGatewayAttachmentIDNotFound, "", nil),
+			true,
+			true,
+		},
+		{
+			"if deleting resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+			true,
+		},
+	} {
+		mockClientErr = tc.clientErr
+		called = false
+
+		err := mockExternalClient.Delete(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(called).To(gomega.Equal(tc.expectedCalled), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha3.TransitGatewayVPCAttachment)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonDeleting), tc.description)
+		}
+	}
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -44,11 +45,17 @@ const (
 	errKubeUpdateFailed    = "cannot update VPC custom resource"
 	errClient              = "cannot create a new VPCClient"
 	errDescribe            = "failed to describe VPC"
+	errLookup              = "failed to look up an existing VPC"
+	errLookupMatches       = "%d VPCs match the lookup parameters, exactly one must match"
 	errMultipleItems       = "retrieved multiple VPCs for the given vpcId"
 	errCreate              = "failed to create the VPC resource"
 	errPersistExternalName = "failed to persist InternetGateway ID"
 	errModifyVPCAttributes = "failed to modify the VPC resource attributes"
 	errCreateTags          = "failed to create tags for the VPC resource"
+	errDeleteTags          = "failed to delete tags for the VPC resource"
+	errModifyTenancy       = "failed to modify the VPC tenancy"
+	errAssociateCIDR       = "failed to associate a CIDR block with the VPC"
+	errDisassociateCIDR    = "failed to disassociate a CIDR block from the VPC"
 	errDelete              = "failed to delete the VPC resource"
 )
 
@@ -105,9 +112,14 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 
 	// AWS network resources are uniquely identified by an ID that is returned
 	// on create time; we can't tell whether they exist unless we have recorded
-	// their ID.
+	// their ID or are asked to look them up.
 	if meta.GetExternalName(cr) == "" {
-		return managed.ExternalObservation{ResourceExists: false}, nil
+		if cr.Spec.Lookup == nil {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		if err := e.lookup(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, err
+		}
 	}
 
 	req := e.client.DescribeVpcsRequest(&awsec2.DescribeVpcsInput{
@@ -128,6 +140,14 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 
 	observed := rsp.Vpcs[0]
 
+	current := cr.Spec.VPCParameters.DeepCopy()
+	ec2.LateInitializeVPC(&cr.Spec.VPCParameters, &observed)
+	if !cmp.Equal(current, &cr.Spec.VPCParameters) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+
 	if observed.State == awsec2.VpcStateAvailable {
 		cr.SetConditions(runtimev1alpha1.Available())
 	}
@@ -137,10 +157,31 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	return managed.ExternalObservation{
 		ResourceExists:    true,
 		ConnectionDetails: managed.ConnectionDetails{},
-		ResourceUpToDate:  ec2.IsUpToDate(cr.Spec.VPCParameters, observed),
+		ResourceUpToDate:  ec2.IsUpToDate(cr.Spec.VPCParameters, observed, cr.Status.ManagedTagKeys),
 	}, nil
 }
 
+// lookup finds the existing VPC that matches the lookup parameters
+// and records its ID as the external name.
+func (e *external) lookup(ctx context.Context, cr *v1alpha3.VPC) error {
+	filters, err := ec2.GenerateLookupFilters(*cr.Spec.Lookup, "")
+	if err != nil {
+		return errors.Wrap(err, errLookup)
+	}
+	rsp, err := e.client.DescribeVpcsRequest(&awsec2.DescribeVpcsInput{
+		Filters: filters,
+	}).Send(ctx)
+	if err != nil {
+		return errors.Wrap(err, errLookup)
+	}
+	if len(rsp.Vpcs) != 1 {
+		return errors.Errorf(errLookupMatches, len(rsp.Vpcs))
+	}
+
+	meta.SetExternalName(cr, aws.StringValue(rsp.Vpcs[0].VpcId))
+	return errors.Wrap(e.kube.Update(ctx, cr), errPersistExternalName)
+}
+
 func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
 	cr, ok := mgd.(*v1alpha3.VPC)
 	if !ok {
@@ -151,9 +192,7 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 	// this happens when an error has occurred when modifying vpc attributes
 	if meta.GetExternalName(cr) == "" {
 
-		req := e.client.CreateVpcRequest(&awsec2.CreateVpcInput{
-			CidrBlock: aws.String(cr.Spec.CIDRBlock),
-		})
+		req := e.client.CreateVpcRequest(ec2.GenerateCreateVpcInput(cr.Spec.VPCParameters))
 
 		rsp, err := req.Send(ctx)
 		if err != nil {
@@ -196,18 +235,85 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
 	}
 
-	// TODO(soorena776): add more sophisticated Update logic, once we
-	// categorize immutable vs mutable fields (see #727)
+	req := e.client.DescribeVpcsRequest(&awsec2.DescribeVpcsInput{
+		VpcIds: []string{meta.GetExternalName(cr)},
+	})
+	rsp, err := req.Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+	if len(rsp.Vpcs) != 1 {
+		return managed.ExternalUpdate{}, errors.New(errMultipleItems)
+	}
+	observed := rsp.Vpcs[0]
+
+	if ec2.NeedsTenancyUpdate(cr.Spec.VPCParameters, observed) {
+		if _, err := e.client.ModifyVpcTenancyRequest(&awsec2.ModifyVpcTenancyInput{
+			VpcId:           aws.String(meta.GetExternalName(cr)),
+			InstanceTenancy: awsec2.VpcTenancyDefault,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errModifyTenancy)
+		}
+	}
+
+	if err := e.updateCIDRBlocks(ctx, cr, observed); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	return managed.ExternalUpdate{}, e.updateTags(ctx, cr, observed)
+}
+
+func (e *external) updateCIDRBlocks(ctx context.Context, cr *v1alpha3.VPC, observed awsec2.Vpc) error {
+	associate, disassociate := ec2.DiffVPCCIDRBlocks(cr.Spec.VPCParameters, observed)
+	associateIPv6, disassociateIPv6 := ec2.DiffVPCIPv6CIDRBlock(cr.Spec.VPCParameters, observed)
 
-	// NOTE(muvaf): VPCs can only be tagged after the creation and this request
-	// is idempotent.
-	if _, err := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
-		Resources: []string{meta.GetExternalName(cr)},
-		Tags:      v1alpha3.GenerateEC2Tags(cr.Spec.Tags),
-	}).Send(ctx); err != nil {
-		return managed.ExternalUpdate{}, errors.Wrap(err, errCreateTags)
+	for _, id := range append(disassociate, disassociateIPv6...) {
+		if _, err := e.client.DisassociateVpcCidrBlockRequest(&awsec2.DisassociateVpcCidrBlockInput{
+			AssociationId: aws.String(id),
+		}).Send(ctx); err != nil {
+			return errors.Wrap(err, errDisassociateCIDR)
+		}
+	}
+	for _, c := range associate {
+		if _, err := e.client.AssociateVpcCidrBlockRequest(&awsec2.AssociateVpcCidrBlockInput{
+			VpcId:     aws.String(meta.GetExternalName(cr)),
+			CidrBlock: aws.String(c),
+		}).Send(ctx); err != nil {
+			return errors.Wrap(err, errAssociateCIDR)
+		}
+	}
+	if associateIPv6 {
+		if _, err := e.client.AssociateVpcCidrBlockRequest(&awsec2.AssociateVpcCidrBlockInput{
+			VpcId:                       aws.String(meta.GetExternalName(cr)),
+			AmazonProvidedIpv6CidrBlock: aws.Bool(true),
+		}).Send(ctx); err != nil {
+			return errors.Wrap(err, errAssociateCIDR)
+		}
+	}
+	return nil
+}
+
+// NOTE(muvaf): VPCs can only be tagged after the creation.
+func (e *external) updateTags(ctx context.Context, cr *v1alpha3.VPC, observed awsec2.Vpc) error {
+	add, remove := ec2.DiffManagedEC2Tags(cr.Spec.Tags, observed.Tags, cr.Status.ManagedTagKeys)
+	if len(remove) > 0 {
+		if _, err := e.client.DeleteTagsRequest(&awsec2.DeleteTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      remove,
+		}).Send(ctx); err != nil {
+			return errors.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      add,
+		}).Send(ctx); err != nil {
+			return errors.Wrap(err, errCreateTags)
+		}
 	}
-	return managed.ExternalUpdate{}, nil
+	cr.Status.ManagedTagKeys = ec2.TagKeys(cr.Spec.Tags)
+	return nil
 }
 
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
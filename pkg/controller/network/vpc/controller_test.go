@@ -360,6 +360,133 @@ func Test_Create(t *testing.T) {
 	}
 }
 
+func Test_Update(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.VPC{
+		Spec: v1alpha3.VPCSpec{
+			VPCParameters: v1alpha3.VPCParameters{
+				CIDRBlock:                   "10.0.0.0/16",
+				SecondaryCIDRBlocks:         []string{"10.1.0.0/16"},
+				AmazonProvidedIPv6CIDRBlock: aws.Bool(true),
+				InstanceTenancy:             aws.String("default"),
+				Tags:                        []v1alpha3.Tag{{Key: "k", Value: "v"}},
+			},
+		},
+		Status: v1alpha3.VPCStatus{
+			ManagedTagKeys: []string{"k", "old"},
+		},
+	}
+	meta.SetExternalName(&mockManaged, "some arbitrary id")
+
+	associated := &awsec2.VpcCidrBlockState{State: awsec2.VpcCidrBlockStateCodeAssociated}
+	observed := awsec2.Vpc{
+		CidrBlock:       aws.String("10.0.0.0/16"),
+		InstanceTenancy: awsec2.TenancyDedicated,
+		CidrBlockAssociationSet: []awsec2.VpcCidrBlockAssociation{
+			{AssociationId: aws.String("primary"), CidrBlock: aws.String("10.0.0.0/16"), CidrBlockState: associated},
+			{AssociationId: aws.String("old"), CidrBlock: aws.String("10.2.0.0/16"), CidrBlockState: associated},
+		},
+		Tags: []awsec2.Tag{
+			{Key: aws.String("old"), Value: aws.String("v")},
+			{Key: aws.String("kubernetes.io/cluster/eks"), Value: aws.String("shared")},
+		},
+	}
+	mockClient.MockDescribeVpcsRequest = func(input *awsec2.DescribeVpcsInput) awsec2.DescribeVpcsRequest {
+		return awsec2.DescribeVpcsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.DescribeVpcsOutput{Vpcs: []awsec2.Vpc{observed}},
+			},
+		}
+	}
+
+	var tenancyModified bool
+	mockClient.MockModifyVpcTenancyRequest = func(input *awsec2.ModifyVpcTenancyInput) awsec2.ModifyVpcTenancyRequest {
+		tenancyModified = true
+		g.Expect(input.InstanceTenancy).To(gomega.Equal(awsec2.VpcTenancyDefault), "the passed parameters are not valid")
+		return awsec2.ModifyVpcTenancyRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.ModifyVpcTenancyOutput{}},
+		}
+	}
+	var disassociated []string
+	mockClient.MockDisassociateVpcCidrBlockRequest = func(input *awsec2.DisassociateVpcCidrBlockInput) awsec2.DisassociateVpcCidrBlockRequest {
+		disassociated = append(disassociated, aws.StringValue(input.AssociationId))
+		return awsec2.DisassociateVpcCidrBlockRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.DisassociateVpcCidrBlockOutput{}},
+		}
+	}
+	var associated4 []string
+	var associated6 bool
+	var mockAssociateErr error
+	mockClient.MockAssociateVpcCidrBlockRequest = func(input *awsec2.AssociateVpcCidrBlockInput) awsec2.AssociateVpcCidrBlockRequest {
+		if aws.BoolValue(input.AmazonProvidedIpv6CidrBlock) {
+			associated6 = true
+		} else {
+			associated4 = append(associated4, aws.StringValue(input.CidrBlock))
+		}
+		return awsec2.AssociateVpcCidrBlockRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.AssociateVpcCidrBlockOutput{}, Error: mockAssociateErr},
+		}
+	}
+	var createdTags, deletedTags []awsec2.Tag
+	mockClient.MockCreateTagsRequest = func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+		createdTags = input.Tags
+		return awsec2.CreateTagsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.CreateTagsOutput{}},
+		}
+	}
+	mockClient.MockDeleteTagsRequest = func(input *awsec2.DeleteTagsInput) awsec2.DeleteTagsRequest {
+		deletedTags = input.Tags
+		return awsec2.DeleteTagsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.DeleteTagsOutput{}},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		associateErr   error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should update tenancy, CIDR blocks and tags",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			false,
+		},
+		{
+			"if associating a CIDR block fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockAssociateErr = tc.associateErr
+		tenancyModified, associated6 = false, false
+		disassociated, associated4, createdTags, deletedTags = nil, nil, nil, nil
+
+		_, err := mockExternalClient.Update(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			g.Expect(tenancyModified).To(gomega.BeTrue(), tc.description)
+			g.Expect(disassociated).To(gomega.Equal([]string{"old"}), tc.description)
+			g.Expect(associated4).To(gomega.Equal([]string{"10.1.0.0/16"}), tc.description)
+			g.Expect(associated6).To(gomega.BeTrue(), tc.description)
+			g.Expect(createdTags).To(gomega.Equal([]awsec2.Tag{{Key: aws.String("k"), Value: aws.String("v")}}), tc.description)
+			g.Expect(deletedTags).To(gomega.Equal([]awsec2.Tag{{Key: aws.String("old")}}), tc.description)
+			g.Expect(tc.managedObj.(*v1alpha3.VPC).Status.ManagedTagKeys).To(gomega.Equal([]string{"k"}), tc.description)
+		}
+	}
+}
+
 func Test_Delete(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 
@@ -510,3 +637,81 @@ func TestInitialize(t *testing.T) {
 		})
 	}
 }
+
+func Test_Lookup(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.VPC{
+		Spec: v1alpha3.VPCSpec{
+			VPCParameters: v1alpha3.VPCParameters{
+				Lookup: &v1alpha3.LookupParameters{Tags: []v1alpha3.Tag{{Key: "Name", Value: "shared"}}},
+			},
+		},
+	}
+
+	var mockClientErr error
+	var itemsList []awsec2.Vpc
+	mockClient.MockDescribeVpcsRequest = func(input *awsec2.DescribeVpcsInput) awsec2.DescribeVpcsRequest {
+		g.Expect(input.Filters).To(gomega.Equal([]awsec2.Filter{{Name: aws.String("tag:Name"), Values: []string{"shared"}}}), "the passed parameters are not valid")
+		return awsec2.DescribeVpcsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsec2.DescribeVpcsOutput{
+					Vpcs: itemsList,
+				},
+				Error: mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description          string
+		itemsReturned        []awsec2.Vpc
+		clientErr            error
+		expectedErrNil       bool
+		expectedExternalName string
+	}{
+		{
+			"a single match should be adopted",
+			[]awsec2.Vpc{{VpcId: aws.String("vpc-1")}},
+			nil,
+			true,
+			"vpc-1",
+		},
+		{
+			"if nothing matches, it should return error",
+			nil,
+			nil,
+			false,
+			"",
+		},
+		{
+			"if more than one VPC matches, it should return error",
+			[]awsec2.Vpc{{VpcId: aws.String("vpc-1")}, {VpcId: aws.String("vpc-2")}},
+			nil,
+			false,
+			"",
+		},
+		{
+			"if describing the VPCs fails, it should return error",
+			nil,
+			errors.New("some error"),
+			false,
+			"",
+		},
+	} {
+		mockClientErr = tc.clientErr
+		itemsList = tc.itemsReturned
+		mgd := mockManaged.DeepCopy()
+
+		err := mockExternalClient.lookup(context.Background(), mgd)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(meta.GetExternalName(mgd)).To(gomega.Equal(tc.expectedExternalName), tc.description)
+	}
+
+	mgd := mockManaged.DeepCopy()
+	mgd.Spec.Lookup = &v1alpha3.LookupParameters{}
+	g.Expect(mockExternalClient.lookup(context.Background(), mgd)).NotTo(gomega.BeNil(), "an empty lookup should return error")
+	g.Expect(meta.GetExternalName(mgd)).To(gomega.Equal(""), "an empty lookup should adopt nothing")
+}
@@ -0,0 +1,611 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpcendpoint
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	v1alpha3 "github.com/crossplane/provider-aws/apis/network/v1alpha3"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	mockExternalClient external
+	mockClient         fake.MockVPCEndpointClient
+
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+)
+
+func TestMain(m *testing.M) {
+
+	mockClient = fake.MockVPCEndpointClient{}
+	mockExternalClient = external{
+		client: &mockClient,
+		kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+	}
+
+	os.Exit(m.Run())
+}
+
+func Test_Connect(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := &v1alpha3.VPCEndpoint{}
+	var clientErr error
+	var configErr error
+
+	conn := connector{
+		client: nil,
+		newClientFn: func(conf *aws.Config) (ec2.VPCEndpointClient, error) {
+			return &mockClient, clientErr
+		},
+		awsConfigFn: func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error) {
+			return &aws.Config{}, configErr
+		},
+	}
+
+	for _, tc := range []struct {
+		description       string
+		managedObj        resource.Managed
+		configErr         error
+		clientErr         error
+		expectedClientNil bool
+		expectedErrNil    bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws config provider fails, should return error",
+			mockManaged,
+			errors.New("some error"),
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws client provider fails, should return error",
+			mockManaged,
+			nil,
+			errors.New("some error"),
+			true,
+			false,
+		},
+	} {
+		clientErr = tc.clientErr
+		configErr = tc.configErr
+
+		res, err := conn.Connect(context.Background(), tc.managedObj)
+		g.Expect(res == nil).To(gomega.Equal(tc.expectedClientNil), tc.description)
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+	}
+}
+
+func Test_Observe(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.VPCEndpoint{
+		Spec: v1alpha3.VPCEndpointSpec{
+			ForProvider: v1alpha3.VPCEndpointParameters{
+				ServiceName:   "com.amazonaws.us-east-1.s3",
+				RouteTableIDs: []string{"rtb-1"},
+			},
+		},
+	}
+	meta.SetExternalName(&mockManaged, "some arbitrary id")
+
+	var mockClientErr error
+	var itemsList []awsec2.VpcEndpoint
+	mockClient.MockDescribeVpcEndpointsRequest = func(input *awsec2.DescribeVpcEndpointsInput) awsec2.DescribeVpcEndpointsRequest {
+		return awsec2.DescribeVpcEndpointsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsec2.DescribeVpcEndpointsOutput{
+					VpcEndpoints: itemsList,
+				},
+				Error: mockClientErr,
+			},
+		}
+	}
+
+	endpoint := func(state awsec2.State, routeTables ...string) awsec2.VpcEndpoint {
+		return awsec2.VpcEndpoint{
+			VpcEndpointId:   aws.String("some arbitrary id"),
+			VpcEndpointType: awsec2.VpcEndpointTypeGateway,
+			State:           state,
+			RouteTableIds:   routeTables,
+			OwnerId:         aws.String("123456789012"),
+		}
+	}
+
+	for _, tc := range []struct {
+		description           string
+		managedObj            resource.Managed
+		itemsReturned         []awsec2.VpcEndpoint
+		clientErr             error
+		expectedErrNil        bool
+		expectedResourceExist bool
+		expectedUpToDate      bool
+		expectedReason        corev1alpha1.ConditionReason
+	}{
+		{
+			"available endpoint should return expected",
+			mockManaged.DeepCopy(),
+			[]awsec2.VpcEndpoint{endpoint(awsec2.StateAvailable, "rtb-1")},
+			nil,
+			true,
+			true,
+			true,
+			corev1alpha1.ReasonAvailable,
+		},
+		{
+			"endpoint with different route tables should not be up to date",
+			mockManaged.DeepCopy(),
+			[]awsec2.VpcEndpoint{endpoint(awsec2.StateAvailable)},
+			nil,
+			true,
+			true,
+			false,
+			corev1alpha1.ReasonAvailable,
+		},
+		{
+			"pending endpoint should be creating",
+			mockManaged.DeepCopy(),
+			[]awsec2.VpcEndpoint{endpoint(awsec2.StatePending, "rtb-1")},
+			nil,
+			true,
+			true,
+			true,
+			corev1alpha1.ReasonCreating,
+		},
+		{
+			"deleted endpoint should not exist",
+			mockManaged.DeepCopy(),
+			[]awsec2.VpcEndpoint{endpoint(awsec2.StateDeleted, "rtb-1")},
+			nil,
+			true,
+			false,
+			false,
+			"",
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			nil,
+			false,
+			false,
+			false,
+			"",
+		},
+		{
+			"if item's identifier is not yet set, returns expected",
+			&v1alpha3.VPCEndpoint{},
+			nil,
+			nil,
+			true,
+			false,
+			false,
+			"",
+		},
+		{
+			"if external resource doesn't exist, it should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			awserr.New(ec2.VPCEndpointIDNotFound, "", nil),
+			true,
+			false,
+			false,
+			"",
+		},
+		{
+			"if external resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			nil,
+			errors.New("some error"),
+			false,
+			false,
+			false,
+			"",
+		},
+		{
+			"if external resource returns a list with other than one item, it should return error",
+			mockManaged.DeepCopy(),
+			[]awsec2.VpcEndpoint{},
+			nil,
+			false,
+			false,
+			false,
+			"",
+		},
+	} {
+		mockClientErr = tc.clientErr
+		itemsList = tc.itemsReturned
+
+		result, err := mockExternalClient.Observe(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(result.ResourceExists).To(gomega.Equal(tc.expectedResourceExist), tc.description)
+		g.Expect(result.ResourceUpToDate).To(gomega.Equal(tc.expectedUpToDate), tc.description)
+		if tc.expectedResourceExist {
+			mgd := tc.managedObj.(*v1alpha3.VPCEndpoint)
+			g.Expect(mgd.Status.GetCondition(corev1alpha1.TypeReady).Reason).To(gomega.Equal(tc.expectedReason), tc.description)
+			g.Expect(mgd.Status.AtProvider.OwnerID).To(gomega.Equal("123456789012"), tc.description)
+			g.Expect(aws.StringValue(mgd.Spec.ForProvider.VPCEndpointType)).To(gomega.Equal(string(awsec2.VpcEndpointTypeGateway)), tc.description)
+		}
+	}
+}
+
+func Test_Create(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.VPCEndpoint{
+		Spec: v1alpha3.VPCEndpointSpec{
+			ForProvider: v1alpha3.VPCEndpointParameters{
+				VPCID:            aws.String("vpc-1"),
+				ServiceName:      "com.amazonaws.us-east-1.ecr.api",
+				VPCEndpointType:  aws.String(string(awsec2.VpcEndpointTypeInterface)),
+				SubnetIDs:        []string{"subnet-1"},
+				SecurityGroupIDs: []string{"sg-1"},
+			},
+		},
+	}
+	var mockClientErr error
+	mockClient.MockCreateVpcEndpointRequest = func(input *awsec2.CreateVpcEndpointInput) awsec2.CreateVpcEndpointRequest {
+		g.Expect(input.VpcEndpointType).To(gomega.Equal(awsec2.VpcEndpointTypeInterface), "the passed parameters are not valid")
+		g.Expect(input.SubnetIds).To(gomega.Equal([]string{"subnet-1"}), "the passed parameters are not valid")
+		g.Expect(input.SecurityGroupIds).To(gomega.Equal([]string{"sg-1"}), "the passed parameters are not valid")
+		return awsec2.CreateVpcEndpointRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsec2.CreateVpcEndpointOutput{
+					VpcEndpoint: &awsec2.VpcEndpoint{
+						VpcEndpointId: aws.String("some arbitrary id"),
+						State:         awsec2.StatePending,
+					},
+				},
+				Error: mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			false,
+		},
+		{
+			"if creating resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+
+		_, err := mockExternalClient.Create(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha3.VPCEndpoint)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonCreating), tc.description)
+			g.Expect(mgd.Status.AtProvider.State).To(gomega.Equal(string(awsec2.StatePending)), tc.description)
+			g.Expect(meta.GetExternalName(mgd)).To(gomega.Equal("some arbitrary id"), tc.description)
+		}
+	}
+}
+
+func Test_Update(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.VPCEndpoint{
+		Spec: v1alpha3.VPCEndpointSpec{
+			ForProvider: v1alpha3.VPCEndpointParameters{
+				RouteTableIDs: []string{"rtb-1"},
+			},
+		},
+	}
+	meta.SetExternalName(&mockManaged, "some arbitrary id")
+
+	var observed awsec2.VpcEndpoint
+	mockClient.MockDescribeVpcEndpointsRequest = func(input *awsec2.DescribeVpcEndpointsInput) awsec2.DescribeVpcEndpointsRequest {
+		return awsec2.DescribeVpcEndpointsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsec2.DescribeVpcEndpointsOutput{
+					VpcEndpoints: []awsec2.VpcEndpoint{observed},
+				},
+			},
+		}
+	}
+
+	var modified bool
+	var mockClientErr error
+	mockClient.MockModifyVpcEndpointRequest = func(input *awsec2.ModifyVpcEndpointInput) awsec2.ModifyVpcEndpointRequest {
+		modified = true
+		g.Expect(input.AddRouteTableIds).To(gomega.Equal([]string{"rtb-1"}), "the passed parameters are not valid")
+		return awsec2.ModifyVpcEndpointRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.ModifyVpcEndpointOutput{},
+				Error:       mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description      string
+		managedObj       resource.Managed
+		observed         awsec2.VpcEndpoint
+		clientErr        error
+		expectedErrNil   bool
+		expectedModified bool
+	}{
+		{
+			"missing route table should be added",
+			mockManaged.DeepCopy(),
+			awsec2.VpcEndpoint{},
+			nil,
+			true,
+			true,
+		},
+		{
+			"up to date endpoint should not be modified",
+			mockManaged.DeepCopy(),
+			awsec2.VpcEndpoint{RouteTableIds: []string{"rtb-1"}},
+			nil,
+			true,
+			false,
+		},
+		{
+			"if modifying the endpoint fails, it should return error",
+			mockManaged.DeepCopy(),
+			awsec2.VpcEndpoint{},
+			errors.New("some error"),
+			false,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			awsec2.VpcEndpoint{},
+			nil,
+			false,
+			false,
+		},
+	} {
+		observed = tc.observed
+		mockClientErr = tc.clientErr
+		modified = false
+
+		_, err := mockExternalClient.Update(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(modified).To(gomega.Equal(tc.expectedModified), tc.description)
+	}
+}
+
+func Test_UpdateTags(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.VPCEndpoint{
+		Spec: v1alpha3.VPCEndpointSpec{
+			ForProvider: v1alpha3.VPCEndpointParameters{
+				Tags: []v1alpha3.Tag{{Key: "k", Value: "v"}},
+			},
+		},
+		Status: v1alpha3.VPCEndpointStatus{
+			ManagedTagKeys: []string{"old"},
+		},
+	}
+	meta.SetExternalName(&mockManaged, "some arbitrary id")
+
+	mockClient.MockDescribeVpcEndpointsRequest = func(input *awsec2.DescribeVpcEndpointsInput) awsec2.DescribeVpcEndpointsRequest {
+		return awsec2.DescribeVpcEndpointsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsec2.DescribeVpcEndpointsOutput{
+					VpcEndpoints: []awsec2.VpcEndpoint{{
+						Tags: []awsec2.Tag{
+							{Key: aws.String("old"), Value: aws.String("v")},
+							{Key: aws.String("foreign"), Value: aws.String("v")},
+						},
+					}},
+				},
+			},
+		}
+	}
+
+	var createdTags, deletedTags []awsec2.Tag
+	var mockClientTagsErr error
+	mockClient.MockCreateTagsRequest = func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+		createdTags = input.Tags
+		return awsec2.CreateTagsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.CreateTagsOutput{},
+				Error:       mockClientTagsErr,
+			},
+		}
+	}
+	mockClient.MockDeleteTagsRequest = func(input *awsec2.DeleteTagsInput) awsec2.DeleteTagsRequest {
+		deletedTags = input.Tags
+		return awsec2.DeleteTagsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.DeleteTagsOutput{},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientTagsErr  error
+		expectedErrNil bool
+	}{
+		{
+			"only the tags that were set from the spec should be removed",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"if tagging resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientTagsErr = tc.clientTagsErr
+		createdTags, deletedTags = nil, nil
+
+		_, err := mockExternalClient.Update(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			g.Expect(createdTags).To(gomega.Equal([]awsec2.Tag{{Key: aws.String("k"), Value: aws.String("v")}}), tc.description)
+			g.Expect(deletedTags).To(gomega.Equal([]awsec2.Tag{{Key: aws.String("old")}}), tc.description)
+			g.Expect(tc.managedObj.(*v1alpha3.VPCEndpoint).Status.ManagedTagKeys).To(gomega.Equal([]string{"k"}), tc.description)
+		}
+	}
+}
+
+func Test_Delete(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.VPCEndpoint{}
+	meta.SetExternalName(&mockManaged, "some arbitrary id")
+	var mockClientErr error
+	var unsuccessful []awsec2.UnsuccessfulItem
+	mockClient.MockDeleteVpcEndpointsRequest = func(input *awsec2.DeleteVpcEndpointsInput) awsec2.DeleteVpcEndpointsRequest {
+		g.Expect(input.VpcEndpointIds).To(gomega.Equal([]string{meta.GetExternalName(&mockManaged)}), "the passed parameters are not valid")
+		return awsec2.DeleteVpcEndpointsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.DeleteVpcEndpointsOutput{Unsuccessful: unsuccessful},
+				Error:       mockClientErr,
+			},
+		}
+	}
+
+	unsuccessfulItem := func(code string) []awsec2.UnsuccessfulItem {
+		return []awsec2.UnsuccessfulItem{{
+			ResourceId: aws.String("some arbitrary id"),
+			Error:      &awsec2.UnsuccessfulItemError{Code: aws.String(code)},
+		}}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		unsuccessful   []awsec2.UnsuccessfulItem
+		expectedErrNil bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			nil,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			nil,
+			false,
+		},
+		{
+			"if the resource doesn't exist deleting resource should not return an error",
+			mockManaged.DeepCopy(),
+			nil,
+			unsuccessfulItem(ec2.VPCEndpointIDNotFound),
+			true,
+		},
+		{
+			"if deleting the endpoint is unsuccessful, it should return error",
+			mockManaged.DeepCopy(),
+			nil,
+			unsuccessfulItem("SomeError"),
+			false,
+		},
+		{
+			"if deleting resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			nil,
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+		unsuccessful = tc.unsuccessful
+
+		err := mockExternalClient.Delete(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha3.VPCEndpoint)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonDeleting), tc.description)
+		}
+	}
+}
@@ -0,0 +1,323 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpcpeeringconnection
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	v1alpha3 "github.com/crossplane/provider-aws/apis/network/v1alpha3"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/controller/utils"
+)
+
+const (
+	errUnexpectedObject    = "The managed resource is not a VPCPeeringConnection resource"
+	errClient              = "cannot create a new VPCPeeringConnectionClient"
+	errAccepterClient      = "cannot create a new VPCPeeringConnectionClient for the accepter"
+	errDescribe            = "failed to describe VPCPeeringConnection"
+	errMultipleItems       = "retrieved multiple VPCPeeringConnections for the given vpcPeeringConnectionId"
+	errCreate              = "failed to create the VPCPeeringConnection resource"
+	errPersistExternalName = "failed to persist VPCPeeringConnection ID"
+	errAccept              = "failed to accept the VPCPeeringConnection"
+	errModifyRequester     = "failed to modify the requester options of the VPCPeeringConnection"
+	errModifyAccepter      = "failed to modify the accepter options of the VPCPeeringConnection"
+	errCreateTags          = "failed to create tags for the VPCPeeringConnection resource"
+	errDeleteTags          = "failed to delete tags for the VPCPeeringConnection resource"
+	errDelete              = "failed to delete the VPCPeeringConnection resource"
+)
+
+// SetupVPCPeeringConnection adds a controller that reconciles
+// VPCPeeringConnections.
+func SetupVPCPeeringConnection(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha3.VPCPeeringConnectionGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha3.VPCPeeringConnection{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.VPCPeeringConnectionGroupVersionKind),
+			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: ec2.NewVPCPeeringConnectionClient, awsConfigFn: utils.RetrieveAwsConfigFromProvider}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	client      client.Client
+	newClientFn func(*aws.Config) (ec2.VPCPeeringConnectionClient, error)
+	awsConfigFn func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error)
+}
+
+func (conn *connector) Connect(ctx context.Context, mgd resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mgd.(*v1alpha3.VPCPeeringConnection)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	awsconfig, err := conn.awsConfigFn(ctx, conn.client, cr.Spec.ProviderReference)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := conn.newClientFn(awsconfig)
+	if err != nil {
+		return nil, errors.Wrap(err, errClient)
+	}
+
+	// the accepter side may live in another account or region, in which case
+	// it has to be managed with different credentials or endpoint.
+	accepterRef := cr.Spec.ProviderReference
+	if cr.Spec.ForProvider.AccepterProviderReference != nil {
+		accepterRef = cr.Spec.ForProvider.AccepterProviderReference
+	}
+	accepterConfig, err := conn.awsConfigFn(ctx, conn.client, accepterRef)
+	if err != nil {
+		return nil, err
+	}
+	if cr.Spec.ForProvider.PeerRegion != nil {
+		accepterConfig.Region = aws.StringValue(cr.Spec.ForProvider.PeerRegion)
+	}
+
+	a, err := conn.newClientFn(accepterConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, errAccepterClient)
+	}
+
+	return &external{kube: conn.client, client: c, accepter: a}, nil
+}
+
+type external struct {
+	kube     client.Client
+	client   ec2.VPCPeeringConnectionClient
+	accepter ec2.VPCPeeringConnectionClient
+}
+
+func (e *external) describe(ctx context.Context, id string) (*awsec2.VpcPeeringConnection, error) {
+	response, err := e.client.DescribeVpcPeeringConnectionsRequest(&awsec2.DescribeVpcPeeringConnectionsInput{
+		VpcPeeringConnectionIds: []string{id},
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.VpcPeeringConnections) != 1 {
+		return nil, errors.New(errMultipleItems)
+	}
+	return &response.VpcPeeringConnections[0], nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha3.VPCPeeringConnection)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// AWS network resources are uniquely identified by an ID that is returned
+	// on create time; we can't tell whether they exist unless we have recorded
+	// their ID.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if ec2.IsVPCPeeringConnectionNotFoundErr(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribe)
+	}
+
+	cr.Status.AtProvider = ec2.GenerateVPCPeeringConnectionObservation(*observed)
+
+	if !setConditions(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ec2.IsVPCPeeringConnectionUpToDate(cr.Spec.ForProvider, *observed, cr.Status.ManagedTagKeys),
+	}, nil
+}
+
+// setConditions sets the conditions of the supplied VPCPeeringConnection
+// according to its observed status code. It returns false if the peering
+// connection should be considered as gone.
+func setConditions(cr *v1alpha3.VPCPeeringConnection) bool {
+	switch awsec2.VpcPeeringConnectionStateReasonCode(cr.Status.AtProvider.StatusCode) {
+	case awsec2.VpcPeeringConnectionStateReasonCodeActive:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case awsec2.VpcPeeringConnectionStateReasonCodeInitiatingRequest,
+		awsec2.VpcPeeringConnectionStateReasonCodePendingAcceptance,
+		awsec2.VpcPeeringConnectionStateReasonCodeProvisioning:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	case awsec2.VpcPeeringConnectionStateReasonCodeDeleting:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+	case awsec2.VpcPeeringConnectionStateReasonCodeDeleted:
+		return false
+	case awsec2.VpcPeeringConnectionStateReasonCodeRejected,
+		awsec2.VpcPeeringConnectionStateReasonCodeFailed,
+		awsec2.VpcPeeringConnectionStateReasonCodeExpired:
+		// these peering connections cannot be deleted and are removed by
+		// AWS after a while; there is nothing to wait for on deletion.
+		if meta.WasDeleted(cr) {
+			return false
+		}
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+	return true
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha3.VPCPeeringConnection)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	rsp, err := e.client.CreateVpcPeeringConnectionRequest(ec2.GenerateCreateVPCPeeringConnectionInput(cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, aws.StringValue(rsp.VpcPeeringConnection.VpcPeeringConnectionId))
+	if err := e.kube.Update(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errPersistExternalName)
+	}
+
+	cr.Status.AtProvider = ec2.GenerateVPCPeeringConnectionObservation(*rsp.VpcPeeringConnection)
+
+	add, _ := ec2.DiffEC2Tags(cr.Spec.ForProvider.Tags, nil)
+	if len(add) == 0 {
+		return managed.ExternalCreation{}, nil
+	}
+	if _, err := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+		Resources: []string{meta.GetExternalName(cr)},
+		Tags:      add,
+	}).Send(ctx); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateTags)
+	}
+	cr.Status.ManagedTagKeys = ec2.TagKeys(cr.Spec.ForProvider.Tags)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha3.VPCPeeringConnection)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+
+	if ec2.IsVPCPeeringConnectionAcceptable(cr.Spec.ForProvider, *observed) {
+		_, err := e.accepter.AcceptVpcPeeringConnectionRequest(&awsec2.AcceptVpcPeeringConnectionInput{
+			VpcPeeringConnectionId: aws.String(meta.GetExternalName(cr)),
+		}).Send(ctx)
+		return managed.ExternalUpdate{}, errors.Wrap(err, errAccept)
+	}
+
+	if err := e.updateOptions(ctx, cr, observed); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	return managed.ExternalUpdate{}, e.updateTags(ctx, cr, observed)
+}
+
+// updateOptions modifies the peering options of each side of an active
+// peering connection with the client of the account that owns that side.
+func (e *external) updateOptions(ctx context.Context, cr *v1alpha3.VPCPeeringConnection, observed *awsec2.VpcPeeringConnection) error {
+	if observed.Status == nil || observed.Status.Code != awsec2.VpcPeeringConnectionStateReasonCodeActive {
+		return nil
+	}
+	if o := ec2.GeneratePeeringOptionsRequest(cr.Spec.ForProvider.RequesterPeeringOptions, observed.RequesterVpcInfo); o != nil {
+		if _, err := e.client.ModifyVpcPeeringConnectionOptionsRequest(&awsec2.ModifyVpcPeeringConnectionOptionsInput{
+			VpcPeeringConnectionId:            aws.String(meta.GetExternalName(cr)),
+			RequesterPeeringConnectionOptions: o,
+		}).Send(ctx); err != nil {
+			return errors.Wrap(err, errModifyRequester)
+		}
+	}
+	if o := ec2.GeneratePeeringOptionsRequest(cr.Spec.ForProvider.AccepterPeeringOptions, observed.AccepterVpcInfo); o != nil {
+		if _, err := e.accepter.ModifyVpcPeeringConnectionOptionsRequest(&awsec2.ModifyVpcPeeringConnectionOptionsInput{
+			VpcPeeringConnectionId:           aws.String(meta.GetExternalName(cr)),
+			AccepterPeeringConnectionOptions: o,
+		}).Send(ctx); err != nil {
+			return errors.Wrap(err, errModifyAccepter)
+		}
+	}
+	return nil
+}
+
+func (e *external) updateTags(ctx context.Context, cr *v1alpha3.VPCPeeringConnection, observed *awsec2.VpcPeeringConnection) error {
+	add, remove := ec2.DiffManagedEC2Tags(cr.Spec.ForProvider.Tags, observed.Tags, cr.Status.ManagedTagKeys)
+	if len(remove) > 0 {
+		if _, err := e.client.DeleteTagsRequest(&awsec2.DeleteTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      remove,
+		}).Send(ctx); err != nil {
+			return errors.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      add,
+		}).Send(ctx); err != nil {
+			return errors.Wrap(err, errCreateTags)
+		}
+	}
+	cr.Status.ManagedTagKeys = ec2.TagKeys(cr.Spec.ForProvider.Tags)
+	return nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha3.VPCPeeringConnection)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+
+	if cr.Status.AtProvider.StatusCode == string(awsec2.VpcPeeringConnectionStateReasonCodeDeleting) {
+		return nil
+	}
+
+	_, err := e.client.DeleteVpcPeeringConnectionRequest(&awsec2.DeleteVpcPeeringConnectionInput{
+		VpcPeeringConnectionId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(ec2.IsVPCPeeringConnectionNotFoundErr, err), errDelete)
+}
@@ -0,0 +1,647 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpcpeeringconnection
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	v1alpha3 "github.com/crossplane/provider-aws/apis/network/v1alpha3"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	mockExternalClient external
+	mockClient         fake.MockVPCPeeringConnectionClient
+	mockAccepterClient fake.MockVPCPeeringConnectionClient
+
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+)
+
+func TestMain(m *testing.M) {
+
+	mockClient = fake.MockVPCPeeringConnectionClient{}
+	mockAccepterClient = fake.MockVPCPeeringConnectionClient{}
+	mockExternalClient = external{
+		client:   &mockClient,
+		accepter: &mockAccepterClient,
+		kube:     &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+	}
+
+	os.Exit(m.Run())
+}
+
+func Test_Connect(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := &v1alpha3.VPCPeeringConnection{}
+	var clientErr error
+	var configErr error
+
+	conn := connector{
+		client: nil,
+		newClientFn: func(conf *aws.Config) (ec2.VPCPeeringConnectionClient, error) {
+			return &mockClient, clientErr
+		},
+		awsConfigFn: func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error) {
+			return &aws.Config{}, configErr
+		},
+	}
+
+	for _, tc := range []struct {
+		description       string
+		managedObj        resource.Managed
+		configErr         error
+		clientErr         error
+		expectedClientNil bool
+		expectedErrNil    bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws config provider fails, should return error",
+			mockManaged,
+			errors.New("some error"),
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws client provider fails, should return error",
+			mockManaged,
+			nil,
+			errors.New("some error"),
+			true,
+			false,
+		},
+	} {
+		clientErr = tc.clientErr
+		configErr = tc.configErr
+
+		res, err := conn.Connect(context.Background(), tc.managedObj)
+		g.Expect(res == nil).To(gomega.Equal(tc.expectedClientNil), tc.description)
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+	}
+}
+
+func Test_Observe(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.VPCPeeringConnection{}
+	meta.SetExternalName(&mockManaged, "some arbitrary id")
+
+	deleted := mockManaged.DeepCopy()
+	now := metav1.Now()
+	deleted.SetDeletionTimestamp(&now)
+
+	var mockClientErr error
+	var itemsList []awsec2.VpcPeeringConnection
+	mockClient.MockDescribeVpcPeeringConnectionsRequest = func(input *awsec2.DescribeVpcPeeringConnectionsInput) awsec2.DescribeVpcPeeringConnectionsRequest {
+		return awsec2.DescribeVpcPeeringConnectionsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsec2.DescribeVpcPeeringConnectionsOutput{
+					VpcPeeringConnections: itemsList,
+				},
+				Error: mockClientErr,
+			},
+		}
+	}
+
+	pcx := func(code awsec2.VpcPeeringConnectionStateReasonCode) awsec2.VpcPeeringConnection {
+		return awsec2.VpcPeeringConnection{
+			VpcPeeringConnectionId: aws.String("some arbitrary id"),
+			Status:                 &awsec2.VpcPeeringConnectionStateReason{Code: code},
+			RequesterVpcInfo:       &awsec2.VpcPeeringConnectionVpcInfo{VpcId: aws.String("vpc-1")},
+		}
+	}
+
+	for _, tc := range []struct {
+		description           string
+		managedObj            resource.Managed
+		itemsReturned         []awsec2.VpcPeeringConnection
+		clientErr             error
+		expectedErrNil        bool
+		expectedResourceExist bool
+		expectedReason        corev1alpha1.ConditionReason
+	}{
+		{
+			"active peering connection should be available",
+			mockManaged.DeepCopy(),
+			[]awsec2.VpcPeeringConnection{pcx(awsec2.VpcPeeringConnectionStateReasonCodeActive)},
+			nil,
+			true,
+			true,
+			corev1alpha1.ReasonAvailable,
+		},
+		{
+			"pending peering connection should be creating",
+			mockManaged.DeepCopy(),
+			[]awsec2.VpcPeeringConnection{pcx(awsec2.VpcPeeringConnectionStateReasonCodePendingAcceptance)},
+			nil,
+			true,
+			true,
+			corev1alpha1.ReasonCreating,
+		},
+		{
+			"rejected peering connection should be unavailable",
+			mockManaged.DeepCopy(),
+			[]awsec2.VpcPeeringConnection{pcx(awsec2.VpcPeeringConnectionStateReasonCodeRejected)},
+			nil,
+			true,
+			true,
+			corev1alpha1.ReasonUnavailable,
+		},
+		{
+			"rejected peering connection should not exist once deleted",
+			deleted,
+			[]awsec2.VpcPeeringConnection{pcx(awsec2.VpcPeeringConnectionStateReasonCodeRejected)},
+			nil,
+			true,
+			false,
+			"",
+		},
+		{
+			"deleted peering connection should not exist",
+			mockManaged.DeepCopy(),
+			[]awsec2.VpcPeeringConnection{pcx(awsec2.VpcPeeringConnectionStateReasonCodeDeleted)},
+			nil,
+			true,
+			false,
+			"",
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			nil,
+			false,
+			false,
+			"",
+		},
+		{
+			"if item's identifier is not yet set, returns expected",
+			&v1alpha3.VPCPeeringConnection{},
+			nil,
+			nil,
+			true,
+			false,
+			"",
+		},
+		{
+			"if external resource doesn't exist, it should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			awserr.New(ec2.VPCPeeringConnectionIDNotFound, "", nil),
+			true,
+			false,
+			"",
+		},
+		{
+			"if external resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			nil,
+			errors.New("some error"),
+			false,
+			false,
+			"",
+		},
+		{
+			"if external resource returns a list with other than one item, it should return error",
+			mockManaged.DeepCopy(),
+			[]awsec2.VpcPeeringConnection{},
+			nil,
+			false,
+			false,
+			"",
+		},
+	} {
+		mockClientErr = tc.clientErr
+		itemsList = tc.itemsReturned
+
+		result, err := mockExternalClient.Observe(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(result.ResourceExists).To(gomega.Equal(tc.expectedResourceExist), tc.description)
+		if tc.expectedResourceExist {
+			mgd := tc.managedObj.(*v1alpha3.VPCPeeringConnection)
+			g.Expect(mgd.Status.GetCondition(corev1alpha1.TypeReady).Reason).To(gomega.Equal(tc.expectedReason), tc.description)
+			g.Expect(mgd.Status.AtProvider.RequesterVPCInfo.VPCID).To(gomega.Equal("vpc-1"), tc.description)
+		}
+	}
+}
+
+func Test_Create(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.VPCPeeringConnection{
+		Spec: v1alpha3.VPCPeeringConnectionSpec{
+			ForProvider: v1alpha3.VPCPeeringConnectionParameters{
+				VPCID:      aws.String("vpc-1"),
+				PeerVPCID:  aws.String("vpc-2"),
+				PeerRegion: aws.String("eu-west-1"),
+			},
+		},
+	}
+	var mockClientErr error
+	mockClient.MockCreateVpcPeeringConnectionRequest = func(input *awsec2.CreateVpcPeeringConnectionInput) awsec2.CreateVpcPeeringConnectionRequest {
+		g.Expect(aws.StringValue(input.VpcId)).To(gomega.Equal("vpc-1"), "the passed parameters are not valid")
+		g.Expect(aws.StringValue(input.PeerVpcId)).To(gomega.Equal("vpc-2"), "the passed parameters are not valid")
+		g.Expect(aws.StringValue(input.PeerRegion)).To(gomega.Equal("eu-west-1"), "the passed parameters are not valid")
+		return awsec2.CreateVpcPeeringConnectionRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsec2.CreateVpcPeeringConnectionOutput{
+					VpcPeeringConnection: &awsec2.VpcPeeringConnection{
+						VpcPeeringConnectionId: aws.String("some arbitrary id"),
+						Status:                 &awsec2.VpcPeeringConnectionStateReason{Code: awsec2.VpcPeeringConnectionStateReasonCodeInitiatingRequest},
+					},
+				},
+				Error: mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			false,
+		},
+		{
+			"if creating resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+
+		_, err := mockExternalClient.Create(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha3.VPCPeeringConnection)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonCreating), tc.description)
+			g.Expect(mgd.Status.AtProvider.StatusCode).To(gomega.Equal(string(awsec2.VpcPeeringConnectionStateReasonCodeInitiatingRequest)), tc.description)
+			g.Expect(meta.GetExternalName(mgd)).To(gomega.Equal("some arbitrary id"), tc.description)
+		}
+	}
+}
+
+func Test_Update(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	dns := &v1alpha3.VPCPeeringConnectionOptions{AllowDNSResolutionFromRemoteVPC: aws.Bool(true)}
+	mockManaged := v1alpha3.VPCPeeringConnection{
+		Spec: v1alpha3.VPCPeeringConnectionSpec{
+			ForProvider: v1alpha3.VPCPeeringConnectionParameters{
+				PeerVPCIDRef:            &corev1alpha1.Reference{Name: "peer"},
+				RequesterPeeringOptions: dns,
+				AccepterPeeringOptions:  dns,
+			},
+		},
+	}
+	meta.SetExternalName(&mockManaged, "some arbitrary id")
+
+	var observed awsec2.VpcPeeringConnection
+	mockClient.MockDescribeVpcPeeringConnectionsRequest = func(input *awsec2.DescribeVpcPeeringConnectionsInput) awsec2.DescribeVpcPeeringConnectionsRequest {
+		return awsec2.DescribeVpcPeeringConnectionsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsec2.DescribeVpcPeeringConnectionsOutput{
+					VpcPeeringConnections: []awsec2.VpcPeeringConnection{observed},
+				},
+			},
+		}
+	}
+
+	var accepted bool
+	var mockAcceptErr error
+	mockAccepterClient.MockAcceptVpcPeeringConnectionRequest = func(input *awsec2.AcceptVpcPeeringConnectionInput) awsec2.AcceptVpcPeeringConnectionRequest {
+		accepted = true
+		return awsec2.AcceptVpcPeeringConnectionRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.AcceptVpcPeeringConnectionOutput{},
+				Error:       mockAcceptErr,
+			},
+		}
+	}
+
+	var requesterModified, accepterModified bool
+	var mockModifyErr error
+	mockClient.MockModifyVpcPeeringConnectionOptionsRequest = func(input *awsec2.ModifyVpcPeeringConnectionOptionsInput) awsec2.ModifyVpcPeeringConnectionOptionsRequest {
+		g.Expect(input.AccepterPeeringConnectionOptions).To(gomega.BeNil(), "the passed parameters are not valid")
+		requesterModified = true
+		return awsec2.ModifyVpcPeeringConnectionOptionsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.ModifyVpcPeeringConnectionOptionsOutput{},
+				Error:       mockModifyErr,
+			},
+		}
+	}
+	mockAccepterClient.MockModifyVpcPeeringConnectionOptionsRequest = func(input *awsec2.ModifyVpcPeeringConnectionOptionsInput) awsec2.ModifyVpcPeeringConnectionOptionsRequest {
+		g.Expect(input.RequesterPeeringConnectionOptions).To(gomega.BeNil(), "the passed parameters are not valid")
+		accepterModified = true
+		return awsec2.ModifyVpcPeeringConnectionOptionsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.ModifyVpcPeeringConnectionOptionsOutput{},
+			},
+		}
+	}
+
+	pending := awsec2.VpcPeeringConnection{
+		Status: &awsec2.VpcPeeringConnectionStateReason{Code: awsec2.VpcPeeringConnectionStateReasonCodePendingAcceptance},
+	}
+	active := awsec2.VpcPeeringConnection{
+		Status: &awsec2.VpcPeeringConnectionStateReason{Code: awsec2.VpcPeeringConnectionStateReasonCodeActive},
+	}
+
+	for _, tc := range []struct {
+		description               string
+		managedObj                resource.Managed
+		observed                  awsec2.VpcPeeringConnection
+		acceptErr                 error
+		modifyErr                 error
+		expectedErrNil            bool
+		expectedAccepted          bool
+		expectedRequesterModified bool
+		expectedAccepterModified  bool
+	}{
+		{
+			"pending peering connection should be accepted by the accepter",
+			mockManaged.DeepCopy(),
+			pending,
+			nil,
+			nil,
+			true,
+			true,
+			false,
+			false,
+		},
+		{
+			"if accepting fails, it should return error",
+			mockManaged.DeepCopy(),
+			pending,
+			errors.New("some error"),
+			nil,
+			false,
+			true,
+			false,
+			false,
+		},
+		{
+			"active peering connection options should be modified by each side",
+			mockManaged.DeepCopy(),
+			active,
+			nil,
+			nil,
+			true,
+			false,
+			true,
+			true,
+		},
+		{
+			"if modifying the requester options fails, it should return error",
+			mockManaged.DeepCopy(),
+			active,
+			nil,
+			errors.New("some error"),
+			false,
+			false,
+			true,
+			false,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			active,
+			nil,
+			nil,
+			false,
+			false,
+			false,
+			false,
+		},
+	} {
+		observed = tc.observed
+		mockAcceptErr = tc.acceptErr
+		mockModifyErr = tc.modifyErr
+		accepted, requesterModified, accepterModified = false, false, false
+
+		_, err := mockExternalClient.Update(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(accepted).To(gomega.Equal(tc.expectedAccepted), tc.description)
+		g.Expect(requesterModified).To(gomega.Equal(tc.expectedRequesterModified), tc.description)
+		g.Expect(accepterModified).To(gomega.Equal(tc.expectedAccepterModified), tc.description)
+	}
+}
+
+func Test_UpdateTags(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.VPCPeeringConnection{
+		Spec: v1alpha3.VPCPeeringConnectionSpec{
+			ForProvider: v1alpha3.VPCPeeringConnectionParameters{
+				Tags: []v1alpha3.Tag{{Key: "k", Value: "v"}},
+			},
+		},
+		Status: v1alpha3.VPCPeeringConnectionStatus{
+			ManagedTagKeys: []string{"old"},
+		},
+	}
+	meta.SetExternalName(&mockManaged, "some arbitrary id")
+
+	mockClient.MockDescribeVpcPeeringConnectionsRequest = func(input *awsec2.DescribeVpcPeeringConnectionsInput) awsec2.DescribeVpcPeeringConnectionsRequest {
+		return awsec2.DescribeVpcPeeringConnectionsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsec2.DescribeVpcPeeringConnectionsOutput{
+					VpcPeeringConnections: []awsec2.VpcPeeringConnection{{
+						Status: &awsec2.VpcPeeringConnectionStateReason{Code: awsec2.VpcPeeringConnectionStateReasonCodeActive},
+						Tags: []awsec2.Tag{
+							{Key: aws.String("old"), Value: aws.String("v")},
+							{Key: aws.String("foreign"), Value: aws.String("v")},
+						},
+					}},
+				},
+			},
+		}
+	}
+
+	var createdTags, deletedTags []awsec2.Tag
+	var mockClientTagsErr error
+	mockClient.MockCreateTagsRequest = func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+		createdTags = input.Tags
+		return awsec2.CreateTagsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.CreateTagsOutput{},
+				Error:       mockClientTagsErr,
+			},
+		}
+	}
+	mockClient.MockDeleteTagsRequest = func(input *awsec2.DeleteTagsInput) awsec2.DeleteTagsRequest {
+		deletedTags = input.Tags
+		return awsec2.DeleteTagsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.DeleteTagsOutput{},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientTagsErr  error
+		expectedErrNil bool
+	}{
+		{
+			"only the tags that were set from the spec should be removed",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"if tagging resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientTagsErr = tc.clientTagsErr
+		createdTags, deletedTags = nil, nil
+
+		_, err := mockExternalClient.Update(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			g.Expect(createdTags).To(gomega.Equal([]awsec2.Tag{{Key: aws.String("k"), Value: aws.String("v")}}), tc.description)
+			g.Expect(deletedTags).To(gomega.Equal([]awsec2.Tag{{Key: aws.String("old")}}), tc.description)
+			g.Expect(tc.managedObj.(*v1alpha3.VPCPeeringConnection).Status.ManagedTagKeys).To(gomega.Equal([]string{"k"}), tc.description)
+		}
+	}
+}
+
+func Test_Delete(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha3.VPCPeeringConnection{}
+	meta.SetExternalName(&mockManaged, "some arbitrary id")
+	var mockClientErr error
+	mockClient.MockDeleteVpcPeeringConnectionRequest = func(input *awsec2.DeleteVpcPeeringConnectionInput) awsec2.DeleteVpcPeeringConnectionRequest {
+		g.Expect(aws.StringValue(input.VpcPeeringConnectionId)).To(gomega.Equal(meta.GetExternalName(&mockManaged)), "the passed parameters are not valid")
+		return awsec2.DeleteVpcPeeringConnectionRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.DeleteVpcPeeringConnectionOutput{},
+				Error:       mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpectedItem,
+			nil,
+			false,
+		},
+		{
+			"if the resource doesn't exist deleting resource should not return an error",
+			mockManaged.DeepCopy(),
+			awserr.New(ec2.VPCPeeringConnectionIDNotFound, "", nil),
+			true,
+		},
+		{
+			"if deleting resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+
+		err := mockExternalClient.Delete(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha3.VPCPeeringConnection)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonDeleting), tc.description)
+		}
+	}
+}
@@ -37,6 +37,8 @@ import (
 
 var _ claimbinding.ManagedConfigurator = claimbinding.ManagedConfiguratorFn(ConfigureS3Bucket)
 
+const namespace = "default"
+
 func TestConfigureBucket(t *testing.T) {
 	type args struct {
 		ctx context.Context
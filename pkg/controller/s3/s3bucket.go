@@ -18,84 +18,102 @@ package s3
 
 import (
 	"context"
+	"sort"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
-	kerrors "k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/reconcile"
-
-	bucketv1alpha3 "github.com/crossplane/provider-aws/apis/storage/v1alpha3"
-	aws "github.com/crossplane/provider-aws/pkg/clients"
-	"github.com/crossplane/provider-aws/pkg/clients/s3"
-	"github.com/crossplane/provider-aws/pkg/controller/utils"
 
 	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	bucketv1alpha3 "github.com/crossplane/provider-aws/apis/storage/v1alpha3"
+	iamc "github.com/crossplane/provider-aws/pkg/clients/iam"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+	"github.com/crossplane/provider-aws/pkg/controller/utils"
 )
 
 const (
-	controllerName = "s3bucket.aws.crossplane.io"
-	finalizer      = "finalizer." + controllerName
-)
+	// managedFinalizer is the finalizer of the managed reconciler.
+	managedFinalizer = "finalizer.managedresource.crossplane.io"
 
-var (
-	ctx           = context.Background()
-	result        = reconcile.Result{}
-	resultRequeue = reconcile.Result{Requeue: true}
+	// legacyFinalizer is the finalizer of the S3Bucket controller before it
+	// used the managed reconciler.
+	legacyFinalizer = "finalizer.s3bucket.aws.crossplane.io"
 )
 
-// Reconciler reconciles a S3Bucket object
-type Reconciler struct {
-	client.Client
-	scheme *runtime.Scheme
-	managed.ConnectionPublisher
-	initializer managed.Initializer
-
-	connect func(*bucketv1alpha3.S3Bucket) (s3.Service, error)
-	create  func(*bucketv1alpha3.S3Bucket, s3.Service) (reconcile.Result, error)
-	sync    func(*bucketv1alpha3.S3Bucket, s3.Service) (reconcile.Result, error)
-	delete  func(*bucketv1alpha3.S3Bucket, s3.Service) (reconcile.Result, error)
-
-	log logging.Logger
-}
+const (
+	errNotS3Bucket      = "managed resource is not an S3Bucket custom resource"
+	errKubeUpdateFailed = "cannot update S3Bucket custom resource"
+
+	errGetBucketInfo         = "cannot get S3 bucket info"
+	errCreateBucket          = "cannot create S3 bucket"
+	errCreateUser            = "cannot create IAM user for S3 bucket"
+	errCreateAccessPolicy    = "cannot create IAM policy for S3 bucket"
+	errAttachAccessPolicy    = "cannot attach IAM policy for S3 bucket to IAM role"
+	errDetachAccessPolicy    = "cannot detach IAM policy for S3 bucket from IAM role"
+	errUpdateVersioning      = "cannot update S3 bucket versioning"
+	errUpdateACL             = "cannot update S3 bucket ACL"
+	errUpdatePolicy          = "cannot update S3 bucket user policy"
+	errSetPolicyVersion      = "cannot set S3 bucket user policy version"
+	errPolicyChanged         = "cannot determine whether S3 bucket user policy has changed"
+	errDeleteBucket          = "cannot delete S3 bucket"
+	errEmptyBucket           = "cannot delete objects of S3 bucket"
+	errUpdateTags            = "cannot update S3 bucket tags"
+	errUpdateObjectLock      = "cannot update S3 bucket Object Lock configuration"
+	errUpdateRequestPayer    = "cannot update S3 bucket request payer"
+	errUpdateLifecycle       = "cannot update S3 bucket lifecycle configuration"
+	errUpdateSSE             = "cannot update S3 bucket default encryption"
+	errUpdatePAB             = "cannot update S3 bucket public access block"
+	errUpdateBucketPol       = "cannot update S3 bucket policy"
+	errUpToDateFailed        = "cannot check whether S3 bucket is up-to-date"
+	errUpdateCORS            = "cannot update S3 bucket CORS configuration"
+	errUpdateWebsite         = "cannot update S3 bucket website configuration"
+	errUpdateLogging         = "cannot update S3 bucket logging configuration"
+	errUpdateReplication     = "cannot update S3 bucket replication configuration"
+	errGetDestination        = "cannot get replication destination S3Bucket"
+	errSourceVersioning      = "replication requires versioning to be enabled on the source bucket"
+	errObjectLockVersioning  = "Object Lock requires versioning to be enabled on the bucket"
+	errDestinationVersioning = "replication requires versioning to be enabled on destination S3Bucket %s"
+)
 
 // SetupS3Bucket adds a controller that reconciles S3Buckets.
 func SetupS3Bucket(mgr ctrl.Manager, l logging.Logger) error {
-	name := managed.ControllerName(bucketv1alpha3.S3BucketClassGroupKind)
-
-	r := &Reconciler{
-		Client:              mgr.GetClient(),
-		scheme:              mgr.GetScheme(),
-		ConnectionPublisher: managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme()),
-		log:                 l.WithValues("controller", name),
-		initializer:         managed.NewNameAsExternalName(mgr.GetClient()),
-	}
-	r.connect = r._connect
-	r.create = r._create
-	r.delete = r._delete
-	r.sync = r._sync
+	name := managed.ControllerName(bucketv1alpha3.S3BucketGroupKind)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		For(&bucketv1alpha3.S3Bucket{}).
-		Owns(&corev1.Secret{}).
-		Complete(r)
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(bucketv1alpha3.S3BucketGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: s3.NewClient, awsConfigFn: utils.RetrieveAwsConfigFromProvider}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
+			managed.WithFinalizer(&finalizer{kube: mgr.GetClient()}),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
 
-// fail - helper function to set fail condition with reason and message
-func (r *Reconciler) fail(bucket *bucketv1alpha3.S3Bucket, err error) (reconcile.Result, error) {
-	bucket.Status.SetConditions(runtimev1alpha1.ReconcileError(err))
-	return reconcile.Result{Requeue: true}, r.Update(context.TODO(), bucket)
+type connector struct {
+	kube        client.Client
+	newClientFn func(*aws.Config) s3.Service
+	awsConfigFn func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error)
 }
 
-func (r *Reconciler) _connect(instance *bucketv1alpha3.S3Bucket) (s3.Service, error) {
-	config, err := utils.RetrieveAwsConfigFromProvider(ctx, r, instance.Spec.ProviderReference)
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*bucketv1alpha3.S3Bucket)
+	if !ok {
+		return nil, errors.New(errNotS3Bucket)
+	}
+
+	config, err := c.awsConfigFn(ctx, c.kube, cr.Spec.ProviderReference)
 	if err != nil {
 		return nil, err
 	}
@@ -103,140 +121,415 @@ func (r *Reconciler) _connect(instance *bucketv1alpha3.S3Bucket) (s3.Service, er
 	// NOTE(negz): Buckets must specify a region for creation. They never use
 	// the provider's region. This should be addressed per the below issue.
 	// https://github.com/crossplane/provider-aws/issues/38
-	config.Region = instance.Spec.Region
+	config.Region = cr.Spec.Region
+
+	return &external{kube: c.kube, client: c.newClientFn(config)}, nil
+}
 
-	// Create new S3 S3Client
-	return s3.NewClient(config), nil
+type external struct {
+	kube   client.Client
+	client s3.Service
 }
 
-func (r *Reconciler) _create(bucket *bucketv1alpha3.S3Bucket, client s3.Service) (reconcile.Result, error) {
-	bucket.Status.SetConditions(runtimev1alpha1.Creating(), runtimev1alpha1.ReconcileSuccess())
-	meta.AddFinalizer(bucket, finalizer)
-	err := client.CreateOrUpdateBucket(bucket)
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*bucketv1alpha3.S3Bucket)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotS3Bucket)
+	}
+
+	// The IAM user or policy is created along with the bucket, so a bucket
+	// without its name has not been created by us yet.
+	if iamName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// A bucket whose IAM user or policy doesn't exist is reported as not
+	// existing, so that a create that failed half way through is retried.
+	info, err := e.client.GetBucketInfo(ctx, iamName(cr), cr)
 	if err != nil {
-		return r.fail(bucket, err)
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(isNotFound, err), errGetBucketInfo)
 	}
 
-	// Set username for iam user
-	if bucket.Spec.IAMUsername == "" {
-		bucket.Spec.IAMUsername = s3.GenerateBucketUsername(bucket)
+	cr.Status.AtProvider = s3.GenerateObservation(*info)
+	cr.Status.SetConditions(runtimev1alpha1.Available())
+	resource.SetBindable(cr)
+
+	// The user's policy is eventually consistent, so we check whether the
+	// observed version is newer than our stored version.
+	changed, err := cr.HasPolicyChanged(info.UserPolicyVersion)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errPolicyChanged)
 	}
 
-	// Get access keys for iam user
-	accessKeys, currentVersion, err := client.CreateUser(bucket.Spec.IAMUsername, bucket)
+	upToDate, err := s3.IsBucketUpToDate(cr.Spec.S3BucketParameters, *info)
 	if err != nil {
-		return r.fail(bucket, err)
+		return managed.ExternalObservation{}, errors.Wrap(err, errUpToDateFailed)
+	}
+
+	conn := connectionDetails(cr)
+	if cr.Spec.AccessMode == bucketv1alpha3.AccessModePolicy {
+		conn[s3.AccessPolicyARNKey] = []byte(info.AccessPolicyARN)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate && !changed,
+		ConnectionDetails: conn,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*bucketv1alpha3.S3Bucket)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotS3Bucket)
 	}
 
-	// Set user policy version in status so we can detect policy drift
-	err = bucket.SetUserPolicyVersion(currentVersion)
+	cr.Status.SetConditions(runtimev1alpha1.Creating())
+	if err := validateObjectLock(cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	if err := e.client.CreateOrUpdateBucket(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateBucket)
+	}
+
+	if cr.Spec.AccessMode == bucketv1alpha3.AccessModePolicy {
+		return e.createAccessPolicy(ctx, cr)
+	}
+
+	// We persist the username before creating the user so that we never lose
+	// track of an IAM user we created. Creating the bucket and the user is
+	// idempotent, so they are both retried if creating the user fails.
+	if cr.Spec.IAMUsername == "" {
+		cr.Spec.IAMUsername = s3.GenerateBucketUsername(cr)
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+
+	accessKeys, currentVersion, err := e.client.CreateUser(ctx, cr.Spec.IAMUsername, cr)
 	if err != nil {
-		return r.fail(bucket, err)
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateUser)
 	}
 
-	if err := r.PublishConnection(ctx, bucket, managed.ConnectionDetails{
-		runtimev1alpha1.ResourceCredentialsSecretUserKey:     []byte(aws.StringValue(accessKeys.AccessKeyId)),
-		runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte(aws.StringValue(accessKeys.SecretAccessKey)),
-		runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(bucket.Spec.Region),
-	}); err != nil {
-		return r.fail(bucket, err)
+	// Set user policy version in status so we can detect policy drift.
+	if err := cr.SetUserPolicyVersion(currentVersion); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errSetPolicyVersion)
 	}
 
-	// No longer creating, we're ready!
-	bucket.Status.SetConditions(runtimev1alpha1.Available())
-	resource.SetBindable(bucket)
-	return result, r.Update(ctx, bucket)
+	conn := connectionDetails(cr)
+	conn[runtimev1alpha1.ResourceCredentialsSecretUserKey] = []byte(aws.StringValue(accessKeys.AccessKeyId))
+	conn[runtimev1alpha1.ResourceCredentialsSecretPasswordKey] = []byte(aws.StringValue(accessKeys.SecretAccessKey))
+	return managed.ExternalCreation{ConnectionDetails: conn}, nil
 }
 
-func (r *Reconciler) _sync(bucket *bucketv1alpha3.S3Bucket, client s3.Service) (reconcile.Result, error) {
-	if bucket.Spec.IAMUsername == "" {
-		return r.fail(bucket, errors.New("username not set, .Status.IAMUsername"))
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*bucketv1alpha3.S3Bucket)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotS3Bucket)
 	}
-	bucketInfo, err := client.GetBucketInfo(bucket.Spec.IAMUsername, bucket)
+
+	// S3 doesn't allow suspending the versioning of a bucket with Object
+	// Lock, so the bucket would never be up to date.
+	if err := validateObjectLock(cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	info, err := e.client.GetBucketInfo(ctx, iamName(cr), cr)
 	if err != nil {
-		return r.fail(bucket, err)
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetBucketInfo)
 	}
 
-	if bucketInfo.Versioning != bucket.Spec.Versioning {
-		err := client.UpdateVersioning(bucket)
-		if err != nil {
-			return r.fail(bucket, err)
+	if info.Versioning != cr.Spec.Versioning {
+		if err := e.client.UpdateVersioning(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateVersioning)
 		}
 	}
 
 	// TODO: Detect if the bucket CannedACL has changed, possibly by managing grants list directly.
-	err = client.UpdateBucketACL(bucket)
+	if err := e.client.UpdateBucketACL(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateACL)
+	}
+
+	if !s3.IsLifecycleConfigurationUpToDate(cr.Spec.LifecycleConfiguration, info.LifecycleRules) {
+		if err := e.client.UpdateLifecycleConfiguration(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateLifecycle)
+		}
+	}
+
+	if !s3.IsServerSideEncryptionUpToDate(cr.Spec.ServerSideEncryptionConfiguration, info.ServerSideEncryption) {
+		if err := e.client.UpdateServerSideEncryption(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateSSE)
+		}
+	}
+
+	if !s3.IsPublicAccessBlockUpToDate(cr.Spec.PublicAccessBlockConfiguration, info.PublicAccessBlock) {
+		if err := e.client.UpdatePublicAccessBlock(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdatePAB)
+		}
+	}
+
+	policyUpToDate, err := s3.IsBucketPolicyUpToDate(cr.Spec.BucketPolicy, info.Policy)
 	if err != nil {
-		return r.fail(bucket, err)
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpToDateFailed)
+	}
+	if !policyUpToDate {
+		if err := e.client.UpdateBucketPolicy(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateBucketPol)
+		}
+	}
+
+	if !s3.IsCORSConfigurationUpToDate(cr.Spec.CORSConfiguration, info.CORSRules) {
+		if err := e.client.UpdateCORS(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateCORS)
+		}
+	}
+
+	if !s3.IsWebsiteConfigurationUpToDate(cr.Spec.WebsiteConfiguration, info.Website) {
+		if err := e.client.UpdateWebsite(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateWebsite)
+		}
 	}
 
-	// Eventually consistent, so we check if this version is newer than our stored version.
-	changed, err := bucket.HasPolicyChanged(bucketInfo.UserPolicyVersion)
+	if !s3.IsLoggingConfigurationUpToDate(cr.Spec.LoggingConfiguration, info.Logging) {
+		if err := e.client.UpdateLogging(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateLogging)
+		}
+	}
+
+	if !s3.IsReplicationConfigurationUpToDate(cr.Spec.ReplicationConfiguration, info.Replication) {
+		if err := e.validateReplication(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		if err := e.client.UpdateReplication(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateReplication)
+		}
+	}
+
+	if !s3.AreTagsUpToDate(cr.Spec.Tags, info.Tags) {
+		if err := e.client.UpdateTags(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTags)
+		}
+	}
+
+	if !s3.IsObjectLockUpToDate(cr.Spec.ObjectLockConfiguration, info.ObjectLock) {
+		if err := e.client.UpdateObjectLock(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateObjectLock)
+		}
+	}
+
+	if !s3.IsRequestPayerUpToDate(cr.Spec.RequestPayer, info.RequestPayer) {
+		if err := e.client.UpdateRequestPayer(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateRequestPayer)
+		}
+	}
+
+	// The IAM policy is detached from roles that are no longer desired, for
+	// example because the role of the bucket changed.
+	if !s3.AreAccessPolicyRolesUpToDate(cr.Spec.S3BucketParameters, info.AccessPolicyRoles) {
+		if err := e.client.AttachAccessPolicy(ctx, iamName(cr), cr); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAttachAccessPolicy)
+		}
+		for _, r := range info.AccessPolicyRoles {
+			if r == aws.StringValue(cr.Spec.IAMRoleName) {
+				continue
+			}
+			if err := e.client.DetachAccessPolicy(ctx, iamName(cr), r); err != nil {
+				return managed.ExternalUpdate{}, errors.Wrap(err, errDetachAccessPolicy)
+			}
+		}
+	}
+
+	changed, err := cr.HasPolicyChanged(info.UserPolicyVersion)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errPolicyChanged)
+	}
+	if !changed {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	currentVersion, err := e.client.UpdatePolicyDocument(ctx, iamName(cr), cr)
 	if err != nil {
-		return r.fail(bucket, err)
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdatePolicy)
 	}
-	if changed {
-		currentVersion, err := client.UpdatePolicyDocument(bucket.Spec.IAMUsername, bucket)
+	return managed.ExternalUpdate{}, errors.Wrap(cr.SetUserPolicyVersion(currentVersion), errSetPolicyVersion)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*bucketv1alpha3.S3Bucket)
+	if !ok {
+		return errors.New(errNotS3Bucket)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+
+	// We delete a single page of objects per reconcile, recording our progress
+	// so that it's reported. We're requeued to delete the next page until the
+	// bucket is empty.
+	if cr.Spec.DeletionMode == bucketv1alpha3.DeletionModeDeleteContents {
+		deleted, err := e.client.EmptyBucket(ctx, cr)
+		cr.Status.DeletedObjects += int64(deleted)
 		if err != nil {
-			return r.fail(bucket, err)
+			return errors.Wrap(err, errEmptyBucket)
 		}
-		err = bucket.SetUserPolicyVersion(currentVersion)
-		if err != nil {
-			return r.fail(bucket, err)
+		if deleted > 0 {
+			return nil
 		}
 	}
 
-	bucket.Status.SetConditions(runtimev1alpha1.ReconcileSuccess())
-	return result, r.Update(ctx, bucket)
+	// A bucket that isn't empty can't be deleted until someone empties it, so
+	// we report why in addition to returning an error.
+	err := e.client.DeleteBucket(ctx, cr)
+	if s3.IsBucketNotEmpty(err) {
+		cr.Status.SetConditions(bucketv1alpha3.BucketNotEmpty())
+	}
+	return errors.Wrap(err, errDeleteBucket)
 }
 
-func (r *Reconciler) _delete(bucket *bucketv1alpha3.S3Bucket, client s3.Service) (reconcile.Result, error) {
-	bucket.Status.SetConditions(runtimev1alpha1.Deleting(), runtimev1alpha1.ReconcileSuccess())
-	if bucket.Spec.ReclaimPolicy == runtimev1alpha1.ReclaimDelete {
-		if err := client.DeleteBucket(bucket); err != nil {
-			return r.fail(bucket, err)
+// createAccessPolicy creates the IAM policy that grants access to a bucket in
+// policy access mode, and publishes its ARN rather than any credentials.
+func (e *external) createAccessPolicy(ctx context.Context, cr *bucketv1alpha3.S3Bucket) (managed.ExternalCreation, error) {
+	// We persist the policy name before creating the policy so that we never
+	// lose track of an IAM policy we created.
+	if cr.Spec.IAMPolicyName == "" {
+		cr.Spec.IAMPolicyName = s3.GenerateBucketPolicyName(cr)
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errKubeUpdateFailed)
 		}
 	}
 
-	meta.RemoveFinalizer(bucket, finalizer)
-	return result, r.Update(ctx, bucket)
+	policyARN, currentVersion, err := e.client.CreateAccessPolicy(ctx, cr.Spec.IAMPolicyName, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateAccessPolicy)
+	}
+
+	// Set policy version in status so we can detect policy drift.
+	if err := cr.SetUserPolicyVersion(currentVersion); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errSetPolicyVersion)
+	}
+
+	conn := connectionDetails(cr)
+	conn[s3.AccessPolicyARNKey] = []byte(policyARN)
+	return managed.ExternalCreation{ConnectionDetails: conn}, nil
 }
 
-// Reconcile reads that state of the bucket for an Instance object and makes changes based on the state read
-// and what is in the Instance.Spec
-func (r *Reconciler) Reconcile(request reconcile.Request) (reconcile.Result, error) {
-	r.log.Debug("Reconciling", "request", request)
+// finalizer adds the finalizer of the managed reconciler, and removes the
+// legacy finalizer of buckets that were created before the managed reconciler
+// was used. Otherwise those buckets could never be deleted. New finalizers
+// can't be added to a bucket that is being deleted, so the legacy finalizer is
+// removed along with the managed one rather than replaced by it.
+type finalizer struct {
+	kube client.Client
+}
 
-	// Fetch the CRD instance
-	bucket := &bucketv1alpha3.S3Bucket{}
-	err := r.Get(ctx, request.NamespacedName, bucket)
-	if err != nil {
-		if kerrors.IsNotFound(err) {
-			// Object not found, return.  Created objects are automatically garbage collected.
-			// For additional cleanup logic use finalizers.
-			return result, nil
+func (f *finalizer) AddFinalizer(ctx context.Context, obj resource.Object) error {
+	if meta.FinalizerExists(obj, managedFinalizer) && !meta.FinalizerExists(obj, legacyFinalizer) {
+		return nil
+	}
+	meta.AddFinalizer(obj, managedFinalizer)
+	meta.RemoveFinalizer(obj, legacyFinalizer)
+	return errors.Wrap(f.kube.Update(ctx, obj), errKubeUpdateFailed)
+}
+
+func (f *finalizer) RemoveFinalizer(ctx context.Context, obj resource.Object) error {
+	if !meta.FinalizerExists(obj, managedFinalizer) && !meta.FinalizerExists(obj, legacyFinalizer) {
+		return nil
+	}
+	meta.RemoveFinalizer(obj, managedFinalizer)
+	meta.RemoveFinalizer(obj, legacyFinalizer)
+	return errors.Wrap(resource.IgnoreNotFound(f.kube.Update(ctx, obj)), errKubeUpdateFailed)
+}
+
+type tagger struct {
+	kube client.Client
+}
+
+func (t *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*bucketv1alpha3.S3Bucket)
+	if !ok {
+		return errors.New(errNotS3Bucket)
+	}
+	tagMap := map[string]string{}
+	for _, t := range cr.Spec.Tags {
+		tagMap[t.Key] = t.Value
+	}
+	changed := false
+	for k, v := range resource.GetExternalTags(mg) {
+		if cur, ok := tagMap[k]; !ok || cur != v {
+			tagMap[k] = v
+			changed = true
 		}
-		return result, err
 	}
-	if err := r.initializer.Initialize(ctx, bucket); err != nil {
-		return result, err
+	if !changed {
+		return nil
+	}
+	cr.Spec.Tags = make([]bucketv1alpha3.Tag, len(tagMap))
+	i := 0
+	for k, v := range tagMap {
+		cr.Spec.Tags[i] = bucketv1alpha3.Tag{Key: k, Value: v}
+		i++
 	}
+	sort.Slice(cr.Spec.Tags, func(i, j int) bool {
+		return cr.Spec.Tags[i].Key < cr.Spec.Tags[j].Key
+	})
+	return errors.Wrap(t.kube.Update(ctx, cr), errKubeUpdateFailed)
+}
 
-	s3Client, err := r.connect(bucket)
-	if err != nil {
-		return r.fail(bucket, err)
+// validateReplication returns an error unless versioning is enabled on the
+// supplied bucket and on every destination S3Bucket it references. S3 itself
+// rejects destinations that are specified by ARN and aren't versioned.
+func (e *external) validateReplication(ctx context.Context, cr *bucketv1alpha3.S3Bucket) error {
+	if !cr.Spec.Versioning {
+		return errors.New(errSourceVersioning)
+	}
+	for _, r := range cr.Spec.ReplicationConfiguration.Rules {
+		ref := r.Destination.BucketRef
+		if ref == nil {
+			continue
+		}
+		dst := &bucketv1alpha3.S3Bucket{}
+		if err := e.kube.Get(ctx, types.NamespacedName{Name: ref.Name}, dst); err != nil {
+			return errors.Wrap(err, errGetDestination)
+		}
+		if !dst.Spec.Versioning {
+			return errors.Errorf(errDestinationVersioning, ref.Name)
+		}
 	}
+	return nil
+}
 
-	// Check for deletion
-	if bucket.DeletionTimestamp != nil {
-		return r.delete(bucket, s3Client)
+// validateObjectLock returns an error if Object Lock is configured for the
+// supplied bucket but versioning is not enabled.
+func validateObjectLock(cr *bucketv1alpha3.S3Bucket) error {
+	if cr.Spec.ObjectLockConfiguration != nil && !cr.Spec.Versioning {
+		return errors.New(errObjectLockVersioning)
 	}
+	return nil
+}
+
+// isNotFound returns true if the supplied error indicates that the bucket, or
+// the IAM user or policy that grants access to it, doesn't exist.
+func isNotFound(err error) bool {
+	return s3.IsErrorNotFound(err) || iamc.IsErrorNotFound(err)
+}
 
-	// Create s3 bucket
-	if bucket.Spec.IAMUsername == "" {
-		return r.create(bucket, s3Client)
+// iamName returns the name of the IAM user or policy that grants access to the
+// supplied bucket. The IAM policy of a bucket in user access mode is named like
+// its IAM user.
+func iamName(cr *bucketv1alpha3.S3Bucket) string {
+	if cr.Spec.AccessMode == bucketv1alpha3.AccessModePolicy {
+		return cr.Spec.IAMPolicyName
 	}
+	return cr.Spec.IAMUsername
+}
 
-	// Update the bucket if it's no longer there.
-	return r.sync(bucket, s3Client)
+// connectionDetails returns the connection details of the supplied bucket that
+// don't depend on its IAM user.
+func connectionDetails(cr *bucketv1alpha3.S3Bucket) managed.ConnectionDetails {
+	conn := managed.ConnectionDetails{
+		runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(cr.Spec.Region),
+	}
+	if cr.Spec.WebsiteConfiguration != nil {
+		conn[s3.WebsiteEndpointKey] = []byte(s3.WebsiteEndpoint(meta.GetExternalName(cr), cr.Spec.Region))
+	}
+	return conn
 }
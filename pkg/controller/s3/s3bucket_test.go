@@ -18,469 +18,1326 @@ package s3
 
 import (
 	"context"
-	"errors"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/google/go-cmp/cmp"
-	. "github.com/onsi/gomega"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/client-go/kubernetes/scheme"
-	. "sigs.k8s.io/controller-runtime/pkg/client/fake"
-	"sigs.k8s.io/controller-runtime/pkg/reconcile"
-
-	"github.com/crossplane/provider-aws/apis"
-	"github.com/crossplane/provider-aws/apis/storage/v1alpha3"
-	. "github.com/crossplane/provider-aws/apis/storage/v1alpha3"
-	aws "github.com/crossplane/provider-aws/pkg/clients"
-	client "github.com/crossplane/provider-aws/pkg/clients/s3"
-	. "github.com/crossplane/provider-aws/pkg/clients/s3/fake"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
-	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 	storagev1alpha1 "github.com/crossplane/crossplane/apis/storage/v1alpha1"
+
+	"github.com/crossplane/provider-aws/apis/storage/v1alpha3"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
 )
 
 const (
-	namespace  = "default"
-	bucketName = "test-bucket"
+	testRegion   = "us-west-2"
+	testUsername = "test-username"
+	testKeyID    = "test-key-id"
+	testSecret   = "test-secret"
+
+	testPolicyName = "test-policy"
+	testPolicyARN  = "arn:aws:iam::123456789012:policy/test-policy"
+	testRoleName   = "test-role"
 )
 
 var (
-	key = types.NamespacedName{
-		Name: bucketName,
+	errBoom = errors.New("boom")
+
+	sse = &v1alpha3.ServerSideEncryptionConfiguration{
+		Rules: []v1alpha3.ServerSideEncryptionRule{{
+			ApplyServerSideEncryptionByDefault: v1alpha3.ServerSideEncryptionByDefault{SSEAlgorithm: string(awss3.ServerSideEncryptionAwsKms)},
+		}},
 	}
-	request = reconcile.Request{
-		NamespacedName: key,
+
+	replication = &v1alpha3.ReplicationConfiguration{
+		Role: aws.String("arn:aws:iam::123456789012:role/replication"),
+		Rules: []v1alpha3.ReplicationRule{{
+			Status: v1alpha3.ReplicationRuleStatusEnabled,
+			Destination: v1alpha3.ReplicationDestination{
+				Bucket:    aws.String("arn:aws:s3:::replica"),
+				BucketRef: &runtimev1alpha1.Reference{Name: "replica"},
+			},
+		}},
 	}
-)
 
-func init() {
-	if err := apis.AddToScheme(scheme.Scheme); err != nil {
-		panic(err)
+	website = &v1alpha3.WebsiteConfiguration{
+		IndexDocument: &v1alpha3.IndexDocument{Suffix: "index.html"},
 	}
-}
 
-func testResource() *S3Bucket {
-	perm := storagev1alpha1.ReadOnlyPermission
-	testIAMUsername := "test-username"
-	return &S3Bucket{
-		ObjectMeta: metav1.ObjectMeta{
-			Name: bucketName,
-		},
-		Spec: S3BucketSpec{
-			ResourceSpec:       runtimev1alpha1.ResourceSpec{ProviderReference: &corev1.ObjectReference{}},
-			S3BucketParameters: v1alpha3.S3BucketParameters{LocalPermission: &perm, IAMUsername: testIAMUsername},
-		},
+	lifecycle = &v1alpha3.BucketLifecycleConfiguration{
+		Rules: []v1alpha3.LifecycleRule{{Status: v1alpha3.LifecycleRuleStatusEnabled}},
 	}
-}
 
-// assertResource a helper function to check on cluster and its status
-func assertResource(g *GomegaWithT, r *Reconciler, s runtimev1alpha1.ConditionedStatus) *S3Bucket {
-	resource := &S3Bucket{}
-	err := r.Get(ctx, key, resource)
-	g.Expect(err).To(BeNil())
-	g.Expect(cmp.Diff(s, resource.Status.ConditionedStatus, test.EquateConditions())).Should(BeZero())
-	return resource
+	_ managed.ExternalClient    = &external{}
+	_ managed.ExternalConnecter = &connector{}
+)
+
+type bucketModifier func(*v1alpha3.S3Bucket)
+
+func withUsername(u string) bucketModifier {
+	return func(r *v1alpha3.S3Bucket) { r.Spec.IAMUsername = u }
 }
 
-func TestSyncBucketError(t *testing.T) {
-	g := NewGomegaWithT(t)
+func withVersioning(v bool) bucketModifier {
+	return func(r *v1alpha3.S3Bucket) { r.Spec.Versioning = v }
+}
 
-	assert := func(instance *S3Bucket, client client.Service, expectedResult reconcile.Result, expectedStatus runtimev1alpha1.ConditionedStatus) {
-		r := &Reconciler{
-			Client: NewFakeClient(instance),
-			log:    logging.NewNopLogger(),
-		}
+func withLifecycle(c *v1alpha3.BucketLifecycleConfiguration) bucketModifier {
+	return func(r *v1alpha3.S3Bucket) { r.Spec.LifecycleConfiguration = c }
+}
 
-		rs, err := r._sync(instance, client)
+func withSSE(c *v1alpha3.ServerSideEncryptionConfiguration) bucketModifier {
+	return func(r *v1alpha3.S3Bucket) { r.Spec.ServerSideEncryptionConfiguration = c }
+}
 
-		g.Expect(err).NotTo(HaveOccurred())
-		g.Expect(rs).To(Equal(expectedResult))
-		assertResource(g, r, expectedStatus)
-	}
+func withAtProvider(o v1alpha3.S3BucketObservation) bucketModifier {
+	return func(r *v1alpha3.S3Bucket) { r.Status.AtProvider = o }
+}
 
-	// error iam username not set
-	testError := errors.New("username not set, .Status.IAMUsername")
-	cl := &MockS3Client{}
+func withBucketPolicy(p string) bucketModifier {
+	return func(r *v1alpha3.S3Bucket) { r.Spec.BucketPolicy = &p }
+}
 
-	expectedStatus := runtimev1alpha1.ConditionedStatus{}
-	expectedStatus.SetConditions(runtimev1alpha1.ReconcileError(testError))
-	noUserResource := testResource()
-	noUserResource.Spec.IAMUsername = ""
-	assert(noUserResource, cl, resultRequeue, expectedStatus)
+func withWebsite(c *v1alpha3.WebsiteConfiguration) bucketModifier {
+	return func(r *v1alpha3.S3Bucket) { r.Spec.WebsiteConfiguration = c }
+}
 
-	// error get bucket info
-	testError = errors.New("mock get bucket info err")
-	cl.MockGetBucketInfo = func(username string, bucket *S3Bucket) (*client.Bucket, error) {
-		return nil, testError
-	}
-	expectedStatus = runtimev1alpha1.ConditionedStatus{}
-	expectedStatus.SetConditions(runtimev1alpha1.ReconcileError(testError))
-	assert(testResource(), cl, resultRequeue, expectedStatus)
+func withReplication(c *v1alpha3.ReplicationConfiguration) bucketModifier {
+	return func(r *v1alpha3.S3Bucket) { r.Spec.ReplicationConfiguration = c }
+}
 
-	//update versioning error
-	cl.MockGetBucketInfo = func(username string, bucket *S3Bucket) (*client.Bucket, error) {
-		return &client.Bucket{Versioning: true, UserPolicyVersion: "v1"}, nil
-	}
+func withDeletionMode(m string) bucketModifier {
+	return func(r *v1alpha3.S3Bucket) { r.Spec.DeletionMode = m }
+}
 
-	testError = errors.New("bucket-versioning-update-error")
-	cl.MockUpdateVersioning = func(bucket *S3Bucket) error {
-		return testError
-	}
-	expectedStatus = runtimev1alpha1.ConditionedStatus{}
-	expectedStatus.SetConditions(runtimev1alpha1.ReconcileError(testError))
-	assert(testResource(), cl, resultRequeue, expectedStatus)
+func withDeletedObjects(n int64) bucketModifier {
+	return func(r *v1alpha3.S3Bucket) { r.Status.DeletedObjects = n }
+}
 
-	// update bucket acl error
-	cl.MockGetBucketInfo = func(username string, bucket *S3Bucket) (*client.Bucket, error) {
-		return &client.Bucket{Versioning: false, UserPolicyVersion: "v1"}, nil
+func withTags(tagMaps ...map[string]string) bucketModifier {
+	var tags []v1alpha3.Tag
+	for _, tagMap := range tagMaps {
+		for k, v := range tagMap {
+			tags = append(tags, v1alpha3.Tag{Key: k, Value: v})
+		}
 	}
+	return func(r *v1alpha3.S3Bucket) { r.Spec.Tags = tags }
+}
 
-	testError = errors.New("bucket-acl-update-error")
-	cl.MockUpdateBucketACL = func(bucket *S3Bucket) error {
-		return testError
-	}
+func withObjectLock(c *v1alpha3.ObjectLockConfiguration) bucketModifier {
+	return func(r *v1alpha3.S3Bucket) { r.Spec.ObjectLockConfiguration = c }
+}
 
-	expectedStatus = runtimev1alpha1.ConditionedStatus{}
-	expectedStatus.SetConditions(runtimev1alpha1.ReconcileError(testError))
-	assert(testResource(), cl, resultRequeue, expectedStatus)
+func withRequestPayer(p string) bucketModifier {
+	return func(r *v1alpha3.S3Bucket) { r.Spec.RequestPayer = &p }
+}
 
-	cl.MockUpdateBucketACL = func(bucket *S3Bucket) error {
-		return nil
+func withAccessPolicy(name string, role *string) bucketModifier {
+	return func(r *v1alpha3.S3Bucket) {
+		r.Spec.AccessMode = v1alpha3.AccessModePolicy
+		r.Spec.IAMPolicyName = name
+		r.Spec.IAMRoleName = role
 	}
+}
 
-	// Update policy error
-	perm := storagev1alpha1.WriteOnlyPermission
-	bucketWithPolicyChanges := testResource()
-	bucketWithPolicyChanges.Spec.LocalPermission = &perm
-	bucketWithPolicyChanges.Status.LastUserPolicyVersion = 1
-	bucketWithPolicyChanges.Status.LastLocalPermission = storagev1alpha1.ReadOnlyPermission
-
-	testError = errors.New("policy-update-err")
-	cl.MockUpdatePolicyDocument = func(username string, bucket *S3Bucket) (string, error) {
-		return "", testError
-	}
-	expectedStatus = runtimev1alpha1.ConditionedStatus{}
-	expectedStatus.SetConditions(runtimev1alpha1.ReconcileError(testError))
-	assert(testResource(), cl, resultRequeue, expectedStatus)
+func withExternalName(n string) bucketModifier {
+	return func(r *v1alpha3.S3Bucket) { meta.SetExternalName(r, n) }
 }
 
-func TestSyncBucket(t *testing.T) {
-	g := NewGomegaWithT(t)
-	tr := testResource()
-	tr.Status.LastUserPolicyVersion = 1
-	tr.Status.LastLocalPermission = storagev1alpha1.ReadOnlyPermission
-
-	r := &Reconciler{
-		Client: NewFakeClient(tr),
-		log:    logging.NewNopLogger(),
-	}
-	//
-	updateBucketACLCalled := false
-	getBucketInfoCalled := false
-	cl := &MockS3Client{
-		MockUpdateBucketACL: func(bucket *S3Bucket) error {
-			updateBucketACLCalled = true
-			return nil
-		},
-		MockGetBucketInfo: func(username string, bucket *S3Bucket) (*client.Bucket, error) {
-			getBucketInfoCalled = true
-			return &client.Bucket{Versioning: false, UserPolicyVersion: "v1"}, nil
-		},
-	}
-
-	expectedStatus := runtimev1alpha1.ConditionedStatus{}
-	expectedStatus.SetConditions(runtimev1alpha1.ReconcileSuccess())
-	rs, err := r._sync(tr, cl)
-	g.Expect(rs).To(Equal(result))
-	g.Expect(err).NotTo(HaveOccurred())
-	g.Expect(updateBucketACLCalled).To(BeTrue())
-	g.Expect(getBucketInfoCalled).To(BeTrue())
-	assertResource(g, r, expectedStatus)
+func withFinalizers(f ...string) bucketModifier {
+	return func(r *v1alpha3.S3Bucket) { r.SetFinalizers(f) }
 }
 
-func TestDelete(t *testing.T) {
-	g := NewGomegaWithT(t)
+func withConditions(c ...runtimev1alpha1.Condition) bucketModifier {
+	return func(r *v1alpha3.S3Bucket) { r.Status.ConditionedStatus.Conditions = c }
+}
 
-	tr := testResource()
+func withBindingPhase(p runtimev1alpha1.BindingPhase) bucketModifier {
+	return func(r *v1alpha3.S3Bucket) { r.Status.SetBindingPhase(p) }
+}
 
-	r := &Reconciler{
-		Client: NewFakeClient(tr),
-		log:    logging.NewNopLogger(),
+func withPolicyVersion(v int) bucketModifier {
+	return func(r *v1alpha3.S3Bucket) {
+		r.Status.LastUserPolicyVersion = v
+		r.Status.LastLocalPermission = *r.Spec.LocalPermission
 	}
+}
 
-	cl := &MockS3Client{}
+func bucket(m ...bucketModifier) *v1alpha3.S3Bucket {
+	perm := storagev1alpha1.ReadOnlyPermission
+	cr := &v1alpha3.S3Bucket{
+		Spec: v1alpha3.S3BucketSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: &corev1.ObjectReference{},
+			},
+			S3BucketParameters: v1alpha3.S3BucketParameters{
+				Region:          testRegion,
+				LocalPermission: &perm,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
 
-	// test delete w/ reclaim policy
-	tr.Spec.ReclaimPolicy = runtimev1alpha1.ReclaimRetain
-	expectedStatus := runtimev1alpha1.ConditionedStatus{}
-	expectedStatus.SetConditions(runtimev1alpha1.Deleting(), runtimev1alpha1.ReconcileSuccess())
+func TestConnect(t *testing.T) {
+	type args struct {
+		awsConfigFn func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error)
+		cr          resource.Managed
+	}
 
-	rs, err := r._delete(tr, cl)
-	g.Expect(rs).To(Equal(result))
-	g.Expect(err).NotTo(HaveOccurred())
-	assertResource(g, r, expectedStatus)
+	cases := map[string]struct {
+		args
+		want error
+	}{
+		"Successful": {
+			args: args{
+				awsConfigFn: func(_ context.Context, _ client.Reader, _ *corev1.ObjectReference) (*aws.Config, error) {
+					return &aws.Config{Region: "us-east-1"}, nil
+				},
+				cr: bucket(),
+			},
+		},
+		"ConfigFailed": {
+			args: args{
+				awsConfigFn: func(_ context.Context, _ client.Reader, _ *corev1.ObjectReference) (*aws.Config, error) {
+					return nil, errBoom
+				},
+				cr: bucket(),
+			},
+			want: errBoom,
+		},
+	}
 
-	// test delete w/ delete policy
-	tr.Spec.ReclaimPolicy = runtimev1alpha1.ReclaimDelete
-	called := false
-	cl.MockDelete = func(bucket *S3Bucket) error {
-		called = true
-		return nil
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{
+				awsConfigFn: tc.awsConfigFn,
+				newClientFn: func(config *aws.Config) s3.Service {
+					if diff := cmp.Diff(testRegion, config.Region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return &fake.MockS3Client{}
+				},
+			}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
 	}
+}
 
-	rs, err = r._delete(tr, cl)
-	g.Expect(rs).To(Equal(result))
-	g.Expect(err).NotTo(HaveOccurred())
-	g.Expect(called).To(BeTrue())
-	assertResource(g, r, expectedStatus)
+func TestObserve(t *testing.T) {
+	type args struct {
+		s3 s3.Service
+		cr *v1alpha3.S3Bucket
+	}
+	type want struct {
+		cr     *v1alpha3.S3Bucket
+		result managed.ExternalObservation
+		err    error
+	}
 
-	// test delete w/ delete policy error
-	testError := errors.New("test-delete-error")
-	called = false
-	cl.MockDelete = func(bucket *S3Bucket) error {
-		called = true
-		return testError
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoUsername": {
+			args: args{
+				s3: &fake.MockS3Client{},
+				cr: bucket(),
+			},
+			want: want{
+				cr: bucket(),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				s3: &fake.MockS3Client{
+					MockGetBucketInfo: func(_ context.Context, _ string, _ *v1alpha3.S3Bucket) (*s3.Bucket, error) {
+						return &s3.Bucket{Versioning: true, UserPolicyVersion: "v1"}, nil
+					},
+				},
+				cr: bucket(withUsername(testUsername), withVersioning(true), withPolicyVersion(1)),
+			},
+			want: want{
+				cr: bucket(withUsername(testUsername), withVersioning(true), withPolicyVersion(1),
+					withConditions(runtimev1alpha1.Available()),
+					withBindingPhase(runtimev1alpha1.BindingPhaseUnbound)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(testRegion),
+					},
+				},
+			},
+		},
+		"PolicyAccessMode": {
+			args: args{
+				s3: &fake.MockS3Client{
+					MockGetBucketInfo: func(_ context.Context, name string, _ *v1alpha3.S3Bucket) (*s3.Bucket, error) {
+						if name != testPolicyName {
+							return nil, errBoom
+						}
+						return &s3.Bucket{
+							UserPolicyVersion: "v1",
+							AccessPolicyARN:   testPolicyARN,
+							AccessPolicyRoles: []string{testRoleName},
+						}, nil
+					},
+				},
+				cr: bucket(withAccessPolicy(testPolicyName, aws.String(testRoleName)), withPolicyVersion(1)),
+			},
+			want: want{
+				cr: bucket(withAccessPolicy(testPolicyName, aws.String(testRoleName)), withPolicyVersion(1),
+					withConditions(runtimev1alpha1.Available()),
+					withBindingPhase(runtimev1alpha1.BindingPhaseUnbound)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(testRegion),
+						s3.AccessPolicyARNKey:                                []byte(testPolicyARN),
+					},
+				},
+			},
+		},
+		"BucketPolicyReformatted": {
+			args: args{
+				s3: &fake.MockS3Client{
+					MockGetBucketInfo: func(_ context.Context, _ string, _ *v1alpha3.S3Bucket) (*s3.Bucket, error) {
+						return &s3.Bucket{UserPolicyVersion: "v1", Policy: aws.String(`{"Version":"2012-10-17","Statement":[]}`)}, nil
+					},
+				},
+				cr: bucket(withUsername(testUsername), withPolicyVersion(1), withBucketPolicy(`{ "Statement": [], "Version": "2012-10-17" }`)),
+			},
+			want: want{
+				cr: bucket(withUsername(testUsername), withPolicyVersion(1), withBucketPolicy(`{ "Statement": [], "Version": "2012-10-17" }`),
+					withConditions(runtimev1alpha1.Available()),
+					withBindingPhase(runtimev1alpha1.BindingPhaseUnbound)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(testRegion),
+					},
+				},
+			},
+		},
+		"Website": {
+			args: args{
+				s3: &fake.MockS3Client{
+					MockGetBucketInfo: func(_ context.Context, _ string, _ *v1alpha3.S3Bucket) (*s3.Bucket, error) {
+						return &s3.Bucket{
+							UserPolicyVersion: "v1",
+							Website:           &awss3.WebsiteConfiguration{IndexDocument: &awss3.IndexDocument{Suffix: aws.String("index.html")}},
+						}, nil
+					},
+				},
+				cr: bucket(withExternalName("site"), withUsername(testUsername), withPolicyVersion(1), withWebsite(website)),
+			},
+			want: want{
+				cr: bucket(withExternalName("site"), withUsername(testUsername), withPolicyVersion(1), withWebsite(website),
+					withConditions(runtimev1alpha1.Available()),
+					withBindingPhase(runtimev1alpha1.BindingPhaseUnbound)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(testRegion),
+						s3.WebsiteEndpointKey:                                []byte("site.s3-website-us-west-2.amazonaws.com"),
+					},
+				},
+			},
+		},
+		"VersioningChanged": {
+			args: args{
+				s3: &fake.MockS3Client{
+					MockGetBucketInfo: func(_ context.Context, _ string, _ *v1alpha3.S3Bucket) (*s3.Bucket, error) {
+						return &s3.Bucket{Versioning: false, UserPolicyVersion: "v1"}, nil
+					},
+				},
+				cr: bucket(withUsername(testUsername), withVersioning(true), withPolicyVersion(1)),
+			},
+			want: want{
+				cr: bucket(withUsername(testUsername), withVersioning(true), withPolicyVersion(1),
+					withConditions(runtimev1alpha1.Available()),
+					withBindingPhase(runtimev1alpha1.BindingPhaseUnbound)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: managed.ConnectionDetails{
+						runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(testRegion),
+					},
+				},
+			},
+		},
+		"PolicyChanged": {
+			args: args{
+				s3: &fake.MockS3Client{
+					MockGetBucketInfo: func(_ context.Context, _ string, _ *v1alpha3.S3Bucket) (*s3.Bucket, error) {
+						return &s3.Bucket{UserPolicyVersion: "v2"}, nil
+					},
+				},
+				cr: bucket(withUsername(testUsername), withPolicyVersion(1)),
+			},
+			want: want{
+				cr: bucket(withUsername(testUsername), withPolicyVersion(1),
+					withConditions(runtimev1alpha1.Available()),
+					withBindingPhase(runtimev1alpha1.BindingPhaseUnbound)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: managed.ConnectionDetails{
+						runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(testRegion),
+					},
+				},
+			},
+		},
+		"LifecycleChanged": {
+			args: args{
+				s3: &fake.MockS3Client{
+					MockGetBucketInfo: func(_ context.Context, _ string, _ *v1alpha3.S3Bucket) (*s3.Bucket, error) {
+						return &s3.Bucket{UserPolicyVersion: "v1"}, nil
+					},
+				},
+				cr: bucket(withUsername(testUsername), withPolicyVersion(1), withLifecycle(lifecycle)),
+			},
+			want: want{
+				cr: bucket(withUsername(testUsername), withPolicyVersion(1), withLifecycle(lifecycle),
+					withConditions(runtimev1alpha1.Available()),
+					withBindingPhase(runtimev1alpha1.BindingPhaseUnbound)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: managed.ConnectionDetails{
+						runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(testRegion),
+					},
+				},
+			},
+		},
+		"EncryptionChanged": {
+			args: args{
+				s3: &fake.MockS3Client{
+					MockGetBucketInfo: func(_ context.Context, _ string, _ *v1alpha3.S3Bucket) (*s3.Bucket, error) {
+						return &s3.Bucket{
+							UserPolicyVersion: "v1",
+							ServerSideEncryption: &awss3.ServerSideEncryptionConfiguration{
+								Rules: []awss3.ServerSideEncryptionRule{{
+									ApplyServerSideEncryptionByDefault: &awss3.ServerSideEncryptionByDefault{SSEAlgorithm: awss3.ServerSideEncryptionAes256},
+								}},
+							},
+						}, nil
+					},
+				},
+				cr: bucket(withUsername(testUsername), withPolicyVersion(1), withSSE(sse)),
+			},
+			want: want{
+				cr: bucket(withUsername(testUsername), withPolicyVersion(1), withSSE(sse),
+					withAtProvider(v1alpha3.S3BucketObservation{
+						ServerSideEncryptionConfiguration: &v1alpha3.ServerSideEncryptionConfiguration{
+							Rules: []v1alpha3.ServerSideEncryptionRule{{
+								ApplyServerSideEncryptionByDefault: v1alpha3.ServerSideEncryptionByDefault{SSEAlgorithm: string(awss3.ServerSideEncryptionAes256)},
+							}},
+						},
+					}),
+					withConditions(runtimev1alpha1.Available()),
+					withBindingPhase(runtimev1alpha1.BindingPhaseUnbound)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: managed.ConnectionDetails{
+						runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(testRegion),
+					},
+				},
+			},
+		},
+		"BucketNotFound": {
+			args: args{
+				s3: &fake.MockS3Client{
+					MockGetBucketInfo: func(_ context.Context, _ string, _ *v1alpha3.S3Bucket) (*s3.Bucket, error) {
+						return nil, awserr.New(awss3.ErrCodeNoSuchBucket, "", nil)
+					},
+				},
+				cr: bucket(withUsername(testUsername)),
+			},
+			want: want{
+				cr: bucket(withUsername(testUsername)),
+			},
+		},
+		"UserNotFound": {
+			args: args{
+				s3: &fake.MockS3Client{
+					MockGetBucketInfo: func(_ context.Context, _ string, _ *v1alpha3.S3Bucket) (*s3.Bucket, error) {
+						return nil, awserr.New(iam.ErrCodeNoSuchEntityException, "", nil)
+					},
+				},
+				cr: bucket(withUsername(testUsername)),
+			},
+			want: want{
+				cr: bucket(withUsername(testUsername)),
+			},
+		},
+		"GetBucketInfoFailed": {
+			args: args{
+				s3: &fake.MockS3Client{
+					MockGetBucketInfo: func(_ context.Context, _ string, _ *v1alpha3.S3Bucket) (*s3.Bucket, error) {
+						return nil, errBoom
+					},
+				},
+				cr: bucket(withUsername(testUsername)),
+			},
+			want: want{
+				cr:  bucket(withUsername(testUsername)),
+				err: errors.Wrap(errBoom, errGetBucketInfo),
+			},
+		},
 	}
-	expectedStatus = runtimev1alpha1.ConditionedStatus{}
-	expectedStatus.SetConditions(runtimev1alpha1.Deleting(), runtimev1alpha1.ReconcileError(testError))
 
-	rs, err = r._delete(tr, cl)
-	g.Expect(rs).To(Equal(resultRequeue))
-	g.Expect(err).NotTo(HaveOccurred())
-	g.Expect(called).To(BeTrue())
-	assertResource(g, r, expectedStatus)
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
 }
 
 func TestCreate(t *testing.T) {
-	g := NewGomegaWithT(t)
-
-	tr := testResource()
-
-	r := &Reconciler{
-		Client:              NewFakeClient(tr),
-		ConnectionPublisher: managed.PublisherChain{}, // A no-op publisher.
-		log:                 logging.NewNopLogger(),
+	type args struct {
+		kube client.Client
+		s3   s3.Service
+		cr   *v1alpha3.S3Bucket
+	}
+	type want struct {
+		cr     *v1alpha3.S3Bucket
+		result managed.ExternalCreation
+		err    error
 	}
 
-	createOrUpdateBucketCalled := false
-	createUserCalled := false
-	cl := &MockS3Client{
-		MockCreateUser: func(username string, bucket *S3Bucket) (*iam.AccessKey, string, error) {
-			createUserCalled = true
-			fakeKey := &iam.AccessKey{
-				AccessKeyId:     aws.String("fake-string"),
-				SecretAccessKey: aws.String(""),
-			}
-			return fakeKey, "v2", nil
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				s3: &fake.MockS3Client{
+					MockCreateOrUpdateBucket: func(_ context.Context, _ *v1alpha3.S3Bucket) error { return nil },
+					MockCreateUser: func(_ context.Context, _ string, _ *v1alpha3.S3Bucket) (*iam.AccessKey, string, error) {
+						return &iam.AccessKey{AccessKeyId: aws.String(testKeyID), SecretAccessKey: aws.String(testSecret)}, "v1", nil
+					},
+				},
+				cr: bucket(withUsername(testUsername)),
+			},
+			want: want{
+				cr: bucket(withUsername(testUsername), withPolicyVersion(1),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{
+						runtimev1alpha1.ResourceCredentialsSecretUserKey:     []byte(testKeyID),
+						runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte(testSecret),
+						runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(testRegion),
+					},
+				},
+			},
 		},
-		MockCreateOrUpdateBucket: func(bucket *S3Bucket) error {
-			createOrUpdateBucketCalled = true
-			return nil
+		"RetriedAfterCreateUserFailed": {
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				s3: &fake.MockS3Client{
+					MockCreateOrUpdateBucket: func(_ context.Context, _ *v1alpha3.S3Bucket) error { return nil },
+					MockCreateUser: func(_ context.Context, name string, _ *v1alpha3.S3Bucket) (*iam.AccessKey, string, error) {
+						if name != testUsername {
+							return nil, "", errBoom
+						}
+						return &iam.AccessKey{AccessKeyId: aws.String(testKeyID), SecretAccessKey: aws.String(testSecret)}, "v1", nil
+					},
+				},
+				cr: bucket(withUsername(testUsername), withConditions(runtimev1alpha1.Creating())),
+			},
+			want: want{
+				cr: bucket(withUsername(testUsername), withPolicyVersion(1),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{
+						runtimev1alpha1.ResourceCredentialsSecretUserKey:     []byte(testKeyID),
+						runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte(testSecret),
+						runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(testRegion),
+					},
+				},
+			},
+		},
+		"SuccessfulPolicyAccessMode": {
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				s3: &fake.MockS3Client{
+					MockCreateOrUpdateBucket: func(_ context.Context, _ *v1alpha3.S3Bucket) error { return nil },
+					MockCreateAccessPolicy: func(_ context.Context, _ string, _ *v1alpha3.S3Bucket) (string, string, error) {
+						return testPolicyARN, "v1", nil
+					},
+				},
+				cr: bucket(withAccessPolicy("", nil)),
+			},
+			want: want{
+				cr: bucket(withAccessPolicy("crossplane-bucket-", nil), withPolicyVersion(1),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{
+						runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(testRegion),
+						s3.AccessPolicyARNKey:                                []byte(testPolicyARN),
+					},
+				},
+			},
+		},
+		"CreateAccessPolicyFailed": {
+			args: args{
+				s3: &fake.MockS3Client{
+					MockCreateOrUpdateBucket: func(_ context.Context, _ *v1alpha3.S3Bucket) error { return nil },
+					MockCreateAccessPolicy: func(_ context.Context, _ string, _ *v1alpha3.S3Bucket) (string, string, error) {
+						return "", "", errBoom
+					},
+				},
+				cr: bucket(withAccessPolicy(testPolicyName, nil)),
+			},
+			want: want{
+				cr:  bucket(withAccessPolicy(testPolicyName, nil), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreateAccessPolicy),
+			},
+		},
+		"CreateBucketFailed": {
+			args: args{
+				s3: &fake.MockS3Client{
+					MockCreateOrUpdateBucket: func(_ context.Context, _ *v1alpha3.S3Bucket) error { return errBoom },
+				},
+				cr: bucket(),
+			},
+			want: want{
+				cr:  bucket(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreateBucket),
+			},
+		},
+		"ObjectLockWithoutVersioning": {
+			args: args{
+				s3: &fake.MockS3Client{},
+				cr: bucket(withObjectLock(&v1alpha3.ObjectLockConfiguration{})),
+			},
+			want: want{
+				cr:  bucket(withObjectLock(&v1alpha3.ObjectLockConfiguration{}), withConditions(runtimev1alpha1.Creating())),
+				err: errors.New(errObjectLockVersioning),
+			},
+		},
+		"KubeUpdateFailed": {
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				s3: &fake.MockS3Client{
+					MockCreateOrUpdateBucket: func(_ context.Context, _ *v1alpha3.S3Bucket) error { return nil },
+				},
+				cr: bucket(withUsername("")),
+			},
+			want: want{
+				cr:  bucket(withUsername("crossplane-bucket-"), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errKubeUpdateFailed),
+			},
+		},
+		"CreateUserFailed": {
+			args: args{
+				s3: &fake.MockS3Client{
+					MockCreateOrUpdateBucket: func(_ context.Context, _ *v1alpha3.S3Bucket) error { return nil },
+					MockCreateUser: func(_ context.Context, _ string, _ *v1alpha3.S3Bucket) (*iam.AccessKey, string, error) {
+						return nil, "", errBoom
+					},
+				},
+				cr: bucket(withUsername(testUsername)),
+			},
+			want: want{
+				cr:  bucket(withUsername(testUsername), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreateUser),
+			},
 		},
 	}
 
-	expectedStatus := runtimev1alpha1.ConditionedStatus{}
-	expectedStatus.SetConditions(runtimev1alpha1.Available(), runtimev1alpha1.ReconcileSuccess())
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.s3}
+			o, err := e.Create(context.Background(), tc.args.cr)
 
-	resource := testResource()
-	rs, err := r._create(resource, cl)
-	g.Expect(rs).To(Equal(result))
-	g.Expect(err).NotTo(HaveOccurred())
-	g.Expect(createOrUpdateBucketCalled).To(BeTrue())
-	g.Expect(createUserCalled).To(BeTrue())
-	assertResource(g, r, expectedStatus)
-	g.Expect(resource.Status.LastUserPolicyVersion).To(Equal(2))
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
 }
 
-func TestCreateSecretFail(t *testing.T) {
-	g := NewGomegaWithT(t)
-	tr := testResource()
-	cl := &MockS3Client{
-		MockCreateUser: func(username string, bucket *S3Bucket) (*iam.AccessKey, string, error) {
-			fakeKey := &iam.AccessKey{
-				AccessKeyId:     aws.String("fake-string"),
-				SecretAccessKey: aws.String(""),
-			}
-			return fakeKey, "v2", nil
-		},
-		MockCreateOrUpdateBucket: func(bucket *S3Bucket) error {
-			return nil
-		},
+func TestUpdate(t *testing.T) {
+	type args struct {
+		kube client.Client
+		s3   s3.Service
+		cr   *v1alpha3.S3Bucket
+	}
+	type want struct {
+		cr  *v1alpha3.S3Bucket
+		err error
 	}
 
-	testError := errors.New("test-publish-secret-error")
-	r := &Reconciler{
-		Client: NewFakeClient(tr),
-		ConnectionPublisher: managed.ConnectionPublisherFns{
-			PublishConnectionFn: func(_ context.Context, _ resource.Managed, _ managed.ConnectionDetails) error {
-				return testError
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				s3: &fake.MockS3Client{
+					MockGetBucketInfo: func(_ context.Context, _ string, _ *v1alpha3.S3Bucket) (*s3.Bucket, error) {
+						return &s3.Bucket{Versioning: false, UserPolicyVersion: "v2"}, nil
+					},
+					MockUpdateVersioning: func(_ context.Context, _ *v1alpha3.S3Bucket) error { return nil },
+					MockUpdateBucketACL:  func(_ context.Context, _ *v1alpha3.S3Bucket) error { return nil },
+					MockUpdatePolicyDocument: func(_ context.Context, _ string, _ *v1alpha3.S3Bucket) (string, error) {
+						return "v3", nil
+					},
+				},
+				cr: bucket(withUsername(testUsername), withVersioning(true), withPolicyVersion(1)),
+			},
+			want: want{
+				cr: bucket(withUsername(testUsername), withVersioning(true), withPolicyVersion(3)),
+			},
+		},
+		"PolicyUnchanged": {
+			args: args{
+				s3: &fake.MockS3Client{
+					MockGetBucketInfo: func(_ context.Context, _ string, _ *v1alpha3.S3Bucket) (*s3.Bucket, error) {
+						return &s3.Bucket{UserPolicyVersion: "v1"}, nil
+					},
+					MockUpdateBucketACL: func(_ context.Context, _ *v1alpha3.S3Bucket) error { return nil },
+				},
+				cr: bucket(withUsername(testUsername), withPolicyVersion(1)),
+			},
+			want: want{
+				cr: bucket(withUsername(testUsername), withPolicyVersion(1)),
+			},
+		},
+		"UpdateVersioningFailed": {
+			args: args{
+				s3: &fake.MockS3Client{
+					MockGetBucketInfo: func(_ context.Context, _ string, _ *v1alpha3.S3Bucket) (*s3.Bucket, error) {
+						return &s3.Bucket{UserPolicyVersion: "v1"}, nil
+					},
+					MockUpdateVersioning: func(_ context.Context, _ *v1alpha3.S3Bucket) error { return errBoom },
+				},
+				cr: bucket(withUsername(testUsername), withVersioning(true)),
+			},
+			want: want{
+				cr:  bucket(withUsername(testUsername), withVersioning(true)),
+				err: errors.Wrap(errBoom, errUpdateVersioning),
+			},
+		},
+		"UpdateACLFailed": {
+			args: args{
+				s3: &fake.MockS3Client{
+					MockGetBucketInfo: func(_ context.Context, _ string, _ *v1alpha3.S3Bucket) (*s3.Bucket, error) {
+						return &s3.Bucket{UserPolicyVersion: "v1"}, nil
+					},
+					MockUpdateBucketACL: func(_ context.Context, _ *v1alpha3.S3Bucket) error { return errBoom },
+				},
+				cr: bucket(withUsername(testUsername)),
+			},
+			want: want{
+				cr:  bucket(withUsername(testUsername)),
+				err: errors.Wrap(errBoom, errUpdateACL),
+			},
+		},
+		"UpdateLifecycleFailed": {
+			args: args{
+				s3: &fake.MockS3Client{
+					MockGetBucketInfo: func(_ context.Context, _ string, _ *v1alpha3.S3Bucket) (*s3.Bucket, error) {
+						return &s3.Bucket{UserPolicyVersion: "v1"}, nil
+					},
+					MockUpdateBucketACL:              func(_ context.Context, _ *v1alpha3.S3Bucket) error { return nil },
+					MockUpdateLifecycleConfiguration: func(_ context.Context, _ *v1alpha3.S3Bucket) error { return errBoom },
+				},
+				cr: bucket(withUsername(testUsername), withLifecycle(lifecycle)),
+			},
+			want: want{
+				cr:  bucket(withUsername(testUsername), withLifecycle(lifecycle)),
+				err: errors.Wrap(errBoom, errUpdateLifecycle),
+			},
+		},
+		"UpdateEncryptionFailed": {
+			args: args{
+				s3: &fake.MockS3Client{
+					MockGetBucketInfo: func(_ context.Context, _ string, _ *v1alpha3.S3Bucket) (*s3.Bucket, error) {
+						return &s3.Bucket{UserPolicyVersion: "v1"}, nil
+					},
+					MockUpdateBucketACL:            func(_ context.Context, _ *v1alpha3.S3Bucket) error { return nil },
+					MockUpdateServerSideEncryption: func(_ context.Context, _ *v1alpha3.S3Bucket) error { return errBoom },
+				},
+				cr: bucket(withUsername(testUsername), withSSE(sse)),
+			},
+			want: want{
+				cr:  bucket(withUsername(testUsername), withSSE(sse)),
+				err: errors.Wrap(errBoom, errUpdateSSE),
+			},
+		},
+		"UpdateBucketPolicyFailed": {
+			args: args{
+				s3: &fake.MockS3Client{
+					MockGetBucketInfo: func(_ context.Context, _ string, _ *v1alpha3.S3Bucket) (*s3.Bucket, error) {
+						return &s3.Bucket{UserPolicyVersion: "v1", Policy: aws.String(`{"Statement":[]}`)}, nil
+					},
+					MockUpdateBucketACL:    func(_ context.Context, _ *v1alpha3.S3Bucket) error { return nil },
+					MockUpdateBucketPolicy: func(_ context.Context, _ *v1alpha3.S3Bucket) error { return errBoom },
+				},
+				cr: bucket(withUsername(testUsername), withBucketPolicy(`{"Statement":[{"Effect":"Allow"}]}`)),
+			},
+			want: want{
+				cr:  bucket(withUsername(testUsername), withBucketPolicy(`{"Statement":[{"Effect":"Allow"}]}`)),
+				err: errors.Wrap(errBoom, errUpdateBucketPol),
+			},
+		},
+		"UpdateWebsiteFailed": {
+			args: args{
+				s3: &fake.MockS3Client{
+					MockGetBucketInfo: func(_ context.Context, _ string, _ *v1alpha3.S3Bucket) (*s3.Bucket, error) {
+						return &s3.Bucket{UserPolicyVersion: "v1"}, nil
+					},
+					MockUpdateBucketACL: func(_ context.Context, _ *v1alpha3.S3Bucket) error { return nil },
+					MockUpdateWebsite:   func(_ context.Context, _ *v1alpha3.S3Bucket) error { return errBoom },
+				},
+				cr: bucket(withUsername(testUsername), withWebsite(website)),
+			},
+			want: want{
+				cr:  bucket(withUsername(testUsername), withWebsite(website)),
+				err: errors.Wrap(errBoom, errUpdateWebsite),
+			},
+		},
+		"ReplicationSourceNotVersioned": {
+			args: args{
+				s3: &fake.MockS3Client{
+					MockGetBucketInfo: func(_ context.Context, _ string, _ *v1alpha3.S3Bucket) (*s3.Bucket, error) {
+						return &s3.Bucket{UserPolicyVersion: "v1"}, nil
+					},
+					MockUpdateBucketACL: func(_ context.Context, _ *v1alpha3.S3Bucket) error { return nil },
+				},
+				cr: bucket(withUsername(testUsername), withReplication(replication)),
+			},
+			want: want{
+				cr:  bucket(withUsername(testUsername), withReplication(replication)),
+				err: errors.New(errSourceVersioning),
+			},
+		},
+		"ReplicationDestinationNotVersioned": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+				s3: &fake.MockS3Client{
+					MockGetBucketInfo: func(_ context.Context, _ string, _ *v1alpha3.S3Bucket) (*s3.Bucket, error) {
+						return &s3.Bucket{Versioning: true, UserPolicyVersion: "v1"}, nil
+					},
+					MockUpdateBucketACL: func(_ context.Context, _ *v1alpha3.S3Bucket) error { return nil },
+				},
+				cr: bucket(withUsername(testUsername), withVersioning(true), withReplication(replication)),
+			},
+			want: want{
+				cr:  bucket(withUsername(testUsername), withVersioning(true), withReplication(replication)),
+				err: errors.Errorf(errDestinationVersioning, "replica"),
+			},
+		},
+		"GetReplicationDestinationFailed": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				s3: &fake.MockS3Client{
+					MockGetBucketInfo: func(_ context.Context, _ string, _ *v1alpha3.S3Bucket) (*s3.Bucket, error) {
+						return &s3.Bucket{Versioning: true, UserPolicyVersion: "v1"}, nil
+					},
+					MockUpdateBucketACL: func(_ context.Context, _ *v1alpha3.S3Bucket) error { return nil },
+				},
+				cr: bucket(withUsername(testUsername), withVersioning(true), withReplication(replication)),
+			},
+			want: want{
+				cr:  bucket(withUsername(testUsername), withVersioning(true), withReplication(replication)),
+				err: errors.Wrap(errBoom, errGetDestination),
+			},
+		},
+		"UpdateReplicationFailed": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(nil, func(obj runtime.Object) error {
+					obj.(*v1alpha3.S3Bucket).Spec.Versioning = true
+					return nil
+				})},
+				s3: &fake.MockS3Client{
+					MockGetBucketInfo: func(_ context.Context, _ string, _ *v1alpha3.S3Bucket) (*s3.Bucket, error) {
+						return &s3.Bucket{Versioning: true, UserPolicyVersion: "v1"}, nil
+					},
+					MockUpdateBucketACL:   func(_ context.Context, _ *v1alpha3.S3Bucket) error { return nil },
+					MockUpdateReplication: func(_ context.Context, _ *v1alpha3.S3Bucket) error { return errBoom },
+				},
+				cr: bucket(withUsername(testUsername), withVersioning(true), withReplication(replication)),
+			},
+			want: want{
+				cr:  bucket(withUsername(testUsername), withVersioning(true), withReplication(replication)),
+				err: errors.Wrap(errBoom, errUpdateReplication),
+			},
+		},
+		"UpdateTagsFailed": {
+			args: args{
+				s3: &fake.MockS3Client{
+					MockGetBucketInfo: func(_ context.Context, _ string, _ *v1alpha3.S3Bucket) (*s3.Bucket, error) {
+						return &s3.Bucket{UserPolicyVersion: "v1"}, nil
+					},
+					MockUpdateBucketACL: func(_ context.Context, _ *v1alpha3.S3Bucket) error { return nil },
+					MockUpdateTags:      func(_ context.Context, _ *v1alpha3.S3Bucket) error { return errBoom },
+				},
+				cr: bucket(withUsername(testUsername), withTags(map[string]string{"k": "v"})),
+			},
+			want: want{
+				cr:  bucket(withUsername(testUsername), withTags(map[string]string{"k": "v"})),
+				err: errors.Wrap(errBoom, errUpdateTags),
+			},
+		},
+		"RemoveTagsFailed": {
+			args: args{
+				s3: &fake.MockS3Client{
+					MockGetBucketInfo: func(_ context.Context, _ string, _ *v1alpha3.S3Bucket) (*s3.Bucket, error) {
+						return &s3.Bucket{UserPolicyVersion: "v1", Tags: []awss3.Tag{{Key: aws.String("k"), Value: aws.String("v")}}}, nil
+					},
+					MockUpdateBucketACL: func(_ context.Context, _ *v1alpha3.S3Bucket) error { return nil },
+					MockUpdateTags:      func(_ context.Context, _ *v1alpha3.S3Bucket) error { return errBoom },
+				},
+				cr: bucket(withUsername(testUsername), withPolicyVersion(1)),
+			},
+			want: want{
+				cr:  bucket(withUsername(testUsername), withPolicyVersion(1)),
+				err: errors.Wrap(errBoom, errUpdateTags),
+			},
+		},
+		"UpdateObjectLockFailed": {
+			args: args{
+				s3: &fake.MockS3Client{
+					MockGetBucketInfo: func(_ context.Context, _ string, _ *v1alpha3.S3Bucket) (*s3.Bucket, error) {
+						return &s3.Bucket{Versioning: true, UserPolicyVersion: "v1"}, nil
+					},
+					MockUpdateBucketACL:  func(_ context.Context, _ *v1alpha3.S3Bucket) error { return nil },
+					MockUpdateObjectLock: func(_ context.Context, _ *v1alpha3.S3Bucket) error { return errBoom },
+				},
+				cr: bucket(withUsername(testUsername), withVersioning(true), withObjectLock(&v1alpha3.ObjectLockConfiguration{})),
+			},
+			want: want{
+				cr:  bucket(withUsername(testUsername), withVersioning(true), withObjectLock(&v1alpha3.ObjectLockConfiguration{})),
+				err: errors.Wrap(errBoom, errUpdateObjectLock),
+			},
+		},
+		"ObjectLockWithoutVersioning": {
+			args: args{
+				s3: &fake.MockS3Client{},
+				cr: bucket(withUsername(testUsername), withObjectLock(&v1alpha3.ObjectLockConfiguration{})),
+			},
+			want: want{
+				cr:  bucket(withUsername(testUsername), withObjectLock(&v1alpha3.ObjectLockConfiguration{})),
+				err: errors.New(errObjectLockVersioning),
+			},
+		},
+		"UpdateRequestPayerFailed": {
+			args: args{
+				s3: &fake.MockS3Client{
+					MockGetBucketInfo: func(_ context.Context, _ string, _ *v1alpha3.S3Bucket) (*s3.Bucket, error) {
+						return &s3.Bucket{UserPolicyVersion: "v1", RequestPayer: awss3.PayerBucketOwner}, nil
+					},
+					MockUpdateBucketACL:    func(_ context.Context, _ *v1alpha3.S3Bucket) error { return nil },
+					MockUpdateRequestPayer: func(_ context.Context, _ *v1alpha3.S3Bucket) error { return errBoom },
+				},
+				cr: bucket(withUsername(testUsername), withRequestPayer(string(awss3.PayerRequester))),
+			},
+			want: want{
+				cr:  bucket(withUsername(testUsername), withRequestPayer(string(awss3.PayerRequester))),
+				err: errors.Wrap(errBoom, errUpdateRequestPayer),
+			},
+		},
+		"AttachAccessPolicyFailed": {
+			args: args{
+				s3: &fake.MockS3Client{
+					MockGetBucketInfo: func(_ context.Context, _ string, _ *v1alpha3.S3Bucket) (*s3.Bucket, error) {
+						return &s3.Bucket{UserPolicyVersion: "v1", AccessPolicyARN: testPolicyARN}, nil
+					},
+					MockUpdateBucketACL: func(_ context.Context, _ *v1alpha3.S3Bucket) error { return nil },
+					MockAttachAccessPolicy: func(_ context.Context, _ string, _ *v1alpha3.S3Bucket) error {
+						return errBoom
+					},
+				},
+				cr: bucket(withAccessPolicy(testPolicyName, aws.String(testRoleName))),
+			},
+			want: want{
+				cr:  bucket(withAccessPolicy(testPolicyName, aws.String(testRoleName))),
+				err: errors.Wrap(errBoom, errAttachAccessPolicy),
+			},
+		},
+		"RoleChanged": {
+			args: args{
+				s3: &fake.MockS3Client{
+					MockGetBucketInfo: func(_ context.Context, _ string, _ *v1alpha3.S3Bucket) (*s3.Bucket, error) {
+						return &s3.Bucket{UserPolicyVersion: "v1", AccessPolicyARN: testPolicyARN, AccessPolicyRoles: []string{"old-role"}}, nil
+					},
+					MockUpdateBucketACL: func(_ context.Context, _ *v1alpha3.S3Bucket) error { return nil },
+					MockAttachAccessPolicy: func(_ context.Context, name string, cr *v1alpha3.S3Bucket) error {
+						if name != testPolicyName || aws.StringValue(cr.Spec.IAMRoleName) != testRoleName {
+							return errBoom
+						}
+						return nil
+					},
+					MockDetachAccessPolicy: func(_ context.Context, name string, role string) error {
+						if name != testPolicyName || role != "old-role" {
+							return errBoom
+						}
+						return nil
+					},
+				},
+				cr: bucket(withAccessPolicy(testPolicyName, aws.String(testRoleName)), withPolicyVersion(1)),
+			},
+			want: want{
+				cr: bucket(withAccessPolicy(testPolicyName, aws.String(testRoleName)), withPolicyVersion(1)),
+			},
+		},
+		"DetachAccessPolicyFailed": {
+			args: args{
+				s3: &fake.MockS3Client{
+					MockGetBucketInfo: func(_ context.Context, _ string, _ *v1alpha3.S3Bucket) (*s3.Bucket, error) {
+						return &s3.Bucket{UserPolicyVersion: "v1", AccessPolicyARN: testPolicyARN, AccessPolicyRoles: []string{testRoleName, "old-role"}}, nil
+					},
+					MockUpdateBucketACL:    func(_ context.Context, _ *v1alpha3.S3Bucket) error { return nil },
+					MockAttachAccessPolicy: func(_ context.Context, _ string, _ *v1alpha3.S3Bucket) error { return nil },
+					MockDetachAccessPolicy: func(_ context.Context, _ string, _ string) error { return errBoom },
+				},
+				cr: bucket(withAccessPolicy(testPolicyName, aws.String(testRoleName))),
+			},
+			want: want{
+				cr:  bucket(withAccessPolicy(testPolicyName, aws.String(testRoleName))),
+				err: errors.Wrap(errBoom, errDetachAccessPolicy),
+			},
+		},
+		"UpdatePolicyFailed": {
+			args: args{
+				s3: &fake.MockS3Client{
+					MockGetBucketInfo: func(_ context.Context, _ string, _ *v1alpha3.S3Bucket) (*s3.Bucket, error) {
+						return &s3.Bucket{UserPolicyVersion: "v2"}, nil
+					},
+					MockUpdateBucketACL: func(_ context.Context, _ *v1alpha3.S3Bucket) error { return nil },
+					MockUpdatePolicyDocument: func(_ context.Context, _ string, _ *v1alpha3.S3Bucket) (string, error) {
+						return "", errBoom
+					},
+				},
+				cr: bucket(withUsername(testUsername), withPolicyVersion(1)),
+			},
+			want: want{
+				cr:  bucket(withUsername(testUsername), withPolicyVersion(1)),
+				err: errors.Wrap(errBoom, errUpdatePolicy),
+			},
+		},
+		"GetBucketInfoFailed": {
+			args: args{
+				s3: &fake.MockS3Client{
+					MockGetBucketInfo: func(_ context.Context, _ string, _ *v1alpha3.S3Bucket) (*s3.Bucket, error) {
+						return nil, errBoom
+					},
+				},
+				cr: bucket(withUsername(testUsername)),
+			},
+			want: want{
+				cr:  bucket(withUsername(testUsername)),
+				err: errors.Wrap(errBoom, errGetBucketInfo),
 			},
 		},
-		log: logging.NewNopLogger(),
 	}
 
-	expectedStatus := runtimev1alpha1.ConditionedStatus{}
-	expectedStatus.SetConditions(runtimev1alpha1.Creating(), runtimev1alpha1.ReconcileError(testError))
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.s3}
+			_, err := e.Update(context.Background(), tc.args.cr)
 
-	rs, err := r._create(tr, cl)
-	g.Expect(rs).To(Equal(resultRequeue))
-	g.Expect(err).NotTo(HaveOccurred())
-	assertResource(g, r, expectedStatus)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
 }
 
-func TestCreateUserFail(t *testing.T) {
-	g := NewGomegaWithT(t)
-	tr := testResource()
-	testError := errors.New("test-create-user--error")
-	r := &Reconciler{
-		Client: NewFakeClient(tr),
-		ConnectionPublisher: managed.ConnectionPublisherFns{
-			PublishConnectionFn: func(_ context.Context, _ resource.Managed, _ managed.ConnectionDetails) error {
-				return testError
+func TestDelete(t *testing.T) {
+	type args struct {
+		s3 s3.Service
+		cr *v1alpha3.S3Bucket
+	}
+	type want struct {
+		cr  *v1alpha3.S3Bucket
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				s3: &fake.MockS3Client{
+					MockDelete: func(_ context.Context, _ *v1alpha3.S3Bucket) error { return nil },
+				},
+				cr: bucket(withUsername(testUsername)),
+			},
+			want: want{
+				cr: bucket(withUsername(testUsername), withConditions(runtimev1alpha1.Deleting())),
 			},
 		},
-		log: logging.NewNopLogger(),
-	}
-	called := false
-	cl := &MockS3Client{
-		MockCreateUser: func(username string, bucket *S3Bucket) (*iam.AccessKey, string, error) {
-			called = true
-			return nil, "", testError
+		"BucketNotEmpty": {
+			args: args{
+				s3: &fake.MockS3Client{
+					MockDelete: func(_ context.Context, _ *v1alpha3.S3Bucket) error {
+						return awserr.New(s3.BucketNotEmptyErrCode, "", nil)
+					},
+				},
+				cr: bucket(withUsername(testUsername)),
+			},
+			want: want{
+				cr:  bucket(withUsername(testUsername), withConditions(v1alpha3.BucketNotEmpty())),
+				err: errors.Wrap(awserr.New(s3.BucketNotEmptyErrCode, "", nil), errDeleteBucket),
+			},
+		},
+		"DeleteContentsPage": {
+			args: args{
+				s3: &fake.MockS3Client{
+					MockEmptyBucket: func(_ context.Context, _ *v1alpha3.S3Bucket) (int, error) { return 42, nil },
+					MockDelete:      func(_ context.Context, _ *v1alpha3.S3Bucket) error { return errBoom },
+				},
+				cr: bucket(withUsername(testUsername), withDeletionMode(v1alpha3.DeletionModeDeleteContents), withDeletedObjects(1000)),
+			},
+			want: want{
+				cr: bucket(withUsername(testUsername), withDeletionMode(v1alpha3.DeletionModeDeleteContents),
+					withDeletedObjects(1042), withConditions(runtimev1alpha1.Deleting())),
+			},
 		},
-		MockCreateOrUpdateBucket: func(bucket *S3Bucket) error {
-			return nil
+		"DeleteContentsEmpty": {
+			args: args{
+				s3: &fake.MockS3Client{
+					MockEmptyBucket: func(_ context.Context, _ *v1alpha3.S3Bucket) (int, error) { return 0, nil },
+					MockDelete:      func(_ context.Context, _ *v1alpha3.S3Bucket) error { return nil },
+				},
+				cr: bucket(withUsername(testUsername), withDeletionMode(v1alpha3.DeletionModeDeleteContents), withDeletedObjects(1042)),
+			},
+			want: want{
+				cr: bucket(withUsername(testUsername), withDeletionMode(v1alpha3.DeletionModeDeleteContents),
+					withDeletedObjects(1042), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"EmptyBucketFailed": {
+			args: args{
+				s3: &fake.MockS3Client{
+					MockEmptyBucket: func(_ context.Context, _ *v1alpha3.S3Bucket) (int, error) { return 7, errBoom },
+				},
+				cr: bucket(withUsername(testUsername), withDeletionMode(v1alpha3.DeletionModeDeleteContents), withDeletedObjects(1000)),
+			},
+			want: want{
+				cr: bucket(withUsername(testUsername), withDeletionMode(v1alpha3.DeletionModeDeleteContents),
+					withDeletedObjects(1007), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errEmptyBucket),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				s3: &fake.MockS3Client{
+					MockDelete: func(_ context.Context, _ *v1alpha3.S3Bucket) error { return errBoom },
+				},
+				cr: bucket(withUsername(testUsername)),
+			},
+			want: want{
+				cr:  bucket(withUsername(testUsername), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteBucket),
+			},
 		},
 	}
 
-	expectedStatus := runtimev1alpha1.ConditionedStatus{}
-	expectedStatus.SetConditions(runtimev1alpha1.Creating(), runtimev1alpha1.ReconcileError(testError))
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3}
+			err := e.Delete(context.Background(), tc.args.cr)
 
-	rs, err := r._create(tr, cl)
-	g.Expect(rs).To(Equal(resultRequeue))
-	g.Expect(err).NotTo(HaveOccurred())
-	g.Expect(called).To(BeTrue())
-	assertResource(g, r, expectedStatus)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
 }
 
-func TestCreateBucketFail(t *testing.T) {
-	g := NewGomegaWithT(t)
-	tr := testResource()
-	testError := errors.New("test-create-bucket--error")
-	r := &Reconciler{
-		Client: NewFakeClient(tr),
-		ConnectionPublisher: managed.ConnectionPublisherFns{
-			PublishConnectionFn: func(_ context.Context, _ resource.Managed, _ managed.ConnectionDetails) error {
-				return testError
-			},
-		},
-		log: logging.NewNopLogger(),
-	}
-	called := false
-	cl := &MockS3Client{
-		MockCreateUser: func(username string, bucket *S3Bucket) (*iam.AccessKey, string, error) {
-			fakeKey := &iam.AccessKey{
-				AccessKeyId:     aws.String("fake-string"),
-				SecretAccessKey: aws.String(""),
-			}
-			return fakeKey, "v2", nil
+func TestFinalizer(t *testing.T) {
+	type args struct {
+		kube client.Client
+		cr   *v1alpha3.S3Bucket
+	}
+	type want struct {
+		cr  *v1alpha3.S3Bucket
+		err error
+	}
+
+	cases := map[string]struct {
+		fn func(*finalizer) func(context.Context, resource.Object) error
+		args
+		want
+	}{
+		"AddMigratesLegacyFinalizer": {
+			fn: func(f *finalizer) func(context.Context, resource.Object) error { return f.AddFinalizer },
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   bucket(withFinalizers(legacyFinalizer)),
+			},
+			want: want{
+				cr: bucket(withFinalizers(managedFinalizer)),
+			},
+		},
+		"AddExisting": {
+			fn: func(f *finalizer) func(context.Context, resource.Object) error { return f.AddFinalizer },
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				cr:   bucket(withFinalizers(managedFinalizer)),
+			},
+			want: want{
+				cr: bucket(withFinalizers(managedFinalizer)),
+			},
 		},
-		MockCreateOrUpdateBucket: func(bucket *S3Bucket) error {
-			called = true
-			return testError
+		"RemoveLegacyFinalizer": {
+			fn: func(f *finalizer) func(context.Context, resource.Object) error { return f.RemoveFinalizer },
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   bucket(withFinalizers(legacyFinalizer)),
+			},
+			want: want{
+				cr: bucket(withFinalizers()),
+			},
+		},
+		"RemoveBothFinalizers": {
+			fn: func(f *finalizer) func(context.Context, resource.Object) error { return f.RemoveFinalizer },
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   bucket(withFinalizers(managedFinalizer, legacyFinalizer)),
+			},
+			want: want{
+				cr: bucket(withFinalizers()),
+			},
+		},
+		"RemoveFailed": {
+			fn: func(f *finalizer) func(context.Context, resource.Object) error { return f.RemoveFinalizer },
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				cr:   bucket(withFinalizers(legacyFinalizer)),
+			},
+			want: want{
+				cr:  bucket(withFinalizers()),
+				err: errors.Wrap(errBoom, errKubeUpdateFailed),
+			},
 		},
-	}
-	cl.MockCreateUser = func(username string, bucket *S3Bucket) (*iam.AccessKey, string, error) {
-		fakeKey := &iam.AccessKey{
-			AccessKeyId:     aws.String("fake-string"),
-			SecretAccessKey: aws.String(""),
-		}
-		return fakeKey, "v2", nil
 	}
 
-	expectedStatus := runtimev1alpha1.ConditionedStatus{}
-	expectedStatus.SetConditions(runtimev1alpha1.Creating(), runtimev1alpha1.ReconcileError(testError))
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			f := &finalizer{kube: tc.kube}
+			err := tc.fn(f)(context.Background(), tc.args.cr)
 
-	rs, err := r._create(tr, cl)
-	g.Expect(rs).To(Equal(resultRequeue))
-	g.Expect(err).NotTo(HaveOccurred())
-	g.Expect(called).To(BeTrue())
-	assertResource(g, r, expectedStatus)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
 }
 
-func TestReconcile(t *testing.T) {
-	g := NewGomegaWithT(t)
-
-	tr := testResource()
-	tr.Spec.IAMUsername = ""
-	kube := NewFakeClient(tr)
-	r := &Reconciler{
-		Client:      kube,
-		log:         logging.NewNopLogger(),
-		initializer: managed.NewNameAsExternalName(kube),
-	}
-
-	// test connect error
-	called := false
-	testError := errors.New("test-connect-error")
-	r.connect = func(*S3Bucket) (client.Service, error) {
-		called = true
-		return nil, testError
-	}
-
-	expectedStatus := runtimev1alpha1.ConditionedStatus{}
-	expectedStatus.SetConditions(runtimev1alpha1.ReconcileError(testError))
-
-	rs, err := r.Reconcile(request)
-	g.Expect(rs).To(Equal(resultRequeue))
-	g.Expect(err).NotTo(HaveOccurred())
-	g.Expect(called).To(BeTrue())
-	assertResource(g, r, expectedStatus)
-
-	// test delete
-	r.connect = func(instance *S3Bucket) (client client.Service, e error) {
-		t := metav1.Now()
-		instance.DeletionTimestamp = &t
-		return nil, nil
-	}
-	called = false
-	r.delete = func(instance *S3Bucket, client client.Service) (i reconcile.Result, e error) {
-		called = true
-		return result, nil
-	}
-	r.Reconcile(request)
-	g.Expect(called).To(BeTrue())
-
-	// test create
-	r.connect = func(instance *S3Bucket) (client client.Service, e error) {
-		return nil, nil
-	}
-	called = false
-	r.delete = r._delete
-	r.create = func(instance *S3Bucket, client client.Service) (i reconcile.Result, e error) {
-		called = true
-		return result, nil
-	}
-	r.Reconcile(request)
-	g.Expect(called).To(BeTrue())
-
-	// test sync
-	r.connect = func(instance *S3Bucket) (client client.Service, e error) {
-		instance.Spec.IAMUsername = "foo-user"
-		return nil, nil
-	}
-	called = false
-	r.create = r._create
-	r.sync = func(instance *S3Bucket, client client.Service) (i reconcile.Result, e error) {
-		called = true
-		return result, nil
-	}
-	r.Reconcile(request)
-	g.Expect(called).To(BeTrue())
+func TestInitialize(t *testing.T) {
+	type args struct {
+		kube client.Client
+		cr   *v1alpha3.S3Bucket
+	}
+	type want struct {
+		cr  *v1alpha3.S3Bucket
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   bucket(withTags(map[string]string{"foo": "bar"})),
+			},
+			want: want{
+				cr: bucket(withTags(resource.GetExternalTags(bucket()), map[string]string{"foo": "bar"})),
+			},
+		},
+		"Unchanged": {
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				cr:   bucket(withTags(resource.GetExternalTags(bucket()), map[string]string{"foo": "bar"})),
+			},
+			want: want{
+				cr: bucket(withTags(resource.GetExternalTags(bucket()), map[string]string{"foo": "bar"})),
+			},
+		},
+		"UpdateFailed": {
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				cr:   bucket(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errKubeUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &tagger{kube: tc.kube}
+			err := e.Initialize(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, cmpopts.SortSlices(func(a, b v1alpha3.Tag) bool { return a.Key > b.Key })); err == nil && diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
 }